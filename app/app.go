@@ -163,10 +163,17 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		ibcclientclient.UpdateClientProposalHandler,
 		ibcclientclient.UpgradeProposalHandler,
 		stakeibcclient.AddValidatorsProposalHandler,
+		stakeibcclient.UpdateHostZoneProposalHandler,
 		ratelimitclient.AddRateLimitProposalHandler,
 		ratelimitclient.UpdateRateLimitProposalHandler,
 		ratelimitclient.RemoveRateLimitProposalHandler,
 		ratelimitclient.ResetRateLimitProposalHandler,
+		ratelimitclient.AddBlacklistedDenomProposalHandler,
+		ratelimitclient.RemoveBlacklistedDenomProposalHandler,
+		ratelimitclient.AddWhitelistedAddressPairProposalHandler,
+		ratelimitclient.RemoveWhitelistedAddressPairProposalHandler,
+		ratelimitclient.SetChannelGroupProposalHandler,
+		ratelimitclient.RemoveChannelGroupProposalHandler,
 	)
 
 	return govProposalHandlers
@@ -438,6 +445,7 @@ func NewStrideApp(
 		app.GetSubspace(ratelimitmoduletypes.ModuleName),
 		app.BankKeeper,
 		app.IBCKeeper.ChannelKeeper,
+		epochsKeeper,
 		// TODO: Implement ICS4Wrapper in Records and pass records keeper here
 		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
 	)
@@ -526,6 +534,7 @@ func NewStrideApp(
 		app.StakingKeeper,
 		app.IcacallbacksKeeper,
 		app.RatelimitKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	app.StakeibcKeeper = *stakeibcKeeper.SetHooks(
 		stakeibcmoduletypes.NewMultiStakeIBCHooks(app.ClaimKeeper.Hooks()),
@@ -539,7 +548,8 @@ func NewStrideApp(
 		keys[autopilottypes.StoreKey],
 		app.GetSubspace(autopilottypes.ModuleName),
 		app.StakeibcKeeper,
-		app.ClaimKeeper)
+		app.ClaimKeeper,
+		app.TransferKeeper)
 	autopilotModule := autopilot.NewAppModule(appCodec, app.AutopilotKeeper)
 
 	// Register Gov (must be registerd after stakeibc)
@@ -571,6 +581,7 @@ func NewStrideApp(
 			app.MintKeeper.Hooks(),
 			app.ClaimKeeper.Hooks(),
 			app.RatelimitKeeper.Hooks(),
+			app.InterchainqueryKeeper.Hooks(),
 		),
 	)
 	epochsModule := epochsmodule.NewAppModule(appCodec, app.EpochsKeeper)
@@ -13,6 +13,7 @@ import (
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
 	v10 "github.com/Stride-Labs/stride/v10/app/upgrades/v10"
+	v11 "github.com/Stride-Labs/stride/v10/app/upgrades/v11"
 	v2 "github.com/Stride-Labs/stride/v10/app/upgrades/v2"
 	v3 "github.com/Stride-Labs/stride/v10/app/upgrades/v3"
 	v4 "github.com/Stride-Labs/stride/v10/app/upgrades/v4"
@@ -133,6 +134,12 @@ func (app *StrideApp) setupUpgradeHandlers() {
 		),
 	)
 
+	// v11 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v11.UpgradeName,
+		v11.CreateUpgradeHandler(app.mm, app.configurator, app.ParamsKeeper),
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(fmt.Errorf("Failed to read upgrade info from disk: %w", err))
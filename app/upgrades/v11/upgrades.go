@@ -0,0 +1,49 @@
+package v11
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	stakeibctypes "github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+var (
+	UpgradeName = "v11"
+)
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v11
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	paramsKeeper paramskeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("Starting upgrade v11...")
+
+		ctx.Logger().Info("Adding new stakeibc params...")
+		if err := AddStakeibcParams(ctx, paramsKeeper); err != nil {
+			return vm, errorsmod.Wrapf(err, "unable to add stakeibc params")
+		}
+
+		ctx.Logger().Info("Running module migrations...")
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
+
+// Initializes any stakeibc params that were added since the last upgrade to their default values,
+// leaving the existing params unchanged
+func AddStakeibcParams(ctx sdk.Context, paramsKeeper paramskeeper.Keeper) error {
+	subspace, found := paramsKeeper.GetSubspace(stakeibctypes.ModuleName)
+	if !found {
+		return errorsmod.Wrapf(stakeibctypes.ErrParamNotFound, "stakeibc param subspace not found")
+	}
+
+	params := stakeibctypes.DefaultParams()
+	subspace.GetParamSetIfExists(ctx, &params)
+	subspace.SetParamSet(ctx, &params)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package v11_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/v10/app/apptesting"
+	stakeibctypes "github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+type UpgradeTestSuite struct {
+	apptesting.AppTestHelper
+}
+
+func (s *UpgradeTestSuite) SetupTest() {
+	s.Setup()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (s *UpgradeTestSuite) TestUpgrade() {
+	dummyUpgradeHeight := int64(5)
+
+	// Remove the new params from the store to simulate the state before the upgrade
+	// and update one of the existing params to confirm it is unchanged
+	subspace, found := s.App.ParamsKeeper.GetSubspace(stakeibctypes.ModuleName)
+	s.Require().True(found, "stakeibc subspace found")
+
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.StrideCommission = 5
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	paramStore := s.Ctx.KVStore(s.App.GetKey("params"))
+	for _, newParamKey := range [][]byte{
+		stakeibctypes.KeyRedemptionRateHistoryRetention,
+		stakeibctypes.KeyMaxAutoClaimsPerEpoch,
+		stakeibctypes.KeyValidatorScoreSignedBlocksWindow,
+		stakeibctypes.KeyValidatorScoreVotingPowerCap,
+		stakeibctypes.KeyMaxValidatorWeightPercent,
+		stakeibctypes.KeyAutoRebalanceThresholdPercent,
+		stakeibctypes.KeyMaxAutoRebalanceRedelegations,
+		stakeibctypes.KeyMaxRedelegationEntries,
+		stakeibctypes.KeyMaxUnbondingEntries,
+	} {
+		paramStore.Delete(append([]byte(subspace.Name()+"/"), newParamKey...))
+		s.Require().False(subspace.Has(s.Ctx, newParamKey), "%s param removed", newParamKey)
+	}
+
+	s.ConfirmUpgradeSucceededs("v11", dummyUpgradeHeight)
+
+	// Confirm the new params were added with their defaults and the old params were unchanged
+	params = s.App.StakeibcKeeper.GetParams(s.Ctx)
+	s.Require().Equal(stakeibctypes.DefaultRedemptionRateHistoryRetention, params.RedemptionRateHistoryRetention, "retention param")
+	s.Require().Equal(stakeibctypes.DefaultMaxAutoClaimsPerEpoch, params.MaxAutoClaimsPerEpoch, "max auto claims param")
+	s.Require().Equal(stakeibctypes.DefaultValidatorScoreSignedBlocksWindow, params.ValidatorScoreSignedBlocksWindow, "signed blocks window param")
+	s.Require().Equal(stakeibctypes.DefaultValidatorScoreVotingPowerCap, params.ValidatorScoreVotingPowerCap, "voting power cap param")
+	s.Require().Equal(stakeibctypes.DefaultMaxValidatorWeightPercent, params.MaxValidatorWeightPercent, "max validator weight param")
+	s.Require().Equal(stakeibctypes.DefaultAutoRebalanceThresholdPercent, params.AutoRebalanceThresholdPercent, "rebalance threshold param")
+	s.Require().Equal(stakeibctypes.DefaultMaxAutoRebalanceRedelegations, params.MaxAutoRebalanceRedelegations, "max auto rebalance redelegations param")
+	s.Require().Equal(stakeibctypes.DefaultMaxRedelegationEntries, params.MaxRedelegationEntries, "max redelegation entries param")
+	s.Require().Equal(stakeibctypes.DefaultMaxUnbondingEntries, params.MaxUnbondingEntries, "max unbonding entries param")
+	s.Require().Equal(uint64(5), params.StrideCommission, "stride commission")
+}
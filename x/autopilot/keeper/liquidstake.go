@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -57,27 +58,82 @@ func (k Keeper) TryLiquidStaking(
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid stride_address (%s) in autopilot memo", strideAddress)
 	}
 
-	return k.RunLiquidStake(ctx, strideAddress, token)
+	minStTokenOut, err := types.ParseMinOutAmount(packetMetadata.MinStTokenOut)
+	if err != nil {
+		return err
+	}
+
+	stToken, err := k.RunLiquidStake(ctx, strideAddress, token, minStTokenOut)
+	if err != nil {
+		return err
+	}
+
+	// If an ibc receiver was specified, forward the minted stTokens back over IBC
+	if packetMetadata.IbcReceiver == "" {
+		return nil
+	}
+
+	// Default to sending the stTokens back over the channel that the native tokens arrived on
+	transferChannel := packetMetadata.TransferChannel
+	if transferChannel == "" {
+		transferChannel = packet.GetDestChannel()
+	}
+
+	// If the transfer can't be initiated, the stTokens are left in the stride address and the liquid stake still succeeds
+	// If the transfer is later rejected or times out, the stTokens are refunded to the stride address by the transfer module
+	if err := k.RunForwardStTokens(ctx, strideAddress, packetMetadata.IbcReceiver, transferChannel, stToken); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to forward %v to %s on %s, stTokens remain with %s: %s",
+			stToken, packetMetadata.IbcReceiver, transferChannel, strideAddress, err.Error()))
+	}
+
+	return nil
 }
 
-func (k Keeper) RunLiquidStake(ctx sdk.Context, addr sdk.AccAddress, token sdk.Coin) error {
+// Liquid stakes the native tokens and returns the minted stTokens
+func (k Keeper) RunLiquidStake(ctx sdk.Context, addr sdk.AccAddress, token sdk.Coin, minStTokenOut sdkmath.Int) (sdk.Coin, error) {
 	msg := &stakeibctypes.MsgLiquidStake{
-		Creator:   addr.String(),
-		Amount:    token.Amount,
-		HostDenom: token.Denom,
+		Creator:       addr.String(),
+		Amount:        token.Amount,
+		HostDenom:     token.Denom,
+		MinStTokenOut: &minStTokenOut,
 	}
 
 	if err := msg.ValidateBasic(); err != nil {
-		return err
+		return sdk.Coin{}, err
 	}
 
 	msgServer := stakeibckeeper.NewMsgServerImpl(k.stakeibcKeeper)
-	_, err := msgServer.LiquidStake(
+	res, err := msgServer.LiquidStake(
 		sdk.WrapSDKContext(ctx),
 		msg,
 	)
 	if err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
 	}
+	return res.StToken, nil
+}
+
+// Transfers the newly minted stTokens from the stride address to the ibc receiver
+// The transfer is executed in a cached context so that no partial state is written if it fails
+func (k Keeper) RunForwardStTokens(ctx sdk.Context, sender sdk.AccAddress, receiver string, channelId string, stToken sdk.Coin) error {
+	msg := &transfertypes.MsgTransfer{
+		SourcePort:       transfertypes.PortID,
+		SourceChannel:    channelId,
+		Token:            stToken,
+		Sender:           sender.String(),
+		Receiver:         receiver,
+		TimeoutTimestamp: uint64(ctx.BlockTime().UnixNano()) + types.ForwardTransferTimeout,
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if _, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(cacheCtx), msg); err != nil {
+		return err
+	}
+	writeCache()
+
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 
 	recordsmodule "github.com/Stride-Labs/stride/v10/x/records"
 
@@ -32,6 +33,16 @@ func getStakeibcPacketMetadata(address, action string) string {
 		}`, address, action)
 }
 
+func getLiquidStakeMinOutPacketMetadata(address, minStTokenOut string) string {
+	return fmt.Sprintf(`
+		{
+			"autopilot": {
+				"receiver": "%[1]s",
+				"stakeibc": { "action": "LiquidStake", "min_st_token_out": "%[2]s" }
+			}
+		}`, address, minStTokenOut)
+}
+
 func (suite *KeeperTestSuite) TestLiquidStakeOnRecvPacket() {
 	now := time.Now()
 
@@ -163,6 +174,34 @@ func (suite *KeeperTestSuite) TestLiquidStakeOnRecvPacket() {
 			expSuccess:     false,
 			expLiquidStake: false,
 		},
+		{ // min st token out met
+			forwardingActive: true,
+			packetData: transfertypes.FungibleTokenPacketData{
+				Denom:    "uatom",
+				Amount:   "1000000",
+				Sender:   "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k",
+				Receiver: addr1.String(),
+				Memo:     getLiquidStakeMinOutPacketMetadata(addr1.String(), "1000000"),
+			},
+			destChannel:    "channel-0",
+			recvDenom:      atomIbcDenom,
+			expSuccess:     true,
+			expLiquidStake: true,
+		},
+		{ // min st token out not met
+			forwardingActive: true,
+			packetData: transfertypes.FungibleTokenPacketData{
+				Denom:    "uatom",
+				Amount:   "1000000",
+				Sender:   "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k",
+				Receiver: addr1.String(),
+				Memo:     getLiquidStakeMinOutPacketMetadata(addr1.String(), "1000001"),
+			},
+			destChannel:    "channel-0",
+			recvDenom:      atomIbcDenom,
+			expSuccess:     false,
+			expLiquidStake: false,
+		},
 		{ // invalid stride address (memo)
 			forwardingActive: true,
 			packetData: transfertypes.FungibleTokenPacketData{
@@ -260,3 +299,126 @@ func (suite *KeeperTestSuite) TestLiquidStakeOnRecvPacket() {
 		})
 	}
 }
+
+func getLiquidStakeForwardPacketMetadata(address, ibcReceiver, transferChannel string) string {
+	return fmt.Sprintf(`
+		{
+			"autopilot": {
+				"receiver": "%[1]s",
+				"stakeibc": { "action": "LiquidStake", "ibc_receiver": "%[2]s", "transfer_channel": "%[3]s" }
+			}
+		}`, address, ibcReceiver, transferChannel)
+}
+
+func (suite *KeeperTestSuite) TestLiquidStakeAndForwardOnRecvPacket() {
+	hostChainId := "GAIA"
+	atomHostDenom := "uatom"
+	stAtomDenom := "stuatom"
+	liquidStakeAmount := sdk.NewInt(1000000)
+
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	hostAddress := sdk.MustBech32ifyAddressBytes("cosmos", ed25519.GenPrivKey().PubKey().Address().Bytes())
+
+	testCases := []struct {
+		name            string
+		transferChannel string
+		expForwarded    bool
+	}{
+		{
+			name:            "forwarded over the inbound channel",
+			transferChannel: "",
+			expForwarded:    true,
+		},
+		{
+			name:            "forwarded over the specified channel",
+			transferChannel: ibctesting.FirstChannelID,
+			expForwarded:    true,
+		},
+		{
+			name:            "transfer failed - stTokens remain on stride",
+			transferChannel: "channel-100",
+			expForwarded:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.CreateTransferChannel(hostChainId)
+			ctx := suite.Ctx
+
+			transferChannel := suite.TransferPath.EndpointA.ChannelID
+			packet := channeltypes.Packet{
+				Sequence:           1,
+				SourcePort:         transfertypes.PortID,
+				SourceChannel:      suite.TransferPath.EndpointB.ChannelID,
+				DestinationPort:    transfertypes.PortID,
+				DestinationChannel: transferChannel,
+				TimeoutHeight:      clienttypes.Height{},
+				TimeoutTimestamp:   0,
+			}
+			prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), atomHostDenom)
+			atomIbcDenom := transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+
+			packetData := transfertypes.FungibleTokenPacketData{
+				Denom:    atomHostDenom,
+				Amount:   liquidStakeAmount.String(),
+				Sender:   hostAddress,
+				Receiver: addr1.String(),
+				Memo:     getLiquidStakeForwardPacketMetadata(addr1.String(), hostAddress, tc.transferChannel),
+			}
+			packet.Data = transfertypes.ModuleCdc.MustMarshalJSON(&packetData)
+
+			suite.App.AutopilotKeeper.SetParams(ctx, types.Params{StakeibcActive: true})
+
+			// set epoch tracker, deposit record and host zone for env
+			suite.App.StakeibcKeeper.SetEpochTracker(ctx, stakeibctypes.EpochTracker{
+				EpochIdentifier: epochtypes.STRIDE_EPOCH,
+				EpochNumber:     1,
+			})
+			suite.App.RecordsKeeper.SetDepositRecord(ctx, recordstypes.DepositRecord{
+				Id:                 1,
+				Amount:             sdk.ZeroInt(),
+				Denom:              atomIbcDenom,
+				HostZoneId:         hostChainId,
+				Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
+				DepositEpochNumber: 1,
+				Source:             recordstypes.DepositRecord_STRIDE,
+			})
+			suite.App.StakeibcKeeper.SetHostZone(ctx, stakeibctypes.HostZone{
+				ChainId:           hostChainId,
+				ConnectionId:      suite.TransferPath.EndpointA.ConnectionID,
+				Bech32Prefix:      "cosmos",
+				TransferChannelId: transferChannel,
+				IbcDenom:          atomIbcDenom,
+				HostDenom:         atomHostDenom,
+				RedemptionRate:    sdk.MustNewDecFromStr("1.25"),
+				Address:           stakeibctypes.NewZoneAddress(hostChainId).String(),
+			})
+
+			transferIBCModule := transfer.NewIBCModule(suite.App.TransferKeeper)
+			recordsStack := recordsmodule.NewIBCModule(suite.App.RecordsKeeper, transferIBCModule)
+			routerIBCModule := autopilot.NewIBCModule(suite.App.AutopilotKeeper, recordsStack)
+			ack := routerIBCModule.OnRecvPacket(ctx, packet, addr1)
+
+			// The liquid stake should succeed regardless of whether the stTokens could be forwarded
+			suite.Require().True(ack.Success(), "ack should be successful - ack: %+v", string(ack.Acknowledgement()))
+
+			expectedStAmount := sdk.NewInt(800000)
+			userStBalance := suite.App.BankKeeper.GetBalance(ctx, addr1, stAtomDenom)
+			escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, transferChannel)
+			escrowStBalance := suite.App.BankKeeper.GetBalance(ctx, escrowAddress, stAtomDenom)
+
+			if tc.expForwarded {
+				suite.Require().Zero(userStBalance.Amount.Int64(), "user stToken balance after forward")
+				suite.Require().Equal(expectedStAmount, escrowStBalance.Amount, "escrowed stToken balance after forward")
+
+				commitment := suite.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, transfertypes.PortID, transferChannel, 1)
+				suite.Require().NotEmpty(commitment, "outbound transfer packet commitment should exist")
+			} else {
+				suite.Require().Equal(expectedStAmount, userStBalance.Amount, "user stToken balance after failed forward")
+				suite.Require().Zero(escrowStBalance.Amount.Int64(), "escrowed stToken balance after failed forward")
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v10/x/autopilot/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v10/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func (k Keeper) TryRedeemStake(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	newData transfertypes.FungibleTokenPacketData,
+	packetMetadata types.StakeibcPacketMetadata,
+) error {
+	params := k.GetParams(ctx)
+	if !params.StakeibcActive {
+		return errorsmod.Wrapf(types.ErrPacketForwardingInactive, "autopilot stakeibc routing is inactive")
+	}
+
+	if packetMetadata.IbcReceiver == "" {
+		return errorsmod.Wrapf(types.ErrInvalidPacketMetadata, "ibc_receiver cannot be empty")
+	}
+
+	// stTokens are native to stride, so only tokens that are being sent back to stride can be redeemed
+	if !transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), newData.Denom) {
+		return errors.New("only stTokens native to stride are supported for redemptions")
+	}
+
+	amount, ok := sdk.NewIntFromString(newData.Amount)
+	if !ok {
+		return errors.New("not a parsable amount field")
+	}
+
+	// Note: newData.denom is the prefixed denom from the sender chain e.g. transfer/channel-0/stuatom
+	// Remove the prefix to get the stToken denom on stride
+	voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+	stDenom := newData.Denom[len(voucherPrefix):]
+	if !transfertypes.ParseDenomTrace(stDenom).IsNativeDenom() {
+		return fmt.Errorf("denom %s is not native to stride", stDenom)
+	}
+
+	// Confirm the stToken belongs to a registered host zone
+	if !k.stakeibcKeeper.CheckIsStToken(ctx, stDenom) {
+		return fmt.Errorf("denom %s is not an stToken", stDenom)
+	}
+	hostDenom := strings.TrimPrefix(stDenom, "st")
+	hostZone, err := k.stakeibcKeeper.GetHostZoneFromHostDenom(ctx, hostDenom)
+	if err != nil {
+		return fmt.Errorf("host zone not found for denom (%s)", hostDenom)
+	}
+
+	strideAddress, err := sdk.AccAddressFromBech32(packetMetadata.StrideAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid stride_address (%s) in autopilot memo", packetMetadata.StrideAddress)
+	}
+
+	minNativeOut, err := types.ParseMinOutAmount(packetMetadata.MinNativeOut)
+	if err != nil {
+		return err
+	}
+
+	return k.RunRedeemStake(ctx, strideAddress, packetMetadata.IbcReceiver, hostZone.ChainId, amount, minNativeOut)
+}
+
+func (k Keeper) RunRedeemStake(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	receiver string,
+	hostZoneId string,
+	amount sdkmath.Int,
+	minNativeOut sdkmath.Int,
+) error {
+	msg := &stakeibctypes.MsgRedeemStake{
+		Creator:      addr.String(),
+		Amount:       amount,
+		HostZone:     hostZoneId,
+		Receiver:     receiver,
+		MinNativeOut: &minNativeOut,
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := stakeibckeeper.NewMsgServerImpl(k.stakeibcKeeper)
+	_, err := msgServer.RedeemStake(
+		sdk.WrapSDKContext(ctx),
+		msg,
+	)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cosmos/ibc-go/v7/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v10/x/autopilot"
+	"github.com/Stride-Labs/stride/v10/x/autopilot/types"
+	epochtypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
+	minttypes "github.com/Stride-Labs/stride/v10/x/mint/types"
+	recordsmodule "github.com/Stride-Labs/stride/v10/x/records"
+	recordstypes "github.com/Stride-Labs/stride/v10/x/records/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func getRedeemStakePacketMetadata(address, ibcReceiver string) string {
+	return fmt.Sprintf(`
+		{
+			"autopilot": {
+				"receiver": "%[1]s",
+				"stakeibc": { "action": "RedeemStake", "ibc_receiver": "%[2]s" }
+			}
+		}`, address, ibcReceiver)
+}
+
+func (suite *KeeperTestSuite) TestRedeemStakeOnRecvPacket() {
+	packet := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-0",
+		Data:               []byte{},
+		TimeoutHeight:      clienttypes.Height{},
+		TimeoutTimestamp:   0,
+	}
+
+	hostChainId := "hub-1"
+	atomHostDenom := "uatom"
+	stAtomDenom := "stuatom"
+	stOsmoDenom := "stuosmo"
+
+	// stTokens being sent back to stride are prefixed with the counterparty's port and channel
+	stAtomPrefixedDenom := transfertypes.GetPrefixedDenom(packet.GetSourcePort(), packet.GetSourceChannel(), stAtomDenom)
+	stOsmoPrefixedDenom := transfertypes.GetPrefixedDenom(packet.GetSourcePort(), packet.GetSourceChannel(), stOsmoDenom)
+
+	// Native tokens from the host are not prefixed
+	atomIbcDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), atomHostDenom),
+	).IBCDenom()
+
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	hostAddress := sdk.MustBech32ifyAddressBytes("cosmos", ed25519.GenPrivKey().PubKey().Address().Bytes())
+	zoneAddress := stakeibctypes.NewZoneAddress(hostChainId)
+	redeemAmount := sdkmath.NewInt(1000000)
+
+	testCases := []struct {
+		name             string
+		forwardingActive bool
+		packetData       transfertypes.FungibleTokenPacketData
+		expSuccess       bool
+	}{
+		{
+			name:             "successful redemption",
+			forwardingActive: true,
+			packetData: transfertypes.FungibleTokenPacketData{
+				Denom:    stAtomPrefixedDenom,
+				Amount:   redeemAmount.String(),
+				Sender:   "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k",
+				Receiver: addr1.String(),
+				Memo:     getRedeemStakePacketMetadata(addr1.String(), hostAddress),
+			},
+			expSuccess: true,
+		},
+		{
+			name:             "successful redemption with metadata in receiver",
+			forwardingActive: true,
+			packetData: transfertypes.FungibleTokenPacketData{
+				Denom:    stAtomPrefixedDenom,
+				Amount:   redeemAmount.String(),
+				Sender:   "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k",
+				Receiver: getRedeemStakePacketMetadata(addr1.String(), hostAddress),
+				Memo:     "",
+			},
+			expSuccess: true,
+		},
+		{
+			name:             "params not enabled",
+			forwardingActive: false,
+			packetData: transfertypes.FungibleTokenPacketData{
+				Denom:    stAtomPrefixedDenom,
+				Amount:   redeemAmount.String(),
+				Sender:   "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k",
+				Receiver: addr1.String(),
+				Memo:     getRedeemStakePacketMetadata(addr1.String(), hostAddress),
+			},
+			expSuccess: false,
+		},
+		{
+			name:             "missing ibc receiver",
+			forwardingActive: true,
+			packetData: transfertypes.FungibleTokenPacketData{
+				Denom:    stAtomPrefixedDenom,
+				Amount:   redeemAmount.String(),
+				Sender:   "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k",
+				Receiver: addr1.String(),
+				Memo:     getStakeibcPacketMetadata(addr1.String(), "RedeemStake"),
+			},
+			expSuccess: false,
+		},
+		{
+			name:             "invalid ibc receiver",
+			forwardingActive: true,
+			packetData: transfertypes.FungibleTokenPacketData{
+				Denom:    stAtomPrefixedDenom,
+				Amount:   redeemAmount.String(),
+				Sender:   "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k",
+				Receiver: addr1.String(),
+				Memo:     getRedeemStakePacketMetadata(addr1.String(), addr1.String()),
+			},
+			expSuccess: false,
+		},
+		{
+			name:             "native host token instead of stToken",
+			forwardingActive: true,
+			packetData: transfertypes.FungibleTokenPacketData{
+				Denom:    atomHostDenom,
+				Amount:   redeemAmount.String(),
+				Sender:   "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k",
+				Receiver: addr1.String(),
+				Memo:     getRedeemStakePacketMetadata(addr1.String(), hostAddress),
+			},
+			expSuccess: false,
+		},
+		{
+			name:             "stToken without a host zone",
+			forwardingActive: true,
+			packetData: transfertypes.FungibleTokenPacketData{
+				Denom:    stOsmoPrefixedDenom,
+				Amount:   redeemAmount.String(),
+				Sender:   "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k",
+				Receiver: addr1.String(),
+				Memo:     getRedeemStakePacketMetadata(addr1.String(), hostAddress),
+			},
+			expSuccess: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			packet.Data = transfertypes.ModuleCdc.MustMarshalJSON(&tc.packetData)
+
+			suite.SetupTest() // reset
+			ctx := suite.Ctx
+
+			suite.App.AutopilotKeeper.SetParams(ctx, types.Params{StakeibcActive: tc.forwardingActive})
+
+			// set epoch tracker and unbonding record for env
+			suite.App.StakeibcKeeper.SetEpochTracker(ctx, stakeibctypes.EpochTracker{
+				EpochIdentifier: epochtypes.DAY_EPOCH,
+				EpochNumber:     1,
+			})
+			suite.App.RecordsKeeper.SetEpochUnbondingRecord(ctx, recordstypes.EpochUnbondingRecord{
+				EpochNumber: 1,
+				HostZoneUnbondings: []*recordstypes.HostZoneUnbonding{
+					{
+						HostZoneId:        hostChainId,
+						Denom:             atomHostDenom,
+						NativeTokenAmount: sdkmath.ZeroInt(),
+						StTokenAmount:     sdkmath.ZeroInt(),
+						Status:            recordstypes.HostZoneUnbonding_UNBONDING_QUEUE,
+					},
+				},
+			})
+			// set host zone for env
+			suite.App.StakeibcKeeper.SetHostZone(ctx, stakeibctypes.HostZone{
+				ChainId:           hostChainId,
+				ConnectionId:      "connection-0",
+				Bech32Prefix:      "cosmos",
+				TransferChannelId: "channel-0",
+				IbcDenom:          atomIbcDenom,
+				HostDenom:         atomHostDenom,
+				RedemptionRate:    sdk.NewDec(1),
+				StakedBal:         sdkmath.NewInt(10000000),
+				Address:           zoneAddress.String(),
+			})
+
+			// mint tokens to the transfer escrow account so that they can be sent back to stride
+			escrowAddress := transfertypes.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+			for _, denom := range []string{stAtomDenom, stOsmoDenom, atomIbcDenom} {
+				coin := sdk.NewCoin(denom, redeemAmount)
+				err := suite.App.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(coin))
+				suite.Require().NoError(err)
+				err = suite.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, escrowAddress, sdk.NewCoins(coin))
+				suite.Require().NoError(err)
+				suite.App.TransferKeeper.SetTotalEscrowForDenom(ctx, coin)
+			}
+
+			transferIBCModule := transfer.NewIBCModule(suite.App.TransferKeeper)
+			recordsStack := recordsmodule.NewIBCModule(suite.App.RecordsKeeper, transferIBCModule)
+			routerIBCModule := autopilot.NewIBCModule(suite.App.AutopilotKeeper, recordsStack)
+			ack := routerIBCModule.OnRecvPacket(
+				ctx,
+				packet,
+				addr1,
+			)
+
+			if tc.expSuccess {
+				suite.Require().True(ack.Success(), "ack should be successful - ack: %+v", string(ack.Acknowledgement()))
+
+				// Check that the stTokens were escrowed for the redemption
+				userBalance := suite.App.BankKeeper.GetBalance(ctx, addr1, stAtomDenom)
+				suite.Require().Zero(userBalance.Amount.Int64(), "user stToken balance")
+				zoneBalance := suite.App.BankKeeper.GetBalance(ctx, zoneAddress, stAtomDenom)
+				suite.Require().Equal(redeemAmount, zoneBalance.Amount, "host zone escrowed stToken balance")
+
+				// Check that the redemption record was created with the host zone receiver
+				redemptionId := recordstypes.UserRedemptionRecordKeyFormatter(hostChainId, 1, addr1.String())
+				redemptionRecord, found := suite.App.RecordsKeeper.GetUserRedemptionRecord(ctx, redemptionId)
+				suite.Require().True(found, "user redemption record should have been created")
+				suite.Require().Equal(hostAddress, redemptionRecord.Receiver, "redemption record receiver")
+				suite.Require().Equal(redeemAmount, redemptionRecord.Amount, "redemption record amount")
+			} else {
+				suite.Require().False(ack.Success(), "ack should have failed - ack: %+v", string(ack.Acknowledgement()))
+			}
+		})
+	}
+}
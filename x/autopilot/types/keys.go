@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "autopilot"
@@ -13,3 +15,7 @@ const (
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
 )
+
+// Timeout for the IBC transfer that forwards stTokens back to the sender's chain after a liquid stake
+// If the transfer times out, the stTokens are refunded to the stride address
+const ForwardTransferTimeout = uint64(time.Hour)
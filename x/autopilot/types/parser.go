@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 )
 
 type RawPacketMetadata struct {
@@ -25,10 +27,25 @@ type ModuleRoutingInfo interface {
 	Validate() error
 }
 
+// Supported stakeibc actions
+const (
+	LiquidStake = "LiquidStake"
+	RedeemStake = "RedeemStake"
+)
+
 // Packet metadata info specific to Stakeibc (e.g. 1-click liquid staking)
+// The IbcReceiver is the address on the host zone that receives the native tokens from a RedeemStake
+// For a LiquidStake, the IbcReceiver is optional and, if provided, the minted stTokens are transferred
+// to that address over the TransferChannel (which defaults to the channel the packet was received on)
+// MinStTokenOut (LiquidStake) and MinNativeOut (RedeemStake) optionally set the minimum output of the
+// action, so that the action fails if the redemption rate moves before the packet is received
 type StakeibcPacketMetadata struct {
-	Action        string `json:"action"`
-	StrideAddress string
+	Action          string `json:"action"`
+	StrideAddress   string
+	IbcReceiver     string `json:"ibc_receiver,omitempty"`
+	TransferChannel string `json:"transfer_channel,omitempty"`
+	MinStTokenOut   string `json:"min_st_token_out,omitempty"`
+	MinNativeOut    string `json:"min_native_out,omitempty"`
 }
 
 // Packet metadata info specific to Claim (e.g. airdrops for non-118 coins)
@@ -37,19 +54,56 @@ type ClaimPacketMetadata struct {
 }
 
 // Validate stakeibc packet metadata fields
-// including the stride address and action type
+// including the stride address and action type (and the host zone receiver for redemptions)
 func (m StakeibcPacketMetadata) Validate() error {
 	_, err := sdk.AccAddressFromBech32(m.StrideAddress)
 	if err != nil {
 		return err
 	}
-	if m.Action != "LiquidStake" {
+	switch m.Action {
+	case LiquidStake:
+		if m.TransferChannel != "" && m.IbcReceiver == "" {
+			return errorsmod.Wrapf(ErrInvalidPacketMetadata, "ibc_receiver must be specified when transfer_channel is set")
+		}
+		if m.TransferChannel != "" && !channeltypes.IsValidChannelID(m.TransferChannel) {
+			return errorsmod.Wrapf(ErrInvalidPacketMetadata, "invalid transfer_channel (%s)", m.TransferChannel)
+		}
+		if m.MinNativeOut != "" {
+			return errorsmod.Wrapf(ErrInvalidPacketMetadata, "min_native_out cannot be specified for action %s", m.Action)
+		}
+		if _, err := ParseMinOutAmount(m.MinStTokenOut); err != nil {
+			return errorsmod.Wrapf(err, "invalid min_st_token_out")
+		}
+	case RedeemStake:
+		if m.IbcReceiver == "" {
+			return errorsmod.Wrapf(ErrInvalidPacketMetadata, "ibc_receiver must be specified for action %s", m.Action)
+		}
+		if m.MinStTokenOut != "" {
+			return errorsmod.Wrapf(ErrInvalidPacketMetadata, "min_st_token_out cannot be specified for action %s", m.Action)
+		}
+		if _, err := ParseMinOutAmount(m.MinNativeOut); err != nil {
+			return errorsmod.Wrapf(err, "invalid min_native_out")
+		}
+	default:
 		return errorsmod.Wrapf(ErrUnsupportedStakeibcAction, "action %s is not supported", m.Action)
 	}
 
 	return nil
 }
 
+// Parses the optional minimum output of a stakeibc action
+// An empty string is treated as no minimum
+func ParseMinOutAmount(minOut string) (sdkmath.Int, error) {
+	if minOut == "" {
+		return sdkmath.ZeroInt(), nil
+	}
+	amount, ok := sdkmath.NewIntFromString(minOut)
+	if !ok || amount.IsNegative() {
+		return sdkmath.ZeroInt(), errorsmod.Wrapf(ErrInvalidPacketMetadata, "minimum output (%s) must be a non-negative integer", minOut)
+	}
+	return amount, nil
+}
+
 // Validate claim packet metadata includes the stride address
 func (m ClaimPacketMetadata) Validate() error {
 	_, err := sdk.AccAddressFromBech32(m.StrideAddress)
@@ -34,6 +34,16 @@ func getStakeibcMemoWithStrideAddress(receiverAddress, action, strideAddress str
 		}`, receiverAddress, strideAddress, action)
 }
 
+func getStakeibcMemoWithIbcReceiver(address, action, ibcReceiver string) string {
+	return fmt.Sprintf(`
+		{
+			"autopilot": {
+				"receiver": "%[1]s",
+				"stakeibc": { "action": "%[2]s", "ibc_receiver": "%[3]s" } 
+			}
+		}`, address, action, ibcReceiver)
+}
+
 func getClaimMemo(address string) string {
 	return fmt.Sprintf(`
 		{
@@ -88,6 +98,14 @@ func TestParsePacketMetadata(t *testing.T) {
 		Action:        validStakeibcAction,
 	}
 
+	validRedeemStakeAction := "RedeemStake"
+	validIbcReceiver := "cosmos1..."
+	validParsedRedeemStakePacketMetadata := types.StakeibcPacketMetadata{
+		StrideAddress: validAddress,
+		Action:        validRedeemStakeAction,
+		IbcReceiver:   validIbcReceiver,
+	}
+
 	validParsedClaimPacketMetadata := types.ClaimPacketMetadata{
 		StrideAddress: validAddress,
 	}
@@ -105,6 +123,11 @@ func TestParsePacketMetadata(t *testing.T) {
 			metadata:       getStakeibcMemo(validAddress, validStakeibcAction),
 			parsedStakeibc: &validParsedStakeibcPacketMetadata,
 		},
+		{
+			name:           "valid redeem stake memo",
+			metadata:       getStakeibcMemoWithIbcReceiver(validAddress, validRedeemStakeAction, validIbcReceiver),
+			parsedStakeibc: &validParsedRedeemStakePacketMetadata,
+		},
 		{
 			name:        "valid claim memo",
 			metadata:    getClaimMemo(validAddress),
@@ -160,6 +183,11 @@ func TestParsePacketMetadata(t *testing.T) {
 			metadata:    getStakeibcMemo(validAddress, "bad_action"),
 			expectedErr: "unsupported stakeibc action",
 		},
+		{
+			name:        "redeem stake memo without ibc receiver",
+			metadata:    getStakeibcMemo(validAddress, validRedeemStakeAction),
+			expectedErr: "ibc_receiver must be specified for action RedeemStake",
+		},
 		{
 			name:        "invalid claim address",
 			metadata:    getClaimMemo(invalidAddress),
@@ -225,6 +253,14 @@ func TestValidateStakeibcPacketMetadata(t *testing.T) {
 			},
 			expectedErr: "decoding bech32 failed",
 		},
+		{
+			name: "valid redeem stake metadata",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress: validAddress,
+				Action:        "RedeemStake",
+				IbcReceiver:   "cosmos1...",
+			},
+		},
 		{
 			name: "invalid action",
 			metadata: &types.StakeibcPacketMetadata{
@@ -233,6 +269,97 @@ func TestValidateStakeibcPacketMetadata(t *testing.T) {
 			},
 			expectedErr: "unsupported stakeibc action",
 		},
+		{
+			name: "redeem stake missing ibc receiver",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress: validAddress,
+				Action:        "RedeemStake",
+			},
+			expectedErr: "ibc_receiver must be specified for action RedeemStake",
+		},
+		{
+			name: "valid liquid stake with forwarding",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress:   validAddress,
+				Action:          validAction,
+				IbcReceiver:     "osmo1...",
+				TransferChannel: "channel-5",
+			},
+		},
+		{
+			name: "liquid stake transfer channel without ibc receiver",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress:   validAddress,
+				Action:          validAction,
+				TransferChannel: "channel-5",
+			},
+			expectedErr: "ibc_receiver must be specified when transfer_channel is set",
+		},
+		{
+			name: "liquid stake invalid transfer channel",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress:   validAddress,
+				Action:          validAction,
+				IbcReceiver:     "osmo1...",
+				TransferChannel: "bad_channel",
+			},
+			expectedErr: "invalid transfer_channel (bad_channel)",
+		},
+		{
+			name: "valid liquid stake with min st token out",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress: validAddress,
+				Action:        validAction,
+				MinStTokenOut: "1000",
+			},
+		},
+		{
+			name: "liquid stake invalid min st token out",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress: validAddress,
+				Action:        validAction,
+				MinStTokenOut: "-1",
+			},
+			expectedErr: "invalid min_st_token_out",
+		},
+		{
+			name: "liquid stake with min native out",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress: validAddress,
+				Action:        validAction,
+				MinNativeOut:  "1000",
+			},
+			expectedErr: "min_native_out cannot be specified for action LiquidStake",
+		},
+		{
+			name: "valid redeem stake with min native out",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress: validAddress,
+				Action:        "RedeemStake",
+				IbcReceiver:   "cosmos1...",
+				MinNativeOut:  "1000",
+			},
+		},
+		{
+			name: "redeem stake invalid min native out",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress: validAddress,
+				Action:        "RedeemStake",
+				IbcReceiver:   "cosmos1...",
+				MinNativeOut:  "abc",
+			},
+			expectedErr: "invalid min_native_out",
+		},
+		{
+			name: "redeem stake with min st token out",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress: validAddress,
+				Action:        "RedeemStake",
+				IbcReceiver:   "cosmos1...",
+				MinStTokenOut: "1000",
+			},
+			expectedErr: "min_st_token_out cannot be specified for action RedeemStake",
+		},
 	}
 
 	for _, tc := range testCases {
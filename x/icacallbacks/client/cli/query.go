@@ -27,6 +27,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListCallbackData())
 	cmd.AddCommand(CmdShowCallbackData())
+	cmd.AddCommand(CmdListFailedCallbacks())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
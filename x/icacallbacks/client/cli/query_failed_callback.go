@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v10/x/icacallbacks/types"
+)
+
+const (
+	FlagHostZoneId = "host-zone-id"
+	FlagCallbackId = "callback-id"
+)
+
+func CmdListFailedCallbacks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-failed-callbacks",
+		Short: "list the most recent packets that timed out or failed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			hostZoneId, err := cmd.Flags().GetString(FlagHostZoneId)
+			if err != nil {
+				return err
+			}
+			callbackId, err := cmd.Flags().GetString(FlagCallbackId)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryFailedCallbacksRequest{
+				HostZoneId: hostZoneId,
+				CallbackId: callbackId,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.FailedCallbacks(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagHostZoneId, "", "Only include packets sent to the given host zone")
+	cmd.Flags().String(FlagCallbackId, "", "Only include packets with the given callback id")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -14,6 +14,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.CallbackDataList {
 		k.SetCallbackData(ctx, elem)
 	}
+	// Set all the failedCallbacks and restore the sequence
+	for _, elem := range genState.FailedCallbacks {
+		k.SetFailedCallback(ctx, elem)
+		if elem.Id >= k.GetFailedCallbackSequence(ctx) {
+			k.SetFailedCallbackSequence(ctx, elem.Id+1)
+		}
+	}
 	k.SetParams(ctx, genState.Params)
 }
 
@@ -23,6 +30,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 
 	genesis.CallbackDataList = k.GetAllCallbackData(ctx)
+	genesis.FailedCallbacks = k.GetAllFailedCallbacks(ctx)
 
 	return genesis
 }
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v10/x/icacallbacks/types"
+)
+
+// Records a packet that timed out or failed on the host
+// Only the most recent MaxFailedCallbacks are retained, so the oldest record is removed once the limit is exceeded
+func (k Keeper) AddFailedCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	callbackId string,
+	status types.FailedCallbackStatus,
+	errMsg string,
+) {
+	id := k.GetFailedCallbackSequence(ctx)
+	k.SetFailedCallback(ctx, types.FailedCallback{
+		Id:          id,
+		PacketId:    types.PacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.Sequence),
+		PortId:      packet.GetSourcePort(),
+		ChannelId:   packet.GetSourceChannel(),
+		Sequence:    packet.Sequence,
+		CallbackId:  callbackId,
+		HostZoneId:  types.HostZoneIdFromPortId(packet.GetSourcePort()),
+		Status:      status,
+		Error:       errMsg,
+		BlockHeight: ctx.BlockHeight(),
+	})
+	k.SetFailedCallbackSequence(ctx, id+1)
+
+	if id >= types.MaxFailedCallbacks {
+		k.RemoveFailedCallback(ctx, id-types.MaxFailedCallbacks)
+	}
+}
+
+// GetFailedCallbackSequence returns the id of the next failed callback
+func (k Keeper) GetFailedCallbackSequence(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.FailedCallbackSequenceKey)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetFailedCallbackSequence sets the id of the next failed callback
+func (k Keeper) SetFailedCallbackSequence(ctx sdk.Context, sequence uint64) {
+	ctx.KVStore(k.storeKey).Set(types.FailedCallbackSequenceKey, sdk.Uint64ToBigEndian(sequence))
+}
+
+// SetFailedCallback set a specific failedCallback in the store from its id
+func (k Keeper) SetFailedCallback(ctx sdk.Context, failedCallback types.FailedCallback) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FailedCallbackKeyPrefix))
+	b := k.cdc.MustMarshal(&failedCallback)
+	store.Set(sdk.Uint64ToBigEndian(failedCallback.Id), b)
+}
+
+// GetFailedCallback returns a failedCallback from its id
+func (k Keeper) GetFailedCallback(ctx sdk.Context, id uint64) (val types.FailedCallback, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FailedCallbackKeyPrefix))
+	b := store.Get(sdk.Uint64ToBigEndian(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveFailedCallback removes a failedCallback from the store
+func (k Keeper) RemoveFailedCallback(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FailedCallbackKeyPrefix))
+	store.Delete(sdk.Uint64ToBigEndian(id))
+}
+
+// GetAllFailedCallbacks returns all failedCallbacks, ordered from oldest to newest
+func (k Keeper) GetAllFailedCallbacks(ctx sdk.Context) (list []types.FailedCallback) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FailedCallbackKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FailedCallback
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
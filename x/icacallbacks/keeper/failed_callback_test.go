@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Stride-Labs/stride/v10/testutil/keeper"
+	"github.com/Stride-Labs/stride/v10/x/icacallbacks/types"
+)
+
+func TestAddFailedCallback(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	packet := channeltypes.Packet{
+		SourcePort:    "icacontroller-GAIA.DELEGATION",
+		SourceChannel: "channel-1",
+		Sequence:      5,
+	}
+	keeper.AddFailedCallback(ctx, packet, "delegate", types.FailedCallbackStatus_FAILURE, "insufficient funds")
+
+	failedCallback, found := keeper.GetFailedCallback(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, types.FailedCallback{
+		Id:          0,
+		PacketId:    "icacontroller-GAIA.DELEGATION.channel-1.5",
+		PortId:      "icacontroller-GAIA.DELEGATION",
+		ChannelId:   "channel-1",
+		Sequence:    5,
+		CallbackId:  "delegate",
+		HostZoneId:  "GAIA",
+		Status:      types.FailedCallbackStatus_FAILURE,
+		Error:       "insufficient funds",
+		BlockHeight: 10,
+	}, failedCallback)
+	require.Equal(t, uint64(1), keeper.GetFailedCallbackSequence(ctx))
+}
+
+func TestAddFailedCallback_PrunesOldest(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+
+	packet := channeltypes.Packet{SourcePort: "transfer", SourceChannel: "channel-0"}
+	for i := 0; i < types.MaxFailedCallbacks+2; i++ {
+		packet.Sequence = uint64(i)
+		keeper.AddFailedCallback(ctx, packet, "transfer", types.FailedCallbackStatus_TIMEOUT, "")
+	}
+
+	failedCallbacks := keeper.GetAllFailedCallbacks(ctx)
+	require.Len(t, failedCallbacks, types.MaxFailedCallbacks)
+	require.Equal(t, uint64(2), failedCallbacks[0].Id, "oldest records should be removed")
+	require.Equal(t, "", failedCallbacks[0].HostZoneId, "transfer packets have no host zone")
+}
+
+func TestHostZoneIdFromPortId(t *testing.T) {
+	require.Equal(t, "GAIA", types.HostZoneIdFromPortId("icacontroller-GAIA.DELEGATION"))
+	require.Equal(t, "cosmoshub-4", types.HostZoneIdFromPortId("icacontroller-cosmoshub-4.WITHDRAWAL"))
+	require.Equal(t, "", types.HostZoneIdFromPortId("transfer"))
+	require.Equal(t, "", types.HostZoneIdFromPortId("icacontroller-GAIA"))
+}
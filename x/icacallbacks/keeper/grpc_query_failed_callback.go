@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v10/x/icacallbacks/types"
+)
+
+func (k Keeper) FailedCallbacks(c context.Context, req *types.QueryFailedCallbacksRequest) (*types.QueryFailedCallbacksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var failedCallbacks []types.FailedCallback
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	failedCallbackStore := prefix.NewStore(store, types.KeyPrefix(types.FailedCallbackKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(failedCallbackStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var failedCallback types.FailedCallback
+		if err := k.cdc.Unmarshal(value, &failedCallback); err != nil {
+			return false, err
+		}
+
+		if req.HostZoneId != "" && failedCallback.HostZoneId != req.HostZoneId {
+			return false, nil
+		}
+		if req.CallbackId != "" && failedCallback.CallbackId != req.CallbackId {
+			return false, nil
+		}
+
+		if accumulate {
+			failedCallbacks = append(failedCallbacks, failedCallback)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFailedCallbacksResponse{FailedCallbacks: failedCallbacks, Pagination: pageRes}, nil
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/Stride-Labs/stride/v10/testutil/keeper"
+	"github.com/Stride-Labs/stride/v10/x/icacallbacks/types"
+)
+
+func TestFailedCallbacksQuery(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	failedCallbacks := []types.FailedCallback{
+		{Id: 0, HostZoneId: "GAIA", CallbackId: "delegate"},
+		{Id: 1, HostZoneId: "GAIA", CallbackId: "undelegate"},
+		{Id: 2, HostZoneId: "OSMO", CallbackId: "delegate"},
+		{Id: 3, HostZoneId: "GAIA", CallbackId: "delegate"},
+	}
+	for _, failedCallback := range failedCallbacks {
+		keeper.SetFailedCallback(ctx, failedCallback)
+	}
+
+	for _, tc := range []struct {
+		desc        string
+		request     *types.QueryFailedCallbacksRequest
+		expectedIds []uint64
+	}{
+		{
+			desc:        "No filter",
+			request:     &types.QueryFailedCallbacksRequest{},
+			expectedIds: []uint64{0, 1, 2, 3},
+		},
+		{
+			desc:        "Host zone filter",
+			request:     &types.QueryFailedCallbacksRequest{HostZoneId: "GAIA"},
+			expectedIds: []uint64{0, 1, 3},
+		},
+		{
+			desc:        "Host zone and callback filter",
+			request:     &types.QueryFailedCallbacksRequest{HostZoneId: "GAIA", CallbackId: "delegate"},
+			expectedIds: []uint64{0, 3},
+		},
+		{
+			desc: "Paginated with filter",
+			request: &types.QueryFailedCallbacksRequest{
+				CallbackId: "delegate",
+				Pagination: &query.PageRequest{Limit: 2},
+			},
+			expectedIds: []uint64{0, 2},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.FailedCallbacks(wctx, tc.request)
+			require.NoError(t, err)
+
+			ids := []uint64{}
+			for _, failedCallback := range response.FailedCallbacks {
+				ids = append(ids, failedCallback.Id)
+			}
+			require.Equal(t, tc.expectedIds, ids)
+		})
+	}
+
+	_, err := keeper.FailedCallbacks(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
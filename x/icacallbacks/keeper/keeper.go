@@ -130,6 +130,15 @@ func (k Keeper) CallRegisteredICACallback(ctx sdk.Context, modulePacket channelt
 		k.Logger(ctx).Error(fmt.Sprintf("Callback %v has no associated callback", callbackData))
 	}
 
+	// record the packet if it timed out or failed on the host
+	// (if the callback itself errors, the transaction is reverted and the packet is not recorded)
+	switch ackResponse.Status {
+	case types.AckResponseStatus_TIMEOUT:
+		k.AddFailedCallback(ctx, modulePacket, callbackData.CallbackId, types.FailedCallbackStatus_TIMEOUT, ackResponse.Error)
+	case types.AckResponseStatus_FAILURE:
+		k.AddFailedCallback(ctx, modulePacket, callbackData.CallbackId, types.FailedCallbackStatus_FAILURE, ackResponse.Error)
+	}
+
 	// remove the callback data
 	k.RemoveCallbackData(ctx, callbackDataKey)
 	return nil
@@ -0,0 +1,754 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/icacallbacks/failed_callback.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type FailedCallbackStatus int32
+
+const (
+	// The packet timed out before it was received on the host
+	FailedCallbackStatus_TIMEOUT FailedCallbackStatus = 0
+	// The packet was received on the host, but returned an error acknowledgement
+	FailedCallbackStatus_FAILURE FailedCallbackStatus = 1
+)
+
+var FailedCallbackStatus_name = map[int32]string{
+	0: "TIMEOUT",
+	1: "FAILURE",
+}
+
+var FailedCallbackStatus_value = map[string]int32{
+	"TIMEOUT": 0,
+	"FAILURE": 1,
+}
+
+func (x FailedCallbackStatus) String() string {
+	return proto.EnumName(FailedCallbackStatus_name, int32(x))
+}
+
+func (FailedCallbackStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8fabdc49fd153740, []int{0}
+}
+
+// Record of an ICA or transfer packet that timed out or failed on the host
+type FailedCallback struct {
+	Id          uint64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PacketId    string               `protobuf:"bytes,2,opt,name=packet_id,json=packetId,proto3" json:"packet_id,omitempty"`
+	PortId      string               `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId   string               `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence    uint64               `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	CallbackId  string               `protobuf:"bytes,6,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	HostZoneId  string               `protobuf:"bytes,7,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+	Status      FailedCallbackStatus `protobuf:"varint,8,opt,name=status,proto3,enum=stride.icacallbacks.FailedCallbackStatus" json:"status,omitempty"`
+	Error       string               `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	BlockHeight int64                `protobuf:"varint,10,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *FailedCallback) Reset()         { *m = FailedCallback{} }
+func (m *FailedCallback) String() string { return proto.CompactTextString(m) }
+func (*FailedCallback) ProtoMessage()    {}
+func (*FailedCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fabdc49fd153740, []int{0}
+}
+func (m *FailedCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedCallback.Merge(m, src)
+}
+func (m *FailedCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedCallback proto.InternalMessageInfo
+
+func (m *FailedCallback) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FailedCallback) GetPacketId() string {
+	if m != nil {
+		return m.PacketId
+	}
+	return ""
+}
+
+func (m *FailedCallback) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *FailedCallback) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *FailedCallback) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *FailedCallback) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *FailedCallback) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+func (m *FailedCallback) GetStatus() FailedCallbackStatus {
+	if m != nil {
+		return m.Status
+	}
+	return FailedCallbackStatus_TIMEOUT
+}
+
+func (m *FailedCallback) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FailedCallback) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("stride.icacallbacks.FailedCallbackStatus", FailedCallbackStatus_name, FailedCallbackStatus_value)
+	proto.RegisterType((*FailedCallback)(nil), "stride.icacallbacks.FailedCallback")
+}
+
+func init() {
+	proto.RegisterFile("stride/icacallbacks/failed_callback.proto", fileDescriptor_8fabdc49fd153740)
+}
+
+var fileDescriptor_8fabdc49fd153740 = []byte{
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x8b, 0xd3, 0x40,
+	0x14, 0xc6, 0x33, 0xd9, 0xdd, 0xb4, 0x79, 0x5d, 0xca, 0x32, 0x2e, 0x18, 0x14, 0x63, 0xf4, 0x94,
+	0x15, 0x4c, 0x56, 0x05, 0xef, 0xab, 0xec, 0x62, 0xa0, 0xa2, 0xa4, 0xed, 0xa5, 0x97, 0x30, 0x99,
+	0x19, 0x9b, 0xa1, 0x31, 0x13, 0x33, 0x53, 0x51, 0xff, 0x0a, 0xff, 0x2c, 0x8f, 0x3d, 0x7a, 0x94,
+	0xf6, 0x1f, 0x91, 0x4c, 0x52, 0xb1, 0xd0, 0xe3, 0xf7, 0xfb, 0xbe, 0xef, 0xcd, 0x3c, 0x1e, 0x5c,
+	0x29, 0xdd, 0x08, 0xc6, 0x63, 0x41, 0x09, 0x25, 0x65, 0x99, 0x13, 0xba, 0x52, 0xf1, 0x27, 0x22,
+	0x4a, 0xce, 0xb2, 0x3d, 0x88, 0xea, 0x46, 0x6a, 0x89, 0xef, 0x75, 0xd1, 0xe8, 0xff, 0xe8, 0xd3,
+	0x8d, 0x0d, 0xe3, 0x3b, 0x13, 0x7f, 0xdb, 0x33, 0x3c, 0x06, 0x5b, 0x30, 0x0f, 0x05, 0x28, 0x3c,
+	0x4d, 0x6d, 0xc1, 0xf0, 0x43, 0x70, 0x6b, 0x42, 0x57, 0x5c, 0x67, 0x82, 0x79, 0x76, 0x80, 0x42,
+	0x37, 0x1d, 0x76, 0x20, 0x61, 0xf8, 0x3e, 0x0c, 0x6a, 0xd9, 0x18, 0xeb, 0xc4, 0x58, 0x4e, 0x2b,
+	0x13, 0x86, 0x1f, 0x01, 0xd0, 0x82, 0x54, 0x15, 0x2f, 0x5b, 0xef, 0xd4, 0x78, 0x6e, 0x4f, 0x12,
+	0x86, 0x1f, 0xc0, 0x50, 0xf1, 0x2f, 0x6b, 0x5e, 0x51, 0xee, 0x9d, 0x99, 0xa7, 0xfe, 0x69, 0xfc,
+	0x18, 0x46, 0xfb, 0x0f, 0xb6, 0x5d, 0xc7, 0x74, 0x61, 0x8f, 0x12, 0x86, 0x03, 0x38, 0x2f, 0xa4,
+	0xd2, 0xd9, 0x0f, 0x59, 0xf1, 0x36, 0x31, 0xe8, 0x12, 0x2d, 0x5b, 0xc8, 0x8a, 0x27, 0x0c, 0xdf,
+	0x80, 0xa3, 0x34, 0xd1, 0x6b, 0xe5, 0x0d, 0x03, 0x14, 0x8e, 0x5f, 0x5e, 0x45, 0x47, 0x96, 0x8f,
+	0x0e, 0x17, 0x9f, 0x9a, 0x42, 0xda, 0x17, 0xf1, 0x25, 0x9c, 0xf1, 0xa6, 0x91, 0x8d, 0xe7, 0x9a,
+	0xe9, 0x9d, 0xc0, 0x4f, 0xe0, 0x3c, 0x2f, 0x25, 0x5d, 0x65, 0x05, 0x17, 0xcb, 0x42, 0x7b, 0x10,
+	0xa0, 0xf0, 0x24, 0x1d, 0x19, 0xf6, 0xce, 0xa0, 0x67, 0xd7, 0x70, 0x79, 0x6c, 0x30, 0x1e, 0xc1,
+	0x60, 0x96, 0xbc, 0xbf, 0xfd, 0x30, 0x9f, 0x5d, 0x58, 0xad, 0xb8, 0xbb, 0x49, 0x26, 0xf3, 0xf4,
+	0xf6, 0x02, 0xbd, 0xf9, 0xf8, 0x6b, 0xeb, 0xa3, 0xcd, 0xd6, 0x47, 0x7f, 0xb6, 0x3e, 0xfa, 0xb9,
+	0xf3, 0xad, 0xcd, 0xce, 0xb7, 0x7e, 0xef, 0x7c, 0x6b, 0xf1, 0x7a, 0x29, 0x74, 0xb1, 0xce, 0x23,
+	0x2a, 0x3f, 0xc7, 0x53, 0xb3, 0xc1, 0xf3, 0x09, 0xc9, 0x55, 0xdc, 0x5f, 0xfd, 0xeb, 0x8b, 0xeb,
+	0xf8, 0xdb, 0xe1, 0xed, 0xf5, 0xf7, 0x9a, 0xab, 0xdc, 0x31, 0x27, 0x7f, 0xf5, 0x77, 0x00, 0x00,
+	0xaa, 0x30, 0x2e, 0x1f, 0x02, 0x00, 0x00,
+}
+
+func (m *FailedCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintFailedCallback(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintFailedCallback(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Status != 0 {
+		i = encodeVarintFailedCallback(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintFailedCallback(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintFailedCallback(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintFailedCallback(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintFailedCallback(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintFailedCallback(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PacketId) > 0 {
+		i -= len(m.PacketId)
+		copy(dAtA[i:], m.PacketId)
+		i = encodeVarintFailedCallback(dAtA, i, uint64(len(m.PacketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintFailedCallback(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFailedCallback(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFailedCallback(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FailedCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFailedCallback(uint64(m.Id))
+	}
+	l = len(m.PacketId)
+	if l > 0 {
+		n += 1 + l + sovFailedCallback(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovFailedCallback(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovFailedCallback(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovFailedCallback(uint64(m.Sequence))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovFailedCallback(uint64(l))
+	}
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovFailedCallback(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovFailedCallback(uint64(m.Status))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovFailedCallback(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovFailedCallback(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func sovFailedCallback(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFailedCallback(x uint64) (n int) {
+	return sovFailedCallback(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FailedCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFailedCallback
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= FailedCallbackStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFailedCallback(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFailedCallback
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFailedCallback(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFailedCallback
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFailedCallback
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFailedCallback
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFailedCallback
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFailedCallback
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFailedCallback
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFailedCallback        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFailedCallback          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFailedCallback = fmt.Errorf("proto: unexpected end of group")
+)
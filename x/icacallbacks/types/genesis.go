@@ -14,6 +14,7 @@ func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		PortId:           PortID,
 		CallbackDataList: []CallbackData{},
+		FailedCallbacks:  []FailedCallback{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -35,6 +36,15 @@ func (gs GenesisState) Validate() error {
 		}
 		callbackDataIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated id in failedCallbacks
+	failedCallbackIdMap := make(map[uint64]struct{})
+
+	for _, elem := range gs.FailedCallbacks {
+		if _, ok := failedCallbackIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for failedCallback")
+		}
+		failedCallbackIdMap[elem.Id] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
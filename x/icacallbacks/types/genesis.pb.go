@@ -25,9 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the icacallbacks module's genesis state.
 type GenesisState struct {
-	Params           Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	PortId           string         `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	CallbackDataList []CallbackData `protobuf:"bytes,3,rep,name=callback_data_list,json=callbackDataList,proto3" json:"callback_data_list"`
+	Params           Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PortId           string           `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	CallbackDataList []CallbackData   `protobuf:"bytes,3,rep,name=callback_data_list,json=callbackDataList,proto3" json:"callback_data_list"`
+	FailedCallbacks  []FailedCallback `protobuf:"bytes,4,rep,name=failed_callbacks,json=failedCallbacks,proto3" json:"failed_callbacks"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +85,13 @@ func (m *GenesisState) GetCallbackDataList() []CallbackData {
 	return nil
 }
 
+func (m *GenesisState) GetFailedCallbacks() []FailedCallback {
+	if m != nil {
+		return m.FailedCallbacks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stride.icacallbacks.GenesisState")
 }
@@ -91,25 +99,27 @@ func init() {
 func init() { proto.RegisterFile("stride/icacallbacks/genesis.proto", fileDescriptor_8c333baddfa20681) }
 
 var fileDescriptor_8c333baddfa20681 = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0xcf, 0x4c, 0x4e, 0x4c, 0x4e, 0xcc, 0xc9, 0x49, 0x4a, 0x4c, 0xce, 0x2e, 0xd6,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x43, 0x56, 0x22, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x96, 0xd7, 0x07, 0xb1, 0x20,
-	0x4a, 0xa5, 0x14, 0xb0, 0x99, 0x56, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x35, 0x4c, 0x4a, 0x1d, 0x9b,
-	0x0a, 0x18, 0x2b, 0x3e, 0x25, 0xb1, 0x24, 0x11, 0xa2, 0x50, 0x69, 0x27, 0x23, 0x17, 0x8f, 0x3b,
-	0xc4, 0x1d, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x96, 0x5c, 0x6c, 0x10, 0x93, 0x24, 0x18, 0x15,
-	0x18, 0x35, 0xb8, 0x8d, 0xa4, 0xf5, 0xb0, 0xb8, 0x4b, 0x2f, 0x00, 0xac, 0xc4, 0x89, 0xe5, 0xc4,
-	0x3d, 0x79, 0x86, 0x20, 0xa8, 0x06, 0x21, 0x71, 0x2e, 0xf6, 0x82, 0xfc, 0xa2, 0x92, 0xf8, 0xcc,
-	0x14, 0x09, 0x26, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x36, 0x10, 0xd7, 0x33, 0x45, 0x28, 0x94, 0x4b,
-	0x08, 0xc5, 0xee, 0xf8, 0x9c, 0xcc, 0xe2, 0x12, 0x09, 0x66, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x45,
-	0xac, 0xe6, 0x3b, 0x43, 0x59, 0x2e, 0x89, 0x25, 0x89, 0x50, 0x5b, 0x04, 0x92, 0x91, 0xc4, 0x7c,
-	0x32, 0x8b, 0x4b, 0x9c, 0x02, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca,
-	0x2c, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x18, 0x6c, 0xbc, 0xae,
-	0x4f, 0x62, 0x52, 0xb1, 0x3e, 0x34, 0x54, 0xca, 0x0c, 0x0d, 0xf4, 0x2b, 0x50, 0xc3, 0xa6, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x28, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe2,
-	0xc3, 0xca, 0xb9, 0xaf, 0x01, 0x00, 0x00,
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xc1, 0x4a, 0xf3, 0x40,
+	0x10, 0xc7, 0x93, 0xb6, 0xf4, 0xe3, 0x4b, 0x05, 0xcb, 0x2a, 0x18, 0x2a, 0xac, 0xa9, 0x1e, 0xac,
+	0x07, 0xb3, 0x5a, 0x41, 0xf0, 0x5a, 0x45, 0x11, 0x7a, 0x28, 0xad, 0x5e, 0xbc, 0x84, 0x49, 0xb2,
+	0x8d, 0x8b, 0xa9, 0x1b, 0xb2, 0xab, 0xe8, 0x53, 0xe8, 0x63, 0xf5, 0xd8, 0xa3, 0x27, 0x91, 0xf6,
+	0x45, 0xa4, 0x9b, 0x2d, 0x34, 0xb2, 0xb7, 0xc9, 0xe4, 0xb7, 0xbf, 0x99, 0xf9, 0x3b, 0x6d, 0x21,
+	0x73, 0x16, 0x53, 0xc2, 0x22, 0x88, 0x20, 0x4d, 0x43, 0x88, 0x9e, 0x04, 0x49, 0xe8, 0x33, 0x15,
+	0x4c, 0xf8, 0x59, 0xce, 0x25, 0x47, 0x5b, 0x05, 0xe2, 0xaf, 0x23, 0xad, 0xed, 0x84, 0x27, 0x5c,
+	0xfd, 0x27, 0xcb, 0xaa, 0x40, 0x5b, 0x9e, 0xc9, 0x96, 0x41, 0x0e, 0x13, 0x2d, 0x6b, 0x1d, 0x9a,
+	0x88, 0x55, 0x15, 0xc4, 0x20, 0x41, 0x83, 0x47, 0x26, 0x70, 0x0c, 0x2c, 0xa5, 0x71, 0xb0, 0x6a,
+	0x14, 0xe8, 0xfe, 0x47, 0xc5, 0xd9, 0xb8, 0x29, 0x56, 0x1e, 0x49, 0x90, 0x14, 0x5d, 0x38, 0xf5,
+	0x62, 0xa8, 0x6b, 0x7b, 0x76, 0xa7, 0xd1, 0xdd, 0xf5, 0x0d, 0x27, 0xf8, 0x03, 0x85, 0xf4, 0x6a,
+	0xd3, 0xef, 0x3d, 0x6b, 0xa8, 0x1f, 0xa0, 0x1d, 0xe7, 0x5f, 0xc6, 0x73, 0x19, 0xb0, 0xd8, 0xad,
+	0x78, 0x76, 0xe7, 0xff, 0xb0, 0xbe, 0xfc, 0xbc, 0x8d, 0xd1, 0xbd, 0x83, 0x4a, 0x6b, 0x06, 0x29,
+	0x13, 0xd2, 0xad, 0x7a, 0xd5, 0x4e, 0xa3, 0xdb, 0x36, 0xfa, 0x2f, 0x75, 0x75, 0x05, 0x12, 0xf4,
+	0x94, 0x66, 0xb4, 0xd6, 0xeb, 0x33, 0x21, 0xd1, 0x9d, 0xd3, 0xfc, 0x73, 0x94, 0x70, 0x6b, 0x4a,
+	0x7a, 0x60, 0x94, 0x5e, 0x2b, 0x78, 0xa5, 0xd6, 0xda, 0xcd, 0x71, 0xa9, 0x2b, 0x7a, 0x83, 0xe9,
+	0x1c, 0xdb, 0xb3, 0x39, 0xb6, 0x7f, 0xe6, 0xd8, 0xfe, 0x5c, 0x60, 0x6b, 0xb6, 0xc0, 0xd6, 0xd7,
+	0x02, 0x5b, 0x0f, 0xe7, 0x09, 0x93, 0x8f, 0x2f, 0xa1, 0x1f, 0xf1, 0x09, 0x19, 0x29, 0xff, 0x71,
+	0x1f, 0x42, 0x41, 0x74, 0xda, 0xaf, 0xa7, 0x27, 0xe4, 0xad, 0x9c, 0xb9, 0x7c, 0xcf, 0xa8, 0x08,
+	0xeb, 0x2a, 0xea, 0xb3, 0xdf, 0x01, 0x00, 0x7a, 0x16, 0xfb, 0x7f, 0x30, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -132,6 +142,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FailedCallbacks) > 0 {
+		for iNdEx := len(m.FailedCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.CallbackDataList) > 0 {
 		for iNdEx := len(m.CallbackDataList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -195,6 +219,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FailedCallbacks) > 0 {
+		for _, e := range m.FailedCallbacks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -332,6 +362,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedCallbacks = append(m.FailedCallbacks, FailedCallback{})
+			if err := m.FailedCallbacks[len(m.FailedCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"strings"
+
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+)
+
+const (
+	// FailedCallbackKeyPrefix is the prefix to retrieve all FailedCallbacks
+	FailedCallbackKeyPrefix = "FailedCallback/value/"
+
+	// Only the most recent failed callbacks are retained in the store
+	MaxFailedCallbacks = 1000
+)
+
+// FailedCallbackSequenceKey stores the id of the next failed callback
+var FailedCallbackSequenceKey = KeyPrefix("FailedCallback/sequence/")
+
+// Parses the host zone chain ID from an ICA controller port (icacontroller-{chainId}.{accountType})
+// Returns an empty string if the port is not an ICA controller port (e.g. the transfer port)
+func HostZoneIdFromPortId(portId string) string {
+	if !strings.HasPrefix(portId, icatypes.ControllerPortPrefix) {
+		return ""
+	}
+	owner := strings.TrimPrefix(portId, icatypes.ControllerPortPrefix)
+	separatorIndex := strings.LastIndex(owner, ".")
+	if separatorIndex == -1 {
+		return ""
+	}
+	return owner[:separatorIndex]
+}
@@ -297,6 +297,118 @@ func (m *QueryAllCallbackDataResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryFailedCallbacksRequest struct {
+	HostZoneId string             `protobuf:"bytes,1,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+	CallbackId string             `protobuf:"bytes,2,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedCallbacksRequest) Reset()         { *m = QueryFailedCallbacksRequest{} }
+func (m *QueryFailedCallbacksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedCallbacksRequest) ProtoMessage()    {}
+func (*QueryFailedCallbacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e73b99abb7e91c2, []int{6}
+}
+func (m *QueryFailedCallbacksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedCallbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedCallbacksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedCallbacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedCallbacksRequest.Merge(m, src)
+}
+func (m *QueryFailedCallbacksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedCallbacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedCallbacksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedCallbacksRequest proto.InternalMessageInfo
+
+func (m *QueryFailedCallbacksRequest) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+func (m *QueryFailedCallbacksRequest) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *QueryFailedCallbacksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFailedCallbacksResponse struct {
+	FailedCallbacks []FailedCallback    `protobuf:"bytes,1,rep,name=failed_callbacks,json=failedCallbacks,proto3" json:"failed_callbacks"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedCallbacksResponse) Reset()         { *m = QueryFailedCallbacksResponse{} }
+func (m *QueryFailedCallbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedCallbacksResponse) ProtoMessage()    {}
+func (*QueryFailedCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e73b99abb7e91c2, []int{7}
+}
+func (m *QueryFailedCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedCallbacksResponse.Merge(m, src)
+}
+func (m *QueryFailedCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedCallbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedCallbacksResponse proto.InternalMessageInfo
+
+func (m *QueryFailedCallbacksResponse) GetFailedCallbacks() []FailedCallback {
+	if m != nil {
+		return m.FailedCallbacks
+	}
+	return nil
+}
+
+func (m *QueryFailedCallbacksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "stride.icacallbacks.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "stride.icacallbacks.QueryParamsResponse")
@@ -304,45 +416,55 @@ func init() {
 	proto.RegisterType((*QueryGetCallbackDataResponse)(nil), "stride.icacallbacks.QueryGetCallbackDataResponse")
 	proto.RegisterType((*QueryAllCallbackDataRequest)(nil), "stride.icacallbacks.QueryAllCallbackDataRequest")
 	proto.RegisterType((*QueryAllCallbackDataResponse)(nil), "stride.icacallbacks.QueryAllCallbackDataResponse")
+	proto.RegisterType((*QueryFailedCallbacksRequest)(nil), "stride.icacallbacks.QueryFailedCallbacksRequest")
+	proto.RegisterType((*QueryFailedCallbacksResponse)(nil), "stride.icacallbacks.QueryFailedCallbacksResponse")
 }
 
 func init() { proto.RegisterFile("stride/icacallbacks/query.proto", fileDescriptor_5e73b99abb7e91c2) }
 
 var fileDescriptor_5e73b99abb7e91c2 = []byte{
-	// 520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3f, 0x6f, 0x13, 0x31,
-	0x18, 0xc6, 0xe3, 0x52, 0x22, 0xe1, 0x16, 0x21, 0xb9, 0x1d, 0x50, 0x5a, 0x5d, 0xdb, 0x1b, 0x48,
-	0x41, 0xc2, 0x4e, 0x8a, 0x54, 0x89, 0x01, 0x41, 0xcb, 0x9f, 0x0e, 0x74, 0x08, 0x61, 0x63, 0x41,
-	0xef, 0x5d, 0xad, 0xe3, 0x54, 0xe7, 0x7c, 0x8d, 0x9d, 0x8a, 0x08, 0xb1, 0x20, 0x46, 0x06, 0x24,
-	0xbe, 0x07, 0x1b, 0x0b, 0x9f, 0xa0, 0x63, 0x25, 0x16, 0x26, 0x84, 0x12, 0x3e, 0x08, 0x8a, 0xed,
-	0x94, 0x3b, 0xd5, 0xd7, 0xa8, 0x6c, 0x96, 0xfd, 0xbc, 0xcf, 0xf3, 0x7b, 0xef, 0x7d, 0x75, 0x78,
-	0x4d, 0xe9, 0x7e, 0x7a, 0xc0, 0x59, 0x1a, 0x43, 0x0c, 0x42, 0x44, 0x10, 0x1f, 0x2a, 0x76, 0x34,
-	0xe0, 0xfd, 0x21, 0xcd, 0xfb, 0x52, 0x4b, 0xb2, 0x64, 0x05, 0xb4, 0x28, 0x68, 0x2c, 0x27, 0x32,
-	0x91, 0xe6, 0x9d, 0x4d, 0x4e, 0x56, 0xda, 0x58, 0x4d, 0xa4, 0x4c, 0x04, 0x67, 0x90, 0xa7, 0x0c,
-	0xb2, 0x4c, 0x6a, 0xd0, 0xa9, 0xcc, 0x94, 0x7b, 0xbd, 0x13, 0x4b, 0xd5, 0x93, 0x8a, 0x45, 0xa0,
-	0xb8, 0x4d, 0x60, 0xc7, 0xed, 0x88, 0x6b, 0x68, 0xb3, 0x1c, 0x92, 0x34, 0x33, 0x62, 0xa7, 0x5d,
-	0xf7, 0x51, 0xe5, 0xd0, 0x87, 0xde, 0xd4, 0xad, 0xe9, 0x53, 0x4c, 0x4f, 0xaf, 0x0f, 0x40, 0x83,
-	0x15, 0x86, 0xcb, 0x98, 0xbc, 0x98, 0x84, 0x75, 0x4c, 0x75, 0x97, 0x1f, 0x0d, 0xb8, 0xd2, 0x61,
-	0x07, 0x2f, 0x95, 0x6e, 0x55, 0x2e, 0x33, 0xc5, 0xc9, 0x7d, 0x5c, 0xb7, 0x29, 0x37, 0xd1, 0x3a,
-	0xda, 0x5c, 0xd8, 0x5a, 0xa1, 0x9e, 0xee, 0xa9, 0x2d, 0xda, 0x9d, 0x3f, 0xf9, 0xb5, 0x56, 0xeb,
-	0xba, 0x82, 0xf0, 0x11, 0x5e, 0x31, 0x8e, 0x7b, 0x5c, 0x3f, 0x76, 0xca, 0x27, 0xa0, 0xc1, 0x05,
-	0x92, 0x0d, 0xbc, 0x78, 0x46, 0x77, 0xc8, 0x87, 0xc6, 0xff, 0x5a, 0x77, 0x61, 0x7a, 0xf7, 0x9c,
-	0x0f, 0x43, 0x81, 0x57, 0xfd, 0x0e, 0x0e, 0x6e, 0x1f, 0x5f, 0x2f, 0x35, 0xe8, 0x18, 0x37, 0xbc,
-	0x8c, 0x45, 0x07, 0x47, 0x7a, 0x06, 0x30, 0xb9, 0x0b, 0xb9, 0xe3, 0xdd, 0x11, 0xc2, 0xc7, 0xfb,
-	0x0c, 0xe3, 0x7f, 0x53, 0x71, 0x49, 0xb7, 0xa8, 0x1d, 0x21, 0x9d, 0x8c, 0x90, 0xda, 0x25, 0x71,
-	0x23, 0xa4, 0x1d, 0x48, 0xb8, 0xab, 0xed, 0x16, 0x2a, 0xc3, 0x6f, 0xc8, 0x75, 0x75, 0x2e, 0xa7,
-	0xba, 0xab, 0x2b, 0xff, 0xdd, 0x15, 0xd9, 0x2b, 0x61, 0xcf, 0x19, 0xec, 0xe6, 0x4c, 0x6c, 0x8b,
-	0x52, 0xe4, 0xde, 0xfa, 0x38, 0x8f, 0xaf, 0x1a, 0x6e, 0xf2, 0x09, 0xe1, 0xba, 0x9d, 0x38, 0x69,
-	0x7a, 0xa1, 0xce, 0xaf, 0x57, 0x63, 0x73, 0xb6, 0xd0, 0x66, 0x86, 0xec, 0xc3, 0x8f, 0x3f, 0x5f,
-	0xe6, 0x6e, 0x93, 0x26, 0x7b, 0x69, 0x2a, 0xee, 0xee, 0x43, 0xa4, 0x58, 0xf5, 0xfa, 0x93, 0xef,
-	0x08, 0x2f, 0x16, 0x3f, 0x03, 0x69, 0x55, 0x67, 0xf9, 0x77, 0xb1, 0xd1, 0xbe, 0x44, 0x85, 0xc3,
-	0x7c, 0x6a, 0x30, 0x1f, 0x92, 0x07, 0x33, 0x31, 0x4b, 0xc3, 0x64, 0xef, 0x8a, 0x4b, 0xff, 0x9e,
-	0x7c, 0x45, 0xf8, 0x46, 0xd1, 0x7f, 0x47, 0x88, 0x8b, 0xf8, 0xfd, 0xbb, 0x79, 0x11, 0x7f, 0xc5,
-	0x96, 0x85, 0xdb, 0x86, 0xbf, 0x45, 0xe8, 0xe5, 0xf8, 0x77, 0x3b, 0x27, 0xa3, 0x00, 0x9d, 0x8e,
-	0x02, 0xf4, 0x7b, 0x14, 0xa0, 0xcf, 0xe3, 0xa0, 0x76, 0x3a, 0x0e, 0x6a, 0x3f, 0xc7, 0x41, 0xed,
-	0xd5, 0x76, 0x92, 0xea, 0x37, 0x83, 0x88, 0xc6, 0xb2, 0xe7, 0xf3, 0x3c, 0x6e, 0xb7, 0xd8, 0xdb,
-	0xb2, 0xb3, 0x1e, 0xe6, 0x5c, 0x45, 0x75, 0xf3, 0x5b, 0xba, 0xf7, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0x8e, 0xd8, 0x4e, 0x92, 0x79, 0x05, 0x00, 0x00,
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0xbb, 0x20, 0x24, 0x0e, 0x35, 0x98, 0x81, 0x83, 0x59, 0xc8, 0x02, 0x6b, 0x62, 0xc1,
+	0xc4, 0x1d, 0x16, 0x12, 0x12, 0x0e, 0x46, 0x41, 0x85, 0x10, 0x39, 0xd4, 0xea, 0x89, 0x4b, 0x33,
+	0xbb, 0x3b, 0x2c, 0x1b, 0x96, 0x9d, 0xa5, 0x33, 0x25, 0x56, 0xe3, 0xc5, 0xb3, 0x07, 0x13, 0xbf,
+	0x84, 0x27, 0x13, 0x0f, 0x26, 0xc6, 0xc4, 0x3b, 0x47, 0x12, 0x2f, 0x9e, 0x8c, 0x69, 0xfd, 0x20,
+	0xa6, 0x33, 0xb3, 0xb8, 0x5b, 0xa6, 0x34, 0x25, 0xde, 0x9a, 0xb7, 0xff, 0xf7, 0xde, 0x6f, 0xfe,
+	0x6f, 0xde, 0x14, 0xcc, 0x31, 0xde, 0x88, 0x02, 0x82, 0x22, 0x1f, 0xfb, 0x38, 0x8e, 0x3d, 0xec,
+	0x1f, 0x32, 0x74, 0xdc, 0x24, 0x8d, 0x96, 0x93, 0x36, 0x28, 0xa7, 0x70, 0x4a, 0x0a, 0x9c, 0xbc,
+	0xc0, 0x9c, 0x0e, 0x69, 0x48, 0xc5, 0x77, 0xd4, 0xfd, 0x25, 0xa5, 0xe6, 0x6c, 0x48, 0x69, 0x18,
+	0x13, 0x84, 0xd3, 0x08, 0xe1, 0x24, 0xa1, 0x1c, 0xf3, 0x88, 0x26, 0x4c, 0x7d, 0xbd, 0xeb, 0x53,
+	0x76, 0x44, 0x19, 0xf2, 0x30, 0x23, 0xb2, 0x03, 0x3a, 0x71, 0x3d, 0xc2, 0xb1, 0x8b, 0x52, 0x1c,
+	0x46, 0x89, 0x10, 0x2b, 0xed, 0xbc, 0x8e, 0x2a, 0xc5, 0x0d, 0x7c, 0x94, 0x55, 0xab, 0xe8, 0x14,
+	0xd9, 0xaf, 0x7a, 0x80, 0x39, 0x56, 0xc2, 0x25, 0x9d, 0x70, 0x1f, 0x47, 0x31, 0x09, 0xea, 0x59,
+	0x40, 0x4a, 0xed, 0x69, 0x00, 0x9f, 0x75, 0xb9, 0xaa, 0xa2, 0x51, 0x8d, 0x1c, 0x37, 0x09, 0xe3,
+	0x76, 0x15, 0x4c, 0x15, 0xa2, 0x2c, 0xa5, 0x09, 0x23, 0x70, 0x1d, 0x8c, 0x4b, 0xa0, 0x5b, 0xc6,
+	0xbc, 0xb1, 0x38, 0xb1, 0x32, 0xe3, 0x68, 0x8c, 0x72, 0x64, 0xd2, 0xe6, 0xb5, 0xd3, 0x5f, 0x73,
+	0xa5, 0x9a, 0x4a, 0xb0, 0x1f, 0x82, 0x19, 0x51, 0x71, 0x9b, 0xf0, 0x47, 0x4a, 0xf9, 0x18, 0x73,
+	0xac, 0x1a, 0xc2, 0x05, 0x50, 0x3e, 0x3f, 0xc8, 0x21, 0x69, 0x89, 0xfa, 0xd7, 0x6b, 0x13, 0x59,
+	0xec, 0x29, 0x69, 0xd9, 0x31, 0x98, 0xd5, 0x57, 0x50, 0x70, 0xbb, 0xe0, 0x46, 0xc1, 0x0b, 0xc5,
+	0xb8, 0xa0, 0x65, 0xcc, 0x57, 0x50, 0xa4, 0x65, 0x3f, 0x17, 0xb3, 0x89, 0xe2, 0xdd, 0x88, 0x63,
+	0x1d, 0xef, 0x16, 0x00, 0xff, 0x06, 0xa8, 0x3a, 0xdd, 0x71, 0xe4, 0xb4, 0x9d, 0xee, 0xb4, 0x1d,
+	0x79, 0x9f, 0xd4, 0xb4, 0x9d, 0x2a, 0x0e, 0x89, 0xca, 0xad, 0xe5, 0x32, 0xed, 0x2f, 0x06, 0x98,
+	0xd5, 0xf7, 0xe9, 0x7f, 0xaa, 0xd1, 0x2b, 0x9f, 0x0a, 0x6e, 0x17, 0xb0, 0x47, 0x04, 0x76, 0x65,
+	0x20, 0xb6, 0x44, 0x29, 0x70, 0x7f, 0x34, 0x94, 0x3f, 0x5b, 0xe2, 0x56, 0x65, 0x8d, 0xb3, 0x0b,
+	0x04, 0xe7, 0x41, 0xf9, 0x80, 0x32, 0x5e, 0x7f, 0x45, 0x13, 0x52, 0x8f, 0x02, 0x35, 0x4f, 0xd0,
+	0x8d, 0xed, 0xd1, 0x84, 0xec, 0x04, 0x70, 0x0e, 0x9c, 0x4f, 0xb7, 0x2b, 0x18, 0x91, 0x82, 0x2c,
+	0xb4, 0x13, 0xf4, 0x58, 0x3c, 0x7a, 0x65, 0x8b, 0xbf, 0x67, 0x16, 0x5f, 0x40, 0x55, 0x16, 0xbf,
+	0x00, 0x37, 0x7b, 0x76, 0x83, 0x29, 0x97, 0x6f, 0x6b, 0x5d, 0x2e, 0xd6, 0x51, 0x3e, 0x4f, 0xee,
+	0x17, 0xab, 0xff, 0x37, 0xab, 0x57, 0xbe, 0x8e, 0x81, 0x31, 0xc1, 0x0f, 0xdf, 0x19, 0x60, 0x5c,
+	0x2e, 0x17, 0xac, 0x68, 0xc9, 0x2e, 0x6e, 0xb2, 0xb9, 0x38, 0x58, 0x28, 0x7b, 0xda, 0xe8, 0xed,
+	0x8f, 0x3f, 0x1f, 0x46, 0x96, 0x60, 0x05, 0x3d, 0x17, 0x19, 0xf7, 0x76, 0xb1, 0xc7, 0x50, 0xff,
+	0x47, 0x09, 0x7e, 0x33, 0x40, 0x39, 0x7f, 0xe3, 0xe0, 0x72, 0xff, 0x5e, 0xfa, 0xb5, 0x37, 0xdd,
+	0x21, 0x32, 0x14, 0xe6, 0x13, 0x81, 0xf9, 0x00, 0xde, 0x1f, 0x88, 0x59, 0xd8, 0x1b, 0xf4, 0x3a,
+	0xff, 0xbe, 0xbc, 0x81, 0x9f, 0x0c, 0x30, 0x99, 0xaf, 0xbf, 0x11, 0xc7, 0x97, 0xf1, 0xeb, 0x9f,
+	0x01, 0xd3, 0x1d, 0x22, 0x43, 0xf1, 0xaf, 0x09, 0xfe, 0x65, 0xe8, 0x0c, 0xc7, 0x0f, 0x3f, 0x1b,
+	0x60, 0xb2, 0xe7, 0x06, 0x5f, 0x06, 0xac, 0xdf, 0x4b, 0xd3, 0x1d, 0x22, 0x43, 0x01, 0xaf, 0x0b,
+	0xe0, 0x55, 0xe8, 0x0e, 0x04, 0xee, 0xdd, 0xa2, 0xcd, 0xea, 0x69, 0xdb, 0x32, 0xce, 0xda, 0x96,
+	0xf1, 0xbb, 0x6d, 0x19, 0xef, 0x3b, 0x56, 0xe9, 0xac, 0x63, 0x95, 0x7e, 0x76, 0xac, 0xd2, 0xde,
+	0x5a, 0x18, 0xf1, 0x83, 0xa6, 0xe7, 0xf8, 0xf4, 0x48, 0x57, 0xf6, 0xc4, 0x5d, 0x46, 0x2f, 0x8b,
+	0xc5, 0x79, 0x2b, 0x25, 0xcc, 0x1b, 0x17, 0xff, 0x5a, 0xab, 0x7f, 0x07, 0x00, 0x5e, 0xd0, 0x6c,
+	0xcc, 0xc3, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -363,6 +485,9 @@ type QueryClient interface {
 	CallbackData(ctx context.Context, in *QueryGetCallbackDataRequest, opts ...grpc.CallOption) (*QueryGetCallbackDataResponse, error)
 	// Queries a list of CallbackData items.
 	CallbackDataAll(ctx context.Context, in *QueryAllCallbackDataRequest, opts ...grpc.CallOption) (*QueryAllCallbackDataResponse, error)
+	// Queries the packets that timed out or failed, optionally filtered by host
+	// zone and callback id
+	FailedCallbacks(ctx context.Context, in *QueryFailedCallbacksRequest, opts ...grpc.CallOption) (*QueryFailedCallbacksResponse, error)
 }
 
 type queryClient struct {
@@ -400,6 +525,15 @@ func (c *queryClient) CallbackDataAll(ctx context.Context, in *QueryAllCallbackD
 	return out, nil
 }
 
+func (c *queryClient) FailedCallbacks(ctx context.Context, in *QueryFailedCallbacksRequest, opts ...grpc.CallOption) (*QueryFailedCallbacksResponse, error) {
+	out := new(QueryFailedCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/stride.icacallbacks.Query/FailedCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -408,6 +542,9 @@ type QueryServer interface {
 	CallbackData(context.Context, *QueryGetCallbackDataRequest) (*QueryGetCallbackDataResponse, error)
 	// Queries a list of CallbackData items.
 	CallbackDataAll(context.Context, *QueryAllCallbackDataRequest) (*QueryAllCallbackDataResponse, error)
+	// Queries the packets that timed out or failed, optionally filtered by host
+	// zone and callback id
+	FailedCallbacks(context.Context, *QueryFailedCallbacksRequest) (*QueryFailedCallbacksResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -423,6 +560,9 @@ func (*UnimplementedQueryServer) CallbackData(ctx context.Context, req *QueryGet
 func (*UnimplementedQueryServer) CallbackDataAll(ctx context.Context, req *QueryAllCallbackDataRequest) (*QueryAllCallbackDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CallbackDataAll not implemented")
 }
+func (*UnimplementedQueryServer) FailedCallbacks(ctx context.Context, req *QueryFailedCallbacksRequest) (*QueryFailedCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedCallbacks not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -482,6 +622,24 @@ func _Query_CallbackDataAll_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FailedCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.icacallbacks.Query/FailedCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedCallbacks(ctx, req.(*QueryFailedCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.icacallbacks.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -498,6 +656,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CallbackDataAll",
 			Handler:    _Query_CallbackDataAll_Handler,
 		},
+		{
+			MethodName: "FailedCallbacks",
+			Handler:    _Query_FailedCallbacks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stride/icacallbacks/query.proto",
@@ -706,6 +868,104 @@ func (m *QueryAllCallbackDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFailedCallbacksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FailedCallbacks) > 0 {
+		for iNdEx := len(m.FailedCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -793,6 +1053,46 @@ func (m *QueryAllCallbackDataResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFailedCallbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedCallbacks) > 0 {
+		for _, e := range m.FailedCallbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1303,6 +1603,276 @@ func (m *QueryAllCallbackDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFailedCallbacksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedCallbacksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedCallbacksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedCallbacksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedCallbacks = append(m.FailedCallbacks, FailedCallback{})
+			if err := m.FailedCallbacks[len(m.FailedCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
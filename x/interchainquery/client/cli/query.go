@@ -28,11 +28,31 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCmdListPendingQueries(),
+		GetCmdListFailedQueries(),
+		GetCmdListPeriodicQueries(),
+		GetCmdShowDataPoint(),
 	)
 
 	return cmd
 }
 
+const (
+	FlagQueryChainId = "query-chain-id"
+	FlagConnectionId = "connection-id"
+	FlagCallbackId   = "callback-id"
+	FlagRequestSent  = "request-sent"
+	RequestSentAll   = "all"
+	RequestSentTrue  = "true"
+	RequestSentFalse = "false"
+)
+
+// Adds the optional chain, connection and callback filters to a query command
+func addQueryFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagQueryChainId, "", "Only include queries to the given host chain")
+	cmd.Flags().String(FlagConnectionId, "", "Only include queries on the given connection")
+	cmd.Flags().String(FlagCallbackId, "", "Only include queries with the given callback id")
+}
+
 // GetCmdQueries provides a list of all pending queries
 // (queries that have not have been requested but have not received a response)
 func GetCmdListPendingQueries() *cobra.Command {
@@ -40,7 +60,8 @@ func GetCmdListPendingQueries() *cobra.Command {
 		Use:   "list-pending-queries",
 		Short: "Query all pending queries",
 		Example: strings.TrimSpace(
-			fmt.Sprintf(`$ %s query interchainquery list-pending-queries`,
+			fmt.Sprintf(`$ %[1]s query interchainquery list-pending-queries
+$ %[1]s query interchainquery list-pending-queries --query-chain-id cosmoshub-4 --request-sent all`,
 				version.AppName,
 			),
 		),
@@ -52,7 +73,47 @@ func GetCmdListPendingQueries() *cobra.Command {
 			}
 			queryClient := types.NewQueryServiceClient(clientCtx)
 
-			req := &types.QueryPendingQueriesRequest{}
+			chainId, err := cmd.Flags().GetString(FlagQueryChainId)
+			if err != nil {
+				return err
+			}
+			connectionId, err := cmd.Flags().GetString(FlagConnectionId)
+			if err != nil {
+				return err
+			}
+			callbackId, err := cmd.Flags().GetString(FlagCallbackId)
+			if err != nil {
+				return err
+			}
+			requestSent, err := cmd.Flags().GetString(FlagRequestSent)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var requestSentFilter types.RequestSentFilter
+			switch requestSent {
+			case RequestSentTrue:
+				requestSentFilter = types.RequestSentFilter_REQUEST_SENT
+			case RequestSentFalse:
+				requestSentFilter = types.RequestSentFilter_REQUEST_NOT_SENT
+			case RequestSentAll:
+				requestSentFilter = types.RequestSentFilter_ALL_REQUESTS
+			default:
+				return fmt.Errorf("invalid %s value (%s), must be one of: %s, %s, %s",
+					FlagRequestSent, requestSent, RequestSentTrue, RequestSentFalse, RequestSentAll)
+			}
+
+			req := &types.QueryPendingQueriesRequest{
+				ChainId:      chainId,
+				ConnectionId: connectionId,
+				CallbackId:   callbackId,
+				RequestSent:  requestSentFilter,
+				Pagination:   pageReq,
+			}
 
 			res, err := queryClient.PendingQueries(context.Background(), req)
 			if err != nil {
@@ -63,6 +124,139 @@ func GetCmdListPendingQueries() *cobra.Command {
 		},
 	}
 
+	addQueryFilterFlags(cmd)
+	cmd.Flags().String(FlagRequestSent, RequestSentTrue,
+		fmt.Sprintf("Filter by whether the request has been emitted (%s, %s, or %s)", RequestSentTrue, RequestSentFalse, RequestSentAll))
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list-pending-queries")
+
+	return cmd
+}
+
+// GetCmdListFailedQueries provides a list of the most recent queries whose callback failed
+func GetCmdListFailedQueries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-failed-queries",
+		Short: "Query the most recent queries whose callback failed",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %[1]s query interchainquery list-failed-queries
+$ %[1]s query interchainquery list-failed-queries --query-chain-id cosmoshub-4 --callback-id withdrawalbalance`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			chainId, err := cmd.Flags().GetString(FlagQueryChainId)
+			if err != nil {
+				return err
+			}
+			connectionId, err := cmd.Flags().GetString(FlagConnectionId)
+			if err != nil {
+				return err
+			}
+			callbackId, err := cmd.Flags().GetString(FlagCallbackId)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFailedQueriesRequest{
+				ChainId:      chainId,
+				ConnectionId: connectionId,
+				CallbackId:   callbackId,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.FailedQueries(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addQueryFilterFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list-failed-queries")
+
+	return cmd
+}
+
+// GetCmdListPeriodicQueries provides a list of all queries that are re-submitted on a recurring schedule
+func GetCmdListPeriodicQueries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-periodic-queries",
+		Short: "Query all periodic queries",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainquery list-periodic-queries`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			req := &types.QueryPeriodicQueriesRequest{}
+
+			res, err := queryClient.PeriodicQueries(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdShowDataPoint provides the latest result of a periodic query
+func GetCmdShowDataPoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-data-point [query-id]",
+		Short: "Query the latest result of a periodic query",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainquery show-data-point [query-id]`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			req := &types.QueryDataPointRequest{
+				QueryId: args[0],
+			}
+
+			res, err := queryClient.DataPoint(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
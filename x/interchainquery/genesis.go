@@ -15,11 +15,27 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		// Initialize empty epoch values via Cosmos SDK
 		k.SetQuery(ctx, query)
 	}
+	for _, periodicQuery := range genState.PeriodicQueries {
+		k.SetPeriodicQuery(ctx, periodicQuery)
+	}
+	for _, dataPoint := range genState.DataPoints {
+		k.SetDataPoint(ctx, dataPoint)
+	}
+	// the failed query sequence continues from the most recent failure
+	for _, failedQuery := range genState.FailedQueries {
+		k.SetFailedQuery(ctx, failedQuery)
+		if failedQuery.Id >= k.GetFailedQuerySequence(ctx) {
+			k.SetFailedQuerySequence(ctx, failedQuery.Id+1)
+		}
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Queries: k.AllQueries(ctx),
+		Queries:         k.AllQueries(ctx),
+		PeriodicQueries: k.AllPeriodicQueries(ctx),
+		DataPoints:      k.AllDataPoints(ctx),
+		FailedQueries:   k.AllFailedQueries(ctx),
 	}
 }
@@ -15,6 +15,17 @@ import (
 func (k Keeper) EndBlocker(ctx sdk.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 	_ = k.Logger(ctx)
+
+	// handle queries that were sent but whose TTL elapsed without a response
+	for _, query := range k.AllQueries(ctx) {
+		if query.RequestSent && k.IsQueryTimedOut(ctx, query) {
+			k.HandleQueryTimeout(ctx, query, false)
+		}
+	}
+
+	// re-submit any periodic queries that are due
+	k.SubmitDuePeriodicQueries(ctx)
+
 	events := sdk.Events{}
 	// emit events for periodic queries
 	k.IterateQueries(ctx, func(_ int64, query types.Query) (stop bool) {
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"time"
+
+	_ "github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/v10/x/interchainquery/types"
+)
+
+func (s *KeeperTestSuite) TestEndBlocker_QueryTimeouts() {
+	blockTime := uint64(s.Ctx.BlockTime().UnixNano())
+	timeoutDuration := uint64(time.Minute.Nanoseconds())
+
+	expiredTtl := blockTime - 1
+	longExpiredTtl := blockTime - timeoutDuration - 1
+	activeTtl := blockTime + timeoutDuration
+
+	queries := []types.Query{
+		// Sent queries with a ttl that has not elapsed should be untouched
+		{Id: "active", Ttl: activeTtl, RequestSent: true, TimeoutPolicy: types.TimeoutPolicy_REJECT_QUERY_RESPONSE},
+		// Stale queries with the reject policy should be removed
+		{Id: "rejected", Ttl: expiredTtl, RequestSent: true, TimeoutPolicy: types.TimeoutPolicy_REJECT_QUERY_RESPONSE},
+		// Stale queries with the retry policy should be re-emitted with a new ttl
+		{Id: "retried", Ttl: expiredTtl, RequestSent: true, TimeoutPolicy: types.TimeoutPolicy_RETRY_QUERY_REQUEST, TimeoutDuration: timeoutDuration},
+		// Stale queries with the execute policy are kept for another timeout duration to wait for a late response
+		{Id: "execute-waiting", Ttl: expiredTtl, RequestSent: true, TimeoutPolicy: types.TimeoutPolicy_EXECUTE_QUERY_RESPONSE, TimeoutDuration: timeoutDuration},
+		{Id: "execute-expired", Ttl: longExpiredTtl, RequestSent: true, TimeoutPolicy: types.TimeoutPolicy_EXECUTE_QUERY_RESPONSE, TimeoutDuration: timeoutDuration},
+		// Queries that have not been sent yet are emitted regardless of their ttl
+		{Id: "unsent", Ttl: expiredTtl, RequestSent: false, TimeoutPolicy: types.TimeoutPolicy_REJECT_QUERY_RESPONSE},
+	}
+	for _, query := range queries {
+		s.App.InterchainqueryKeeper.SetQuery(s.Ctx, query)
+	}
+
+	s.App.InterchainqueryKeeper.EndBlocker(s.Ctx)
+
+	// Check the remaining queries
+	expectedRemaining := map[string]uint64{
+		"active":          activeTtl,
+		"retried":         blockTime + timeoutDuration,
+		"execute-waiting": expiredTtl,
+		"unsent":          expiredTtl,
+	}
+	remainingQueries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(remainingQueries, len(expectedRemaining), "number of remaining queries")
+	for _, query := range remainingQueries {
+		expectedTtl, ok := expectedRemaining[query.Id]
+		s.Require().True(ok, "query %s should have been removed", query.Id)
+		s.Require().Equal(expectedTtl, query.Ttl, "ttl for query %s", query.Id)
+		s.Require().True(query.RequestSent, "query %s should be marked as sent", query.Id)
+	}
+
+	// Check that a timeout event was emitted for each handled query
+	outcomes := map[string]string{}
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != types.EventTypeQueryTimeout {
+			continue
+		}
+		var queryId, outcome string
+		for _, attribute := range event.Attributes {
+			switch attribute.Key {
+			case types.AttributeKeyQueryId:
+				queryId = attribute.Value
+			case types.AttributeKeyOutcome:
+				outcome = attribute.Value
+			}
+		}
+		outcomes[queryId] = outcome
+	}
+	expectedOutcomes := map[string]string{
+		"rejected":        types.AttributeValueQueryRejected,
+		"retried":         types.AttributeValueQueryRetried,
+		"execute-expired": types.AttributeValueQueryRejected,
+	}
+	s.Require().Equal(expectedOutcomes, outcomes, "query timeout events")
+}
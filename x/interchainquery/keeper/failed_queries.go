@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v10/x/interchainquery/types"
+)
+
+// Records a query whose callback returned an error
+// Only the most recent MaxFailedQueries are retained, so the oldest record is removed once the limit is exceeded
+func (k Keeper) AddFailedQuery(ctx sdk.Context, query types.Query, errMsg string) {
+	id := k.GetFailedQuerySequence(ctx)
+	k.SetFailedQuery(ctx, types.FailedQuery{
+		Id:           id,
+		Query:        query,
+		Error:        errMsg,
+		FailedHeight: ctx.BlockHeight(),
+	})
+	k.SetFailedQuerySequence(ctx, id+1)
+
+	if id >= types.MaxFailedQueries {
+		k.RemoveFailedQuery(ctx, id-types.MaxFailedQueries)
+	}
+}
+
+// GetFailedQuerySequence returns the id of the next failed query
+func (k Keeper) GetFailedQuerySequence(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.FailedQuerySequenceKey)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetFailedQuerySequence sets the id of the next failed query
+func (k Keeper) SetFailedQuerySequence(ctx sdk.Context, sequence uint64) {
+	ctx.KVStore(k.storeKey).Set(types.FailedQuerySequenceKey, sdk.Uint64ToBigEndian(sequence))
+}
+
+// GetFailedQuery returns a failed query
+func (k Keeper) GetFailedQuery(ctx sdk.Context, id uint64) (types.FailedQuery, bool) {
+	failedQuery := types.FailedQuery{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedQuery)
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
+	if len(bz) == 0 {
+		return failedQuery, false
+	}
+	k.cdc.MustUnmarshal(bz, &failedQuery)
+	return failedQuery, true
+}
+
+// SetFailedQuery sets a failed query
+func (k Keeper) SetFailedQuery(ctx sdk.Context, failedQuery types.FailedQuery) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedQuery)
+	bz := k.cdc.MustMarshal(&failedQuery)
+	store.Set(sdk.Uint64ToBigEndian(failedQuery.Id), bz)
+}
+
+// RemoveFailedQuery removes a failed query
+func (k Keeper) RemoveFailedQuery(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedQuery)
+	store.Delete(sdk.Uint64ToBigEndian(id))
+}
+
+// AllFailedQueries returns every failed query in the store, ordered from oldest to newest
+func (k Keeper) AllFailedQueries(ctx sdk.Context) []types.FailedQuery {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedQuery)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	failedQueries := []types.FailedQuery{}
+	for ; iterator.Valid(); iterator.Next() {
+		failedQuery := types.FailedQuery{}
+		k.cdc.MustUnmarshal(iterator.Value(), &failedQuery)
+		failedQueries = append(failedQueries, failedQuery)
+	}
+	return failedQueries
+}
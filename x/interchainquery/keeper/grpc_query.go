@@ -3,7 +3,11 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/Stride-Labs/stride/v10/x/interchainquery/types"
 )
@@ -11,15 +15,99 @@ import (
 var _ types.QueryServiceServer = Keeper{}
 
 // Queries all queries that have been requested but have not received a response
+// Queries can optionally be filtered by chain, connection, callback, and whether the request has been emitted
 func (k Keeper) PendingQueries(c context.Context, req *types.QueryPendingQueriesRequest) (*types.QueryPendingQueriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
 	ctx := sdk.UnwrapSDKContext(c)
 
 	pendingQueries := []types.Query{}
-	for _, query := range k.AllQueries(ctx) {
-		if query.RequestSent {
-			pendingQueries = append(pendingQueries, query)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQuery)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var pendingQuery types.Query
+		if err := k.cdc.Unmarshal(value, &pendingQuery); err != nil {
+			return false, err
+		}
+
+		if !queryMatchesFilters(pendingQuery, req.ChainId, req.ConnectionId, req.CallbackId) {
+			return false, nil
+		}
+		if (req.RequestSent == types.RequestSentFilter_REQUEST_SENT && !pendingQuery.RequestSent) ||
+			(req.RequestSent == types.RequestSentFilter_REQUEST_NOT_SENT && pendingQuery.RequestSent) {
+			return false, nil
+		}
+
+		if accumulate {
+			pendingQueries = append(pendingQueries, pendingQuery)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingQueriesResponse{PendingQueries: pendingQueries, Pagination: pageRes}, nil
+}
+
+// Queries the most recent queries whose callback returned an error
+// Queries can optionally be filtered by chain, connection, and callback
+func (k Keeper) FailedQueries(c context.Context, req *types.QueryFailedQueriesRequest) (*types.QueryFailedQueriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	failedQueries := []types.FailedQuery{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedQuery)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var failedQuery types.FailedQuery
+		if err := k.cdc.Unmarshal(value, &failedQuery); err != nil {
+			return false, err
 		}
+
+		if !queryMatchesFilters(failedQuery.Query, req.ChainId, req.ConnectionId, req.CallbackId) {
+			return false, nil
+		}
+
+		if accumulate {
+			failedQueries = append(failedQueries, failedQuery)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFailedQueriesResponse{FailedQueries: failedQueries, Pagination: pageRes}, nil
+}
+
+// Checks if a query matches each of the provided filters (empty filters match every query)
+func queryMatchesFilters(q types.Query, chainId, connectionId, callbackId string) bool {
+	return (chainId == "" || q.ChainId == chainId) &&
+		(connectionId == "" || q.ConnectionId == connectionId) &&
+		(callbackId == "" || q.CallbackId == callbackId)
+}
+
+// Queries all queries that are re-submitted on a recurring schedule
+func (k Keeper) PeriodicQueries(c context.Context, req *types.QueryPeriodicQueriesRequest) (*types.QueryPeriodicQueriesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryPeriodicQueriesResponse{PeriodicQueries: k.AllPeriodicQueries(ctx)}, nil
+}
+
+// Queries the latest result of a periodic query
+func (k Keeper) DataPoint(c context.Context, req *types.QueryDataPointRequest) (*types.QueryDataPointResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	dataPoint, found := k.GetDataPoint(ctx, req.QueryId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no data point found for query %s", req.QueryId)
 	}
 
-	return &types.QueryPendingQueriesResponse{PendingQueries: pendingQueries}, nil
+	return &types.QueryDataPointResponse{DataPoint: dataPoint}, nil
 }
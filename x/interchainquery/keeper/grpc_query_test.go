@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/v10/x/interchainquery/types"
+)
+
+func (s *KeeperTestSuite) TestQueryPendingQueries() {
+	queries := []types.Query{
+		{Id: "1", ChainId: "chain-1", ConnectionId: "connection-1", CallbackId: "callback-1", RequestSent: true},
+		{Id: "2", ChainId: "chain-1", ConnectionId: "connection-1", CallbackId: "callback-2", RequestSent: false},
+		{Id: "3", ChainId: "chain-2", ConnectionId: "connection-2", CallbackId: "callback-1", RequestSent: true},
+		{Id: "4", ChainId: "chain-2", ConnectionId: "connection-2", CallbackId: "callback-2", RequestSent: false},
+		{Id: "5", ChainId: "chain-1", ConnectionId: "connection-3", CallbackId: "callback-1", RequestSent: true},
+	}
+	for _, query := range queries {
+		s.App.InterchainqueryKeeper.SetQuery(s.Ctx, query)
+	}
+
+	testCases := []struct {
+		name        string
+		request     types.QueryPendingQueriesRequest
+		expectedIds []string
+	}{
+		{
+			name:        "no filters - sent requests only",
+			request:     types.QueryPendingQueriesRequest{},
+			expectedIds: []string{"1", "3", "5"},
+		},
+		{
+			name:        "not sent",
+			request:     types.QueryPendingQueriesRequest{RequestSent: types.RequestSentFilter_REQUEST_NOT_SENT},
+			expectedIds: []string{"2", "4"},
+		},
+		{
+			name:        "all requests",
+			request:     types.QueryPendingQueriesRequest{RequestSent: types.RequestSentFilter_ALL_REQUESTS},
+			expectedIds: []string{"1", "2", "3", "4", "5"},
+		},
+		{
+			name:        "chain id",
+			request:     types.QueryPendingQueriesRequest{ChainId: "chain-1", RequestSent: types.RequestSentFilter_ALL_REQUESTS},
+			expectedIds: []string{"1", "2", "5"},
+		},
+		{
+			name:        "connection id",
+			request:     types.QueryPendingQueriesRequest{ConnectionId: "connection-1"},
+			expectedIds: []string{"1"},
+		},
+		{
+			name:        "callback id",
+			request:     types.QueryPendingQueriesRequest{CallbackId: "callback-2", RequestSent: types.RequestSentFilter_ALL_REQUESTS},
+			expectedIds: []string{"2", "4"},
+		},
+		{
+			name:        "multiple filters",
+			request:     types.QueryPendingQueriesRequest{ChainId: "chain-1", CallbackId: "callback-1"},
+			expectedIds: []string{"1", "5"},
+		},
+		{
+			name:        "no matches",
+			request:     types.QueryPendingQueriesRequest{ChainId: "chain-3"},
+			expectedIds: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			resp, err := s.App.InterchainqueryKeeper.PendingQueries(sdk.WrapSDKContext(s.Ctx), &tc.request)
+			s.Require().NoError(err, "no error expected")
+
+			actualIds := []string{}
+			for _, query := range resp.PendingQueries {
+				actualIds = append(actualIds, query.Id)
+			}
+			s.Require().Equal(tc.expectedIds, actualIds, "query ids")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryPendingQueries_Pagination() {
+	for i := 0; i < 5; i++ {
+		s.App.InterchainqueryKeeper.SetQuery(s.Ctx, types.Query{Id: fmt.Sprintf("query-%d", i), RequestSent: true})
+	}
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, types.Query{Id: "query-unsent", RequestSent: false})
+
+	// Paginate through the sent queries two at a time
+	actualIds := []string{}
+	var nextKey []byte
+	for page := 0; page < 3; page++ {
+		resp, err := s.App.InterchainqueryKeeper.PendingQueries(sdk.WrapSDKContext(s.Ctx), &types.QueryPendingQueriesRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 2, CountTotal: page == 0},
+		})
+		s.Require().NoError(err, "no error expected for page %d", page)
+		s.Require().LessOrEqual(len(resp.PendingQueries), 2, "page size for page %d", page)
+		if page == 0 {
+			s.Require().Equal(uint64(5), resp.Pagination.Total, "total")
+		}
+
+		for _, query := range resp.PendingQueries {
+			actualIds = append(actualIds, query.Id)
+		}
+		nextKey = resp.Pagination.NextKey
+	}
+	s.Require().Nil(nextKey, "there should be no more pages")
+	s.Require().Equal([]string{"query-0", "query-1", "query-2", "query-3", "query-4"}, actualIds, "query ids")
+}
+
+func (s *KeeperTestSuite) TestQueryFailedQueries() {
+	// Add more failed queries than can be stored
+	numFailed := types.MaxFailedQueries + 5
+	for i := 0; i < numFailed; i++ {
+		chainId := "chain-1"
+		if i%2 == 1 {
+			chainId = "chain-2"
+		}
+		query := types.Query{Id: fmt.Sprintf("query-%d", i), ChainId: chainId}
+		s.App.InterchainqueryKeeper.AddFailedQuery(s.Ctx, query, fmt.Sprintf("error-%d", i))
+	}
+
+	// Only the most recent failures should be retained
+	failedQueries := s.App.InterchainqueryKeeper.AllFailedQueries(s.Ctx)
+	s.Require().Len(failedQueries, types.MaxFailedQueries, "number of failed queries")
+	s.Require().Equal(uint64(5), failedQueries[0].Id, "oldest failed query id")
+	s.Require().Equal("error-5", failedQueries[0].Error, "oldest failed query error")
+	s.Require().Equal(uint64(numFailed), s.App.InterchainqueryKeeper.GetFailedQuerySequence(s.Ctx), "failed query sequence")
+
+	// Query with a filter and pagination
+	resp, err := s.App.InterchainqueryKeeper.FailedQueries(sdk.WrapSDKContext(s.Ctx), &types.QueryFailedQueriesRequest{
+		ChainId:    "chain-2",
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	s.Require().NoError(err, "no error expected")
+	s.Require().Equal(uint64(types.MaxFailedQueries/2), resp.Pagination.Total, "total chain-2 failures")
+
+	actualIds := []string{}
+	for _, failedQuery := range resp.FailedQueries {
+		s.Require().Equal("chain-2", failedQuery.Query.ChainId, "failed query chain id")
+		actualIds = append(actualIds, failedQuery.Query.Id)
+	}
+	s.Require().Equal([]string{"query-5", "query-7", "query-9"}, actualIds, "failed query ids")
+}
@@ -50,9 +50,20 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-func (k *Keeper) MakeRequest(ctx sdk.Context, module string, callbackId string, chainId string, connectionId string, queryType string, request []byte, ttl uint64) error {
+func (k *Keeper) MakeRequest(
+	ctx sdk.Context,
+	module string,
+	callbackId string,
+	chainId string,
+	connectionId string,
+	queryType string,
+	request []byte,
+	ttl uint64,
+	timeoutPolicy types.TimeoutPolicy,
+) error {
 	k.Logger(ctx).Info(utils.LogWithHostZone(chainId,
-		"Submitting ICQ Request - module=%s, callbackId=%s, connectionId=%s, queryType=%s, ttl=%d", module, callbackId, connectionId, queryType, ttl))
+		"Submitting ICQ Request - module=%s, callbackId=%s, connectionId=%s, queryType=%s, ttl=%d, timeoutPolicy=%s",
+		module, callbackId, connectionId, queryType, ttl, timeoutPolicy.String()))
 
 	// Confirm the connectionId and chainId are valid
 	if connectionId == "" {
@@ -71,6 +82,15 @@ func (k *Keeper) MakeRequest(ctx sdk.Context, module string, callbackId string,
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, errMsg)
 	}
 
+	// Confirm the TTL is in the future, otherwise the query would time out immediately
+	// and, with a retry policy, be re-issued with a zero timeout every block
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	if ttl <= blockTime {
+		errMsg := fmt.Sprintf("[ICQ Validation Check] Failed! ttl (%d) must be after the current block time (%d)", ttl, blockTime)
+		k.Logger(ctx).Error(errMsg)
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, errMsg)
+	}
+
 	// Confirm the module and callbackId exist
 	if module != "" {
 		if _, exists := k.callbacks[module]; !exists {
@@ -88,7 +108,7 @@ func (k *Keeper) MakeRequest(ctx sdk.Context, module string, callbackId string,
 	// Save the query to the store
 	// If the same query is re-requested, it will get replace in the store with an updated TTL
 	//  and the RequestSent bool reset to false
-	query := k.NewQuery(ctx, module, callbackId, chainId, connectionId, queryType, request, ttl)
+	query := k.NewQuery(ctx, module, callbackId, chainId, connectionId, queryType, request, ttl, timeoutPolicy)
 	k.SetQuery(ctx, *query)
 
 	return nil
@@ -162,20 +162,20 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 		return nil, err
 	}
 
-	// Immediately delete the query so it cannot process again
-	k.DeleteQuery(ctx, query.Id)
-
-	// Verify the query hasn't expired (if the block time is greater than the TTL timestamp, the query is expired)
-	currBlockTime, err := cast.ToUint64E(ctx.BlockTime().UnixNano())
-	if err != nil {
-		return nil, err
-	}
-	if query.Ttl < currBlockTime {
-		k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
-			"QUERY TIMEOUT - QueryId: %s, TTL: %d, BlockTime: %d", query.Id, query.Ttl, ctx.BlockHeader().Time.UnixNano()))
-		return &types.MsgSubmitQueryResponseResponse{}, nil
+	// Check if the query has expired (if the block time is greater than the TTL timestamp, the query is expired)
+	// If so, the query's timeout policy determines whether the response should still be processed
+	if k.IsQueryTimedOut(ctx, query) {
+		if executeResponse := k.HandleQueryTimeout(ctx, query, true); !executeResponse {
+			return &types.MsgSubmitQueryResponseResponse{}, nil
+		}
+	} else {
+		// Immediately delete the query so it cannot process again
+		k.DeleteQuery(ctx, query.Id)
 	}
 
+	// If the query is periodic, store the latest result as a data point
+	k.StorePeriodicQueryResult(ctx, query, msg.Height, msg.Result)
+
 	// If the query is contentless, end
 	if len(msg.Result) == 0 {
 		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
@@ -183,14 +183,18 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 		return &types.MsgSubmitQueryResponseResponse{}, nil
 	}
 
-	// Call the query's associated callback function
-	err = k.InvokeCallback(ctx, msg, query)
+	// Call the query's associated callback function in a cached context so that, if the callback fails,
+	// its partial state is discarded and the failed query is recorded for debugging
+	cacheCtx, writeCache := ctx.CacheContext()
+	err = k.InvokeCallback(cacheCtx, msg, query)
 	if err != nil {
 		k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
 			"Error invoking ICQ callback, error: %s, QueryId: %s, QueryType: %s, ConnectionId: %s, QueryRequest: %v, QueryReponse: %v",
 			err.Error(), msg.QueryId, query.QueryType, query.ConnectionId, query.Request, msg.Result))
-		return nil, err
+		k.AddFailedQuery(ctx, query, err.Error())
+		return &types.MsgSubmitQueryResponseResponse{}, nil
 	}
+	writeCache()
 
 	return &types.MsgSubmitQueryResponseResponse{}, nil
 }
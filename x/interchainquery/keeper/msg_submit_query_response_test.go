@@ -109,6 +109,73 @@ func (s *KeeperTestSuite) TestMsgSubmitQueryResponse_ExceededTtl() {
 	s.Require().False(found)
 }
 
+func (s *KeeperTestSuite) TestMsgSubmitQueryResponse_ExceededTtl_RetryPolicy() {
+	tc := s.SetupMsgSubmitQueryResponse()
+
+	// Remove key from the query type so to bypass the VerifyKeyProof function
+	tc.query.QueryType = strings.ReplaceAll(tc.query.QueryType, "key", "")
+
+	// set ttl to be expired with a retry policy
+	tc.query.Ttl = uint64(1)
+	tc.query.RequestSent = true
+	tc.query.TimeoutPolicy = types.TimeoutPolicy_RETRY_QUERY_REQUEST
+	tc.query.TimeoutDuration = uint64(10)
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	resp, err := s.GetMsgServer().SubmitQueryResponse(tc.goCtx, &tc.validMsg)
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	// check that the query was kept with a refreshed ttl, and will be re-emitted
+	query, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, tc.query.Id)
+	s.Require().True(found, "query should still be in the store")
+	s.Require().Equal(uint64(s.Ctx.BlockTime().UnixNano())+10, query.Ttl, "query ttl")
+	s.Require().False(query.RequestSent, "query request sent")
+}
+
+func (s *KeeperTestSuite) TestMsgSubmitQueryResponse_ExceededTtl_ExecutePolicy() {
+	tc := s.SetupMsgSubmitQueryResponse()
+
+	// Remove key from the query type so to bypass the VerifyKeyProof function
+	tc.query.QueryType = strings.ReplaceAll(tc.query.QueryType, "key", "")
+
+	// set ttl to be expired with an execute policy
+	tc.query.Ttl = uint64(1)
+	tc.query.TimeoutPolicy = types.TimeoutPolicy_EXECUTE_QUERY_RESPONSE
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	// the callback should still be invoked (and fail since there's no host zone)
+	_, err := s.GetMsgServer().SubmitQueryResponse(tc.goCtx, &tc.validMsg)
+	s.Require().NoError(err)
+
+	failedQuery, found := s.App.InterchainqueryKeeper.GetFailedQuery(s.Ctx, 0)
+	s.Require().True(found, "callback should have been invoked and failed")
+	s.Require().Contains(failedQuery.Error, "no registered zone for queried chain ID", "failed query error")
+}
+
+func (s *KeeperTestSuite) TestMsgSubmitQueryResponse_CallbackFailed() {
+	tc := s.SetupMsgSubmitQueryResponse()
+
+	// Remove key from the query type so to bypass the VerifyKeyProof function
+	tc.query.QueryType = strings.ReplaceAll(tc.query.QueryType, "key", "")
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+
+	// The callback will fail since there's no host zone, but the response should still be accepted
+	resp, err := s.GetMsgServer().SubmitQueryResponse(tc.goCtx, &tc.validMsg)
+	s.Require().NoError(err)
+	s.Require().NotNil(resp)
+
+	// The query should be removed from the pending store, and recorded as failed
+	_, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, tc.query.Id)
+	s.Require().False(found, "query should have been removed")
+
+	failedQuery, found := s.App.InterchainqueryKeeper.GetFailedQuery(s.Ctx, 0)
+	s.Require().True(found, "failed query should have been recorded")
+	s.Require().Equal(tc.query, failedQuery.Query, "failed query")
+	s.Require().Equal(s.Ctx.BlockHeight(), failedQuery.FailedHeight, "failed query height")
+	s.Require().Contains(failedQuery.Error, "no registered zone for queried chain ID", "failed query error")
+}
+
 func (s *KeeperTestSuite) TestMsgSubmitQueryResponse_FindAndInvokeCallback_WrongHostZone() {
 	tc := s.SetupMsgSubmitQueryResponse()
 
@@ -1,19 +1,22 @@
 package keeper_test
 
 import (
+	"time"
+
 	_ "github.com/stretchr/testify/suite"
 
 	icqtypes "github.com/Stride-Labs/stride/v10/x/interchainquery/types"
 )
 
 type NewQueryTestCase struct {
-	module       string
-	callbackId   string
-	chainId      string
-	connectionId string
-	queryType    string
-	request      []byte
-	ttl          uint64
+	module        string
+	callbackId    string
+	chainId       string
+	connectionId  string
+	queryType     string
+	request       []byte
+	ttl           uint64
+	timeoutPolicy icqtypes.TimeoutPolicy
 }
 
 func (suite *KeeperTestSuite) SetupNewQuery() NewQueryTestCase {
@@ -34,15 +37,18 @@ func (suite *KeeperTestSuite) SetupNewQuery() NewQueryTestCase {
 	request := []byte{0x01, 0x02, 0x03}
 	// ttl is the expiry time of the query, in absolute units of time, unix nanos
 	ttl := uint64(0) // ttl
+	// timeoutPolicy determines how the query is handled if the ttl passes before a response is processed
+	timeoutPolicy := icqtypes.TimeoutPolicy_RETRY_QUERY_REQUEST
 
 	return NewQueryTestCase{
-		module:       module,
-		callbackId:   callbackId,
-		chainId:      chainId,
-		connectionId: connectionId,
-		queryType:    queryType,
-		request:      request,
-		ttl:          ttl,
+		module:        module,
+		callbackId:    callbackId,
+		chainId:       chainId,
+		connectionId:  connectionId,
+		queryType:     queryType,
+		request:       request,
+		ttl:           ttl,
+		timeoutPolicy: timeoutPolicy,
 	}
 }
 
@@ -58,6 +64,7 @@ func (s *KeeperTestSuite) TestNewQuerySuccessful() {
 		tc.queryType,
 		tc.request,
 		tc.ttl,
+		tc.timeoutPolicy,
 	)
 
 	// this hash is testing `GenerateQueryHash`.
@@ -77,4 +84,72 @@ func (s *KeeperTestSuite) TestNewQuerySuccessful() {
 	s.Require().Equal(tc.request, actualQuery.Request)
 	s.Require().Equal(tc.callbackId, actualQuery.CallbackId)
 	s.Require().Equal(tc.ttl, actualQuery.Ttl)
+	s.Require().Equal(tc.timeoutPolicy, actualQuery.TimeoutPolicy)
+
+	// the ttl is already in the past, so there's no remaining duration
+	s.Require().Zero(actualQuery.TimeoutDuration)
+}
+
+func (s *KeeperTestSuite) TestNewQuery_TimeoutDuration() {
+	tc := s.SetupNewQuery()
+
+	timeoutDuration := uint64(time.Hour.Nanoseconds())
+	ttl := uint64(s.Ctx.BlockTime().UnixNano()) + timeoutDuration
+
+	actualQuery := s.App.InterchainqueryKeeper.NewQuery(
+		s.Ctx,
+		tc.module,
+		tc.callbackId,
+		tc.chainId,
+		tc.connectionId,
+		tc.queryType,
+		tc.request,
+		ttl,
+		tc.timeoutPolicy,
+	)
+
+	s.Require().Equal(ttl, actualQuery.Ttl)
+	s.Require().Equal(timeoutDuration, actualQuery.TimeoutDuration)
+}
+
+func (s *KeeperTestSuite) TestMakeRequest_TtlNotInFuture() {
+	tc := s.SetupNewQuery()
+	blockTime := uint64(s.Ctx.BlockTime().UnixNano())
+
+	// A query with a TTL at or before the current block time would time out immediately,
+	// and with a retry policy, it would be re-issued with a zero timeout every block
+	for _, ttl := range []uint64{0, blockTime - 1, blockTime} {
+		err := s.App.InterchainqueryKeeper.MakeRequest(
+			s.Ctx,
+			tc.module,
+			tc.callbackId,
+			tc.chainId,
+			tc.connectionId,
+			tc.queryType,
+			tc.request,
+			ttl,
+			tc.timeoutPolicy,
+		)
+		s.Require().ErrorContains(err, "must be after the current block time", "ttl %d", ttl)
+	}
+	s.Require().Empty(s.App.InterchainqueryKeeper.AllQueries(s.Ctx), "no queries should be stored")
+
+	// A TTL after the current block time should be accepted, with a non-zero timeout duration for retries
+	ttl := blockTime + uint64(time.Hour.Nanoseconds())
+	err := s.App.InterchainqueryKeeper.MakeRequest(
+		s.Ctx,
+		tc.module,
+		tc.callbackId,
+		tc.chainId,
+		tc.connectionId,
+		tc.queryType,
+		tc.request,
+		ttl,
+		tc.timeoutPolicy,
+	)
+	s.Require().NoError(err, "no error expected for ttl in the future")
+
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "one query should be stored")
+	s.Require().Equal(uint64(time.Hour.Nanoseconds()), queries[0].TimeoutDuration, "timeout duration")
 }
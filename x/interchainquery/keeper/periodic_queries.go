@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v10/utils"
+	"github.com/Stride-Labs/stride/v10/x/interchainquery/types"
+)
+
+// Registers a query that is re-submitted every `period` blocks (or every `period` epochs if an epoch identifier is provided)
+// The query is submitted immediately, and the latest result is stored as a data point after each response
+// If the same query is re-registered, the schedule is replaced
+func (k *Keeper) RegisterPeriodicQuery(
+	ctx sdk.Context,
+	module string,
+	callbackId string,
+	chainId string,
+	connectionId string,
+	queryType string,
+	request []byte,
+	epochIdentifier string,
+	period uint64,
+	timeoutDuration uint64,
+	timeoutPolicy types.TimeoutPolicy,
+) error {
+	if period == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "periodic query period must be greater than zero")
+	}
+	if timeoutDuration == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "periodic query timeout duration must be greater than zero")
+	}
+
+	periodicQuery := types.PeriodicQuery{
+		Id:              GenerateQueryHash(connectionId, chainId, queryType, request, module, callbackId),
+		Module:          module,
+		CallbackId:      callbackId,
+		ChainId:         chainId,
+		ConnectionId:    connectionId,
+		QueryType:       queryType,
+		Request:         request,
+		EpochIdentifier: epochIdentifier,
+		Period:          period,
+		TimeoutDuration: timeoutDuration,
+		TimeoutPolicy:   timeoutPolicy,
+	}
+
+	return k.SubmitPeriodicQuery(ctx, periodicQuery)
+}
+
+// Submits the next request for a periodic query and records the submission height
+func (k *Keeper) SubmitPeriodicQuery(ctx sdk.Context, periodicQuery types.PeriodicQuery) error {
+	ttl := uint64(ctx.BlockTime().UnixNano()) + periodicQuery.TimeoutDuration
+	if err := k.MakeRequest(
+		ctx,
+		periodicQuery.Module,
+		periodicQuery.CallbackId,
+		periodicQuery.ChainId,
+		periodicQuery.ConnectionId,
+		periodicQuery.QueryType,
+		periodicQuery.Request,
+		ttl,
+		periodicQuery.TimeoutPolicy,
+	); err != nil {
+		return err
+	}
+
+	periodicQuery.LastSubmissionHeight = ctx.BlockHeight()
+	k.SetPeriodicQuery(ctx, periodicQuery)
+
+	return nil
+}
+
+// Re-submits each block-based periodic query whose period has elapsed
+func (k *Keeper) SubmitDuePeriodicQueries(ctx sdk.Context) {
+	for _, periodicQuery := range k.AllPeriodicQueries(ctx) {
+		if periodicQuery.EpochIdentifier != "" {
+			continue
+		}
+		if uint64(ctx.BlockHeight()-periodicQuery.LastSubmissionHeight) < periodicQuery.Period {
+			continue
+		}
+		if err := k.SubmitPeriodicQuery(ctx, periodicQuery); err != nil {
+			k.Logger(ctx).Error(utils.LogWithHostZone(periodicQuery.ChainId,
+				"Unable to re-submit periodic query %s: %s", periodicQuery.Id, err.Error()))
+		}
+	}
+}
+
+// Re-submits each epoch-based periodic query for the given epoch
+// Queries that were already submitted in this block (e.g. from a registration in an earlier hook) are skipped
+func (k *Keeper) SubmitEpochPeriodicQueries(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
+	for _, periodicQuery := range k.AllPeriodicQueries(ctx) {
+		if periodicQuery.EpochIdentifier != epochIdentifier || epochNumber%periodicQuery.Period != 0 {
+			continue
+		}
+		if periodicQuery.LastSubmissionHeight == ctx.BlockHeight() {
+			continue
+		}
+		if err := k.SubmitPeriodicQuery(ctx, periodicQuery); err != nil {
+			k.Logger(ctx).Error(utils.LogWithHostZone(periodicQuery.ChainId,
+				"Unable to re-submit periodic query %s: %s", periodicQuery.Id, err.Error()))
+		}
+	}
+}
+
+// GetPeriodicQuery returns a periodic query
+func (k Keeper) GetPeriodicQuery(ctx sdk.Context, id string) (types.PeriodicQuery, bool) {
+	periodicQuery := types.PeriodicQuery{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPeriodicQuery)
+	bz := store.Get([]byte(id))
+	if len(bz) == 0 {
+		return periodicQuery, false
+	}
+	k.cdc.MustUnmarshal(bz, &periodicQuery)
+	return periodicQuery, true
+}
+
+// SetPeriodicQuery sets a periodic query
+func (k Keeper) SetPeriodicQuery(ctx sdk.Context, periodicQuery types.PeriodicQuery) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPeriodicQuery)
+	bz := k.cdc.MustMarshal(&periodicQuery)
+	store.Set([]byte(periodicQuery.Id), bz)
+}
+
+// RemovePeriodicQuery stops a periodic query from being re-submitted and removes its latest data point
+func (k Keeper) RemovePeriodicQuery(ctx sdk.Context, id string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPeriodicQuery)
+	store.Delete([]byte(id))
+	k.RemoveDataPoint(ctx, id)
+}
+
+// AllPeriodicQueries returns every periodic query in the store
+func (k Keeper) AllPeriodicQueries(ctx sdk.Context) []types.PeriodicQuery {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPeriodicQuery)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	periodicQueries := []types.PeriodicQuery{}
+	for ; iterator.Valid(); iterator.Next() {
+		periodicQuery := types.PeriodicQuery{}
+		k.cdc.MustUnmarshal(iterator.Value(), &periodicQuery)
+		periodicQueries = append(periodicQueries, periodicQuery)
+	}
+	return periodicQueries
+}
+
+// GetDataPoint returns the latest result of a periodic query
+func (k Keeper) GetDataPoint(ctx sdk.Context, id string) (types.DataPoint, bool) {
+	dataPoint := types.DataPoint{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixData)
+	bz := store.Get([]byte(id))
+	if len(bz) == 0 {
+		return dataPoint, false
+	}
+	k.cdc.MustUnmarshal(bz, &dataPoint)
+	return dataPoint, true
+}
+
+// SetDataPoint stores the latest result of a periodic query
+func (k Keeper) SetDataPoint(ctx sdk.Context, dataPoint types.DataPoint) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixData)
+	bz := k.cdc.MustMarshal(&dataPoint)
+	store.Set([]byte(dataPoint.Id), bz)
+}
+
+// RemoveDataPoint removes the latest result of a periodic query
+func (k Keeper) RemoveDataPoint(ctx sdk.Context, id string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixData)
+	store.Delete([]byte(id))
+}
+
+// AllDataPoints returns every data point in the store
+func (k Keeper) AllDataPoints(ctx sdk.Context) []types.DataPoint {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixData)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	dataPoints := []types.DataPoint{}
+	for ; iterator.Valid(); iterator.Next() {
+		dataPoint := types.DataPoint{}
+		k.cdc.MustUnmarshal(iterator.Value(), &dataPoint)
+		dataPoints = append(dataPoints, dataPoint)
+	}
+	return dataPoints
+}
+
+// Stores the result of a query response as a data point if the query is periodic
+func (k Keeper) StorePeriodicQueryResult(ctx sdk.Context, query types.Query, remoteHeight int64, result []byte) {
+	if _, found := k.GetPeriodicQuery(ctx, query.Id); !found {
+		return
+	}
+
+	k.SetDataPoint(ctx, types.DataPoint{
+		Id:           query.Id,
+		RemoteHeight: sdk.NewInt(remoteHeight),
+		LocalHeight:  sdk.NewInt(ctx.BlockHeight()),
+		Value:        result,
+	})
+
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
+		"Stored data point for periodic query %s at remote height %d", query.Id, remoteHeight))
+}
@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	epochtypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
+	"github.com/Stride-Labs/stride/v10/x/interchainquery/keeper"
+	"github.com/Stride-Labs/stride/v10/x/interchainquery/types"
+)
+
+const (
+	PeriodicQueryModule     = "stakeibc"
+	PeriodicQueryCallbackId = "withdrawalbalance"
+	PeriodicQueryConnection = "connection-0"
+)
+
+var (
+	PeriodicQueryRequest    = []byte{0x01, 0x02, 0x03}
+	PeriodicQueryTimeout    = uint64(time.Hour.Nanoseconds())
+	ExpectedPeriodicQueryId = keeper.GenerateQueryHash(PeriodicQueryConnection, HostChainId, types.BANK_STORE_QUERY_WITH_PROOF,
+		PeriodicQueryRequest, PeriodicQueryModule, PeriodicQueryCallbackId)
+)
+
+func (s *KeeperTestSuite) registerPeriodicQuery(epochIdentifier string, period uint64) {
+	err := s.App.InterchainqueryKeeper.RegisterPeriodicQuery(
+		s.Ctx,
+		PeriodicQueryModule,
+		PeriodicQueryCallbackId,
+		HostChainId,
+		PeriodicQueryConnection,
+		types.BANK_STORE_QUERY_WITH_PROOF,
+		PeriodicQueryRequest,
+		epochIdentifier,
+		period,
+		PeriodicQueryTimeout,
+		types.TimeoutPolicy_REJECT_QUERY_RESPONSE,
+	)
+	s.Require().NoError(err, "no error expected when registering periodic query")
+}
+
+// Marks the pending query as answered by removing it from the store
+func (s *KeeperTestSuite) clearPendingQuery() {
+	s.App.InterchainqueryKeeper.DeleteQuery(s.Ctx, ExpectedPeriodicQueryId)
+}
+
+func (s *KeeperTestSuite) checkPendingQuerySubmitted(expectedSubmitted bool) {
+	query, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, ExpectedPeriodicQueryId)
+	s.Require().Equal(expectedSubmitted, found, "pending query found")
+	if expectedSubmitted {
+		expectedTtl := uint64(s.Ctx.BlockTime().UnixNano()) + PeriodicQueryTimeout
+		s.Require().Equal(expectedTtl, query.Ttl, "pending query ttl")
+		s.Require().Equal(PeriodicQueryTimeout, query.TimeoutDuration, "pending query timeout duration")
+	}
+}
+
+func (s *KeeperTestSuite) TestRegisterPeriodicQuery_Successful() {
+	s.registerPeriodicQuery("", 10)
+
+	periodicQuery, found := s.App.InterchainqueryKeeper.GetPeriodicQuery(s.Ctx, ExpectedPeriodicQueryId)
+	s.Require().True(found, "periodic query should have been registered")
+	s.Require().Equal(PeriodicQueryModule, periodicQuery.Module, "module")
+	s.Require().Equal(PeriodicQueryCallbackId, periodicQuery.CallbackId, "callback id")
+	s.Require().Equal(uint64(10), periodicQuery.Period, "period")
+	s.Require().Equal(s.Ctx.BlockHeight(), periodicQuery.LastSubmissionHeight, "last submission height")
+
+	// The query should be submitted immediately
+	s.checkPendingQuerySubmitted(true)
+}
+
+func (s *KeeperTestSuite) TestRegisterPeriodicQuery_Failure() {
+	// Zero period
+	err := s.App.InterchainqueryKeeper.RegisterPeriodicQuery(s.Ctx, PeriodicQueryModule, PeriodicQueryCallbackId, HostChainId,
+		PeriodicQueryConnection, types.BANK_STORE_QUERY_WITH_PROOF, PeriodicQueryRequest, "", 0, PeriodicQueryTimeout,
+		types.TimeoutPolicy_REJECT_QUERY_RESPONSE)
+	s.Require().ErrorContains(err, "periodic query period must be greater than zero")
+
+	// Zero timeout
+	err = s.App.InterchainqueryKeeper.RegisterPeriodicQuery(s.Ctx, PeriodicQueryModule, PeriodicQueryCallbackId, HostChainId,
+		PeriodicQueryConnection, types.BANK_STORE_QUERY_WITH_PROOF, PeriodicQueryRequest, "", 1, 0,
+		types.TimeoutPolicy_REJECT_QUERY_RESPONSE)
+	s.Require().ErrorContains(err, "periodic query timeout duration must be greater than zero")
+
+	// Unregistered callback
+	err = s.App.InterchainqueryKeeper.RegisterPeriodicQuery(s.Ctx, PeriodicQueryModule, "fake_callback", HostChainId,
+		PeriodicQueryConnection, types.BANK_STORE_QUERY_WITH_PROOF, PeriodicQueryRequest, "", 1, PeriodicQueryTimeout,
+		types.TimeoutPolicy_REJECT_QUERY_RESPONSE)
+	s.Require().ErrorContains(err, "no callback handler registered for module")
+
+	s.Require().Empty(s.App.InterchainqueryKeeper.AllPeriodicQueries(s.Ctx), "no periodic queries should be registered")
+}
+
+func (s *KeeperTestSuite) TestSubmitDuePeriodicQueries() {
+	period := uint64(5)
+	s.registerPeriodicQuery("", period)
+	s.clearPendingQuery()
+	startHeight := s.Ctx.BlockHeight()
+
+	// Before the period has elapsed, the query should not be re-submitted
+	s.Ctx = s.Ctx.WithBlockHeight(startHeight + int64(period) - 1)
+	s.App.InterchainqueryKeeper.EndBlocker(s.Ctx)
+	s.checkPendingQuerySubmitted(false)
+
+	// Once the period has elapsed, the query should be re-submitted and emitted
+	s.Ctx = s.Ctx.WithBlockHeight(startHeight + int64(period))
+	s.App.InterchainqueryKeeper.EndBlocker(s.Ctx)
+	s.checkPendingQuerySubmitted(true)
+
+	periodicQuery, found := s.App.InterchainqueryKeeper.GetPeriodicQuery(s.Ctx, ExpectedPeriodicQueryId)
+	s.Require().True(found, "periodic query should still be registered")
+	s.Require().Equal(startHeight+int64(period), periodicQuery.LastSubmissionHeight, "last submission height")
+
+	query, _ := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, ExpectedPeriodicQueryId)
+	s.Require().True(query.RequestSent, "query should have been emitted")
+}
+
+func (s *KeeperTestSuite) TestSubmitEpochPeriodicQueries() {
+	period := uint64(2)
+	s.registerPeriodicQuery(epochtypes.STRIDE_EPOCH, period)
+
+	// The query should not be re-submitted in the same block it was registered
+	s.clearPendingQuery()
+	s.App.InterchainqueryKeeper.BeforeEpochStart(s.Ctx, epochtypes.EpochInfo{Identifier: epochtypes.STRIDE_EPOCH, CurrentEpoch: 2})
+	s.checkPendingQuerySubmitted(false)
+
+	// Block-based re-submission should not apply to epoch-based queries
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 100)
+	s.App.InterchainqueryKeeper.SubmitDuePeriodicQueries(s.Ctx)
+	s.checkPendingQuerySubmitted(false)
+
+	// Epochs with a different identifier or that are not on the period should be ignored
+	s.App.InterchainqueryKeeper.BeforeEpochStart(s.Ctx, epochtypes.EpochInfo{Identifier: epochtypes.DAY_EPOCH, CurrentEpoch: 4})
+	s.checkPendingQuerySubmitted(false)
+	s.App.InterchainqueryKeeper.BeforeEpochStart(s.Ctx, epochtypes.EpochInfo{Identifier: epochtypes.STRIDE_EPOCH, CurrentEpoch: 3})
+	s.checkPendingQuerySubmitted(false)
+
+	// The query should be re-submitted on the next epoch in the period
+	s.App.InterchainqueryKeeper.BeforeEpochStart(s.Ctx, epochtypes.EpochInfo{Identifier: epochtypes.STRIDE_EPOCH, CurrentEpoch: 4})
+	s.checkPendingQuerySubmitted(true)
+}
+
+func (s *KeeperTestSuite) TestRemovePeriodicQuery() {
+	s.registerPeriodicQuery("", 1)
+	s.App.InterchainqueryKeeper.SetDataPoint(s.Ctx, types.DataPoint{
+		Id:           ExpectedPeriodicQueryId,
+		RemoteHeight: sdkmath.NewInt(1),
+		LocalHeight:  sdkmath.NewInt(1),
+	})
+
+	s.App.InterchainqueryKeeper.RemovePeriodicQuery(s.Ctx, ExpectedPeriodicQueryId)
+
+	_, found := s.App.InterchainqueryKeeper.GetPeriodicQuery(s.Ctx, ExpectedPeriodicQueryId)
+	s.Require().False(found, "periodic query should have been removed")
+	_, found = s.App.InterchainqueryKeeper.GetDataPoint(s.Ctx, ExpectedPeriodicQueryId)
+	s.Require().False(found, "data point should have been removed")
+}
+
+func (s *KeeperTestSuite) TestRemoveAllChainQueries() {
+	// Register a periodic query (which also submits a pending query), and add a failed query for the chain
+	s.registerPeriodicQuery("", 1)
+	s.App.InterchainqueryKeeper.SetDataPoint(s.Ctx, types.DataPoint{
+		Id:           ExpectedPeriodicQueryId,
+		RemoteHeight: sdkmath.NewInt(1),
+		LocalHeight:  sdkmath.NewInt(1),
+	})
+	s.App.InterchainqueryKeeper.AddFailedQuery(s.Ctx, types.Query{Id: "failed", ChainId: HostChainId}, "error")
+
+	// Add queries for a different chain that should not be removed
+	otherChainId := "OSMO"
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, types.Query{Id: "other", ChainId: otherChainId})
+	s.App.InterchainqueryKeeper.SetPeriodicQuery(s.Ctx, types.PeriodicQuery{Id: "other", ChainId: otherChainId})
+	s.App.InterchainqueryKeeper.AddFailedQuery(s.Ctx, types.Query{Id: "other", ChainId: otherChainId}, "error")
+
+	s.App.InterchainqueryKeeper.RemoveAllChainQueries(s.Ctx, HostChainId)
+
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "number of pending queries")
+	s.Require().Equal(otherChainId, queries[0].ChainId, "remaining pending query chain")
+
+	periodicQueries := s.App.InterchainqueryKeeper.AllPeriodicQueries(s.Ctx)
+	s.Require().Len(periodicQueries, 1, "number of periodic queries")
+	s.Require().Equal(otherChainId, periodicQueries[0].ChainId, "remaining periodic query chain")
+
+	failedQueries := s.App.InterchainqueryKeeper.AllFailedQueries(s.Ctx)
+	s.Require().Len(failedQueries, 1, "number of failed queries")
+	s.Require().Equal(otherChainId, failedQueries[0].Query.ChainId, "remaining failed query chain")
+
+	_, found := s.App.InterchainqueryKeeper.GetDataPoint(s.Ctx, ExpectedPeriodicQueryId)
+	s.Require().False(found, "data point should have been removed")
+}
+
+func (s *KeeperTestSuite) TestMsgSubmitQueryResponse_PeriodicQueryDataPoint() {
+	tc := s.SetupMsgSubmitQueryResponse()
+
+	// Remove key from the query type so to bypass the VerifyKeyProof function
+	tc.query.QueryType = strings.ReplaceAll(tc.query.QueryType, "key", "")
+	s.App.InterchainqueryKeeper.SetQuery(s.Ctx, tc.query)
+	s.App.InterchainqueryKeeper.SetPeriodicQuery(s.Ctx, types.PeriodicQuery{Id: tc.query.Id, Period: 1})
+
+	// Submit a contentless response so the callback is not invoked
+	tc.validMsg.Result = []byte{}
+	_, err := s.GetMsgServer().SubmitQueryResponse(tc.goCtx, &tc.validMsg)
+	s.Require().NoError(err)
+
+	// The pending query should be removed, but the periodic query and data point should remain
+	_, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, tc.query.Id)
+	s.Require().False(found, "pending query should have been removed")
+	_, found = s.App.InterchainqueryKeeper.GetPeriodicQuery(s.Ctx, tc.query.Id)
+	s.Require().True(found, "periodic query should still be registered")
+
+	// Check the data point through the grpc query
+	res, err := s.App.InterchainqueryKeeper.DataPoint(sdk.WrapSDKContext(s.Ctx), &types.QueryDataPointRequest{QueryId: tc.query.Id})
+	s.Require().NoError(err, "no error expected when querying data point")
+	s.Require().Equal(tc.query.Id, res.DataPoint.Id, "data point id")
+	s.Require().Equal(sdkmath.NewInt(tc.validMsg.Height), res.DataPoint.RemoteHeight, "data point remote height")
+	s.Require().Equal(sdkmath.NewInt(s.Ctx.BlockHeight()), res.DataPoint.LocalHeight, "data point local height")
+
+	// Queries without a data point should return an error
+	_, err = s.App.InterchainqueryKeeper.DataPoint(sdk.WrapSDKContext(s.Ctx), &types.QueryDataPointRequest{QueryId: "fake_query"})
+	s.Require().ErrorContains(err, "no data point found for query fake_query")
+}
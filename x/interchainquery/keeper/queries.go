@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 
+	"github.com/Stride-Labs/stride/v10/utils"
 	"github.com/Stride-Labs/stride/v10/x/interchainquery/types"
 )
 
@@ -19,17 +20,96 @@ func GenerateQueryHash(connectionId string, chainId string, queryType string, re
 	return fmt.Sprintf("%x", crypto.Sha256(append([]byte(module+connectionId+chainId+queryType+callbackId), request...)))
 }
 
-func (k Keeper) NewQuery(ctx sdk.Context, module string, callbackId string, chainId string, connectionId string, queryType string, request []byte, ttl uint64) *types.Query {
+func (k Keeper) NewQuery(
+	ctx sdk.Context,
+	module string,
+	callbackId string,
+	chainId string,
+	connectionId string,
+	queryType string,
+	request []byte,
+	ttl uint64,
+	timeoutPolicy types.TimeoutPolicy,
+) *types.Query {
+	// The timeout duration is the time remaining until the TTL, and is used to refresh the TTL if the query is retried
+	timeoutDuration := uint64(0)
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	if ttl > blockTime {
+		timeoutDuration = ttl - blockTime
+	}
+
 	return &types.Query{
-		Id:           GenerateQueryHash(connectionId, chainId, queryType, request, module, callbackId),
-		ConnectionId: connectionId,
-		ChainId:      chainId,
-		QueryType:    queryType,
-		Request:      request,
-		CallbackId:   callbackId,
-		Ttl:          ttl,
-		RequestSent:  false,
+		Id:              GenerateQueryHash(connectionId, chainId, queryType, request, module, callbackId),
+		ConnectionId:    connectionId,
+		ChainId:         chainId,
+		QueryType:       queryType,
+		Request:         request,
+		CallbackId:      callbackId,
+		Ttl:             ttl,
+		RequestSent:     false,
+		TimeoutPolicy:   timeoutPolicy,
+		TimeoutDuration: timeoutDuration,
+	}
+}
+
+// Checks if the query's TTL has elapsed as of the current block time
+func (k Keeper) IsQueryTimedOut(ctx sdk.Context, query types.Query) bool {
+	return query.Ttl < uint64(ctx.BlockTime().UnixNano())
+}
+
+// Handles a query whose TTL has elapsed according to its timeout policy
+//   - REJECT_QUERY_RESPONSE:  the query is deleted
+//   - RETRY_QUERY_REQUEST:    the query's TTL is refreshed and the request is re-emitted in the next EndBlocker
+//   - EXECUTE_QUERY_RESPONSE: if a response was received, the query is deleted and the response should be processed;
+//     otherwise, the query is kept until it has been stale for another full timeout duration, after which it's deleted
+//
+// Returns true if the caller should proceed with processing the response
+func (k Keeper) HandleQueryTimeout(ctx sdk.Context, query types.Query, responseReceived bool) (executeResponse bool) {
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+
+	var outcome string
+	switch query.TimeoutPolicy {
+	case types.TimeoutPolicy_RETRY_QUERY_REQUEST:
+		query.Ttl = blockTime + query.TimeoutDuration
+		query.RequestSent = false
+		k.SetQuery(ctx, query)
+		outcome = types.AttributeValueQueryRetried
+
+	case types.TimeoutPolicy_EXECUTE_QUERY_RESPONSE:
+		if responseReceived {
+			k.DeleteQuery(ctx, query.Id)
+			executeResponse = true
+			outcome = types.AttributeValueQueryExecuted
+		} else {
+			if query.Ttl+query.TimeoutDuration >= blockTime {
+				return false
+			}
+			k.DeleteQuery(ctx, query.Id)
+			outcome = types.AttributeValueQueryRejected
+		}
+
+	default:
+		k.DeleteQuery(ctx, query.Id)
+		outcome = types.AttributeValueQueryRejected
 	}
+
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, query.CallbackId,
+		"QUERY TIMEOUT - QueryId: %s, TTL: %d, BlockTime: %d, Policy: %s, Outcome: %s",
+		query.Id, query.Ttl, blockTime, query.TimeoutPolicy.String(), outcome))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueryTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyQueryId, query.Id),
+			sdk.NewAttribute(types.AttributeKeyChainId, query.ChainId),
+			sdk.NewAttribute(types.AttributeKeyCallbackId, query.CallbackId),
+			sdk.NewAttribute(types.AttributeKeyTimeoutPolicy, query.TimeoutPolicy.String()),
+			sdk.NewAttribute(types.AttributeKeyOutcome, outcome),
+		),
+	)
+
+	return executeResponse
 }
 
 // GetQuery returns query
@@ -86,6 +166,25 @@ func (k Keeper) AllQueries(ctx sdk.Context) []types.Query {
 	return queries
 }
 
+// Removes every pending, periodic and failed query for a chain (e.g. when the host zone is removed)
+func (k Keeper) RemoveAllChainQueries(ctx sdk.Context, chainId string) {
+	for _, query := range k.AllQueries(ctx) {
+		if query.ChainId == chainId {
+			k.DeleteQuery(ctx, query.Id)
+		}
+	}
+	for _, periodicQuery := range k.AllPeriodicQueries(ctx) {
+		if periodicQuery.ChainId == chainId {
+			k.RemovePeriodicQuery(ctx, periodicQuery.Id)
+		}
+	}
+	for _, failedQuery := range k.AllFailedQueries(ctx) {
+		if failedQuery.Query.ChainId == chainId {
+			k.RemoveFailedQuery(ctx, failedQuery.Id)
+		}
+	}
+}
+
 // Helper function to unmarshal a Balance query response across SDK versions
 // Before SDK v46, the query response returned a sdk.Coin type. SDK v46 returns an int type
 // https://github.com/cosmos/cosmos-sdk/pull/9832
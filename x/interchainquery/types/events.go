@@ -1,14 +1,23 @@
 package types
 
 const (
-	AttributeKeyQueryId      = "query_id"
-	AttributeKeyChainId      = "chain_id"
-	AttributeKeyConnectionId = "connection_id"
-	AttributeKeyType         = "type"
-	AttributeKeyParams       = "parameters"
-	AttributeKeyRequest      = "request"
-	AttributeKeyHeight       = "height"
+	AttributeKeyQueryId       = "query_id"
+	AttributeKeyChainId       = "chain_id"
+	AttributeKeyConnectionId  = "connection_id"
+	AttributeKeyType          = "type"
+	AttributeKeyParams        = "parameters"
+	AttributeKeyRequest       = "request"
+	AttributeKeyHeight        = "height"
+	AttributeKeyCallbackId    = "callback_id"
+	AttributeKeyTimeoutPolicy = "timeout_policy"
+	AttributeKeyOutcome       = "outcome"
 
 	AttributeValueCategory = ModuleName
 	AttributeValueQuery    = "query"
+
+	EventTypeQueryTimeout = "query_timeout"
+
+	AttributeValueQueryRejected = "rejected"
+	AttributeValueQueryRetried  = "retried"
+	AttributeValueQueryExecuted = "executed"
 )
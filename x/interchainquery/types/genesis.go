@@ -1,13 +1,12 @@
 package types
 
-func NewGenesisState(queries []Query) *GenesisState {
-	return &GenesisState{Queries: queries}
+func NewGenesisState(queries []Query, periodicQueries []PeriodicQuery, dataPoints []DataPoint, failedQueries []FailedQuery) *GenesisState {
+	return &GenesisState{Queries: queries, PeriodicQueries: periodicQueries, DataPoints: dataPoints, FailedQueries: failedQueries}
 }
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
-	queries := []Query{}
-	return NewGenesisState(queries)
+	return NewGenesisState([]Query{}, []PeriodicQuery{}, []DataPoint{}, []FailedQuery{})
 }
 
 // Validate performs basic genesis state validation returning an error upon any
@@ -25,15 +25,50 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Determines the behavior when a query's TTL has elapsed before a response was processed
+type TimeoutPolicy int32
+
+const (
+	// The query is removed and any late response is ignored
+	TimeoutPolicy_REJECT_QUERY_RESPONSE TimeoutPolicy = 0
+	// The query is re-submitted with a refreshed TTL
+	TimeoutPolicy_RETRY_QUERY_REQUEST TimeoutPolicy = 1
+	// A late response is still passed to the callback
+	TimeoutPolicy_EXECUTE_QUERY_RESPONSE TimeoutPolicy = 2
+)
+
+var TimeoutPolicy_name = map[int32]string{
+	0: "REJECT_QUERY_RESPONSE",
+	1: "RETRY_QUERY_REQUEST",
+	2: "EXECUTE_QUERY_RESPONSE",
+}
+
+var TimeoutPolicy_value = map[string]int32{
+	"REJECT_QUERY_RESPONSE":  0,
+	"RETRY_QUERY_REQUEST":    1,
+	"EXECUTE_QUERY_RESPONSE": 2,
+}
+
+func (x TimeoutPolicy) String() string {
+	return proto.EnumName(TimeoutPolicy_name, int32(x))
+}
+
+func (TimeoutPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_74cd646eb05658fd, []int{0}
+}
+
 type Query struct {
-	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
-	ChainId      string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	QueryType    string `protobuf:"bytes,4,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
-	Request      []byte `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty"`
-	CallbackId   string `protobuf:"bytes,8,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
-	Ttl          uint64 `protobuf:"varint,9,opt,name=ttl,proto3" json:"ttl,omitempty"`
-	RequestSent  bool   `protobuf:"varint,11,opt,name=request_sent,json=requestSent,proto3" json:"request_sent,omitempty"`
+	Id            string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConnectionId  string        `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	ChainId       string        `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	QueryType     string        `protobuf:"bytes,4,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
+	Request       []byte        `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty"`
+	CallbackId    string        `protobuf:"bytes,8,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	Ttl           uint64        `protobuf:"varint,9,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	RequestSent   bool          `protobuf:"varint,11,opt,name=request_sent,json=requestSent,proto3" json:"request_sent,omitempty"`
+	TimeoutPolicy TimeoutPolicy `protobuf:"varint,12,opt,name=timeout_policy,json=timeoutPolicy,proto3,enum=stride.interchainquery.v1.TimeoutPolicy" json:"timeout_policy,omitempty"`
+	// The time window (in nanoseconds) used to refresh the TTL when the query is retried
+	TimeoutDuration uint64 `protobuf:"varint,13,opt,name=timeout_duration,json=timeoutDuration,proto3" json:"timeout_duration,omitempty"`
 }
 
 func (m *Query) Reset()         { *m = Query{} }
@@ -125,6 +160,228 @@ func (m *Query) GetRequestSent() bool {
 	return false
 }
 
+func (m *Query) GetTimeoutPolicy() TimeoutPolicy {
+	if m != nil {
+		return m.TimeoutPolicy
+	}
+	return TimeoutPolicy_REJECT_QUERY_RESPONSE
+}
+
+func (m *Query) GetTimeoutDuration() uint64 {
+	if m != nil {
+		return m.TimeoutDuration
+	}
+	return 0
+}
+
+// A query that is re-submitted on a recurring schedule
+// The latest result of each periodic query is stored as a DataPoint under the same id
+type PeriodicQuery struct {
+	// The id of the underlying query (shared across each submission)
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Module       string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	CallbackId   string `protobuf:"bytes,3,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	ChainId      string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConnectionId string `protobuf:"bytes,5,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	QueryType    string `protobuf:"bytes,6,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
+	Request      []byte `protobuf:"bytes,7,opt,name=request,proto3" json:"request,omitempty"`
+	// If an epoch identifier is set, the query is re-submitted every `period`
+	// epochs; otherwise, it's re-submitted every `period` blocks
+	EpochIdentifier string `protobuf:"bytes,8,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	Period          uint64 `protobuf:"varint,9,opt,name=period,proto3" json:"period,omitempty"`
+	// The time window (in nanoseconds) used to set the TTL of each submission
+	TimeoutDuration      uint64        `protobuf:"varint,10,opt,name=timeout_duration,json=timeoutDuration,proto3" json:"timeout_duration,omitempty"`
+	TimeoutPolicy        TimeoutPolicy `protobuf:"varint,11,opt,name=timeout_policy,json=timeoutPolicy,proto3,enum=stride.interchainquery.v1.TimeoutPolicy" json:"timeout_policy,omitempty"`
+	LastSubmissionHeight int64         `protobuf:"varint,12,opt,name=last_submission_height,json=lastSubmissionHeight,proto3" json:"last_submission_height,omitempty"`
+}
+
+func (m *PeriodicQuery) Reset()         { *m = PeriodicQuery{} }
+func (m *PeriodicQuery) String() string { return proto.CompactTextString(m) }
+func (*PeriodicQuery) ProtoMessage()    {}
+func (*PeriodicQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74cd646eb05658fd, []int{1}
+}
+func (m *PeriodicQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodicQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodicQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodicQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicQuery.Merge(m, src)
+}
+func (m *PeriodicQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodicQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodicQuery proto.InternalMessageInfo
+
+func (m *PeriodicQuery) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PeriodicQuery) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *PeriodicQuery) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *PeriodicQuery) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *PeriodicQuery) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *PeriodicQuery) GetQueryType() string {
+	if m != nil {
+		return m.QueryType
+	}
+	return ""
+}
+
+func (m *PeriodicQuery) GetRequest() []byte {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *PeriodicQuery) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *PeriodicQuery) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *PeriodicQuery) GetTimeoutDuration() uint64 {
+	if m != nil {
+		return m.TimeoutDuration
+	}
+	return 0
+}
+
+func (m *PeriodicQuery) GetTimeoutPolicy() TimeoutPolicy {
+	if m != nil {
+		return m.TimeoutPolicy
+	}
+	return TimeoutPolicy_REJECT_QUERY_RESPONSE
+}
+
+func (m *PeriodicQuery) GetLastSubmissionHeight() int64 {
+	if m != nil {
+		return m.LastSubmissionHeight
+	}
+	return 0
+}
+
+// A query whose callback returned an error
+// Only the most recent failures are retained
+type FailedQuery struct {
+	Id           uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Query        Query  `protobuf:"bytes,2,opt,name=query,proto3" json:"query"`
+	Error        string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	FailedHeight int64  `protobuf:"varint,4,opt,name=failed_height,json=failedHeight,proto3" json:"failed_height,omitempty"`
+}
+
+func (m *FailedQuery) Reset()         { *m = FailedQuery{} }
+func (m *FailedQuery) String() string { return proto.CompactTextString(m) }
+func (*FailedQuery) ProtoMessage()    {}
+func (*FailedQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74cd646eb05658fd, []int{2}
+}
+func (m *FailedQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedQuery.Merge(m, src)
+}
+func (m *FailedQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedQuery proto.InternalMessageInfo
+
+func (m *FailedQuery) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FailedQuery) GetQuery() Query {
+	if m != nil {
+		return m.Query
+	}
+	return Query{}
+}
+
+func (m *FailedQuery) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FailedQuery) GetFailedHeight() int64 {
+	if m != nil {
+		return m.FailedHeight
+	}
+	return 0
+}
+
 type DataPoint struct {
 	Id           string                                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	RemoteHeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=remote_height,json=remoteHeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remote_height"`
@@ -136,7 +393,7 @@ func (m *DataPoint) Reset()         { *m = DataPoint{} }
 func (m *DataPoint) String() string { return proto.CompactTextString(m) }
 func (*DataPoint) ProtoMessage()    {}
 func (*DataPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74cd646eb05658fd, []int{1}
+	return fileDescriptor_74cd646eb05658fd, []int{3}
 }
 func (m *DataPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -181,14 +438,17 @@ func (m *DataPoint) GetValue() []byte {
 
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
-	Queries []Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	Queries         []Query         `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	PeriodicQueries []PeriodicQuery `protobuf:"bytes,2,rep,name=periodic_queries,json=periodicQueries,proto3" json:"periodic_queries"`
+	DataPoints      []DataPoint     `protobuf:"bytes,3,rep,name=data_points,json=dataPoints,proto3" json:"data_points"`
+	FailedQueries   []FailedQuery   `protobuf:"bytes,4,rep,name=failed_queries,json=failedQueries,proto3" json:"failed_queries"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74cd646eb05658fd, []int{2}
+	return fileDescriptor_74cd646eb05658fd, []int{4}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,8 +484,32 @@ func (m *GenesisState) GetQueries() []Query {
 	return nil
 }
 
+func (m *GenesisState) GetPeriodicQueries() []PeriodicQuery {
+	if m != nil {
+		return m.PeriodicQueries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDataPoints() []DataPoint {
+	if m != nil {
+		return m.DataPoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFailedQueries() []FailedQuery {
+	if m != nil {
+		return m.FailedQueries
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("stride.interchainquery.v1.TimeoutPolicy", TimeoutPolicy_name, TimeoutPolicy_value)
 	proto.RegisterType((*Query)(nil), "stride.interchainquery.v1.Query")
+	proto.RegisterType((*PeriodicQuery)(nil), "stride.interchainquery.v1.PeriodicQuery")
+	proto.RegisterType((*FailedQuery)(nil), "stride.interchainquery.v1.FailedQuery")
 	proto.RegisterType((*DataPoint)(nil), "stride.interchainquery.v1.DataPoint")
 	proto.RegisterType((*GenesisState)(nil), "stride.interchainquery.v1.GenesisState")
 }
@@ -235,38 +519,61 @@ func init() {
 }
 
 var fileDescriptor_74cd646eb05658fd = []byte{
-	// 492 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xee, 0xb4, 0x5b, 0xdb, 0x4e, 0xb3, 0xb2, 0x0c, 0x7b, 0x48, 0x17, 0x4c, 0x63, 0x05, 0x2d,
-	0x62, 0x13, 0xab, 0x27, 0xc1, 0x83, 0x14, 0x41, 0x03, 0x1e, 0xd6, 0xd4, 0x93, 0x97, 0x30, 0x4d,
-	0x86, 0x74, 0xd8, 0x64, 0xa6, 0x9b, 0x79, 0x29, 0xf6, 0x37, 0x78, 0xf1, 0xc7, 0xf8, 0x23, 0xf6,
-	0xb8, 0x78, 0x12, 0x0f, 0x45, 0x5a, 0xf0, 0xe0, 0xaf, 0x90, 0xcc, 0xa4, 0x28, 0x2e, 0xde, 0x3c,
-	0x65, 0xde, 0xfb, 0xde, 0xfb, 0xde, 0xfb, 0x5e, 0x3e, 0xfc, 0x40, 0x41, 0xc1, 0x13, 0xe6, 0x73,
-	0x01, 0xac, 0x88, 0x97, 0x94, 0x8b, 0xcb, 0x92, 0x15, 0x1b, 0x7f, 0x3d, 0xf5, 0x53, 0x26, 0x98,
-	0xe2, 0xca, 0x5b, 0x15, 0x12, 0x24, 0x19, 0x98, 0x42, 0xef, 0xaf, 0x42, 0x6f, 0x3d, 0x3d, 0x3b,
-	0x4d, 0x65, 0x2a, 0x75, 0x95, 0x5f, 0xbd, 0x4c, 0xc3, 0xd9, 0x20, 0x96, 0x2a, 0x97, 0x2a, 0x32,
-	0x80, 0x09, 0x0c, 0x34, 0xfa, 0x81, 0x70, 0xfb, 0x6d, 0xd5, 0x4d, 0x6e, 0xe3, 0x26, 0x4f, 0x6c,
-	0xe4, 0xa2, 0x71, 0x2f, 0x6c, 0xf2, 0x84, 0xdc, 0xc3, 0xc7, 0xb1, 0x14, 0x82, 0xc5, 0xc0, 0xa5,
-	0x88, 0x78, 0x62, 0x37, 0x35, 0x64, 0xfd, 0x4e, 0x06, 0x09, 0x19, 0xe0, 0xae, 0x5e, 0xa0, 0xc2,
-	0x5b, 0x1a, 0xef, 0xe8, 0x38, 0x48, 0xc8, 0x1d, 0x8c, 0xf5, 0x5a, 0x11, 0x6c, 0x56, 0xcc, 0x3e,
-	0xd2, 0x60, 0x4f, 0x67, 0xde, 0x6d, 0x56, 0x8c, 0xd8, 0xb8, 0x53, 0xb0, 0xcb, 0x92, 0x29, 0xb0,
-	0xdb, 0x2e, 0x1a, 0x5b, 0xe1, 0x21, 0x24, 0x43, 0xdc, 0x8f, 0x69, 0x96, 0x2d, 0x68, 0x7c, 0x51,
-	0xd1, 0x76, 0x75, 0x27, 0x3e, 0xa4, 0x82, 0x84, 0x9c, 0xe0, 0x16, 0x40, 0x66, 0xf7, 0x5c, 0x34,
-	0x3e, 0x0a, 0xab, 0x27, 0xb9, 0x8b, 0xad, 0xba, 0x3b, 0x52, 0x4c, 0x80, 0xdd, 0x77, 0xd1, 0xb8,
-	0x1b, 0xf6, 0xeb, 0xdc, 0x9c, 0x09, 0x18, 0x7d, 0x6c, 0xe2, 0xde, 0x4b, 0x0a, 0xf4, 0x5c, 0x72,
-	0x01, 0x37, 0xc4, 0x52, 0x7c, 0x5c, 0xb0, 0x5c, 0x02, 0x8b, 0x96, 0x8c, 0xa7, 0x4b, 0x30, 0x62,
-	0x67, 0xcf, 0xaf, 0xb6, 0xc3, 0xc6, 0xb7, 0xed, 0xf0, 0x7e, 0xca, 0x61, 0x59, 0x2e, 0xbc, 0x58,
-	0xe6, 0xf5, 0xf9, 0xea, 0xcf, 0x44, 0x25, 0x17, 0x7e, 0x25, 0x50, 0x79, 0x81, 0x80, 0x2f, 0x9f,
-	0x27, 0xb8, 0xbe, 0x6e, 0x20, 0x20, 0xb4, 0x0c, 0xe5, 0x6b, 0xcd, 0x48, 0x22, 0x6c, 0x65, 0x32,
-	0xa6, 0xd9, 0x61, 0x42, 0xeb, 0x3f, 0x4c, 0xe8, 0x6b, 0xc6, 0x7a, 0xc0, 0x43, 0xdc, 0x5e, 0xd3,
-	0xac, 0x34, 0xb7, 0xb6, 0x66, 0xa7, 0x3f, 0xb7, 0xc3, 0x93, 0x82, 0xa9, 0x32, 0x83, 0x47, 0x32,
-	0xe7, 0xc0, 0xf2, 0x15, 0x6c, 0x42, 0x53, 0x32, 0x3a, 0xc7, 0xd6, 0x2b, 0xe3, 0xa9, 0x39, 0x50,
-	0x60, 0xe4, 0x05, 0xee, 0x54, 0xbf, 0x86, 0x33, 0x65, 0x23, 0xb7, 0x35, 0xee, 0x3f, 0x71, 0xbd,
-	0x7f, 0x9a, 0xcc, 0xd3, 0x7e, 0x99, 0x1d, 0x55, 0x9b, 0x87, 0x87, 0xb6, 0xd9, 0xfc, 0x6a, 0xe7,
-	0xa0, 0xeb, 0x9d, 0x83, 0xbe, 0xef, 0x1c, 0xf4, 0x69, 0xef, 0x34, 0xae, 0xf7, 0x4e, 0xe3, 0xeb,
-	0xde, 0x69, 0xbc, 0x7f, 0xf6, 0x87, 0xb4, 0xb9, 0x26, 0x9d, 0xbc, 0xa1, 0x0b, 0xe5, 0xd7, 0x76,
-	0x5f, 0x4f, 0x1f, 0xfb, 0x1f, 0x6e, 0x98, 0x5e, 0x2b, 0x5e, 0xdc, 0xd2, 0x26, 0x7d, 0xfa, 0x2b,
-	0x00, 0x00, 0xff, 0xff, 0x6f, 0x57, 0x74, 0x01, 0x1b, 0x03, 0x00, 0x00,
+	// 851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xe3, 0xa4, 0x7f, 0x9e, 0x9d, 0x36, 0x1a, 0x4a, 0x71, 0x2b, 0x91, 0x86, 0x82, 0x96,
+	0xec, 0x8a, 0x26, 0x74, 0xe1, 0x82, 0xb4, 0x07, 0xd4, 0x5d, 0x03, 0x01, 0xc4, 0xb6, 0x76, 0x2a,
+	0x51, 0x2e, 0x96, 0x63, 0x4f, 0x93, 0xd1, 0xda, 0x1e, 0xaf, 0x67, 0x5c, 0x91, 0xcf, 0xc0, 0x85,
+	0x13, 0x9f, 0x84, 0xcf, 0x80, 0xf6, 0x82, 0x54, 0x71, 0x42, 0x1c, 0x2a, 0xd4, 0xde, 0xf8, 0x14,
+	0x68, 0xc6, 0xe3, 0x90, 0x34, 0x21, 0x12, 0x62, 0x4f, 0xf6, 0xfb, 0xbd, 0x79, 0xbf, 0xf7, 0xe6,
+	0xfd, 0xde, 0xcc, 0xc0, 0xfb, 0x8c, 0x67, 0x24, 0xc4, 0x3d, 0x92, 0x70, 0x9c, 0x05, 0x63, 0x9f,
+	0x24, 0x2f, 0x73, 0x9c, 0x4d, 0x7a, 0x57, 0xc7, 0xbd, 0x11, 0x4e, 0x30, 0x23, 0xac, 0x9b, 0x66,
+	0x94, 0x53, 0xb4, 0x57, 0x2c, 0xec, 0xde, 0x5b, 0xd8, 0xbd, 0x3a, 0xde, 0xdf, 0x19, 0xd1, 0x11,
+	0x95, 0xab, 0x7a, 0xe2, 0xaf, 0x08, 0xd8, 0xdf, 0x0b, 0x28, 0x8b, 0x29, 0xf3, 0x0a, 0x47, 0x61,
+	0x14, 0xae, 0xc3, 0xdb, 0x2a, 0xd4, 0xcf, 0x44, 0x34, 0xda, 0x82, 0x2a, 0x09, 0x2d, 0xad, 0xad,
+	0x75, 0x36, 0x9d, 0x2a, 0x09, 0xd1, 0xbb, 0xd0, 0x08, 0x68, 0x92, 0xe0, 0x80, 0x13, 0x9a, 0x78,
+	0x24, 0xb4, 0xaa, 0xd2, 0x65, 0xfe, 0x03, 0xf6, 0x43, 0xb4, 0x07, 0x1b, 0xb2, 0x00, 0xe1, 0xd7,
+	0xa5, 0x7f, 0x5d, 0xda, 0xfd, 0x10, 0xbd, 0x0d, 0x20, 0xcb, 0xf2, 0xf8, 0x24, 0xc5, 0x56, 0x4d,
+	0x3a, 0x37, 0x25, 0x32, 0x98, 0xa4, 0x18, 0x59, 0xb0, 0x9e, 0xe1, 0x97, 0x39, 0x66, 0xdc, 0xaa,
+	0xb7, 0xb5, 0x8e, 0xe9, 0x94, 0x26, 0x3a, 0x00, 0x23, 0xf0, 0xa3, 0x68, 0xe8, 0x07, 0x2f, 0x04,
+	0xed, 0x86, 0x8c, 0x84, 0x12, 0xea, 0x87, 0xa8, 0x09, 0x3a, 0xe7, 0x91, 0xb5, 0xd9, 0xd6, 0x3a,
+	0x35, 0x47, 0xfc, 0xa2, 0x77, 0xc0, 0x54, 0xd1, 0x1e, 0xc3, 0x09, 0xb7, 0x8c, 0xb6, 0xd6, 0xd9,
+	0x70, 0x0c, 0x85, 0xb9, 0x38, 0xe1, 0xe8, 0x39, 0x6c, 0x71, 0x12, 0x63, 0x9a, 0x73, 0x2f, 0xa5,
+	0x11, 0x09, 0x26, 0x96, 0xd9, 0xd6, 0x3a, 0x5b, 0x8f, 0x3b, 0xdd, 0x7f, 0xed, 0x66, 0x77, 0x50,
+	0x04, 0x9c, 0xca, 0xf5, 0x4e, 0x83, 0xcf, 0x9a, 0xe8, 0x21, 0x34, 0x4b, 0xc2, 0x30, 0xcf, 0x7c,
+	0xd1, 0x10, 0xab, 0x21, 0x4b, 0xda, 0x56, 0xf8, 0x33, 0x05, 0x1f, 0xfe, 0xa2, 0x43, 0xe3, 0x14,
+	0x67, 0x84, 0x86, 0x24, 0x58, 0xde, 0xec, 0x5d, 0x58, 0x8b, 0x69, 0x98, 0x47, 0x58, 0x75, 0x59,
+	0x59, 0xf7, 0x7b, 0xa1, 0x2f, 0xf4, 0x62, 0x56, 0x80, 0xda, 0xbc, 0x00, 0x0b, 0x02, 0xd6, 0x97,
+	0x08, 0x38, 0xaf, 0xd2, 0xda, 0x0a, 0x95, 0xd6, 0xe7, 0x55, 0x7a, 0x08, 0x4d, 0x9c, 0xd2, 0x60,
+	0xec, 0x91, 0x10, 0x27, 0x9c, 0x5c, 0x12, 0x9c, 0x29, 0xa9, 0xb6, 0x25, 0xde, 0x9f, 0xc2, 0x62,
+	0x73, 0xa9, 0xdc, 0xbd, 0x92, 0x4c, 0x59, 0x4b, 0x3b, 0x08, 0x4b, 0x3b, 0xb8, 0x44, 0x3d, 0xe3,
+	0xff, 0xa9, 0xf7, 0x31, 0xec, 0x46, 0xbe, 0x18, 0x97, 0x7c, 0x18, 0x13, 0xc6, 0x44, 0x87, 0xc6,
+	0x98, 0x8c, 0xc6, 0x5c, 0x8e, 0x85, 0xee, 0xec, 0x08, 0xaf, 0x3b, 0x75, 0x7e, 0x21, 0x7d, 0x87,
+	0x3f, 0x69, 0x60, 0x7c, 0xe6, 0x93, 0x08, 0x87, 0xf7, 0x65, 0xac, 0x49, 0x19, 0x9f, 0x40, 0x5d,
+	0xa6, 0x97, 0x2a, 0x1a, 0x8f, 0xdb, 0x2b, 0xaa, 0x93, 0x04, 0x27, 0xb5, 0x57, 0x37, 0x07, 0x15,
+	0xa7, 0x08, 0x42, 0x3b, 0x50, 0xc7, 0x59, 0x46, 0x33, 0x25, 0x73, 0x61, 0x08, 0x19, 0x2f, 0x65,
+	0xca, 0xb2, 0xc0, 0x9a, 0x2c, 0xd0, 0x2c, 0x40, 0x55, 0xd8, 0x0f, 0x55, 0xd8, 0x7c, 0xe6, 0x73,
+	0xff, 0x94, 0x92, 0x84, 0x2f, 0x4c, 0x97, 0x0f, 0x8d, 0x0c, 0xc7, 0x94, 0xe3, 0x92, 0x42, 0x0e,
+	0xd9, 0xc9, 0x13, 0x91, 0xfc, 0x8f, 0x9b, 0x83, 0x07, 0x23, 0xc2, 0xc7, 0xf9, 0xb0, 0x1b, 0xd0,
+	0x58, 0x5d, 0x0e, 0xea, 0x73, 0xc4, 0xc2, 0x17, 0x3d, 0x31, 0x18, 0xac, 0xdb, 0x4f, 0xf8, 0x6f,
+	0x3f, 0x1f, 0x41, 0x81, 0x0b, 0xcb, 0x31, 0x0b, 0xca, 0xa2, 0x00, 0xe4, 0x81, 0x19, 0xd1, 0xc0,
+	0x8f, 0xca, 0x0c, 0xfa, 0x6b, 0xc8, 0x60, 0x48, 0x46, 0x95, 0xe0, 0x11, 0xd4, 0xaf, 0xfc, 0x28,
+	0x2f, 0x6e, 0x12, 0xf3, 0x64, 0xe7, 0xaf, 0x9b, 0x83, 0x66, 0x86, 0x59, 0x1e, 0xf1, 0x0f, 0x68,
+	0x4c, 0x38, 0x8e, 0x53, 0x3e, 0x71, 0x8a, 0x25, 0x87, 0xbf, 0x56, 0xc1, 0xfc, 0xbc, 0xb8, 0x32,
+	0x5d, 0xee, 0x73, 0x8c, 0x3e, 0x85, 0x75, 0xd1, 0x62, 0x82, 0x99, 0xa5, 0xb5, 0xf5, 0xff, 0xa0,
+	0x4c, 0x19, 0x86, 0x2e, 0xa0, 0x99, 0xaa, 0x13, 0xec, 0x95, 0x54, 0x55, 0x49, 0xb5, 0x6a, 0x04,
+	0xe7, 0x0e, 0xbd, 0xa2, 0xdc, 0x4e, 0x67, 0x40, 0x41, 0xfd, 0x15, 0x18, 0xa1, 0xcf, 0x7d, 0x2f,
+	0x15, 0xda, 0x31, 0x4b, 0x97, 0xac, 0xef, 0xad, 0x60, 0x9d, 0x0a, 0xad, 0x18, 0x21, 0x2c, 0x01,
+	0x86, 0x5c, 0xd8, 0x52, 0xd3, 0x52, 0x56, 0x59, 0x93, 0x7c, 0x0f, 0x56, 0xf0, 0xcd, 0x4c, 0xb4,
+	0x62, 0x6c, 0x5c, 0x4e, 0x21, 0x82, 0xd9, 0x23, 0x0f, 0x1a, 0x73, 0x87, 0x09, 0xed, 0xc1, 0x9b,
+	0x8e, 0xfd, 0xa5, 0xfd, 0x74, 0xe0, 0x9d, 0x9d, 0xdb, 0xce, 0x85, 0xe7, 0xd8, 0xee, 0xe9, 0xf3,
+	0x6f, 0x5c, 0xbb, 0x59, 0x41, 0x6f, 0xc1, 0x1b, 0x8e, 0x3d, 0x70, 0x2e, 0xa6, 0x9e, 0xb3, 0x73,
+	0xdb, 0x1d, 0x34, 0x35, 0xb4, 0x0f, 0xbb, 0xf6, 0xb7, 0xf6, 0xd3, 0xf3, 0x81, 0x7d, 0x3f, 0xa8,
+	0x7a, 0xe2, 0xbe, 0xba, 0x6d, 0x69, 0xd7, 0xb7, 0x2d, 0xed, 0xcf, 0xdb, 0x96, 0xf6, 0xe3, 0x5d,
+	0xab, 0x72, 0x7d, 0xd7, 0xaa, 0xfc, 0x7e, 0xd7, 0xaa, 0x7c, 0xf7, 0xc9, 0xcc, 0xe4, 0xb8, 0x72,
+	0x07, 0x47, 0x5f, 0xfb, 0x43, 0xd6, 0x53, 0x6f, 0xe5, 0xd5, 0xf1, 0x87, 0xbd, 0xef, 0x17, 0x5e,
+	0x4c, 0x39, 0x50, 0xc3, 0x35, 0xf9, 0xc2, 0x7d, 0xf4, 0xf7, 0x00, 0x33, 0xef, 0x6c, 0x96, 0x58,
+	0x07, 0x00, 0x00,
 }
 
 func (m *Query) Marshal() (dAtA []byte, err error) {
@@ -289,6 +596,16 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TimeoutDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutDuration))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.TimeoutPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutPolicy))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.RequestSent {
 		i--
 		if m.RequestSent {
@@ -349,7 +666,7 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DataPoint) Marshal() (dAtA []byte, err error) {
+func (m *PeriodicQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -359,43 +676,85 @@ func (m *DataPoint) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DataPoint) MarshalTo(dAtA []byte) (int, error) {
+func (m *PeriodicQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PeriodicQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Value)))
+	if m.LastSubmissionHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSubmissionHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.TimeoutPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutPolicy))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.TimeoutDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutDuration))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Period != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Request) > 0 {
+		i -= len(m.Request)
+		copy(dAtA[i:], m.Request)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Request)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.QueryType) > 0 {
+		i -= len(m.QueryType)
+		copy(dAtA[i:], m.QueryType)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.QueryType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
 		i--
 		dAtA[i] = 0x22
 	}
-	{
-		size := m.LocalHeight.Size()
-		i -= size
-		if _, err := m.LocalHeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.RemoteHeight.Size()
-		i -= size
-		if _, err := m.RemoteHeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -406,7 +765,7 @@ func (m *DataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *FailedQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -416,27 +775,176 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *FailedQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FailedQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Queries) > 0 {
-		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
+	if m.FailedHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FailedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.LocalHeight.Size()
+		i -= size
+		if _, err := m.LocalHeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RemoteHeight.Size()
+		i -= size
+		if _, err := m.RemoteHeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailedQueries) > 0 {
+		for iNdEx := len(m.FailedQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DataPoints) > 0 {
+		for iNdEx := len(m.DataPoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataPoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PeriodicQueries) > 0 {
+		for iNdEx := len(m.PeriodicQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodicQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0xa
 		}
 	}
@@ -490,6 +998,86 @@ func (m *Query) Size() (n int) {
 	if m.RequestSent {
 		n += 2
 	}
+	if m.TimeoutPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutPolicy))
+	}
+	if m.TimeoutDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutDuration))
+	}
+	return n
+}
+
+func (m *PeriodicQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.QueryType)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Request)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovGenesis(uint64(m.Period))
+	}
+	if m.TimeoutDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutDuration))
+	}
+	if m.TimeoutPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutPolicy))
+	}
+	if m.LastSubmissionHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSubmissionHeight))
+	}
+	return n
+}
+
+func (m *FailedQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	l = m.Query.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.FailedHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.FailedHeight))
+	}
 	return n
 }
 
@@ -526,6 +1114,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PeriodicQueries) > 0 {
+		for _, e := range m.PeriodicQueries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DataPoints) > 0 {
+		for _, e := range m.DataPoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FailedQueries) > 0 {
+		for _, e := range m.FailedQueries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -626,13 +1232,528 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
+			if m.Request == nil {
+				m.Request = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestSent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequestSent = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPolicy", wireType)
+			}
+			m.TimeoutPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutPolicy |= TimeoutPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
+			}
+			m.TimeoutDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodicQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
+			if m.Request == nil {
+				m.Request = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			var stringLen uint64
+			m.Period = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -642,29 +1763,54 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Period |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutDuration", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			m.TimeoutDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPolicy", wireType)
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			m.TimeoutPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutPolicy |= TimeoutPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSubmissionHeight", wireType)
+			}
+			m.LastSubmissionHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -674,29 +1820,85 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastSubmissionHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueryType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailedQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -706,29 +1908,28 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
-			if m.Request == nil {
-				m.Request = []byte{}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -756,32 +1957,13 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
-			}
-			m.Ttl = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Ttl |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestSent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedHeight", wireType)
 			}
-			var v int
+			m.FailedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -791,12 +1973,11 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.FailedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequestSent = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1065,6 +2246,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodicQueries = append(m.PeriodicQueries, PeriodicQuery{})
+			if err := m.PeriodicQueries[len(m.PeriodicQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataPoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataPoints = append(m.DataPoints, DataPoint{})
+			if err := m.DataPoints[len(m.DataPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedQueries = append(m.FailedQueries, FailedQuery{})
+			if err := m.FailedQueries[len(m.FailedQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
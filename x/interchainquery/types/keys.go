@@ -16,10 +16,16 @@ const (
 
 // prefix bytes for the interchainquery persistent store
 const (
-	prefixData  = iota + 1
-	prefixQuery = iota + 1
+	prefixData          = iota + 1
+	prefixQuery         = iota + 1
+	prefixPeriodicQuery = iota + 1
+	prefixFailedQuery   = iota + 1
 )
 
+// The maximum number of failed queries that are retained in the store
+// Once exceeded, the oldest failed query is removed
+const MaxFailedQueries = 100
+
 // keys for proof queries to various stores, note: there's an implicit assumption here that
 // the stores on the counterparty chain are prefixed with the standard cosmos-sdk module names
 // this might not be true for all IBC chains, and is something we should verify before onboarding a
@@ -30,11 +36,17 @@ const (
 	STAKING_STORE_QUERY_WITH_PROOF = "store/staking/key"
 	// The bank store is key'd by the account address
 	BANK_STORE_QUERY_WITH_PROOF = "store/bank/key"
+	// The slashing store is key'd by the validator's consensus address
+	SLASHING_STORE_QUERY_WITH_PROOF = "store/slashing/key"
 )
 
 var (
-	KeyPrefixData  = []byte{prefixData}
-	KeyPrefixQuery = []byte{prefixQuery}
+	KeyPrefixData          = []byte{prefixData}
+	KeyPrefixQuery         = []byte{prefixQuery}
+	KeyPrefixPeriodicQuery = []byte{prefixPeriodicQuery}
+	KeyPrefixFailedQuery   = []byte{prefixFailedQuery}
+
+	FailedQuerySequenceKey = []byte("failed-query-sequence")
 )
 
 func KeyPrefix(p string) []byte {
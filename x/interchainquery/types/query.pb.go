@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -29,7 +30,45 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Filters pending queries by whether the request has been emitted to relayers
+type RequestSentFilter int32
+
+const (
+	// Only queries that have been emitted but have not received a response
+	RequestSentFilter_REQUEST_SENT RequestSentFilter = 0
+	// Only queries that have yet to be emitted
+	RequestSentFilter_REQUEST_NOT_SENT RequestSentFilter = 1
+	// All queries in the store
+	RequestSentFilter_ALL_REQUESTS RequestSentFilter = 2
+)
+
+var RequestSentFilter_name = map[int32]string{
+	0: "REQUEST_SENT",
+	1: "REQUEST_NOT_SENT",
+	2: "ALL_REQUESTS",
+}
+
+var RequestSentFilter_value = map[string]int32{
+	"REQUEST_SENT":     0,
+	"REQUEST_NOT_SENT": 1,
+	"ALL_REQUESTS":     2,
+}
+
+func (x RequestSentFilter) String() string {
+	return proto.EnumName(RequestSentFilter_name, int32(x))
+}
+
+func (RequestSentFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{0}
+}
+
 type QueryPendingQueriesRequest struct {
+	// Optional filters - if empty, queries are not filtered by the field
+	ChainId      string             `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConnectionId string             `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	CallbackId   string             `protobuf:"bytes,3,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	RequestSent  RequestSentFilter  `protobuf:"varint,4,opt,name=request_sent,json=requestSent,proto3,enum=stride.interchainquery.v1.RequestSentFilter" json:"request_sent,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryPendingQueriesRequest) Reset()         { *m = QueryPendingQueriesRequest{} }
@@ -65,8 +104,44 @@ func (m *QueryPendingQueriesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryPendingQueriesRequest proto.InternalMessageInfo
 
+func (m *QueryPendingQueriesRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryPendingQueriesRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *QueryPendingQueriesRequest) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *QueryPendingQueriesRequest) GetRequestSent() RequestSentFilter {
+	if m != nil {
+		return m.RequestSent
+	}
+	return RequestSentFilter_REQUEST_SENT
+}
+
+func (m *QueryPendingQueriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryPendingQueriesResponse struct {
-	PendingQueries []Query `protobuf:"bytes,1,rep,name=pending_queries,json=pendingQueries,proto3" json:"pending_queries"`
+	PendingQueries []Query             `protobuf:"bytes,1,rep,name=pending_queries,json=pendingQueries,proto3" json:"pending_queries"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryPendingQueriesResponse) Reset()         { *m = QueryPendingQueriesResponse{} }
@@ -109,9 +184,312 @@ func (m *QueryPendingQueriesResponse) GetPendingQueries() []Query {
 	return nil
 }
 
+func (m *QueryPendingQueriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPeriodicQueriesRequest struct {
+}
+
+func (m *QueryPeriodicQueriesRequest) Reset()         { *m = QueryPeriodicQueriesRequest{} }
+func (m *QueryPeriodicQueriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPeriodicQueriesRequest) ProtoMessage()    {}
+func (*QueryPeriodicQueriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{2}
+}
+func (m *QueryPeriodicQueriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPeriodicQueriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPeriodicQueriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPeriodicQueriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPeriodicQueriesRequest.Merge(m, src)
+}
+func (m *QueryPeriodicQueriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPeriodicQueriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPeriodicQueriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPeriodicQueriesRequest proto.InternalMessageInfo
+
+type QueryPeriodicQueriesResponse struct {
+	PeriodicQueries []PeriodicQuery `protobuf:"bytes,1,rep,name=periodic_queries,json=periodicQueries,proto3" json:"periodic_queries"`
+}
+
+func (m *QueryPeriodicQueriesResponse) Reset()         { *m = QueryPeriodicQueriesResponse{} }
+func (m *QueryPeriodicQueriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPeriodicQueriesResponse) ProtoMessage()    {}
+func (*QueryPeriodicQueriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{3}
+}
+func (m *QueryPeriodicQueriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPeriodicQueriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPeriodicQueriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPeriodicQueriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPeriodicQueriesResponse.Merge(m, src)
+}
+func (m *QueryPeriodicQueriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPeriodicQueriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPeriodicQueriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPeriodicQueriesResponse proto.InternalMessageInfo
+
+func (m *QueryPeriodicQueriesResponse) GetPeriodicQueries() []PeriodicQuery {
+	if m != nil {
+		return m.PeriodicQueries
+	}
+	return nil
+}
+
+type QueryDataPointRequest struct {
+	QueryId string `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+}
+
+func (m *QueryDataPointRequest) Reset()         { *m = QueryDataPointRequest{} }
+func (m *QueryDataPointRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataPointRequest) ProtoMessage()    {}
+func (*QueryDataPointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{4}
+}
+func (m *QueryDataPointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataPointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataPointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataPointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataPointRequest.Merge(m, src)
+}
+func (m *QueryDataPointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataPointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataPointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataPointRequest proto.InternalMessageInfo
+
+func (m *QueryDataPointRequest) GetQueryId() string {
+	if m != nil {
+		return m.QueryId
+	}
+	return ""
+}
+
+type QueryDataPointResponse struct {
+	DataPoint DataPoint `protobuf:"bytes,1,opt,name=data_point,json=dataPoint,proto3" json:"data_point"`
+}
+
+func (m *QueryDataPointResponse) Reset()         { *m = QueryDataPointResponse{} }
+func (m *QueryDataPointResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataPointResponse) ProtoMessage()    {}
+func (*QueryDataPointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{5}
+}
+func (m *QueryDataPointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataPointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataPointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDataPointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataPointResponse.Merge(m, src)
+}
+func (m *QueryDataPointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataPointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataPointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataPointResponse proto.InternalMessageInfo
+
+func (m *QueryDataPointResponse) GetDataPoint() DataPoint {
+	if m != nil {
+		return m.DataPoint
+	}
+	return DataPoint{}
+}
+
+type QueryFailedQueriesRequest struct {
+	// Optional filters - if empty, queries are not filtered by the field
+	ChainId      string             `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConnectionId string             `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	CallbackId   string             `protobuf:"bytes,3,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedQueriesRequest) Reset()         { *m = QueryFailedQueriesRequest{} }
+func (m *QueryFailedQueriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedQueriesRequest) ProtoMessage()    {}
+func (*QueryFailedQueriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{6}
+}
+func (m *QueryFailedQueriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedQueriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedQueriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedQueriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedQueriesRequest.Merge(m, src)
+}
+func (m *QueryFailedQueriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedQueriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedQueriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedQueriesRequest proto.InternalMessageInfo
+
+func (m *QueryFailedQueriesRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryFailedQueriesRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *QueryFailedQueriesRequest) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *QueryFailedQueriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFailedQueriesResponse struct {
+	FailedQueries []FailedQuery       `protobuf:"bytes,1,rep,name=failed_queries,json=failedQueries,proto3" json:"failed_queries"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedQueriesResponse) Reset()         { *m = QueryFailedQueriesResponse{} }
+func (m *QueryFailedQueriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedQueriesResponse) ProtoMessage()    {}
+func (*QueryFailedQueriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b720c147b9144d5b, []int{7}
+}
+func (m *QueryFailedQueriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedQueriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedQueriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedQueriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedQueriesResponse.Merge(m, src)
+}
+func (m *QueryFailedQueriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedQueriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedQueriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedQueriesResponse proto.InternalMessageInfo
+
+func (m *QueryFailedQueriesResponse) GetFailedQueries() []FailedQuery {
+	if m != nil {
+		return m.FailedQueries
+	}
+	return nil
+}
+
+func (m *QueryFailedQueriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("stride.interchainquery.v1.RequestSentFilter", RequestSentFilter_name, RequestSentFilter_value)
 	proto.RegisterType((*QueryPendingQueriesRequest)(nil), "stride.interchainquery.v1.QueryPendingQueriesRequest")
 	proto.RegisterType((*QueryPendingQueriesResponse)(nil), "stride.interchainquery.v1.QueryPendingQueriesResponse")
+	proto.RegisterType((*QueryPeriodicQueriesRequest)(nil), "stride.interchainquery.v1.QueryPeriodicQueriesRequest")
+	proto.RegisterType((*QueryPeriodicQueriesResponse)(nil), "stride.interchainquery.v1.QueryPeriodicQueriesResponse")
+	proto.RegisterType((*QueryDataPointRequest)(nil), "stride.interchainquery.v1.QueryDataPointRequest")
+	proto.RegisterType((*QueryDataPointResponse)(nil), "stride.interchainquery.v1.QueryDataPointResponse")
+	proto.RegisterType((*QueryFailedQueriesRequest)(nil), "stride.interchainquery.v1.QueryFailedQueriesRequest")
+	proto.RegisterType((*QueryFailedQueriesResponse)(nil), "stride.interchainquery.v1.QueryFailedQueriesResponse")
 }
 
 func init() {
@@ -119,27 +497,56 @@ func init() {
 }
 
 var fileDescriptor_b720c147b9144d5b = []byte{
-	// 314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0xcf, 0xcc, 0x2b, 0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x2b, 0x2c, 0x4d,
-	0x2d, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x07, 0x33, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x24,
-	0x21, 0xca, 0xf4, 0xd0, 0x94, 0xe9, 0x95, 0x19, 0x4a, 0xa9, 0xe3, 0x36, 0x21, 0x3d, 0x35, 0x2f,
-	0xb5, 0x38, 0xb3, 0x18, 0x62, 0x86, 0x94, 0x4c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62,
-	0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e, 0x49, 0x62, 0x49, 0x66, 0x7e, 0x1e, 0x4c, 0x56, 0x24,
-	0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2, 0x4a, 0x32, 0x5c, 0x52, 0x81, 0x20,
-	0xd3, 0x02, 0x52, 0xf3, 0x52, 0x32, 0xf3, 0xd2, 0x41, 0xec, 0xcc, 0xd4, 0xe2, 0xa0, 0xd4, 0xc2,
-	0xd2, 0xd4, 0xe2, 0x12, 0xa5, 0x3c, 0x2e, 0x69, 0xac, 0xb2, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9,
-	0x42, 0xfe, 0x5c, 0xfc, 0x05, 0x10, 0x99, 0xf8, 0x42, 0x88, 0x94, 0x04, 0xa3, 0x02, 0xb3, 0x06,
-	0xb7, 0x91, 0x82, 0x1e, 0x4e, 0xef, 0xe8, 0x81, 0x0d, 0x74, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21,
-	0x88, 0xaf, 0x00, 0xc5, 0x60, 0xa3, 0xb3, 0x8c, 0x5c, 0x3c, 0x60, 0xf9, 0xe0, 0xd4, 0xa2, 0xb2,
-	0xcc, 0xe4, 0x54, 0xa1, 0x3d, 0x8c, 0x5c, 0x7c, 0xa8, 0x96, 0x0b, 0x99, 0x12, 0x32, 0x1b, 0xab,
-	0x57, 0xa4, 0xcc, 0x48, 0xd5, 0x06, 0xf1, 0xa3, 0x92, 0x75, 0xd3, 0xe5, 0x27, 0x93, 0x99, 0x4c,
-	0x85, 0x8c, 0xf5, 0x83, 0xc1, 0xfa, 0x75, 0x7d, 0x12, 0x93, 0x8a, 0xf5, 0x71, 0x44, 0x09, 0x5a,
-	0x68, 0x38, 0x05, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
-	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x65, 0x7a,
-	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0x36, 0x83, 0xcb, 0x0c, 0x0d, 0xf4, 0x2b,
-	0x30, 0x8c, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xc7, 0x9c, 0x31, 0x20, 0x00, 0x00,
-	0xff, 0xff, 0x14, 0x0f, 0x05, 0x44, 0x5a, 0x02, 0x00, 0x00,
+	// 777 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x4f, 0x13, 0x4f,
+	0x18, 0xef, 0x14, 0xfe, 0xfc, 0x65, 0x5a, 0x4a, 0x9d, 0xa0, 0x29, 0x2b, 0x96, 0xa6, 0x2a, 0x34,
+	0x44, 0x77, 0x69, 0x79, 0x31, 0x88, 0x17, 0x88, 0x60, 0x9a, 0x20, 0x2f, 0x5d, 0x3c, 0xe8, 0xa5,
+	0x99, 0xee, 0x0e, 0xcb, 0xc4, 0xb2, 0xb3, 0xec, 0x0e, 0x8d, 0xc4, 0x78, 0xd0, 0x4f, 0x60, 0x62,
+	0xe2, 0x17, 0xf1, 0xa0, 0x31, 0x1e, 0x3c, 0x78, 0xe0, 0x48, 0xe2, 0xc5, 0x93, 0x31, 0xe0, 0x07,
+	0x31, 0x3b, 0xbb, 0x4b, 0xbb, 0xa5, 0xf4, 0xc5, 0x18, 0x6f, 0xb3, 0xcf, 0xcb, 0x6f, 0x7e, 0xbf,
+	0x67, 0x9e, 0xe7, 0x69, 0xe1, 0x2d, 0x87, 0xdb, 0x54, 0x27, 0x0a, 0x35, 0x39, 0xb1, 0xb5, 0x5d,
+	0x4c, 0xcd, 0xfd, 0x03, 0x62, 0x1f, 0x2a, 0xb5, 0xbc, 0x22, 0x0e, 0xb2, 0x65, 0x33, 0xce, 0xd0,
+	0xa8, 0x17, 0x26, 0x37, 0x85, 0xc9, 0xb5, 0xbc, 0x34, 0x79, 0x31, 0x82, 0x41, 0x4c, 0xe2, 0x50,
+	0xc7, 0xc3, 0x90, 0xc6, 0x0c, 0xc6, 0x8c, 0x2a, 0x51, 0xb0, 0x45, 0x15, 0x6c, 0x9a, 0x8c, 0x63,
+	0x4e, 0x99, 0x19, 0x78, 0x47, 0x0c, 0x66, 0x30, 0x71, 0x54, 0xdc, 0x93, 0x6f, 0x9d, 0xd2, 0x98,
+	0xb3, 0xc7, 0x1c, 0xa5, 0x82, 0x1d, 0xa2, 0x04, 0xb8, 0x15, 0xc2, 0x71, 0x5e, 0xb1, 0xb0, 0x41,
+	0x4d, 0x01, 0xe1, 0xc5, 0x66, 0xdf, 0x45, 0xa1, 0xb4, 0xe5, 0x86, 0x6c, 0x12, 0x53, 0xa7, 0xa6,
+	0xe1, 0x9e, 0x29, 0x71, 0x4a, 0x64, 0xff, 0x80, 0x38, 0x1c, 0x8d, 0xc2, 0x4b, 0x82, 0x5d, 0x99,
+	0xea, 0x29, 0x90, 0x01, 0xb9, 0xc1, 0xd2, 0xff, 0xe2, 0xbb, 0xa8, 0xa3, 0x1b, 0x70, 0x48, 0x63,
+	0xa6, 0x49, 0x34, 0x17, 0xcd, 0xf5, 0x47, 0x85, 0x3f, 0x5e, 0x37, 0x16, 0x75, 0x34, 0x0e, 0x63,
+	0x1a, 0xae, 0x56, 0x2b, 0x58, 0x7b, 0xe6, 0x86, 0xf4, 0x89, 0x10, 0x18, 0x98, 0x8a, 0x3a, 0xda,
+	0x80, 0x71, 0xdb, 0xbb, 0xab, 0xec, 0x10, 0x93, 0xa7, 0xfa, 0x33, 0x20, 0x97, 0x28, 0xdc, 0x96,
+	0x2f, 0x2c, 0x9d, 0xec, 0x53, 0x53, 0x89, 0xc9, 0x57, 0x69, 0x95, 0x13, 0xbb, 0x14, 0xb3, 0xeb,
+	0x26, 0xb4, 0x0a, 0x61, 0x5d, 0x64, 0xea, 0xbf, 0x0c, 0xc8, 0xc5, 0x0a, 0x13, 0xb2, 0x57, 0x11,
+	0xd9, 0xad, 0x88, 0x1c, 0x20, 0x89, 0x8a, 0xc8, 0x9b, 0xd8, 0x20, 0x3e, 0x64, 0xa9, 0x21, 0x33,
+	0xfb, 0x01, 0xc0, 0x6b, 0x2d, 0x0b, 0xe3, 0x58, 0xcc, 0x74, 0x08, 0xda, 0x80, 0xc3, 0x96, 0xe7,
+	0x29, 0xef, 0x7b, 0xae, 0x14, 0xc8, 0xf4, 0xe5, 0x62, 0x85, 0x4c, 0x1b, 0xee, 0x02, 0x70, 0xb9,
+	0xff, 0xe8, 0xc7, 0x78, 0xa4, 0x94, 0xb0, 0x42, 0xc0, 0xe8, 0x61, 0x88, 0x78, 0x54, 0x10, 0x9f,
+	0xec, 0x48, 0xdc, 0x63, 0x13, 0x62, 0x7e, 0xfd, 0x8c, 0xb8, 0x4d, 0x99, 0x4e, 0xb5, 0xf0, 0x93,
+	0x66, 0x0f, 0xe1, 0x58, 0x6b, 0xb7, 0x2f, 0xec, 0x09, 0x4c, 0x5a, 0xbe, 0xab, 0x49, 0x59, 0xae,
+	0x8d, 0xb2, 0x46, 0xb4, 0x40, 0xe1, 0xb0, 0x15, 0xbe, 0x22, 0x5b, 0x80, 0x57, 0x84, 0xff, 0x01,
+	0xe6, 0x78, 0x93, 0x51, 0x93, 0x37, 0xb4, 0x99, 0x40, 0x6a, 0x68, 0x33, 0xf1, 0x5d, 0xd4, 0xb3,
+	0x1a, 0xbc, 0xda, 0x9c, 0xe3, 0x13, 0x2d, 0x42, 0xa8, 0x63, 0x8e, 0xcb, 0x96, 0x6b, 0x15, 0x69,
+	0xb1, 0xc2, 0xcd, 0x36, 0x14, 0xcf, 0x10, 0x7c, 0x7a, 0x83, 0x7a, 0x60, 0xc8, 0x7e, 0x05, 0x70,
+	0x54, 0xdc, 0xb2, 0x8a, 0x69, 0x95, 0xe8, 0xff, 0x7a, 0x08, 0xc2, 0x3d, 0xdb, 0xff, 0xc7, 0x3d,
+	0xfb, 0x09, 0x40, 0xa9, 0x95, 0x0c, 0xbf, 0x60, 0x2a, 0x4c, 0xec, 0x08, 0x47, 0xd3, 0xbb, 0x4e,
+	0xb4, 0x29, 0x5a, 0x1d, 0x29, 0x78, 0xd5, 0xa1, 0x9d, 0x46, 0xf0, 0xbf, 0xd6, 0xb6, 0x53, 0x8f,
+	0xe0, 0xe5, 0x73, 0xa3, 0x8d, 0x92, 0x30, 0x5e, 0x5a, 0xd9, 0x7a, 0xbc, 0xa2, 0x6e, 0x97, 0xd5,
+	0x95, 0xf5, 0xed, 0x64, 0x04, 0x8d, 0xc0, 0x64, 0x60, 0x59, 0xdf, 0xf0, 0xad, 0xc0, 0x8d, 0x5b,
+	0x5a, 0x5b, 0x2b, 0xfb, 0x1e, 0x35, 0x19, 0x2d, 0xbc, 0x1a, 0x80, 0x71, 0x41, 0x5b, 0x25, 0x76,
+	0x8d, 0x6a, 0x04, 0x7d, 0x06, 0x30, 0x11, 0x9e, 0x65, 0x34, 0xd7, 0x69, 0x54, 0x5b, 0x2e, 0x45,
+	0x69, 0xbe, 0xd7, 0x34, 0x4f, 0x6d, 0x76, 0xf1, 0xf5, 0xb7, 0x5f, 0x6f, 0xa3, 0x73, 0x68, 0x46,
+	0x51, 0x45, 0xfe, 0x9d, 0x35, 0x5c, 0x71, 0x94, 0x0b, 0x7e, 0x09, 0x9a, 0x96, 0x0b, 0xfa, 0x02,
+	0xe0, 0x70, 0xd3, 0xc8, 0xa2, 0x2e, 0x88, 0xb4, 0x5a, 0x01, 0xd2, 0xdd, 0x9e, 0xf3, 0x7c, 0x05,
+	0xf7, 0x85, 0x82, 0x79, 0x34, 0xdb, 0x9d, 0x82, 0xf0, 0x16, 0x41, 0xef, 0x01, 0x1c, 0x3c, 0x1b,
+	0x42, 0x34, 0xdd, 0x89, 0x44, 0xf3, 0x96, 0x90, 0xf2, 0x3d, 0x64, 0xf8, 0x84, 0x97, 0x04, 0xe1,
+	0x45, 0xb4, 0xd0, 0x0d, 0xe1, 0xfa, 0x36, 0x51, 0x5e, 0x04, 0xeb, 0xe8, 0x25, 0xfa, 0x08, 0xe0,
+	0x50, 0x68, 0x9e, 0xd0, 0x6c, 0x27, 0x1e, 0xad, 0xb6, 0x88, 0x34, 0xd7, 0x63, 0x96, 0xaf, 0xe0,
+	0x9e, 0x50, 0x30, 0x8b, 0x0a, 0xdd, 0x28, 0x08, 0x8f, 0xf7, 0xb2, 0x7a, 0x74, 0x92, 0x06, 0xc7,
+	0x27, 0x69, 0xf0, 0xf3, 0x24, 0x0d, 0xde, 0x9c, 0xa6, 0x23, 0xc7, 0xa7, 0xe9, 0xc8, 0xf7, 0xd3,
+	0x74, 0xe4, 0xe9, 0x82, 0x41, 0xf9, 0xee, 0x41, 0x45, 0xd6, 0xd8, 0x5e, 0x2b, 0xdc, 0x5a, 0x7e,
+	0x5a, 0x79, 0x7e, 0x0e, 0x9d, 0x1f, 0x5a, 0xc4, 0xa9, 0x0c, 0x88, 0x3f, 0x0e, 0x33, 0xbf, 0x07,
+	0x00, 0xe7, 0x72, 0xf4, 0xa0, 0x05, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +562,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryServiceClient interface {
 	PendingQueries(ctx context.Context, in *QueryPendingQueriesRequest, opts ...grpc.CallOption) (*QueryPendingQueriesResponse, error)
+	PeriodicQueries(ctx context.Context, in *QueryPeriodicQueriesRequest, opts ...grpc.CallOption) (*QueryPeriodicQueriesResponse, error)
+	DataPoint(ctx context.Context, in *QueryDataPointRequest, opts ...grpc.CallOption) (*QueryDataPointResponse, error)
+	FailedQueries(ctx context.Context, in *QueryFailedQueriesRequest, opts ...grpc.CallOption) (*QueryFailedQueriesResponse, error)
 }
 
 type queryServiceClient struct {
@@ -174,9 +584,39 @@ func (c *queryServiceClient) PendingQueries(ctx context.Context, in *QueryPendin
 	return out, nil
 }
 
+func (c *queryServiceClient) PeriodicQueries(ctx context.Context, in *QueryPeriodicQueriesRequest, opts ...grpc.CallOption) (*QueryPeriodicQueriesResponse, error) {
+	out := new(QueryPeriodicQueriesResponse)
+	err := c.cc.Invoke(ctx, "/stride.interchainquery.v1.QueryService/PeriodicQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) DataPoint(ctx context.Context, in *QueryDataPointRequest, opts ...grpc.CallOption) (*QueryDataPointResponse, error) {
+	out := new(QueryDataPointResponse)
+	err := c.cc.Invoke(ctx, "/stride.interchainquery.v1.QueryService/DataPoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) FailedQueries(ctx context.Context, in *QueryFailedQueriesRequest, opts ...grpc.CallOption) (*QueryFailedQueriesResponse, error) {
+	out := new(QueryFailedQueriesResponse)
+	err := c.cc.Invoke(ctx, "/stride.interchainquery.v1.QueryService/FailedQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 type QueryServiceServer interface {
 	PendingQueries(context.Context, *QueryPendingQueriesRequest) (*QueryPendingQueriesResponse, error)
+	PeriodicQueries(context.Context, *QueryPeriodicQueriesRequest) (*QueryPeriodicQueriesResponse, error)
+	DataPoint(context.Context, *QueryDataPointRequest) (*QueryDataPointResponse, error)
+	FailedQueries(context.Context, *QueryFailedQueriesRequest) (*QueryFailedQueriesResponse, error)
 }
 
 // UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
@@ -186,6 +626,15 @@ type UnimplementedQueryServiceServer struct {
 func (*UnimplementedQueryServiceServer) PendingQueries(ctx context.Context, req *QueryPendingQueriesRequest) (*QueryPendingQueriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PendingQueries not implemented")
 }
+func (*UnimplementedQueryServiceServer) PeriodicQueries(ctx context.Context, req *QueryPeriodicQueriesRequest) (*QueryPeriodicQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeriodicQueries not implemented")
+}
+func (*UnimplementedQueryServiceServer) DataPoint(ctx context.Context, req *QueryDataPointRequest) (*QueryDataPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataPoint not implemented")
+}
+func (*UnimplementedQueryServiceServer) FailedQueries(ctx context.Context, req *QueryFailedQueriesRequest) (*QueryFailedQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedQueries not implemented")
+}
 
 func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
 	s.RegisterService(&_QueryService_serviceDesc, srv)
@@ -209,32 +658,98 @@ func _QueryService_PendingQueries_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-var _QueryService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "stride.interchainquery.v1.QueryService",
-	HandlerType: (*QueryServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PendingQueries",
-			Handler:    _QueryService_PendingQueries_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "stride/interchainquery/v1/query.proto",
-}
-
-func (m *QueryPendingQueriesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _QueryService_PeriodicQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPeriodicQueriesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServiceServer).PeriodicQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.interchainquery.v1.QueryService/PeriodicQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).PeriodicQueries(ctx, req.(*QueryPeriodicQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPendingQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _QueryService_DataPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).DataPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.interchainquery.v1.QueryService/DataPoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).DataPoint(ctx, req.(*QueryDataPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_FailedQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).FailedQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.interchainquery.v1.QueryService/FailedQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).FailedQueries(ctx, req.(*QueryFailedQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "stride.interchainquery.v1.QueryService",
+	HandlerType: (*QueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PendingQueries",
+			Handler:    _QueryService_PendingQueries_Handler,
+		},
+		{
+			MethodName: "PeriodicQueries",
+			Handler:    _QueryService_PeriodicQueries_Handler,
+		},
+		{
+			MethodName: "DataPoint",
+			Handler:    _QueryService_DataPoint_Handler,
+		},
+		{
+			MethodName: "FailedQueries",
+			Handler:    _QueryService_FailedQueries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stride/interchainquery/v1/query.proto",
+}
+
+func (m *QueryPendingQueriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
 func (m *QueryPendingQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
@@ -242,6 +757,44 @@ func (m *QueryPendingQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RequestSent != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RequestSent))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -265,6 +818,18 @@ func (m *QueryPendingQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.PendingQueries) > 0 {
 		for iNdEx := len(m.PendingQueries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -282,6 +847,234 @@ func (m *QueryPendingQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPeriodicQueriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPeriodicQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPeriodicQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPeriodicQueriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPeriodicQueriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPeriodicQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PeriodicQueries) > 0 {
+		for iNdEx := len(m.PeriodicQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodicQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataPointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataPointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataPointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueryId) > 0 {
+		i -= len(m.QueryId)
+		copy(dAtA[i:], m.QueryId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QueryId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataPointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataPointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataPointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DataPoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedQueriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedQueriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedQueriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FailedQueries) > 0 {
+		for iNdEx := len(m.FailedQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -297,33 +1090,768 @@ func (m *QueryPendingQueriesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RequestSent != 0 {
+		n += 1 + sovQuery(uint64(m.RequestSent))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingQueries) > 0 {
+		for _, e := range m.PendingQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPeriodicQueriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPeriodicQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PeriodicQueries) > 0 {
+		for _, e := range m.PeriodicQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDataPointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueryId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataPointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DataPoint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFailedQueriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedQueries) > 0 {
+		for _, e := range m.FailedQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPendingQueriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingQueriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestSent", wireType)
+			}
+			m.RequestSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestSent |= RequestSentFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingQueriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingQueriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingQueries = append(m.PendingQueries, Query{})
+			if err := m.PendingQueries[len(m.PendingQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPeriodicQueriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodicQueriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodicQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPeriodicQueriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodicQueriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodicQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodicQueries = append(m.PeriodicQueries, PeriodicQuery{})
+			if err := m.PeriodicQueries[len(m.PeriodicQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataPointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataPointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataPointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryPendingQueriesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.PendingQueries) > 0 {
-		for _, e := range m.PendingQueries {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryDataPointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataPointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataPointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataPoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DataPoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryPendingQueriesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFailedQueriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -346,12 +1874,144 @@ func (m *QueryPendingQueriesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingQueriesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFailedQueriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFailedQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -373,7 +2033,7 @@ func (m *QueryPendingQueriesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingQueriesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFailedQueriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -396,15 +2056,15 @@ func (m *QueryPendingQueriesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingQueriesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFailedQueriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFailedQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingQueries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedQueries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -431,8 +2091,44 @@ func (m *QueryPendingQueriesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingQueries = append(m.PendingQueries, Query{})
-			if err := m.PendingQueries[len(m.PendingQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FailedQueries = append(m.FailedQueries, FailedQuery{})
+			if err := m.FailedQueries[len(m.FailedQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
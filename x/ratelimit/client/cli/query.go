@@ -33,6 +33,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryRateLimit(),
 		GetCmdQueryAllRateLimits(),
 		GetCmdQueryRateLimitsByChainId(),
+		GetCmdQueryAggregateRateLimits(),
+		GetCmdQueryAllChannelGroups(),
 	)
 	return cmd
 }
@@ -150,3 +152,72 @@ func GetCmdQueryRateLimitsByChainId() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryAggregateRateLimits returns the aggregate rate limits (across every channel or
+// a channel group), optionally filtered by denom
+func GetCmdQueryAggregateRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-rate-limits",
+		Short: "Query the aggregate rate limits across every channel or a channel group",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the aggregate rate limits across every channel or a channel group.
+
+Example:
+  $ %s query %s aggregate-rate-limits
+  $ %s query %s aggregate-rate-limits --denom=[denom]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAggregateRateLimitsRequest{
+				Denom: denom,
+			}
+			res, err := queryClient.AggregateRateLimits(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The denom of the aggregate rate limits")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAllChannelGroups returns all channel groups
+func GetCmdQueryAllChannelGroups() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-channel-groups",
+		Short: "Query all channel groups",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAllChannelGroupsRequest{}
+			res, err := queryClient.AllChannelGroups(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -82,6 +82,11 @@ Where proposal.json contains:
     "max_percent_send": "10",
 	"max_percent_recv": "10",
 	"duration_hours": "24", 
+    "sliding_window": false,
+    "max_amount_send": "1000000",
+    "max_amount_recv": "1000000",
+    "flow_measurement": "FLOW_NET",
+    "address_quota": {"max_percent_send": "1", "max_percent_recv": "1"},
     "deposit": "10000000ustrd"
 }
 `, version.AppName)),
@@ -143,6 +148,11 @@ Where proposal.json contains:
     "max_percent_send": "10",
 	"max_percent_recv": "20",
 	"duration_hours": "24", 
+    "sliding_window": false,
+    "max_amount_send": "1000000",
+    "max_amount_recv": "1000000",
+    "flow_measurement": "FLOW_NET",
+    "address_quota": {"max_percent_send": "1", "max_percent_recv": "1"},
     "deposit": "10000000ustrd"
 }
 `, version.AppName)),
@@ -298,3 +308,348 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+// Blacklist a denom
+func CmdAddBlacklistedDenomProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-blacklisted-denom [proposal-file]",
+		Short: "Submit a add-blacklisted-denom proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a add-blacklisted-denom proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-legacy-proposal add-blacklisted-denom <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+    "title": "Blacklist Denom ...",
+    "description": "Proposal to block all IBC transfers of...",
+    "denom": "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+    "deposit": "10000000ustrd"
+}
+`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			proposalFile := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var proposal types.AddBlacklistedDenomProposal
+			if err := parseProposalFile(clientCtx.Codec, proposalFile, &proposal); err != nil {
+				return err
+			}
+
+			depositFromFlags, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			// if deposit from flags is not empty, it overrides the deposit from proposal
+			if depositFromFlags != "" {
+				proposal.Deposit = depositFromFlags
+			}
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, &proposal, deposit)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// Remove a denom from the blacklist
+func CmdRemoveBlacklistedDenomProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-blacklisted-denom [proposal-file]",
+		Short: "Submit a remove-blacklisted-denom proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a remove-blacklisted-denom proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-legacy-proposal remove-blacklisted-denom <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+    "title": "Remove Blacklisted Denom ...",
+    "description": "Proposal to re-enable IBC transfers of...",
+    "denom": "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+    "deposit": "10000000ustrd"
+}
+`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			proposalFile := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var proposal types.RemoveBlacklistedDenomProposal
+			if err := parseProposalFile(clientCtx.Codec, proposalFile, &proposal); err != nil {
+				return err
+			}
+
+			depositFromFlags, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			// if deposit from flags is not empty, it overrides the deposit from proposal
+			if depositFromFlags != "" {
+				proposal.Deposit = depositFromFlags
+			}
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, &proposal, deposit)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// Whitelist a sender/receiver address pair
+func CmdAddWhitelistedAddressPairProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-whitelisted-address-pair [proposal-file]",
+		Short: "Submit a add-whitelisted-address-pair proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a add-whitelisted-address-pair proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-legacy-proposal add-whitelisted-address-pair <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+    "title": "Whitelist Address Pair ...",
+    "description": "Proposal to exclude transfers between...",
+    "sender": "stride1...",
+    "receiver": "cosmos1...",
+    "deposit": "10000000ustrd"
+}
+`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			proposalFile := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var proposal types.AddWhitelistedAddressPairProposal
+			if err := parseProposalFile(clientCtx.Codec, proposalFile, &proposal); err != nil {
+				return err
+			}
+
+			depositFromFlags, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			// if deposit from flags is not empty, it overrides the deposit from proposal
+			if depositFromFlags != "" {
+				proposal.Deposit = depositFromFlags
+			}
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, &proposal, deposit)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// Remove a whitelisted sender/receiver address pair
+func CmdRemoveWhitelistedAddressPairProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-whitelisted-address-pair [proposal-file]",
+		Short: "Submit a remove-whitelisted-address-pair proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a remove-whitelisted-address-pair proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-legacy-proposal remove-whitelisted-address-pair <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+    "title": "Remove Whitelisted Address Pair ...",
+    "description": "Proposal to count the transfers between...",
+    "sender": "stride1...",
+    "receiver": "cosmos1...",
+    "deposit": "10000000ustrd"
+}
+`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			proposalFile := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var proposal types.RemoveWhitelistedAddressPairProposal
+			if err := parseProposalFile(clientCtx.Codec, proposalFile, &proposal); err != nil {
+				return err
+			}
+
+			depositFromFlags, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			// if deposit from flags is not empty, it overrides the deposit from proposal
+			if depositFromFlags != "" {
+				proposal.Deposit = depositFromFlags
+			}
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, &proposal, deposit)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// Create or update a named group of channels
+func CmdSetChannelGroupProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-channel-group [proposal-file]",
+		Short: "Submit a set-channel-group proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a set-channel-group proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-legacy-proposal set-channel-group <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+    "title": "Set Channel Group ...",
+    "description": "Proposal to group the channels to...",
+    "name": "osmosis",
+    "channel_ids": ["channel-5", "channel-326"],
+    "deposit": "10000000ustrd"
+}
+`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			proposalFile := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var proposal types.SetChannelGroupProposal
+			if err := parseProposalFile(clientCtx.Codec, proposalFile, &proposal); err != nil {
+				return err
+			}
+
+			depositFromFlags, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			// if deposit from flags is not empty, it overrides the deposit from proposal
+			if depositFromFlags != "" {
+				proposal.Deposit = depositFromFlags
+			}
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, &proposal, deposit)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+// Remove a channel group
+func CmdRemoveChannelGroupProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-channel-group [proposal-file]",
+		Short: "Submit a remove-channel-group proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a remove-channel-group proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-legacy-proposal remove-channel-group <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+    "title": "Remove Channel Group ...",
+    "description": "Proposal to remove the channel group...",
+    "name": "osmosis",
+    "deposit": "10000000ustrd"
+}
+`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			proposalFile := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var proposal types.RemoveChannelGroupProposal
+			if err := parseProposalFile(clientCtx.Codec, proposalFile, &proposal); err != nil {
+				return err
+			}
+
+			depositFromFlags, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			// if deposit from flags is not empty, it overrides the deposit from proposal
+			if depositFromFlags != "" {
+				proposal.Deposit = depositFromFlags
+			}
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(clientCtx, cmd, &proposal, deposit)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -7,8 +7,14 @@ import (
 )
 
 var (
-	AddRateLimitProposalHandler    = govclient.NewProposalHandler(cli.CmdAddRateLimitProposal)
-	UpdateRateLimitProposalHandler = govclient.NewProposalHandler(cli.CmdUpdateRateLimitProposal)
-	RemoveRateLimitProposalHandler = govclient.NewProposalHandler(cli.CmdRemoveRateLimitProposal)
-	ResetRateLimitProposalHandler  = govclient.NewProposalHandler(cli.CmdResetRateLimitProposal)
+	AddRateLimitProposalHandler                 = govclient.NewProposalHandler(cli.CmdAddRateLimitProposal)
+	UpdateRateLimitProposalHandler              = govclient.NewProposalHandler(cli.CmdUpdateRateLimitProposal)
+	RemoveRateLimitProposalHandler              = govclient.NewProposalHandler(cli.CmdRemoveRateLimitProposal)
+	ResetRateLimitProposalHandler               = govclient.NewProposalHandler(cli.CmdResetRateLimitProposal)
+	AddBlacklistedDenomProposalHandler          = govclient.NewProposalHandler(cli.CmdAddBlacklistedDenomProposal)
+	RemoveBlacklistedDenomProposalHandler       = govclient.NewProposalHandler(cli.CmdRemoveBlacklistedDenomProposal)
+	AddWhitelistedAddressPairProposalHandler    = govclient.NewProposalHandler(cli.CmdAddWhitelistedAddressPairProposal)
+	RemoveWhitelistedAddressPairProposalHandler = govclient.NewProposalHandler(cli.CmdRemoveWhitelistedAddressPairProposal)
+	SetChannelGroupProposalHandler              = govclient.NewProposalHandler(cli.CmdSetChannelGroupProposal)
+	RemoveChannelGroupProposalHandler           = govclient.NewProposalHandler(cli.CmdRemoveChannelGroupProposal)
 )
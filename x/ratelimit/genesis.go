@@ -14,6 +14,9 @@ import (
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
+	for _, channelGroup := range genState.ChannelGroups {
+		k.SetChannelGroup(ctx, channelGroup)
+	}
 	for _, rateLimit := range genState.RateLimits {
 		k.SetRateLimit(ctx, rateLimit)
 	}
@@ -23,6 +26,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, addressPair := range genState.WhitelistedAddressPairs {
 		k.SetWhitelistedAddressPair(ctx, addressPair)
 	}
+	for _, addressFlow := range genState.AddressFlows {
+		k.SetAddressFlow(ctx, addressFlow)
+	}
 	for _, pendingPacketId := range genState.PendingSendPacketSequenceNumbers {
 		splits := strings.Split(pendingPacketId, "/")
 		if len(splits) != 2 {
@@ -46,6 +52,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.BlacklistedDenoms = k.GetAllBlacklistedDenoms(ctx)
 	genesis.WhitelistedAddressPairs = k.GetAllWhitelistedAddressPairs(ctx)
 	genesis.PendingSendPacketSequenceNumbers = k.GetAllPendingSendPackets(ctx)
+	genesis.AddressFlows = k.GetAllAddressFlows(ctx)
+	genesis.ChannelGroups = k.GetAllChannelGroups(ctx)
 
 	return genesis
 }
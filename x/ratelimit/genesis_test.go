@@ -33,6 +33,12 @@ func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params:     types.Params{},
 		RateLimits: createRateLimits(),
+		AddressFlows: []types.AddressFlow{
+			{Denom: "denom-1", ChannelId: "channel-1", Address: "address", Inflow: sdkmath.NewInt(1), Outflow: sdkmath.NewInt(2)},
+		},
+		ChannelGroups: []types.ChannelGroup{
+			{Name: "osmosis", ChannelIds: []string{"channel-1", "channel-2"}},
+		},
 	}
 
 	s := apptesting.SetupSuitelessTestHelper()
@@ -44,4 +50,6 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(got)
 
 	require.Equal(t, genesisState.RateLimits, got.RateLimits)
+	require.Equal(t, genesisState.AddressFlows, got.AddressFlows)
+	require.Equal(t, genesisState.ChannelGroups, got.ChannelGroups)
 }
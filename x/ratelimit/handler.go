@@ -40,6 +40,18 @@ func NewRateLimitProposalHandler(k keeper.Keeper, channelKeeper channelkeeper.Ke
 			return handleRemoveRateLimitProposal(ctx, k, c)
 		case *types.ResetRateLimitProposal:
 			return handleResetRateLimitProposal(ctx, k, c)
+		case *types.AddBlacklistedDenomProposal:
+			return handleAddBlacklistedDenomProposal(ctx, k, c)
+		case *types.RemoveBlacklistedDenomProposal:
+			return handleRemoveBlacklistedDenomProposal(ctx, k, c)
+		case *types.AddWhitelistedAddressPairProposal:
+			return handleAddWhitelistedAddressPairProposal(ctx, k, c)
+		case *types.RemoveWhitelistedAddressPairProposal:
+			return handleRemoveWhitelistedAddressPairProposal(ctx, k, c)
+		case *types.SetChannelGroupProposal:
+			return handleSetChannelGroupProposal(ctx, k, channelKeeper, c)
+		case *types.RemoveChannelGroupProposal:
+			return handleRemoveChannelGroupProposal(ctx, k, c)
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ratelimit proposal content type: %T", c)
 		}
@@ -65,3 +77,33 @@ func handleRemoveRateLimitProposal(ctx sdk.Context, k keeper.Keeper, proposal *t
 func handleResetRateLimitProposal(ctx sdk.Context, k keeper.Keeper, proposal *types.ResetRateLimitProposal) error {
 	return gov.ResetRateLimit(ctx, k, proposal)
 }
+
+// Handler for blacklisting a denom through governance
+func handleAddBlacklistedDenomProposal(ctx sdk.Context, k keeper.Keeper, proposal *types.AddBlacklistedDenomProposal) error {
+	return gov.AddBlacklistedDenom(ctx, k, proposal)
+}
+
+// Handler for removing a denom from the blacklist through governance
+func handleRemoveBlacklistedDenomProposal(ctx sdk.Context, k keeper.Keeper, proposal *types.RemoveBlacklistedDenomProposal) error {
+	return gov.RemoveBlacklistedDenom(ctx, k, proposal)
+}
+
+// Handler for whitelisting an address pair through governance
+func handleAddWhitelistedAddressPairProposal(ctx sdk.Context, k keeper.Keeper, proposal *types.AddWhitelistedAddressPairProposal) error {
+	return gov.AddWhitelistedAddressPair(ctx, k, proposal)
+}
+
+// Handler for removing a whitelisted address pair through governance
+func handleRemoveWhitelistedAddressPairProposal(ctx sdk.Context, k keeper.Keeper, proposal *types.RemoveWhitelistedAddressPairProposal) error {
+	return gov.RemoveWhitelistedAddressPair(ctx, k, proposal)
+}
+
+// Handler for creating or updating a channel group through governance
+func handleSetChannelGroupProposal(ctx sdk.Context, k keeper.Keeper, channelKeeper channelkeeper.Keeper, proposal *types.SetChannelGroupProposal) error {
+	return gov.SetChannelGroup(ctx, k, channelKeeper, proposal)
+}
+
+// Handler for removing a channel group through governance
+func handleRemoveChannelGroupProposal(ctx sdk.Context, k keeper.Keeper, proposal *types.RemoveChannelGroupProposal) error {
+	return gov.RemoveChannelGroup(ctx, k, proposal)
+}
@@ -1,6 +1,7 @@
 package gov
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
@@ -11,10 +12,22 @@ import (
 )
 
 // Adds a new rate limit. Fails if the rate limit already exists or the channel value is 0
+// (unless the quota has an absolute cap, which can be enforced without a channel value)
 func AddRateLimit(ctx sdk.Context, k keeper.Keeper, channelKeeper channelkeeper.Keeper, p *types.AddRateLimitProposal) error {
+	quota := types.Quota{
+		MaxPercentSend:  p.MaxPercentSend,
+		MaxPercentRecv:  p.MaxPercentRecv,
+		DurationHours:   p.DurationHours,
+		SlidingWindow:   p.SlidingWindow,
+		MaxAmountSend:   amountOrZero(p.MaxAmountSend),
+		MaxAmountRecv:   amountOrZero(p.MaxAmountRecv),
+		FlowMeasurement: p.FlowMeasurement,
+		AddressQuota:    p.AddressQuota,
+	}
+
 	// Confirm the channel value is not zero
 	channelValue := k.GetChannelValue(ctx, p.Denom)
-	if channelValue.IsZero() {
+	if channelValue.IsZero() && !quota.HasAmountCap() {
 		return types.ErrZeroChannelValue
 	}
 
@@ -24,10 +37,15 @@ func AddRateLimit(ctx sdk.Context, k keeper.Keeper, channelKeeper channelkeeper.
 		return types.ErrRateLimitAlreadyExists
 	}
 
-	// Confirm the channel exists
-	_, found = channelKeeper.GetChannel(ctx, transfertypes.PortID, p.ChannelId)
-	if !found {
-		return types.ErrChannelNotFound
+	// Confirm the channel exists (or, for an aggregate rate limit on a channel group, that the group exists)
+	if groupName, isGroup := types.ParseChannelGroupChannelId(p.ChannelId); isGroup {
+		if _, found := k.GetChannelGroup(ctx, groupName); !found {
+			return types.ErrChannelGroupNotFound
+		}
+	} else if p.ChannelId != types.AnyChannelId {
+		if _, found := channelKeeper.GetChannel(ctx, transfertypes.PortID, p.ChannelId); !found {
+			return types.ErrChannelNotFound
+		}
 	}
 
 	// Create and store the rate limit object
@@ -35,11 +53,6 @@ func AddRateLimit(ctx sdk.Context, k keeper.Keeper, channelKeeper channelkeeper.
 		Denom:     p.Denom,
 		ChannelId: p.ChannelId,
 	}
-	quota := types.Quota{
-		MaxPercentSend: p.MaxPercentSend,
-		MaxPercentRecv: p.MaxPercentRecv,
-		DurationHours:  p.DurationHours,
-	}
 	flow := types.Flow{
 		Inflow:       sdkmath.ZeroInt(),
 		Outflow:      sdkmath.ZeroInt(),
@@ -51,6 +64,7 @@ func AddRateLimit(ctx sdk.Context, k keeper.Keeper, channelKeeper channelkeeper.
 		Quota: &quota,
 		Flow:  &flow,
 	})
+	k.StartNewRateLimitWindow(ctx, p.Denom, p.ChannelId)
 
 	return nil
 }
@@ -70,9 +84,14 @@ func UpdateRateLimit(ctx sdk.Context, k keeper.Keeper, p *types.UpdateRateLimitP
 		ChannelId: p.ChannelId,
 	}
 	quota := types.Quota{
-		MaxPercentSend: p.MaxPercentSend,
-		MaxPercentRecv: p.MaxPercentRecv,
-		DurationHours:  p.DurationHours,
+		MaxPercentSend:  p.MaxPercentSend,
+		MaxPercentRecv:  p.MaxPercentRecv,
+		DurationHours:   p.DurationHours,
+		SlidingWindow:   p.SlidingWindow,
+		MaxAmountSend:   amountOrZero(p.MaxAmountSend),
+		MaxAmountRecv:   amountOrZero(p.MaxAmountRecv),
+		FlowMeasurement: p.FlowMeasurement,
+		AddressQuota:    p.AddressQuota,
 	}
 	flow := types.Flow{
 		Inflow:       sdkmath.ZeroInt(),
@@ -85,6 +104,8 @@ func UpdateRateLimit(ctx sdk.Context, k keeper.Keeper, p *types.UpdateRateLimitP
 		Quota: &quota,
 		Flow:  &flow,
 	})
+	k.RemoveAllAddressFlows(ctx, p.Denom, p.ChannelId)
+	k.StartNewRateLimitWindow(ctx, p.Denom, p.ChannelId)
 
 	return nil
 }
@@ -97,6 +118,7 @@ func RemoveRateLimit(ctx sdk.Context, k keeper.Keeper, msg *types.RemoveRateLimi
 	}
 
 	k.RemoveRateLimit(ctx, msg.Denom, msg.ChannelId)
+	k.RemoveAllAddressFlows(ctx, msg.Denom, msg.ChannelId)
 	return nil
 }
 
@@ -104,3 +126,145 @@ func RemoveRateLimit(ctx sdk.Context, k keeper.Keeper, msg *types.RemoveRateLimi
 func ResetRateLimit(ctx sdk.Context, k keeper.Keeper, msg *types.ResetRateLimitProposal) error {
 	return k.ResetRateLimit(ctx, msg.Denom, msg.ChannelId)
 }
+
+// Blacklists a denom so that all IBC transfers of the denom are rejected. Fails if the denom is already blacklisted
+func AddBlacklistedDenom(ctx sdk.Context, k keeper.Keeper, p *types.AddBlacklistedDenomProposal) error {
+	if k.IsDenomBlacklisted(ctx, p.Denom) {
+		return types.ErrDenomAlreadyBlacklisted
+	}
+
+	k.AddDenomToBlacklist(ctx, p.Denom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventAddBlacklistedDenom,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyDenom, p.Denom),
+		),
+	)
+
+	return nil
+}
+
+// Removes a denom from the blacklist to re-enable IBC transfers. Fails if the denom is not blacklisted
+func RemoveBlacklistedDenom(ctx sdk.Context, k keeper.Keeper, p *types.RemoveBlacklistedDenomProposal) error {
+	if !k.IsDenomBlacklisted(ctx, p.Denom) {
+		return types.ErrDenomNotBlacklisted
+	}
+
+	k.RemoveDenomFromBlacklist(ctx, p.Denom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventRemoveBlacklistedDenom,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyDenom, p.Denom),
+		),
+	)
+
+	return nil
+}
+
+// Whitelists a sender/receiver address pair so that transfers between them skip the rate limit
+// Fails if the pair is already whitelisted
+func AddWhitelistedAddressPair(ctx sdk.Context, k keeper.Keeper, p *types.AddWhitelistedAddressPairProposal) error {
+	if k.IsAddressPairWhitelisted(ctx, p.Sender, p.Receiver) {
+		return types.ErrAddressPairAlreadyWhitelisted
+	}
+
+	k.SetWhitelistedAddressPair(ctx, types.WhitelistedAddressPair{
+		Sender:   p.Sender,
+		Receiver: p.Receiver,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventAddWhitelistedAddressPair,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, p.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, p.Receiver),
+		),
+	)
+
+	return nil
+}
+
+// Removes a whitelisted address pair so that its transfers are counted in the quota
+// Fails if the pair is not whitelisted
+func RemoveWhitelistedAddressPair(ctx sdk.Context, k keeper.Keeper, p *types.RemoveWhitelistedAddressPairProposal) error {
+	if !k.IsAddressPairWhitelisted(ctx, p.Sender, p.Receiver) {
+		return types.ErrAddressPairNotWhitelisted
+	}
+
+	k.RemoveWhitelistedAddressPair(ctx, p.Sender, p.Receiver)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventRemoveWhitelistedAddressPair,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeySender, p.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, p.Receiver),
+		),
+	)
+
+	return nil
+}
+
+// Creates or updates a channel group, which can be used for an aggregate rate limit across the group's channels
+// Fails if any of the channels do not exist
+func SetChannelGroup(ctx sdk.Context, k keeper.Keeper, channelKeeper channelkeeper.Keeper, p *types.SetChannelGroupProposal) error {
+	for _, channelId := range p.ChannelIds {
+		if _, found := channelKeeper.GetChannel(ctx, transfertypes.PortID, channelId); !found {
+			return errorsmod.Wrapf(types.ErrChannelNotFound, "channel %s", channelId)
+		}
+	}
+
+	k.SetChannelGroup(ctx, types.ChannelGroup{
+		Name:       p.Name,
+		ChannelIds: p.ChannelIds,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventSetChannelGroup,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyName, p.Name),
+		),
+	)
+
+	return nil
+}
+
+// Removes a channel group. Fails if the group doesn't exist or if it still has rate limits
+func RemoveChannelGroup(ctx sdk.Context, k keeper.Keeper, p *types.RemoveChannelGroupProposal) error {
+	if _, found := k.GetChannelGroup(ctx, p.Name); !found {
+		return types.ErrChannelGroupNotFound
+	}
+
+	groupChannelId := types.GetChannelGroupChannelId(p.Name)
+	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+		if rateLimit.Path.ChannelId == groupChannelId {
+			return errorsmod.Wrapf(types.ErrChannelGroupInUse, "rate limit on %s must be removed first", rateLimit.Path.Denom)
+		}
+	}
+
+	k.RemoveChannelGroup(ctx, p.Name)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventRemoveChannelGroup,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyName, p.Name),
+		),
+	)
+
+	return nil
+}
+
+// Returns the optional amount from a proposal, or zero if it wasn't provided
+func amountOrZero(amount *sdkmath.Int) sdkmath.Int {
+	if amount == nil {
+		return sdkmath.ZeroInt()
+	}
+	return *amount
+}
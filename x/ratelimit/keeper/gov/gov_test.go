@@ -60,6 +60,39 @@ var (
 		Denom:     "denom",
 		ChannelId: "channel-0",
 	}
+
+	addBlacklistedDenomMsg = types.AddBlacklistedDenomProposal{
+		Title: "AddBlacklistedDenom",
+		Denom: "denom",
+	}
+
+	removeBlacklistedDenomMsg = types.RemoveBlacklistedDenomProposal{
+		Title: "RemoveBlacklistedDenom",
+		Denom: "denom",
+	}
+
+	addWhitelistedAddressPairMsg = types.AddWhitelistedAddressPairProposal{
+		Title:    "AddWhitelistedAddressPair",
+		Sender:   "sender",
+		Receiver: "receiver",
+	}
+
+	removeWhitelistedAddressPairMsg = types.RemoveWhitelistedAddressPairProposal{
+		Title:    "RemoveWhitelistedAddressPair",
+		Sender:   "sender",
+		Receiver: "receiver",
+	}
+
+	setChannelGroupMsg = types.SetChannelGroupProposal{
+		Title:      "SetChannelGroup",
+		Name:       "osmosis",
+		ChannelIds: []string{"channel-0", "channel-1"},
+	}
+
+	removeChannelGroupMsg = types.RemoveChannelGroupProposal{
+		Title: "RemoveChannelGroup",
+		Name:  "osmosis",
+	}
 )
 
 // Helper function to create a channel and prevent a channel not exists error
@@ -73,6 +106,21 @@ func (s *KeeperTestSuite) createChannelValue(denom string, channelValue sdkmath.
 	s.Require().NoError(err)
 }
 
+// Helper function to confirm an event was emitted with the given attribute value
+func (s *KeeperTestSuite) checkEventValueEmitted(eventType, attributeKey, expectedValue string) {
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if attribute.Key == attributeKey && attribute.Value == expectedValue {
+				return
+			}
+		}
+	}
+	s.Fail("event not emitted", "%s event with %s=%s", eventType, attributeKey, expectedValue)
+}
+
 // Helper function to add a rate limit with an optional error expectation
 func (s *KeeperTestSuite) addRateLimit(expectedErr *errorsmod.Error) {
 	actualErr := gov.AddRateLimit(s.Ctx, s.App.RatelimitKeeper, s.App.IBCKeeper.ChannelKeeper, &addRateLimitMsg)
@@ -151,9 +199,43 @@ func (s *KeeperTestSuite) TestMsgServer_UpdateRateLimit() {
 		MaxPercentSend: updateRateLimitMsg.MaxPercentSend,
 		MaxPercentRecv: updateRateLimitMsg.MaxPercentRecv,
 		DurationHours:  updateRateLimitMsg.DurationHours,
+		MaxAmountSend:  sdkmath.ZeroInt(),
+		MaxAmountRecv:  sdkmath.ZeroInt(),
 	})
 }
 
+func (s *KeeperTestSuite) TestMsgServer_AddRateLimit_AbsoluteCap() {
+	channelId := addRateLimitMsg.ChannelId
+	s.createChannel(channelId)
+
+	// Add a rate limit on a denom with no supply, using only absolute caps
+	maxAmountSend := sdkmath.NewInt(1000)
+	maxAmountRecv := sdkmath.NewInt(2000)
+	proposal := addRateLimitMsg
+	proposal.Denom = "new-denom"
+	proposal.MaxPercentSend = sdkmath.ZeroInt()
+	proposal.MaxPercentRecv = sdkmath.ZeroInt()
+	proposal.MaxAmountSend = &maxAmountSend
+	proposal.MaxAmountRecv = &maxAmountRecv
+	proposal.FlowMeasurement = types.FLOW_GROSS
+
+	// The zero channel value should not prevent the rate limit from being added
+	err := gov.AddRateLimit(s.Ctx, s.App.RatelimitKeeper, s.App.IBCKeeper.ChannelKeeper, &proposal)
+	s.Require().NoError(err)
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, proposal.Denom, channelId)
+	s.Require().True(found)
+	s.Require().Equal(&types.Quota{
+		MaxPercentSend:  sdkmath.ZeroInt(),
+		MaxPercentRecv:  sdkmath.ZeroInt(),
+		DurationHours:   proposal.DurationHours,
+		MaxAmountSend:   maxAmountSend,
+		MaxAmountRecv:   maxAmountRecv,
+		FlowMeasurement: types.FLOW_GROSS,
+	}, rateLimit.Quota)
+	s.Require().True(rateLimit.Flow.ChannelValue.IsZero())
+}
+
 func (s *KeeperTestSuite) TestMsgServer_RemoveRateLimit() {
 	denom := removeRateLimitMsg.Denom
 	channelId := removeRateLimitMsg.ChannelId
@@ -206,3 +288,173 @@ func (s *KeeperTestSuite) TestMsgServer_ResetRateLimit() {
 		ChannelValue: channelValue,
 	})
 }
+
+func (s *KeeperTestSuite) TestMsgServer_AddBlacklistedDenom() {
+	denom := addBlacklistedDenomMsg.Denom
+
+	// Blacklist the denom successfully
+	err := gov.AddBlacklistedDenom(s.Ctx, s.App.RatelimitKeeper, &addBlacklistedDenomMsg)
+	s.Require().NoError(err)
+	s.Require().True(s.App.RatelimitKeeper.IsDenomBlacklisted(s.Ctx, denom))
+
+	// Confirm an event was emitted
+	s.checkEventValueEmitted(types.EventAddBlacklistedDenom, types.AttributeKeyDenom, denom)
+
+	// Attempt to blacklist the same denom again
+	err = gov.AddBlacklistedDenom(s.Ctx, s.App.RatelimitKeeper, &addBlacklistedDenomMsg)
+	s.Require().Equal(err, types.ErrDenomAlreadyBlacklisted)
+}
+
+func (s *KeeperTestSuite) TestMsgServer_RemoveBlacklistedDenom() {
+	denom := removeBlacklistedDenomMsg.Denom
+
+	// Attempt to remove a denom that's not blacklisted
+	err := gov.RemoveBlacklistedDenom(s.Ctx, s.App.RatelimitKeeper, &removeBlacklistedDenomMsg)
+	s.Require().Equal(err, types.ErrDenomNotBlacklisted)
+
+	// Blacklist the denom and then remove it successfully
+	s.App.RatelimitKeeper.AddDenomToBlacklist(s.Ctx, denom)
+
+	err = gov.RemoveBlacklistedDenom(s.Ctx, s.App.RatelimitKeeper, &removeBlacklistedDenomMsg)
+	s.Require().NoError(err)
+	s.Require().False(s.App.RatelimitKeeper.IsDenomBlacklisted(s.Ctx, denom))
+
+	// Confirm an event was emitted
+	s.checkEventValueEmitted(types.EventRemoveBlacklistedDenom, types.AttributeKeyDenom, denom)
+}
+
+func (s *KeeperTestSuite) TestMsgServer_AddWhitelistedAddressPair() {
+	sender := addWhitelistedAddressPairMsg.Sender
+	receiver := addWhitelistedAddressPairMsg.Receiver
+
+	// Whitelist the pair successfully
+	err := gov.AddWhitelistedAddressPair(s.Ctx, s.App.RatelimitKeeper, &addWhitelistedAddressPairMsg)
+	s.Require().NoError(err)
+	s.Require().True(s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, sender, receiver))
+
+	// The reverse direction should not be whitelisted
+	s.Require().False(s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, receiver, sender))
+
+	// Confirm an event was emitted
+	s.checkEventValueEmitted(types.EventAddWhitelistedAddressPair, types.AttributeKeySender, sender)
+	s.checkEventValueEmitted(types.EventAddWhitelistedAddressPair, types.AttributeKeyReceiver, receiver)
+
+	// Attempt to whitelist the same pair again
+	err = gov.AddWhitelistedAddressPair(s.Ctx, s.App.RatelimitKeeper, &addWhitelistedAddressPairMsg)
+	s.Require().Equal(err, types.ErrAddressPairAlreadyWhitelisted)
+}
+
+func (s *KeeperTestSuite) TestMsgServer_RemoveWhitelistedAddressPair() {
+	sender := removeWhitelistedAddressPairMsg.Sender
+	receiver := removeWhitelistedAddressPairMsg.Receiver
+
+	// Attempt to remove a pair that's not whitelisted
+	err := gov.RemoveWhitelistedAddressPair(s.Ctx, s.App.RatelimitKeeper, &removeWhitelistedAddressPairMsg)
+	s.Require().Equal(err, types.ErrAddressPairNotWhitelisted)
+
+	// Whitelist the pair and then remove it successfully
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{
+		Sender:   sender,
+		Receiver: receiver,
+	})
+
+	err = gov.RemoveWhitelistedAddressPair(s.Ctx, s.App.RatelimitKeeper, &removeWhitelistedAddressPairMsg)
+	s.Require().NoError(err)
+	s.Require().False(s.App.RatelimitKeeper.IsAddressPairWhitelisted(s.Ctx, sender, receiver))
+
+	// Confirm an event was emitted
+	s.checkEventValueEmitted(types.EventRemoveWhitelistedAddressPair, types.AttributeKeySender, sender)
+}
+
+func (s *KeeperTestSuite) TestMsgServer_AddRateLimit_Aggregate() {
+	s.createChannelValue(addRateLimitMsg.Denom, sdkmath.NewInt(100))
+
+	// A rate limit across all channels does not require any channel to exist
+	allChannelsProposal := addRateLimitMsg
+	allChannelsProposal.ChannelId = types.AnyChannelId
+
+	err := gov.AddRateLimit(s.Ctx, s.App.RatelimitKeeper, s.App.IBCKeeper.ChannelKeeper, &allChannelsProposal)
+	s.Require().NoError(err)
+
+	_, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, allChannelsProposal.Denom, types.AnyChannelId)
+	s.Require().True(found)
+
+	// A rate limit on a channel group requires the group to exist
+	groupProposal := addRateLimitMsg
+	groupProposal.ChannelId = types.GetChannelGroupChannelId(setChannelGroupMsg.Name)
+
+	err = gov.AddRateLimit(s.Ctx, s.App.RatelimitKeeper, s.App.IBCKeeper.ChannelKeeper, &groupProposal)
+	s.Require().Equal(err, types.ErrChannelGroupNotFound)
+
+	s.App.RatelimitKeeper.SetChannelGroup(s.Ctx, types.ChannelGroup{
+		Name:       setChannelGroupMsg.Name,
+		ChannelIds: setChannelGroupMsg.ChannelIds,
+	})
+
+	err = gov.AddRateLimit(s.Ctx, s.App.RatelimitKeeper, s.App.IBCKeeper.ChannelKeeper, &groupProposal)
+	s.Require().NoError(err)
+
+	_, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, groupProposal.Denom, groupProposal.ChannelId)
+	s.Require().True(found)
+}
+
+func (s *KeeperTestSuite) TestMsgServer_SetChannelGroup() {
+	// Attempt to set the group before the channels have been created
+	err := gov.SetChannelGroup(s.Ctx, s.App.RatelimitKeeper, s.App.IBCKeeper.ChannelKeeper, &setChannelGroupMsg)
+	s.Require().ErrorIs(err, types.ErrChannelNotFound)
+
+	// Create the channels and then set the group successfully
+	for _, channelId := range setChannelGroupMsg.ChannelIds {
+		s.createChannel(channelId)
+	}
+
+	err = gov.SetChannelGroup(s.Ctx, s.App.RatelimitKeeper, s.App.IBCKeeper.ChannelKeeper, &setChannelGroupMsg)
+	s.Require().NoError(err)
+
+	channelGroup, found := s.App.RatelimitKeeper.GetChannelGroup(s.Ctx, setChannelGroupMsg.Name)
+	s.Require().True(found)
+	s.Require().Equal(setChannelGroupMsg.ChannelIds, channelGroup.ChannelIds)
+
+	// Confirm an event was emitted
+	s.checkEventValueEmitted(types.EventSetChannelGroup, types.AttributeKeyName, setChannelGroupMsg.Name)
+
+	// Setting the group again should overwrite the channels
+	updatedProposal := setChannelGroupMsg
+	updatedProposal.ChannelIds = []string{"channel-0"}
+
+	err = gov.SetChannelGroup(s.Ctx, s.App.RatelimitKeeper, s.App.IBCKeeper.ChannelKeeper, &updatedProposal)
+	s.Require().NoError(err)
+
+	channelGroup, found = s.App.RatelimitKeeper.GetChannelGroup(s.Ctx, setChannelGroupMsg.Name)
+	s.Require().True(found)
+	s.Require().Equal([]string{"channel-0"}, channelGroup.ChannelIds)
+}
+
+func (s *KeeperTestSuite) TestMsgServer_RemoveChannelGroup() {
+	name := removeChannelGroupMsg.Name
+
+	// Attempt to remove a group that does not exist
+	err := gov.RemoveChannelGroup(s.Ctx, s.App.RatelimitKeeper, &removeChannelGroupMsg)
+	s.Require().Equal(err, types.ErrChannelGroupNotFound)
+
+	// Create the group with a rate limit - it should not be removable while the rate limit exists
+	s.App.RatelimitKeeper.SetChannelGroup(s.Ctx, types.ChannelGroup{Name: name, ChannelIds: []string{"channel-0"}})
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+		Path: &types.Path{Denom: "denom", ChannelId: types.GetChannelGroupChannelId(name)},
+	})
+
+	err = gov.RemoveChannelGroup(s.Ctx, s.App.RatelimitKeeper, &removeChannelGroupMsg)
+	s.Require().ErrorIs(err, types.ErrChannelGroupInUse)
+
+	// Remove the rate limit, and then the group can be removed successfully
+	s.App.RatelimitKeeper.RemoveRateLimit(s.Ctx, "denom", types.GetChannelGroupChannelId(name))
+
+	err = gov.RemoveChannelGroup(s.Ctx, s.App.RatelimitKeeper, &removeChannelGroupMsg)
+	s.Require().NoError(err)
+
+	_, found := s.App.RatelimitKeeper.GetChannelGroup(s.Ctx, name)
+	s.Require().False(found)
+
+	// Confirm an event was emitted
+	s.checkEventValueEmitted(types.EventRemoveChannelGroup, types.AttributeKeyName, name)
+}
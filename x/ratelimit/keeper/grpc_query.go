@@ -37,6 +37,10 @@ func (k Keeper) RateLimitsByChainId(c context.Context, req *types.QueryRateLimit
 
 	rateLimits := []types.RateLimit{}
 	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+		// Aggregate rate limits span multiple channels, and are not specific to a chain
+		if types.IsAggregateChannelId(rateLimit.Path.ChannelId) {
+			continue
+		}
 
 		// Determine the client state from the channel Id
 		_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, transfertypes.PortID, rateLimit.Path.ChannelId)
@@ -72,6 +76,31 @@ func (k Keeper) RateLimitsByChannelId(c context.Context, req *types.QueryRateLim
 	return &types.QueryRateLimitsByChannelIdResponse{RateLimits: rateLimits}, nil
 }
 
+// Query the aggregate rate limits for a given denom (across every channel or a channel group),
+// or the aggregate rate limits for all denoms if no denom is provided
+func (k Keeper) AggregateRateLimits(c context.Context, req *types.QueryAggregateRateLimitsRequest) (*types.QueryAggregateRateLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimits := []types.RateLimit{}
+	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+		if !types.IsAggregateChannelId(rateLimit.Path.ChannelId) {
+			continue
+		}
+		if req.Denom == "" || rateLimit.Path.Denom == req.Denom {
+			rateLimits = append(rateLimits, rateLimit)
+		}
+	}
+
+	return &types.QueryAggregateRateLimitsResponse{RateLimits: rateLimits}, nil
+}
+
+// Query all channel groups
+func (k Keeper) AllChannelGroups(c context.Context, req *types.QueryAllChannelGroupsRequest) (*types.QueryAllChannelGroupsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	channelGroups := k.GetAllChannelGroups(ctx)
+	return &types.QueryAllChannelGroupsResponse{ChannelGroups: channelGroups}, nil
+}
+
 // Query all blacklisted denoms
 func (k Keeper) AllBlacklistedDenoms(c context.Context, req *types.QueryAllBlacklistedDenomsRequest) (*types.QueryAllBlacklistedDenomsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
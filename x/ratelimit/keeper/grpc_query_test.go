@@ -118,3 +118,47 @@ func (s *KeeperTestSuite) TestQueryAllWhitelistedAddresses() {
 	}
 	s.Require().Equal(expectedWhitelist, queryResponse.AddressPairs)
 }
+
+func (s *KeeperTestSuite) TestQueryAggregateRateLimits() {
+	individualRateLimits := s.setupQueryRateLimitTests()
+
+	aggregateRateLimits := []types.RateLimit{
+		{Path: &types.Path{Denom: "denom", ChannelId: types.AnyChannelId}},
+		{Path: &types.Path{Denom: "denom", ChannelId: "group/osmosis"}},
+		{Path: &types.Path{Denom: "other-denom", ChannelId: types.AnyChannelId}},
+	}
+	for _, rateLimit := range aggregateRateLimits {
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, rateLimit)
+	}
+
+	// Without a denom, all aggregate rate limits should be returned
+	queryResponse, err := s.QueryClient.AggregateRateLimits(context.Background(), &types.QueryAggregateRateLimitsRequest{})
+	s.Require().NoError(err, "no error expected when querying all aggregate rate limits")
+	s.Require().ElementsMatch(aggregateRateLimits, queryResponse.RateLimits)
+
+	// With a denom, only the aggregate rate limits of that denom should be returned
+	queryResponse, err = s.QueryClient.AggregateRateLimits(context.Background(), &types.QueryAggregateRateLimitsRequest{Denom: "denom"})
+	s.Require().NoError(err, "no error expected when querying aggregate rate limits by denom")
+	s.Require().ElementsMatch(aggregateRateLimits[:2], queryResponse.RateLimits)
+
+	// The aggregate rate limits should not be returned when querying by chain
+	chainQueryResponse, err := s.QueryClient.RateLimitsByChainId(context.Background(), &types.QueryRateLimitsByChainIdRequest{
+		ChainId: "chain-0",
+	})
+	s.Require().NoError(err, "no error expected when querying rate limits by chain")
+	s.Require().Equal([]types.RateLimit{individualRateLimits[0]}, chainQueryResponse.RateLimits)
+}
+
+func (s *KeeperTestSuite) TestQueryAllChannelGroups() {
+	channelGroups := []types.ChannelGroup{
+		{Name: "group-A", ChannelIds: []string{"channel-0"}},
+		{Name: "group-B", ChannelIds: []string{"channel-1", "channel-2"}},
+	}
+	for _, channelGroup := range channelGroups {
+		s.App.RatelimitKeeper.SetChannelGroup(s.Ctx, channelGroup)
+	}
+
+	queryResponse, err := s.QueryClient.AllChannelGroups(context.Background(), &types.QueryAllChannelGroupsRequest{})
+	s.Require().NoError(err, "no error expected when querying channel groups")
+	s.Require().Equal(channelGroups, queryResponse.ChannelGroups)
+}
@@ -10,11 +10,17 @@ import (
 
 // Before each hour epoch, check if any of the rate limits have expired,
 //  and reset them if they have
+// Sliding window rate limits are never reset, and instead drop the flow from the hour that left the window
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
 	if epochInfo.Identifier == epochstypes.HOUR_EPOCH {
 		epochHour := uint64(epochInfo.CurrentEpoch)
 
 		for _, rateLimit := range k.GetAllRateLimits(ctx) {
+			if rateLimit.Quota.SlidingWindow {
+				k.AdvanceSlidingWindow(ctx, rateLimit, epochHour)
+				continue
+			}
+
 			if epochHour%rateLimit.Quota.DurationHours == 0 {
 				err := k.ResetRateLimit(ctx, rateLimit.Path.Denom, rateLimit.Path.ChannelId)
 				if err != nil {
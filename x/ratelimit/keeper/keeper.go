@@ -21,6 +21,7 @@ type (
 
 		bankKeeper    types.BankKeeper
 		channelKeeper types.ChannelKeeper
+		epochsKeeper  types.EpochsKeeper
 		ics4Wrapper   types.ICS4Wrapper
 	}
 )
@@ -31,6 +32,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	bankKeeper types.BankKeeper,
 	channelKeeper types.ChannelKeeper,
+	epochsKeeper types.EpochsKeeper,
 	ics4Wrapper types.ICS4Wrapper,
 ) *Keeper {
 	return &Keeper{
@@ -39,6 +41,7 @@ func NewKeeper(
 		paramstore:    ps,
 		bankKeeper:    bankKeeper,
 		channelKeeper: channelKeeper,
+		epochsKeeper:  epochsKeeper,
 		ics4Wrapper:   ics4Wrapper,
 	}
 }
@@ -172,8 +172,10 @@ func (k Keeper) SendRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packe
 
 	// Store the sequence number of the packet so that if the transfer fails,
 	// we can identify if it was sent during this quota and can revert the outflow
+	// The window of each rate limit is stored as well, since the rate limits can reset at different times
 	if updatedFlow {
 		k.SetPendingSendPacket(ctx, packetInfo.ChannelID, packet.Sequence)
+		k.SetPendingSendPacketWindows(ctx, packetInfo.ChannelID, packet.Sequence, packetInfo.Denom)
 	}
 
 	return nil
@@ -214,7 +216,7 @@ func (k Keeper) AcknowledgeRateLimitedPacket(ctx sdk.Context, packet channeltype
 	}
 
 	// If the ack failed, undo the change to the rate limit Outflow
-	return k.UndoSendPacket(ctx, packetInfo.ChannelID, packet.Sequence, packetInfo.Denom, packetInfo.Amount)
+	return k.UndoSendPacket(ctx, packetInfo.ChannelID, packet.Sequence, packetInfo.Denom, packetInfo.Sender, packetInfo.Amount)
 }
 
 // Middleware implementation for OnAckPacket with rate limiting
@@ -225,7 +227,7 @@ func (k Keeper) TimeoutRateLimitedPacket(ctx sdk.Context, packet channeltypes.Pa
 		return err
 	}
 
-	return k.UndoSendPacket(ctx, packetInfo.ChannelID, packet.Sequence, packetInfo.Denom, packetInfo.Amount)
+	return k.UndoSendPacket(ctx, packetInfo.ChannelID, packet.Sequence, packetInfo.Denom, packetInfo.Sender, packetInfo.Amount)
 }
 
 // SendPacket wraps IBC ChannelKeeper's SendPacket function
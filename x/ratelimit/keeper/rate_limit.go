@@ -12,6 +12,7 @@ import (
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	epochstypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
 	"github.com/Stride-Labs/stride/v10/x/ratelimit/types"
 )
 
@@ -26,6 +27,15 @@ func (k Keeper) GetChannelValue(ctx sdk.Context, denom string) sdkmath.Int {
 	return k.bankKeeper.GetSupply(ctx, denom).Amount
 }
 
+// Returns the current hour epoch number, which identifies the hourly flows of sliding window quotas
+func (k Keeper) GetCurrentEpochHour(ctx sdk.Context) uint64 {
+	epochInfo, found := k.epochsKeeper.GetEpochInfo(ctx, epochstypes.HOUR_EPOCH)
+	if !found {
+		return 0
+	}
+	return uint64(epochInfo.CurrentEpoch)
+}
+
 // If the rate limit is exceeded or the denom is blacklisted, we emit an event
 func EmitTransferDeniedEvent(ctx sdk.Context, reason, denom, channelId string, direction types.PacketDirection, amount sdkmath.Int, err error) {
 	ctx.EventManager().EmitEvent(
@@ -54,8 +64,31 @@ func (k Keeper) UpdateFlow(rateLimit types.RateLimit, direction types.PacketDire
 	}
 }
 
+// Returns the rate limits that apply to a packet: the rate limit on the packet's channel, as well as
+// any aggregate rate limits on the denom across every channel or across a channel group that includes the channel
+func (k Keeper) GetRateLimitsForPacket(ctx sdk.Context, denom string, channelId string) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+
+	aggregateChannelIds := []string{channelId, types.AnyChannelId}
+	for _, channelGroup := range k.GetAllChannelGroups(ctx) {
+		if channelGroup.ContainsChannel(channelId) {
+			aggregateChannelIds = append(aggregateChannelIds, types.GetChannelGroupChannelId(channelGroup.Name))
+		}
+	}
+
+	for _, rateLimitChannelId := range aggregateChannelIds {
+		if rateLimit, found := k.GetRateLimit(ctx, denom, rateLimitChannelId); found {
+			rateLimits = append(rateLimits, rateLimit)
+		}
+	}
+
+	return rateLimits
+}
+
 // Checks whether the given packet will exceed the rate limit
 // Called by OnRecvPacket and OnSendPacket
+// The packet must be within the quota of every rate limit that applies to it (see GetRateLimitsForPacket),
+// and the flows are only updated if none of the quotas are exceeded
 func (k Keeper) CheckRateLimitAndUpdateFlow(
 	ctx sdk.Context,
 	direction types.PacketDirection,
@@ -73,8 +106,8 @@ func (k Keeper) CheckRateLimitAndUpdateFlow(
 	}
 
 	// If there's no rate limit yet for this denom, no action is necessary
-	rateLimit, found := k.GetRateLimit(ctx, denom, channelId)
-	if !found {
+	rateLimits := k.GetRateLimitsForPacket(ctx, denom, channelId)
+	if len(rateLimits) == 0 {
 		return false, nil
 	}
 
@@ -84,35 +117,145 @@ func (k Keeper) CheckRateLimitAndUpdateFlow(
 		return false, nil
 	}
 
+	epochHour := k.GetCurrentEpochHour(ctx)
+	addressFlows := []types.AddressFlow{}
+	for _, rateLimit := range rateLimits {
+		addressFlow, err := k.updateRateLimitFlow(ctx, rateLimit, direction, packetInfo, epochHour)
+		if err != nil {
+			return false, err
+		}
+		if addressFlow != nil {
+			addressFlows = append(addressFlows, *addressFlow)
+		}
+	}
+
+	// If there's no quota error, update the rate limit objects in the store with the new flow
+	for _, rateLimit := range rateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+	for _, addressFlow := range addressFlows {
+		k.SetAddressFlow(ctx, addressFlow)
+	}
+
+	return true, nil
+}
+
+// Adds a packet to the flow of a single rate limit (without storing it), and returns the updated
+// flow of the sender or receiver if the quota has an address sub-quota
+// If the quota is exceeded, a transfer denied event is emitted with the rate limit's channel-id
+func (k Keeper) updateRateLimitFlow(
+	ctx sdk.Context,
+	rateLimit types.RateLimit,
+	direction types.PacketDirection,
+	packetInfo RateLimitedPacketInfo,
+	epochHour uint64,
+) (*types.AddressFlow, error) {
+	denom := rateLimit.Path.Denom
+	channelId := rateLimit.Path.ChannelId
+	amount := packetInfo.Amount
+
+	// Errors from aggregate rate limits identify which aggregate was exceeded
+	wrapAggregateErr := func(err error) error {
+		if types.IsAggregateChannelId(channelId) {
+			return errorsmod.Wrapf(err, "aggregate rate limit on %s", channelId)
+		}
+		return err
+	}
+
+	// For sliding window quotas, any flow that isn't tracked in an hourly flow is attributed to the current hour
+	// so that it's still counted. UpdateRateLimit resets the flow whenever the quota changes, so this only
+	// applies to a sliding window rate limit imported from genesis with a flow but no hourly flows
+	if rateLimit.Quota.SlidingWindow {
+		rateLimit.Flow.InitializeHourlyFlows(epochHour)
+	}
+
 	// Update the flow object with the change in amount
 	if err := k.UpdateFlow(rateLimit, direction, amount); err != nil {
 		// If the rate limit was exceeded, emit an event
+		err = wrapAggregateErr(err)
 		EmitTransferDeniedEvent(ctx, types.EventRateLimitExceeded, denom, channelId, direction, amount, err)
-		return false, err
+		return nil, err
 	}
 
-	// If there's no quota error, update the rate limit object in the store with the new flow
-	k.SetRateLimit(ctx, rateLimit)
+	// If the quota has an address sub-quota, also update the flow of the individual address
+	// (the sender for outflows and the receiver for inflows)
+	var addressFlow *types.AddressFlow
+	if rateLimit.Quota.AddressQuota != nil {
+		address := packetInfo.Sender
+		if direction == types.PACKET_RECV {
+			address = packetInfo.Receiver
+		}
+
+		flow := k.GetAddressFlow(ctx, denom, channelId, address)
+		if err := flow.AddFlow(direction, amount, *rateLimit.Quota, rateLimit.Flow.ChannelValue); err != nil {
+			err = wrapAggregateErr(err)
+			EmitTransferDeniedEvent(ctx, types.EventPerAddressQuotaExceeded, denom, channelId, direction, amount, err)
+			return nil, err
+		}
+		addressFlow = &flow
+	}
 
-	return true, nil
+	// Sliding window quotas also track the amount in the current hour's flow
+	if rateLimit.Quota.SlidingWindow {
+		rateLimit.Flow.AddHourlyFlow(direction, amount, epochHour)
+	}
+
+	return addressFlow, nil
 }
 
 // If a SendPacket fails or times out, undo the outflow increment that happened during the send
-func (k Keeper) UndoSendPacket(ctx sdk.Context, channelId string, sequence uint64, denom string, amount sdkmath.Int) error {
-	rateLimit, found := k.GetRateLimit(ctx, denom, channelId)
-	if !found {
+// The outflow is removed from every rate limit that counted the packet in its current window, and if
+// a quota has an address sub-quota, the outflow is also removed from the sender's flow
+func (k Keeper) UndoSendPacket(ctx sdk.Context, channelId string, sequence uint64, denom string, sender string, amount sdkmath.Int) error {
+	rateLimits := k.GetRateLimitsForPacket(ctx, denom, channelId)
+	if len(rateLimits) == 0 {
 		return nil
 	}
 
 	// If the packet was sent during this quota, decrement the outflow
 	// Otherwise, it can be ignored
-	if k.CheckPacketSentDuringCurrentQuota(ctx, channelId, sequence) {
-		rateLimit.Flow.Outflow = rateLimit.Flow.Outflow.Sub(amount)
+	if !k.CheckPacketSentDuringCurrentQuota(ctx, channelId, sequence) {
+		return nil
+	}
+
+	// Each rate limit only removes the outflow if it counted the packet and has not been reset since
+	// Packets that were stored without their windows fall back to the checks below
+	windows := k.GetPendingSendPacketWindows(ctx, channelId, sequence)
+
+	for _, rateLimit := range rateLimits {
+		rateLimitChannelId := rateLimit.Path.ChannelId
+
+		if len(windows) > 0 {
+			window, counted := windows[rateLimitChannelId]
+			if !counted || window != k.GetRateLimitWindow(ctx, denom, rateLimitChannelId) {
+				continue
+			}
+		}
+
+		// For sliding window quotas, the outflow is only decremented if the packet's hour is still in the window
+		// Aggregate rate limits can be reset while the packet is still pending on its channel,
+		// so their outflow is floored at zero
+		if rateLimit.GetQuota().GetSlidingWindow() {
+			if epochHour, found := k.GetPendingSendPacketEpochHour(ctx, channelId, sequence); found {
+				rateLimit.Flow.RemoveHourlyOutflow(amount, epochHour)
+			}
+		} else if types.IsAggregateChannelId(rateLimitChannelId) {
+			rateLimit.Flow.Outflow = sdkmath.MaxInt(rateLimit.Flow.Outflow.Sub(amount), sdkmath.ZeroInt())
+		} else {
+			rateLimit.Flow.Outflow = rateLimit.Flow.Outflow.Sub(amount)
+		}
 		k.SetRateLimit(ctx, rateLimit)
 
-		k.RemovePendingSendPacket(ctx, channelId, sequence)
+		// The sender's flow may have been reset since the packet was sent, so the outflow is floored at zero
+		if rateLimit.GetQuota().GetAddressQuota() != nil {
+			addressFlow := k.GetAddressFlow(ctx, denom, rateLimitChannelId, sender)
+			addressFlow.Outflow = sdkmath.MaxInt(addressFlow.Outflow.Sub(amount), sdkmath.ZeroInt())
+			k.SetAddressFlow(ctx, addressFlow)
+		}
 	}
 
+	k.RemovePendingSendPacket(ctx, channelId, sequence)
+
 	return nil
 }
 
@@ -133,10 +276,68 @@ func (k Keeper) ResetRateLimit(ctx sdk.Context, denom string, channelId string)
 	rateLimit.Flow = &flow
 
 	k.SetRateLimit(ctx, rateLimit)
-	k.RemoveAllChannelPendingSendPackets(ctx, channelId)
+	k.RemoveAllAddressFlows(ctx, denom, channelId)
+	k.StartNewRateLimitWindow(ctx, denom, channelId)
+
+	// Pending packets record the window in which each of their rate limits counted them, so they're
+	// kept until the packet is acknowledged or times out, and each rate limit can tell whether it has
+	// been reset since the packet was sent
+	// Packets stored without windows are stored by the channel they were sent on, and are removed
+	// when the rate limit of that channel resets (not when an aggregate rate limit resets)
+	if !types.IsAggregateChannelId(channelId) {
+		k.RemoveChannelPendingSendPacketsWithoutWindows(ctx, channelId)
+	}
+	return nil
+}
+
+// Moves a rate limit to a different channel (e.g. when a host zone's transfer channel is updated)
+// The quota is kept, but the flow is reset since it was accumulated on the previous channel
+// This is a no-op if there's no rate limit on the previous channel
+func (k Keeper) MoveRateLimit(ctx sdk.Context, denom string, oldChannelId string, newChannelId string) error {
+	rateLimit, found := k.GetRateLimit(ctx, denom, oldChannelId)
+	if !found {
+		return nil
+	}
+	if _, found := k.GetRateLimit(ctx, denom, newChannelId); found {
+		return errorsmod.Wrapf(types.ErrRateLimitAlreadyExists, "rate limit already exists for %s on %s", denom, newChannelId)
+	}
+
+	k.RemoveRateLimit(ctx, denom, oldChannelId)
+	k.RemoveAllAddressFlows(ctx, denom, oldChannelId)
+
+	rateLimit.Path.ChannelId = newChannelId
+	rateLimit.Flow = &types.Flow{
+		Inflow:       sdkmath.ZeroInt(),
+		Outflow:      sdkmath.ZeroInt(),
+		ChannelValue: k.GetChannelValue(ctx, denom),
+	}
+	k.SetRateLimit(ctx, rateLimit)
+	k.StartNewRateLimitWindow(ctx, denom, newChannelId)
+
 	return nil
 }
 
+// Removes every rate limit for a denom (across all channels and aggregates) along with the address flows
+// This is used when the denom is no longer transferred (e.g. when a host zone's stToken is removed)
+func (k Keeper) RemoveDenomRateLimits(ctx sdk.Context, denom string) {
+	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+		if rateLimit.Path.Denom == denom {
+			k.RemoveRateLimit(ctx, denom, rateLimit.Path.ChannelId)
+			k.RemoveAllAddressFlows(ctx, denom, rateLimit.Path.ChannelId)
+		}
+	}
+}
+
+// Advances a sliding window quota to the given hour epoch
+// The hourly flows that have left the window are dropped from the flow, and the channel value is refreshed
+func (k Keeper) AdvanceSlidingWindow(ctx sdk.Context, rateLimit types.RateLimit, epochHour uint64) {
+	rateLimit.Flow.InitializeHourlyFlows(epochHour)
+	rateLimit.Flow.PruneHourlyFlows(epochHour, rateLimit.Quota.DurationHours)
+	rateLimit.Flow.ChannelValue = k.GetChannelValue(ctx, rateLimit.Path.Denom)
+
+	k.SetRateLimit(ctx, rateLimit)
+}
+
 // Stores/Updates a rate limit object in the store
 func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKeyPrefix)
@@ -187,11 +388,128 @@ func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
 	return allRateLimits
 }
 
+// Stores/Updates the flow of an individual address along a rate limited path
+func (k Keeper) SetAddressFlow(ctx sdk.Context, addressFlow types.AddressFlow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressFlowKeyPrefix)
+
+	key := types.GetAddressFlowKey(addressFlow.Denom, addressFlow.ChannelId, addressFlow.Address)
+	value := k.cdc.MustMarshal(&addressFlow)
+
+	store.Set(key, value)
+}
+
+// Returns the flow of an individual address along a rate limited path
+// If the address has not transferred since the last reset, an empty flow is returned
+func (k Keeper) GetAddressFlow(ctx sdk.Context, denom, channelId, address string) types.AddressFlow {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressFlowKeyPrefix)
+
+	key := types.GetAddressFlowKey(denom, channelId, address)
+	value := store.Get(key)
+
+	if len(value) == 0 {
+		return types.NewAddressFlow(denom, channelId, address)
+	}
+
+	addressFlow := types.AddressFlow{}
+	k.cdc.MustUnmarshal(value, &addressFlow)
+	return addressFlow
+}
+
+// Returns all address flows stored
+func (k Keeper) GetAllAddressFlows(ctx sdk.Context) []types.AddressFlow {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressFlowKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allAddressFlows := []types.AddressFlow{}
+	for ; iterator.Valid(); iterator.Next() {
+		addressFlow := types.AddressFlow{}
+		k.cdc.MustUnmarshal(iterator.Value(), &addressFlow)
+		allAddressFlows = append(allAddressFlows, addressFlow)
+	}
+
+	return allAddressFlows
+}
+
+// Removes the flows of all addresses along a rate limited path
+// This is executed when the quota resets
+func (k Keeper) RemoveAllAddressFlows(ctx sdk.Context, denom, channelId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressFlowKeyPrefix)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetAddressFlowRateLimitPrefix(denom, channelId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(iterator.Key())
+	}
+}
+
+// Stores/Updates a channel group
+func (k Keeper) SetChannelGroup(ctx sdk.Context, channelGroup types.ChannelGroup) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelGroupKeyPrefix)
+	key := types.KeyPrefix(channelGroup.Name)
+	value := k.cdc.MustMarshal(&channelGroup)
+	store.Set(key, value)
+}
+
+// Grabs and returns a channel group from the store using its name
+func (k Keeper) GetChannelGroup(ctx sdk.Context, name string) (channelGroup types.ChannelGroup, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelGroupKeyPrefix)
+
+	value := store.Get(types.KeyPrefix(name))
+	if len(value) == 0 {
+		return channelGroup, false
+	}
+
+	k.cdc.MustUnmarshal(value, &channelGroup)
+	return channelGroup, true
+}
+
+// Removes a channel group from the store
+func (k Keeper) RemoveChannelGroup(ctx sdk.Context, name string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelGroupKeyPrefix)
+	store.Delete(types.KeyPrefix(name))
+}
+
+// Returns all channel groups stored
+func (k Keeper) GetAllChannelGroups(ctx sdk.Context) []types.ChannelGroup {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelGroupKeyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allChannelGroups := []types.ChannelGroup{}
+	for ; iterator.Valid(); iterator.Next() {
+		channelGroup := types.ChannelGroup{}
+		k.cdc.MustUnmarshal(iterator.Value(), &channelGroup)
+		allChannelGroups = append(allChannelGroups, channelGroup)
+	}
+
+	return allChannelGroups
+}
+
 // Sets the sequence number of a packet that was just sent
+// The hour epoch in which it was sent is stored so that sliding window quotas can locate its hourly flow
 func (k Keeper) SetPendingSendPacket(ctx sdk.Context, channelId string, sequence uint64) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
 	key := types.GetPendingSendPacketKey(channelId, sequence)
-	store.Set(key, []byte{1})
+
+	epochHourBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(epochHourBz, k.GetCurrentEpochHour(ctx))
+	store.Set(key, epochHourBz)
+}
+
+// Returns the hour epoch in which a pending packet was sent
+// Packets that were stored before the hour was recorded return false
+func (k Keeper) GetPendingSendPacketEpochHour(ctx sdk.Context, channelId string, sequence uint64) (epochHour uint64, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
+	key := types.GetPendingSendPacketKey(channelId, sequence)
+	valueBz := store.Get(key)
+	if len(valueBz) != 8 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(valueBz), true
 }
 
 // Remove a pending packet sequence number from the store
@@ -200,6 +518,8 @@ func (k Keeper) RemovePendingSendPacket(ctx sdk.Context, channelId string, seque
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
 	key := types.GetPendingSendPacketKey(channelId, sequence)
 	store.Delete(key)
+
+	k.RemovePendingSendPacketWindows(ctx, channelId, sequence)
 }
 
 // Checks whether the packet sequence number is in the store - indicating that it was
@@ -234,12 +554,90 @@ func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []string {
 	return pendingPackets
 }
 
-// Remove all pending sequence numbers from the store
-// This is executed when the quota resets
+// Remove all pending sequence numbers from the store, along with their windows
 func (k Keeper) RemoveAllChannelPendingSendPackets(ctx sdk.Context, channelId string) {
+	for _, prefixKey := range [][]byte{types.PendingSendPacketPrefix, types.PendingSendPacketWindowKeyPrefix} {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+
+		iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefix(channelId))
+		for ; iterator.Valid(); iterator.Next() {
+			store.Delete(iterator.Key())
+		}
+		iterator.Close()
+	}
+}
+
+// Removes the pending packets of a channel that were stored without the windows of their rate limits
+// Packets with windows are instead ignored by each rate limit that has been reset since the packet was sent
+func (k Keeper) RemoveChannelPendingSendPacketsWithoutWindows(ctx sdk.Context, channelId string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketPrefix)
 
-	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefix(channelId))
+	channelPrefix := types.GetPendingSendPacketKey(channelId, 0)[:types.PendingSendPacketChannelLength]
+	iterator := sdk.KVStorePrefixIterator(store, channelPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		sequence := binary.BigEndian.Uint64(iterator.Key()[types.PendingSendPacketChannelLength:])
+		if len(k.GetPendingSendPacketWindows(ctx, channelId, sequence)) == 0 {
+			store.Delete(iterator.Key())
+		}
+	}
+}
+
+// Returns the current window of a rate limit, which is incremented each time the rate limit's flow is reset
+func (k Keeper) GetRateLimitWindow(ctx sdk.Context, denom string, channelId string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitWindowKeyPrefix)
+	windowBz := store.Get(GetRateLimitItemKey(denom, channelId))
+	if len(windowBz) == 0 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(windowBz)
+}
+
+// Starts a new window for a rate limit, so that the packets sent during the previous window
+// are no longer removed from the rate limit's flow if they fail
+// This should be called each time the flow of a rate limit is reset
+func (k Keeper) StartNewRateLimitWindow(ctx sdk.Context, denom string, channelId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitWindowKeyPrefix)
+	windowBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(windowBz, k.GetRateLimitWindow(ctx, denom, channelId)+1)
+	store.Set(GetRateLimitItemKey(denom, channelId), windowBz)
+}
+
+// Stores the current window of each rate limit that counted a sent packet
+func (k Keeper) SetPendingSendPacketWindows(ctx sdk.Context, channelId string, sequence uint64, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketWindowKeyPrefix)
+
+	for _, rateLimit := range k.GetRateLimitsForPacket(ctx, denom, channelId) {
+		rateLimitChannelId := rateLimit.Path.ChannelId
+		windowBz := make([]byte, 8)
+		binary.BigEndian.PutUint64(windowBz, k.GetRateLimitWindow(ctx, denom, rateLimitChannelId))
+		store.Set(types.GetPendingSendPacketWindowKey(channelId, sequence, rateLimitChannelId), windowBz)
+	}
+}
+
+// Returns the window in which each rate limit counted a pending packet, keyed by the rate limit's channel-id
+// Packets that were stored before the windows were recorded return an empty map
+func (k Keeper) GetPendingSendPacketWindows(ctx sdk.Context, channelId string, sequence uint64) map[string]uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketWindowKeyPrefix)
+
+	packetKey := types.GetPendingSendPacketKey(channelId, sequence)
+	iterator := sdk.KVStorePrefixIterator(store, packetKey)
+	defer iterator.Close()
+
+	windows := map[string]uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		rateLimitChannelId := string(iterator.Key()[len(packetKey):])
+		windows[rateLimitChannelId] = binary.BigEndian.Uint64(iterator.Value())
+	}
+	return windows
+}
+
+// Removes the windows of a pending packet
+func (k Keeper) RemovePendingSendPacketWindows(ctx sdk.Context, channelId string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketWindowKeyPrefix)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPendingSendPacketKey(channelId, sequence))
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -7,6 +7,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	epochstypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
 	minttypes "github.com/Stride-Labs/stride/v10/x/mint/types"
 	"github.com/Stride-Labs/stride/v10/x/ratelimit/keeper"
 	"github.com/Stride-Labs/stride/v10/x/ratelimit/types"
@@ -628,14 +629,14 @@ func (s *KeeperTestSuite) TestUndoSendPacket() {
 
 	// Undo a send of 10 from the first rate limit, with sequence 1
 	// If should NOT modify the outflow since sequence 1 was not sent in the current quota
-	err := s.App.RatelimitKeeper.UndoSendPacket(s.Ctx, channelId, 1, denom, packetSendAmount)
+	err := s.App.RatelimitKeeper.UndoSendPacket(s.Ctx, channelId, 1, denom, sender, packetSendAmount)
 	s.Require().NoError(err, "no error expected when undoing send packet sequence 1")
 
 	checkOutflow(channelId, denom, initialOutflow)
 
 	// Now undo a send from the same rate limit with sequence 2
 	// If should decrement the outflow since 2 is in the current quota
-	err = s.App.RatelimitKeeper.UndoSendPacket(s.Ctx, channelId, 2, denom, packetSendAmount)
+	err = s.App.RatelimitKeeper.UndoSendPacket(s.Ctx, channelId, 2, denom, sender, packetSendAmount)
 	s.Require().NoError(err, "no error expected when undoing send packet sequence 2")
 
 	checkOutflow(channelId, denom, initialOutflow.Sub(packetSendAmount))
@@ -647,3 +648,625 @@ func (s *KeeperTestSuite) TestUndoSendPacket() {
 	found := s.App.RatelimitKeeper.CheckPacketSentDuringCurrentQuota(s.Ctx, channelId, 2)
 	s.Require().False(found, "packet sequence number should have been removed")
 }
+
+type slidingWindowAction struct {
+	epochHour     int64
+	direction     types.PacketDirection
+	amount        int64
+	expectedError string
+}
+
+type slidingWindowTestCase struct {
+	name          string
+	durationHours uint64
+	slidingWindow bool
+	actions       []slidingWindowAction
+}
+
+// Helper function to start a new hour epoch, which advances or resets the rate limits
+func (s *KeeperTestSuite) startHourEpoch(epochHour int64) {
+	epochInfo := epochstypes.EpochInfo{
+		Identifier:   epochstypes.HOUR_EPOCH,
+		CurrentEpoch: epochHour,
+	}
+	s.App.EpochsKeeper.SetEpochInfo(s.Ctx, epochInfo)
+	s.App.RatelimitKeeper.BeforeEpochStart(s.Ctx, epochInfo)
+}
+
+// Helper function to check a rate limit across a series of transfers in different hour epochs
+// The expected flow is the sum of the transfers in the last durationHours epochs for sliding windows,
+// or since the last reset for fixed windows
+func (s *KeeperTestSuite) processSlidingWindowTestCase(tc slidingWindowTestCase) {
+	// Mint the channel value, since it's refreshed each hour
+	err := s.App.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))))
+	s.Require().NoError(err)
+
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+		Path: &types.Path{Denom: denom, ChannelId: channelId},
+		Quota: &types.Quota{
+			MaxPercentSend: sdkmath.NewInt(10),
+			MaxPercentRecv: sdkmath.NewInt(10),
+			DurationHours:  tc.durationHours,
+			SlidingWindow:  tc.slidingWindow,
+		},
+		Flow: &types.Flow{
+			Inflow:       sdkmath.ZeroInt(),
+			Outflow:      sdkmath.ZeroInt(),
+			ChannelValue: sdkmath.NewInt(100),
+		},
+	})
+
+	inflowByHour := map[int64]int64{}
+	outflowByHour := map[int64]int64{}
+	currentHour := int64(0)
+	for i, action := range tc.actions {
+		if action.epochHour != currentHour {
+			currentHour = action.epochHour
+			s.startHourEpoch(currentHour)
+		}
+
+		packetInfo := keeper.RateLimitedPacketInfo{
+			ChannelID: channelId,
+			Denom:     denom,
+			Amount:    sdkmath.NewInt(action.amount),
+			Sender:    sender,
+			Receiver:  receiver,
+		}
+		_, err := s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, action.direction, packetInfo)
+
+		if action.expectedError != "" {
+			s.Require().ErrorContains(err, action.expectedError, tc.name+" - action: #%d - error", i)
+		} else {
+			s.Require().NoError(err, tc.name+" - action: #%d - no error", i)
+			if action.direction == types.PACKET_RECV {
+				inflowByHour[currentHour] += action.amount
+			} else {
+				outflowByHour[currentHour] += action.amount
+			}
+		}
+
+		// Sum the flows that are still counted towards the quota
+		windowStart := currentHour - int64(tc.durationHours) + 1
+		if !tc.slidingWindow {
+			windowStart = currentHour - (currentHour % int64(tc.durationHours))
+		}
+		expectedInflow, expectedOutflow := int64(0), int64(0)
+		for hour := windowStart; hour <= currentHour; hour++ {
+			expectedInflow += inflowByHour[hour]
+			expectedOutflow += outflowByHour[hour]
+		}
+
+		rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+		s.Require().True(found)
+		s.Require().Equal(expectedInflow, rateLimit.Flow.Inflow.Int64(), tc.name+" - action: #%d - inflow", i)
+		s.Require().Equal(expectedOutflow, rateLimit.Flow.Outflow.Int64(), tc.name+" - action: #%d - outflow", i)
+	}
+}
+
+func (s *KeeperTestSuite) TestCheckRateLimitAndUpdateFlow_SlidingWindow() {
+	testCases := []slidingWindowTestCase{
+		{
+			// With a fixed window, the full quota can be used on either side of the reset
+			name:          "fixed_window_drained_across_reset",
+			durationHours: 2,
+			slidingWindow: false,
+			actions: []slidingWindowAction{
+				{epochHour: 1, direction: types.PACKET_SEND, amount: 10},
+				{epochHour: 2, direction: types.PACKET_SEND, amount: 10},
+			},
+		},
+		{
+			// With a sliding window, the outflow from hour 1 is still counted in hour 2
+			name:          "sliding_window_not_drained_across_boundary",
+			durationHours: 2,
+			slidingWindow: true,
+			actions: []slidingWindowAction{
+				{epochHour: 1, direction: types.PACKET_SEND, amount: 10},
+				{epochHour: 2, direction: types.PACKET_SEND, amount: 1,
+					expectedError: "Outflow exceeds quota"},
+				{epochHour: 3, direction: types.PACKET_SEND, amount: 10},
+			},
+		},
+		{
+			name:          "sliding_window_flow_leaves_window_one_hour_at_a_time",
+			durationHours: 3,
+			slidingWindow: true,
+			actions: []slidingWindowAction{
+				{epochHour: 1, direction: types.PACKET_SEND, amount: 4},
+				{epochHour: 2, direction: types.PACKET_SEND, amount: 3},
+				{epochHour: 3, direction: types.PACKET_SEND, amount: 3},
+				{epochHour: 3, direction: types.PACKET_SEND, amount: 1,
+					expectedError: "Outflow exceeds quota"},
+				// Hour 1 leaves the window, freeing up 4
+				{epochHour: 4, direction: types.PACKET_SEND, amount: 4},
+				{epochHour: 4, direction: types.PACKET_SEND, amount: 1,
+					expectedError: "Outflow exceeds quota"},
+				// Hour 2 leaves the window, freeing up 3
+				{epochHour: 5, direction: types.PACKET_SEND, amount: 3},
+			},
+		},
+		{
+			name:          "sliding_window_bidirectional",
+			durationHours: 2,
+			slidingWindow: true,
+			actions: []slidingWindowAction{
+				{epochHour: 1, direction: types.PACKET_RECV, amount: 8}, // Net: +8
+				{epochHour: 2, direction: types.PACKET_SEND, amount: 6}, // Net: +2
+				{epochHour: 2, direction: types.PACKET_RECV, amount: 8}, // Net: +10
+				{epochHour: 2, direction: types.PACKET_RECV, amount: 1, // Net: +11 (exceeds threshold)
+					expectedError: "Inflow exceeds quota"},
+				// Hour 1's inflow leaves the window, Net: +2
+				{epochHour: 3, direction: types.PACKET_SEND, amount: 12}, // Net: -10
+				{epochHour: 3, direction: types.PACKET_SEND, amount: 1, // Net: -11 (exceeds threshold)
+					expectedError: "Outflow exceeds quota"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.processSlidingWindowTestCase(tc)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCheckRateLimitAndUpdateFlow_GenesisSlidingWindowWithoutHourlyFlows() {
+	// Store a fixed window rate limit that already has an outflow
+	s.SetupCheckRateLimitAndUpdateFlowTest()
+	err := s.App.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))))
+	s.Require().NoError(err)
+	s.startHourEpoch(5)
+
+	packetInfo := keeper.RateLimitedPacketInfo{ChannelID: channelId, Denom: denom, Amount: sdkmath.NewInt(6), Sender: sender, Receiver: receiver}
+	_, err = s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, types.PACKET_SEND, packetInfo)
+	s.Require().NoError(err, "no error expected for fixed window send")
+
+	// Switch the rate limit to a sliding window without resetting the flow,
+	// as if it were imported from genesis with a flow but no hourly flows
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	rateLimit.Quota.SlidingWindow = true
+	rateLimit.Quota.DurationHours = 2
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, rateLimit)
+
+	// The existing outflow should be attributed to the current hour and still count towards the quota
+	packetInfo.Amount = sdkmath.NewInt(5)
+	_, err = s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, types.PACKET_SEND, packetInfo)
+	s.Require().ErrorContains(err, "Outflow exceeds quota", "existing outflow should be counted")
+
+	packetInfo.Amount = sdkmath.NewInt(4)
+	_, err = s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, types.PACKET_SEND, packetInfo)
+	s.Require().NoError(err, "no error expected for send within quota")
+
+	rateLimit, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	s.Require().Equal([]types.HourlyFlow{{EpochHour: 5, Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.NewInt(10)}},
+		rateLimit.Flow.HourlyFlows, "hourly flows after migration")
+
+	// Once the hour leaves the window, the flow should be dropped
+	s.startHourEpoch(7)
+	rateLimit, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().True(found)
+	s.Require().Zero(rateLimit.Flow.Outflow.Int64(), "outflow after window")
+	s.Require().Empty(rateLimit.Flow.HourlyFlows, "hourly flows after window")
+}
+
+func (s *KeeperTestSuite) TestUndoSendPacket_SlidingWindow() {
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+		Path:  &types.Path{Denom: denom, ChannelId: channelId},
+		Quota: &types.Quota{DurationHours: 2, SlidingWindow: true},
+		Flow: &types.Flow{
+			Inflow:  sdkmath.ZeroInt(),
+			Outflow: sdkmath.NewInt(30),
+			HourlyFlows: []types.HourlyFlow{
+				{EpochHour: 4, Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.NewInt(10)},
+				{EpochHour: 5, Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.NewInt(20)},
+			},
+		},
+	})
+
+	// Send packet 1 in hour 3 (which has left the window), and packet 2 in hour 4
+	s.App.EpochsKeeper.SetEpochInfo(s.Ctx, epochstypes.EpochInfo{Identifier: epochstypes.HOUR_EPOCH, CurrentEpoch: 3})
+	s.App.RatelimitKeeper.SetPendingSendPacket(s.Ctx, channelId, 1)
+	s.App.EpochsKeeper.SetEpochInfo(s.Ctx, epochstypes.EpochInfo{Identifier: epochstypes.HOUR_EPOCH, CurrentEpoch: 4})
+	s.App.RatelimitKeeper.SetPendingSendPacket(s.Ctx, channelId, 2)
+
+	epochHour, found := s.App.RatelimitKeeper.GetPendingSendPacketEpochHour(s.Ctx, channelId, 2)
+	s.Require().True(found, "pending packet epoch hour should be found")
+	s.Require().Equal(uint64(4), epochHour, "pending packet epoch hour")
+
+	// Undoing packet 1 should not change the flow since its hour is no longer in the window
+	err := s.App.RatelimitKeeper.UndoSendPacket(s.Ctx, channelId, 1, denom, sender, sdkmath.NewInt(5))
+	s.Require().NoError(err, "no error expected when undoing send packet sequence 1")
+
+	rateLimit, _ := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().Equal(int64(30), rateLimit.Flow.Outflow.Int64(), "outflow after undoing packet 1")
+
+	// Undoing packet 2 should decrement the outflow from hour 4
+	err = s.App.RatelimitKeeper.UndoSendPacket(s.Ctx, channelId, 2, denom, sender, sdkmath.NewInt(5))
+	s.Require().NoError(err, "no error expected when undoing send packet sequence 2")
+
+	rateLimit, _ = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	s.Require().Equal(int64(25), rateLimit.Flow.Outflow.Int64(), "outflow after undoing packet 2")
+	s.Require().Equal(int64(5), rateLimit.Flow.HourlyFlows[0].Outflow.Int64(), "hour 4 outflow after undoing packet 2")
+	s.Require().Equal(int64(20), rateLimit.Flow.HourlyFlows[1].Outflow.Int64(), "hour 5 outflow after undoing packet 2")
+
+	// Both packets should be removed
+	s.Require().False(s.App.RatelimitKeeper.CheckPacketSentDuringCurrentQuota(s.Ctx, channelId, 1), "packet 1 removed")
+	s.Require().False(s.App.RatelimitKeeper.CheckPacketSentDuringCurrentQuota(s.Ctx, channelId, 2), "packet 2 removed")
+}
+
+type addressQuotaAction struct {
+	direction     types.PacketDirection
+	sender        string
+	receiver      string
+	amount        int64
+	expectedError string
+}
+
+func (s *KeeperTestSuite) TestCheckRateLimitAndUpdateFlow_AddressQuota() {
+	// The channel value is 100, with a 10% channel quota and a 5% quota for each address
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+		Path: &types.Path{Denom: denom, ChannelId: channelId},
+		Quota: &types.Quota{
+			MaxPercentSend: sdkmath.NewInt(10),
+			MaxPercentRecv: sdkmath.NewInt(10),
+			DurationHours:  1,
+			AddressQuota: &types.AddressQuota{
+				MaxPercentSend: sdkmath.NewInt(5),
+				MaxPercentRecv: sdkmath.NewInt(5),
+			},
+		},
+		Flow: &types.Flow{
+			Inflow:       sdkmath.ZeroInt(),
+			Outflow:      sdkmath.ZeroInt(),
+			ChannelValue: sdkmath.NewInt(100),
+		},
+	})
+
+	actions := []addressQuotaAction{
+		{direction: types.PACKET_SEND, sender: "whale", receiver: receiver, amount: 4},
+		{direction: types.PACKET_SEND, sender: "whale", receiver: receiver, amount: 2, expectedError: "per address quota exceeded"},
+		{direction: types.PACKET_SEND, sender: "user-1", receiver: receiver, amount: 5},
+		// The channel quota is checked before the address quota
+		{direction: types.PACKET_SEND, sender: "user-2", receiver: receiver, amount: 2, expectedError: "Outflow exceeds quota"},
+		// Inflows are tracked against the receiver, and are netted against the address's outflows
+		{direction: types.PACKET_RECV, sender: "host-address", receiver: "whale", amount: 9},
+		{direction: types.PACKET_RECV, sender: "host-address", receiver: "user-2", amount: 6, expectedError: "per address quota exceeded"},
+		{direction: types.PACKET_RECV, sender: "host-address", receiver: "user-2", amount: 5},
+	}
+
+	for i, action := range actions {
+		s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+		packetInfo := keeper.RateLimitedPacketInfo{
+			ChannelID: channelId,
+			Denom:     denom,
+			Amount:    sdkmath.NewInt(action.amount),
+			Sender:    action.sender,
+			Receiver:  action.receiver,
+		}
+		_, err := s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, action.direction, packetInfo)
+
+		if action.expectedError == "" {
+			s.Require().NoError(err, "action #%d - no error", i)
+			continue
+		}
+		s.Require().ErrorContains(err, action.expectedError, "action #%d - error", i)
+
+		// Confirm the denial event was emitted with the reason for the failure
+		expectedReason := types.EventRateLimitExceeded
+		if action.expectedError == "per address quota exceeded" {
+			expectedReason = types.EventPerAddressQuotaExceeded
+		}
+		s.Require().True(s.checkTransferDeniedReason(expectedReason), "action #%d - event reason", i)
+	}
+
+	// Confirm the flow of each address
+	expectedAddressFlows := map[string][2]int64{ // address -> [inflow, outflow]
+		"whale":  {9, 4},
+		"user-1": {0, 5},
+		"user-2": {5, 0},
+	}
+	for address, expectedFlow := range expectedAddressFlows {
+		addressFlow := s.App.RatelimitKeeper.GetAddressFlow(s.Ctx, denom, channelId, address)
+		s.Require().Equal(expectedFlow[0], addressFlow.Inflow.Int64(), "%s inflow", address)
+		s.Require().Equal(expectedFlow[1], addressFlow.Outflow.Int64(), "%s outflow", address)
+	}
+
+	// Undoing a send should remove the outflow from the sender
+	s.App.RatelimitKeeper.SetPendingSendPacket(s.Ctx, channelId, 1)
+	err := s.App.RatelimitKeeper.UndoSendPacket(s.Ctx, channelId, 1, denom, "whale", sdkmath.NewInt(4))
+	s.Require().NoError(err, "no error expected when undoing send packet")
+	s.Require().Zero(s.App.RatelimitKeeper.GetAddressFlow(s.Ctx, denom, channelId, "whale").Outflow.Int64(), "whale outflow after undo")
+
+	// Whitelisted address pairs should skip the address quota
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, types.WhitelistedAddressPair{Sender: "user-1", Receiver: receiver})
+	packetInfo := keeper.RateLimitedPacketInfo{
+		ChannelID: channelId,
+		Denom:     denom,
+		Amount:    sdkmath.NewInt(5),
+		Sender:    "user-1",
+		Receiver:  receiver,
+	}
+	updatedFlow, err := s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, types.PACKET_SEND, packetInfo)
+	s.Require().NoError(err, "no error expected for whitelisted pair")
+	s.Require().False(updatedFlow, "flow should not be updated for whitelisted pair")
+	s.Require().Equal(int64(5), s.App.RatelimitKeeper.GetAddressFlow(s.Ctx, denom, channelId, "user-1").Outflow.Int64(), "user-1 outflow")
+
+	// Resetting the rate limit should also reset the address flows
+	err = s.App.RatelimitKeeper.ResetRateLimit(s.Ctx, denom, channelId)
+	s.Require().NoError(err, "no error expected when resetting rate limit")
+	s.Require().Empty(s.App.RatelimitKeeper.GetAllAddressFlows(s.Ctx), "address flows after reset")
+}
+
+func (s *KeeperTestSuite) TestRemoveAllAddressFlows() {
+	// Store address flows on two channels whose ids share a prefix
+	for _, path := range []types.Path{{Denom: denom, ChannelId: "channel-1"}, {Denom: denom, ChannelId: "channel-10"}} {
+		for _, address := range []string{"address-1", "address-2"} {
+			s.App.RatelimitKeeper.SetAddressFlow(s.Ctx, types.NewAddressFlow(path.Denom, path.ChannelId, address))
+		}
+	}
+	s.Require().Len(s.App.RatelimitKeeper.GetAllAddressFlows(s.Ctx), 4, "address flows before removal")
+
+	// Only the flows from channel-1 should be removed
+	s.App.RatelimitKeeper.RemoveAllAddressFlows(s.Ctx, denom, "channel-1")
+
+	remainingAddressFlows := s.App.RatelimitKeeper.GetAllAddressFlows(s.Ctx)
+	s.Require().Len(remainingAddressFlows, 2, "address flows after removal")
+	for _, addressFlow := range remainingAddressFlows {
+		s.Require().Equal("channel-10", addressFlow.ChannelId, "remaining address flow channel")
+	}
+}
+
+// Helper function to check if a transfer denied event was emitted with the given reason
+func (s *KeeperTestSuite) checkTransferDeniedReason(reason string) bool {
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != types.EventTransferDenied {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if attribute.Key == types.AttributeKeyReason && attribute.Value == reason {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *KeeperTestSuite) TestCheckRateLimitAndUpdateFlow_AggregateRateLimits() {
+	newRateLimit := func(rateLimitChannelId string, maxPercent int64) types.RateLimit {
+		return types.RateLimit{
+			Path: &types.Path{Denom: denom, ChannelId: rateLimitChannelId},
+			Quota: &types.Quota{
+				MaxPercentSend: sdkmath.NewInt(maxPercent),
+				MaxPercentRecv: sdkmath.NewInt(maxPercent),
+				DurationHours:  1,
+			},
+			Flow: &types.Flow{
+				Inflow:       sdkmath.ZeroInt(),
+				Outflow:      sdkmath.ZeroInt(),
+				ChannelValue: sdkmath.NewInt(100),
+			},
+		}
+	}
+
+	// The channel value is 100, with a 20% limit across every channel, a 10% limit across
+	// the group of channel-1 and channel-2, and a 10% limit on channel-0 individually
+	groupChannelId := types.GetChannelGroupChannelId("osmosis")
+	s.App.RatelimitKeeper.SetChannelGroup(s.Ctx, types.ChannelGroup{Name: "osmosis", ChannelIds: []string{"channel-1", "channel-2"}})
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, newRateLimit(types.AnyChannelId, 20))
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, newRateLimit(groupChannelId, 10))
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, newRateLimit("channel-0", 10))
+
+	actions := []struct {
+		channelId     string
+		amount        int64
+		expectedError string
+	}{
+		{channelId: "channel-0", amount: 8},
+		// The individual channel limit still applies
+		{channelId: "channel-0", amount: 3, expectedError: "Outflow exceeds quota"},
+		{channelId: "channel-1", amount: 6},
+		// The group's outflow is shared across its channels
+		{channelId: "channel-2", amount: 5, expectedError: "aggregate rate limit on group/osmosis"},
+		{channelId: "channel-2", amount: 4},
+		// Channels without their own rate limit are still held to the limit across all channels
+		{channelId: "channel-3", amount: 3, expectedError: "aggregate rate limit on *"},
+		{channelId: "channel-3", amount: 2},
+	}
+
+	for i, action := range actions {
+		s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+		packetInfo := keeper.RateLimitedPacketInfo{
+			ChannelID: action.channelId,
+			Denom:     denom,
+			Amount:    sdkmath.NewInt(action.amount),
+			Sender:    sender,
+			Receiver:  receiver,
+		}
+		updatedFlow, err := s.App.RatelimitKeeper.CheckRateLimitAndUpdateFlow(s.Ctx, types.PACKET_SEND, packetInfo)
+
+		if action.expectedError == "" {
+			s.Require().NoError(err, "action #%d - no error", i)
+			s.Require().True(updatedFlow, "action #%d - updated flow", i)
+		} else {
+			s.Require().ErrorContains(err, action.expectedError, "action #%d - error", i)
+			s.Require().True(s.checkTransferDeniedReason(types.EventRateLimitExceeded), "action #%d - event reason", i)
+		}
+	}
+
+	// A denied packet should not have updated the flow of any of the rate limits
+	expectedOutflows := map[string]int64{
+		types.AnyChannelId: 20,
+		groupChannelId:     10,
+		"channel-0":        8,
+	}
+	for rateLimitChannelId, expectedOutflow := range expectedOutflows {
+		rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, rateLimitChannelId)
+		s.Require().True(found, "rate limit %s found", rateLimitChannelId)
+		s.Require().Equal(expectedOutflow, rateLimit.Flow.Outflow.Int64(), "rate limit %s outflow", rateLimitChannelId)
+	}
+}
+
+func (s *KeeperTestSuite) TestUndoSendPacket_AggregateRateLimits() {
+	initialOutflow := sdkmath.NewInt(100)
+	for _, rateLimitChannelId := range []string{types.AnyChannelId, channelId} {
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+			Path: &types.Path{Denom: denom, ChannelId: rateLimitChannelId},
+			Flow: &types.Flow{Outflow: initialOutflow},
+		})
+	}
+	s.App.RatelimitKeeper.SetPendingSendPacket(s.Ctx, channelId, 1)
+
+	// Undoing the send should decrement both the individual and aggregate outflows
+	err := s.App.RatelimitKeeper.UndoSendPacket(s.Ctx, channelId, 1, denom, sender, sdkmath.NewInt(10))
+	s.Require().NoError(err, "no error expected when undoing send packet")
+
+	for _, rateLimitChannelId := range []string{types.AnyChannelId, channelId} {
+		rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, rateLimitChannelId)
+		s.Require().True(found, "rate limit %s found", rateLimitChannelId)
+		s.Require().Equal(int64(90), rateLimit.Flow.Outflow.Int64(), "rate limit %s outflow", rateLimitChannelId)
+	}
+	s.Require().False(s.App.RatelimitKeeper.CheckPacketSentDuringCurrentQuota(s.Ctx, channelId, 1), "packet removed")
+
+	// Resetting the aggregate rate limit should not remove the pending packets of the individual channel
+	s.App.RatelimitKeeper.SetPendingSendPacket(s.Ctx, channelId, 2)
+	err = s.App.RatelimitKeeper.ResetRateLimit(s.Ctx, denom, types.AnyChannelId)
+	s.Require().NoError(err, "no error expected when resetting aggregate rate limit")
+	s.Require().True(s.App.RatelimitKeeper.CheckPacketSentDuringCurrentQuota(s.Ctx, channelId, 2), "packet not removed")
+}
+
+func (s *KeeperTestSuite) TestUndoSendPacket_RateLimitWindows() {
+	initialOutflow := sdkmath.NewInt(100)
+	for _, rateLimitChannelId := range []string{types.AnyChannelId, channelId} {
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+			Path: &types.Path{Denom: denom, ChannelId: rateLimitChannelId},
+			Flow: &types.Flow{Outflow: initialOutflow},
+		})
+	}
+	checkOutflows := func(expectedAggregateOutflow, expectedChannelOutflow int64) {
+		aggregateRateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, types.AnyChannelId)
+		s.Require().True(found, "aggregate rate limit found")
+		s.Require().Equal(expectedAggregateOutflow, aggregateRateLimit.Flow.Outflow.Int64(), "aggregate outflow")
+
+		channelRateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+		s.Require().True(found, "channel rate limit found")
+		s.Require().Equal(expectedChannelOutflow, channelRateLimit.Flow.Outflow.Int64(), "channel outflow")
+	}
+
+	// Send packet 1 in the first window of both rate limits
+	s.App.RatelimitKeeper.SetPendingSendPacket(s.Ctx, channelId, 1)
+	s.App.RatelimitKeeper.SetPendingSendPacketWindows(s.Ctx, channelId, 1, denom)
+
+	// Reset the aggregate rate limit, and then send packet 2
+	err := s.App.RatelimitKeeper.ResetRateLimit(s.Ctx, denom, types.AnyChannelId)
+	s.Require().NoError(err, "no error expected when resetting aggregate rate limit")
+	s.App.RatelimitKeeper.SetPendingSendPacket(s.Ctx, channelId, 2)
+	s.App.RatelimitKeeper.SetPendingSendPacketWindows(s.Ctx, channelId, 2, denom)
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+		Path: &types.Path{Denom: denom, ChannelId: types.AnyChannelId},
+		Flow: &types.Flow{Outflow: sdkmath.NewInt(30)},
+	})
+
+	// Undoing packet 1 should only decrement the channel rate limit, since the aggregate was reset
+	err = s.App.RatelimitKeeper.UndoSendPacket(s.Ctx, channelId, 1, denom, sender, sdkmath.NewInt(10))
+	s.Require().NoError(err, "no error expected when undoing packet 1")
+	checkOutflows(30, 90)
+	s.Require().Empty(s.App.RatelimitKeeper.GetPendingSendPacketWindows(s.Ctx, channelId, 1), "packet 1 windows removed")
+
+	// Resetting the channel rate limit should keep packet 2, since it still counts towards the aggregate
+	err = s.App.RatelimitKeeper.ResetRateLimit(s.Ctx, denom, channelId)
+	s.Require().NoError(err, "no error expected when resetting channel rate limit")
+	s.Require().True(s.App.RatelimitKeeper.CheckPacketSentDuringCurrentQuota(s.Ctx, channelId, 2), "packet 2 not removed")
+
+	// Undoing packet 2 should only decrement the aggregate rate limit, since the channel was reset
+	err = s.App.RatelimitKeeper.UndoSendPacket(s.Ctx, channelId, 2, denom, sender, sdkmath.NewInt(10))
+	s.Require().NoError(err, "no error expected when undoing packet 2")
+	checkOutflows(20, 0)
+}
+
+func (s *KeeperTestSuite) TestChannelGroups() {
+	channelGroups := []types.ChannelGroup{
+		{Name: "group-A", ChannelIds: []string{"channel-0"}},
+		{Name: "group-B", ChannelIds: []string{"channel-1", "channel-2"}},
+	}
+	for _, channelGroup := range channelGroups {
+		s.App.RatelimitKeeper.SetChannelGroup(s.Ctx, channelGroup)
+	}
+	s.Require().Equal(channelGroups, s.App.RatelimitKeeper.GetAllChannelGroups(s.Ctx), "all channel groups")
+
+	channelGroup, found := s.App.RatelimitKeeper.GetChannelGroup(s.Ctx, "group-B")
+	s.Require().True(found, "group-B found")
+	s.Require().Equal(channelGroups[1], channelGroup, "group-B")
+
+	// Only the rate limits that apply to the channel should be returned for a packet
+	for _, rateLimitChannelId := range []string{"channel-1", "channel-2", types.AnyChannelId, "group/group-A", "group/group-B"} {
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{Path: &types.Path{Denom: denom, ChannelId: rateLimitChannelId}})
+	}
+	packetRateLimitChannelIds := []string{}
+	for _, rateLimit := range s.App.RatelimitKeeper.GetRateLimitsForPacket(s.Ctx, denom, "channel-1") {
+		packetRateLimitChannelIds = append(packetRateLimitChannelIds, rateLimit.Path.ChannelId)
+	}
+	s.Require().ElementsMatch([]string{"channel-1", types.AnyChannelId, "group/group-B"}, packetRateLimitChannelIds, "packet rate limits")
+
+	s.App.RatelimitKeeper.RemoveChannelGroup(s.Ctx, "group-A")
+	_, found = s.App.RatelimitKeeper.GetChannelGroup(s.Ctx, "group-A")
+	s.Require().False(found, "group-A removed")
+}
+
+func (s *KeeperTestSuite) TestMoveRateLimit() {
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{
+		Path:  &types.Path{Denom: denom, ChannelId: "channel-0"},
+		Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentRecv: sdkmath.NewInt(10), DurationHours: 24},
+		Flow:  &types.Flow{Inflow: sdkmath.NewInt(5), Outflow: sdkmath.NewInt(5), ChannelValue: sdkmath.NewInt(100)},
+	})
+	s.App.RatelimitKeeper.SetAddressFlow(s.Ctx, types.NewAddressFlow(denom, "channel-0", sender))
+
+	// Moving a rate limit that doesn't exist is a no-op
+	err := s.App.RatelimitKeeper.MoveRateLimit(s.Ctx, "other-denom", "channel-0", "channel-1")
+	s.Require().NoError(err, "no error expected when there's no rate limit to move")
+	_, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, "other-denom", "channel-1")
+	s.Require().False(found, "no rate limit should have been created")
+
+	// Moving onto a channel that already has a rate limit for the denom should fail
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{Path: &types.Path{Denom: denom, ChannelId: "channel-2"}})
+	err = s.App.RatelimitKeeper.MoveRateLimit(s.Ctx, denom, "channel-0", "channel-2")
+	s.Require().ErrorIs(err, types.ErrRateLimitAlreadyExists)
+
+	// Move the rate limit successfully - the quota is kept and the flow is reset
+	err = s.App.RatelimitKeeper.MoveRateLimit(s.Ctx, denom, "channel-0", "channel-1")
+	s.Require().NoError(err, "no error expected when moving the rate limit")
+
+	_, found = s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, "channel-0")
+	s.Require().False(found, "rate limit should have been removed from the old channel")
+	s.Require().Empty(s.App.RatelimitKeeper.GetAllAddressFlows(s.Ctx), "address flows of the old channel should be removed")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, "channel-1")
+	s.Require().True(found, "rate limit should be on the new channel")
+	s.Require().Equal(int64(10), rateLimit.Quota.MaxPercentSend.Int64(), "quota")
+	s.Require().Zero(rateLimit.Flow.Inflow.Int64(), "inflow")
+	s.Require().Zero(rateLimit.Flow.Outflow.Int64(), "outflow")
+}
+
+func (s *KeeperTestSuite) TestRemoveDenomRateLimits() {
+	for _, channelId := range []string{"channel-0", "channel-1", types.AnyChannelId} {
+		s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{Path: &types.Path{Denom: denom, ChannelId: channelId}})
+		s.App.RatelimitKeeper.SetAddressFlow(s.Ctx, types.NewAddressFlow(denom, channelId, sender))
+	}
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, types.RateLimit{Path: &types.Path{Denom: "other-denom", ChannelId: "channel-0"}})
+	s.App.RatelimitKeeper.SetAddressFlow(s.Ctx, types.NewAddressFlow("other-denom", "channel-0", sender))
+
+	s.App.RatelimitKeeper.RemoveDenomRateLimits(s.Ctx, denom)
+
+	// Only the rate limit and address flow of the other denom should remain
+	rateLimits := s.App.RatelimitKeeper.GetAllRateLimits(s.Ctx)
+	s.Require().Len(rateLimits, 1, "number of rate limits")
+	s.Require().Equal("other-denom", rateLimits[0].Path.Denom, "remaining rate limit denom")
+
+	addressFlows := s.App.RatelimitKeeper.GetAllAddressFlows(s.Ctx)
+	s.Require().Len(addressFlows, 1, "number of address flows")
+	s.Require().Equal("other-denom", addressFlows[0].Denom, "remaining address flow denom")
+}
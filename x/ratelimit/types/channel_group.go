@@ -0,0 +1,96 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// The channel-id of an aggregate rate limit that applies to a denom across every channel
+	AnyChannelId = "*"
+	// The channel-id prefix of an aggregate rate limit that applies to a denom across a channel group
+	ChannelGroupPrefix = "group/"
+)
+
+var (
+	channelIdRegex        = regexp.MustCompile(`^channel-\d+$`)
+	channelGroupNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+)
+
+// Returns the channel-id used for the rate limit of a channel group (e.g. group/osmosis)
+func GetChannelGroupChannelId(name string) string {
+	return ChannelGroupPrefix + name
+}
+
+// Returns the channel group name from the channel-id of a channel group rate limit,
+// and false if the channel-id is not for a channel group
+func ParseChannelGroupChannelId(channelId string) (name string, isGroup bool) {
+	if !strings.HasPrefix(channelId, ChannelGroupPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(channelId, ChannelGroupPrefix), true
+}
+
+// Returns true if the channel-id of a rate limit is for an aggregate across multiple channels
+// (either every channel or a channel group), rather than an individual channel
+func IsAggregateChannelId(channelId string) bool {
+	_, isGroup := ParseChannelGroupChannelId(channelId)
+	return channelId == AnyChannelId || isGroup
+}
+
+// Validates the channel-id of a rate limit, which can be an individual channel,
+// every channel ('*'), or a channel group ('group/{name}')
+func ValidateRateLimitChannelId(channelId string) error {
+	if channelId == AnyChannelId || channelIdRegex.MatchString(channelId) {
+		return nil
+	}
+	if name, isGroup := ParseChannelGroupChannelId(channelId); isGroup && channelGroupNameRegex.MatchString(name) {
+		return nil
+	}
+	return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+		"invalid channel-id (%s), must be of the format 'channel-{N}', '%s' or '%s{name}'", channelId, AnyChannelId, ChannelGroupPrefix)
+}
+
+// Validates the name of a channel group
+func ValidateChannelGroupName(name string) error {
+	if !channelGroupNameRegex.MatchString(name) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"invalid channel group name (%s), must only contain alphanumeric characters, hyphens and underscores", name)
+	}
+	return nil
+}
+
+// Validates the name and channels of a channel group
+func (g ChannelGroup) Validate() error {
+	if err := ValidateChannelGroupName(g.Name); err != nil {
+		return err
+	}
+	if len(g.ChannelIds) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "channel group %s must have at least one channel", g.Name)
+	}
+
+	channelIds := map[string]bool{}
+	for _, channelId := range g.ChannelIds {
+		if !channelIdRegex.MatchString(channelId) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel-id (%s), must be of the format 'channel-{N}'", channelId)
+		}
+		if channelIds[channelId] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate channel-id (%s) in channel group %s", channelId, g.Name)
+		}
+		channelIds[channelId] = true
+	}
+	return nil
+}
+
+// Returns true if the channel is part of the group
+func (g ChannelGroup) ContainsChannel(channelId string) bool {
+	for _, groupChannelId := range g.ChannelIds {
+		if groupChannelId == channelId {
+			return true
+		}
+	}
+	return false
+}
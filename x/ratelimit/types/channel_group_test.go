@@ -0,0 +1,98 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v10/x/ratelimit/types"
+)
+
+func TestValidateRateLimitChannelId(t *testing.T) {
+	testCases := []struct {
+		channelId string
+		valid     bool
+	}{
+		{channelId: "channel-0", valid: true},
+		{channelId: "channel-123", valid: true},
+		{channelId: "*", valid: true},
+		{channelId: "group/osmosis", valid: true},
+		{channelId: "group/osmosis_pools-1", valid: true},
+		{channelId: "", valid: false},
+		{channelId: "chan-1", valid: false},
+		{channelId: "channel-", valid: false},
+		{channelId: "**", valid: false},
+		{channelId: "group/", valid: false},
+		{channelId: "group/osmosis/pools", valid: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.channelId, func(t *testing.T) {
+			err := types.ValidateRateLimitChannelId(tc.channelId)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, "invalid channel-id")
+			}
+		})
+	}
+}
+
+func TestParseChannelGroupChannelId(t *testing.T) {
+	name, isGroup := types.ParseChannelGroupChannelId(types.GetChannelGroupChannelId("osmosis"))
+	require.True(t, isGroup, "group channel-id")
+	require.Equal(t, "osmosis", name, "group name")
+
+	_, isGroup = types.ParseChannelGroupChannelId("channel-0")
+	require.False(t, isGroup, "individual channel-id")
+
+	require.True(t, types.IsAggregateChannelId("*"), "any channel")
+	require.True(t, types.IsAggregateChannelId("group/osmosis"), "channel group")
+	require.False(t, types.IsAggregateChannelId("channel-0"), "individual channel")
+}
+
+func TestChannelGroupValidate(t *testing.T) {
+	testCases := []struct {
+		name         string
+		channelGroup types.ChannelGroup
+		err          string
+	}{
+		{
+			name:         "valid group",
+			channelGroup: types.ChannelGroup{Name: "osmosis", ChannelIds: []string{"channel-0", "channel-1"}},
+		},
+		{
+			name:         "invalid name",
+			channelGroup: types.ChannelGroup{Name: "", ChannelIds: []string{"channel-0"}},
+			err:          "invalid channel group name",
+		},
+		{
+			name:         "no channels",
+			channelGroup: types.ChannelGroup{Name: "osmosis"},
+			err:          "must have at least one channel",
+		},
+		{
+			name:         "invalid channel",
+			channelGroup: types.ChannelGroup{Name: "osmosis", ChannelIds: []string{"group/other"}},
+			err:          "invalid channel-id",
+		},
+		{
+			name:         "duplicate channel",
+			channelGroup: types.ChannelGroup{Name: "osmosis", ChannelIds: []string{"channel-0", "channel-0"}},
+			err:          "duplicate channel-id",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.channelGroup.Validate()
+			if tc.err == "" {
+				require.NoError(t, err)
+				require.True(t, tc.channelGroup.ContainsChannel("channel-1"), "contains channel")
+				require.False(t, tc.channelGroup.ContainsChannel("channel-2"), "does not contain channel")
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
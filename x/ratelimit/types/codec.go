@@ -14,6 +14,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&UpdateRateLimitProposal{},
 		&RemoveRateLimitProposal{},
 		&ResetRateLimitProposal{},
+		&AddBlacklistedDenomProposal{},
+		&RemoveBlacklistedDenomProposal{},
+		&AddWhitelistedAddressPairProposal{},
+		&RemoveWhitelistedAddressPairProposal{},
+		&SetChannelGroupProposal{},
+		&RemoveChannelGroupProposal{},
 	)
 }
 
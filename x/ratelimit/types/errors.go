@@ -21,4 +21,20 @@ var (
 	ErrDenomIsBlacklisted = errorsmod.Register(ModuleName, 7,
 		"denom is blacklisted",
 	)
+	ErrDenomAlreadyBlacklisted = errorsmod.Register(ModuleName, 8,
+		"denom is already blacklisted")
+	ErrDenomNotBlacklisted = errorsmod.Register(ModuleName, 9,
+		"denom is not blacklisted")
+	ErrAddressPairAlreadyWhitelisted = errorsmod.Register(ModuleName, 10,
+		"address pair is already whitelisted")
+	ErrAddressPairNotWhitelisted = errorsmod.Register(ModuleName, 11,
+		"address pair is not whitelisted")
+	ErrInvalidRateLimit = errorsmod.Register(ModuleName, 12,
+		"invalid rate limit")
+	ErrPerAddressQuotaExceeded = errorsmod.Register(ModuleName, 13,
+		"per address quota exceeded")
+	ErrChannelGroupNotFound = errorsmod.Register(ModuleName, 14,
+		"channel group not found")
+	ErrChannelGroupInUse = errorsmod.Register(ModuleName, 15,
+		"channel group has rate limits")
 )
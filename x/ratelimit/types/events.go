@@ -3,14 +3,25 @@ package types
 var (
 	EventTransferDenied = "transfer_denied"
 
-	EventRateLimitExceeded = "rate_limit_exceeded"
-	EventBlacklistedDenom  = "blacklisted_denom"
+	EventRateLimitExceeded       = "rate_limit_exceeded"
+	EventPerAddressQuotaExceeded = "per_address_quota_exceeded"
+	EventBlacklistedDenom        = "blacklisted_denom"
 
-	AttributeKeyReason  = "reason"
-	AttributeKeyModule  = "module"
-	AttributeKeyAction  = "action"
-	AttributeKeyDenom   = "denom"
-	AttributeKeyChannel = "channel"
-	AttributeKeyAmount  = "amount"
-	AttributeKeyError   = "error"
+	EventAddBlacklistedDenom          = "add_blacklisted_denom"
+	EventRemoveBlacklistedDenom       = "remove_blacklisted_denom"
+	EventAddWhitelistedAddressPair    = "add_whitelisted_address_pair"
+	EventRemoveWhitelistedAddressPair = "remove_whitelisted_address_pair"
+	EventSetChannelGroup              = "set_channel_group"
+	EventRemoveChannelGroup           = "remove_channel_group"
+
+	AttributeKeyReason   = "reason"
+	AttributeKeyModule   = "module"
+	AttributeKeyAction   = "action"
+	AttributeKeyDenom    = "denom"
+	AttributeKeyChannel  = "channel"
+	AttributeKeyAmount   = "amount"
+	AttributeKeyError    = "error"
+	AttributeKeySender   = "sender"
+	AttributeKeyReceiver = "receiver"
+	AttributeKeyName     = "name"
 )
@@ -7,6 +7,8 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	epochstypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
 )
 
 // BankKeeper defines the banking contract that must be fulfilled when
@@ -22,6 +24,12 @@ type ChannelKeeper interface {
 	GetChannelClientState(ctx sdk.Context, portID string, channelID string) (string, exported.ClientState, error)
 }
 
+// EpochsKeeper defines the epochs contract that must be fulfilled when
+// creating a x/ratelimit keeper.
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, bool)
+}
+
 // ICS4Wrapper defines the expected ICS4Wrapper for middleware
 type ICS4Wrapper interface {
 	WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error
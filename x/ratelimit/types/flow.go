@@ -18,13 +18,18 @@ func NewFlow(channelValue sdkmath.Int) Flow {
 
 // Adds an amount to the rate limit's flow after an incoming packet was received
 // Returns an error if the new inflow will cause the rate limit to exceed its quota
+// By default, the net inflow (inflow minus outflow) is checked against the quota,
+// unless the quota measures the gross flow
 func (f *Flow) AddInflow(amount sdkmath.Int, quota Quota) error {
-	netInflow := f.Inflow.Sub(f.Outflow).Add(amount)
+	measuredInflow := f.Inflow.Add(amount)
+	if quota.FlowMeasurement == FLOW_NET {
+		measuredInflow = measuredInflow.Sub(f.Outflow)
+	}
 
-	if quota.CheckExceedsQuota(PACKET_RECV, netInflow, f.ChannelValue) {
+	if quota.CheckExceedsQuota(PACKET_RECV, measuredInflow, f.ChannelValue) {
 		return errorsmod.Wrapf(ErrQuotaExceeded,
-			"Inflow exceeds quota - Net Inflow: %v, Channel Value: %v, Threshold: %v%%",
-			netInflow, f.ChannelValue, quota.MaxPercentRecv)
+			"Inflow exceeds quota - %s Inflow: %v, Channel Value: %v, Threshold: %v%%, Max Amount: %v",
+			flowMeasurementLabel(quota.FlowMeasurement), measuredInflow, f.ChannelValue, quota.MaxPercentRecv, quota.MaxAmountRecv)
 	}
 
 	f.Inflow = f.Inflow.Add(amount)
@@ -33,15 +38,165 @@ func (f *Flow) AddInflow(amount sdkmath.Int, quota Quota) error {
 
 // Adds an amount to the rate limit's flow after a packet was sent
 // Returns an error if the new outflow will cause the rate limit to exceed its quota
+// By default, the net outflow (outflow minus inflow) is checked against the quota,
+// unless the quota measures the gross flow
 func (f *Flow) AddOutflow(amount sdkmath.Int, quota Quota) error {
-	netOutflow := f.Outflow.Sub(f.Inflow).Add(amount)
+	measuredOutflow := f.Outflow.Add(amount)
+	if quota.FlowMeasurement == FLOW_NET {
+		measuredOutflow = measuredOutflow.Sub(f.Inflow)
+	}
 
-	if quota.CheckExceedsQuota(PACKET_SEND, netOutflow, f.ChannelValue) {
+	if quota.CheckExceedsQuota(PACKET_SEND, measuredOutflow, f.ChannelValue) {
 		return errorsmod.Wrapf(ErrQuotaExceeded,
-			"Outflow exceeds quota - Net Outflow: %v, Channel Value: %v, Threshold: %v%%",
-			netOutflow, f.ChannelValue, quota.MaxPercentSend)
+			"Outflow exceeds quota - %s Outflow: %v, Channel Value: %v, Threshold: %v%%, Max Amount: %v",
+			flowMeasurementLabel(quota.FlowMeasurement), measuredOutflow, f.ChannelValue, quota.MaxPercentSend, quota.MaxAmountSend)
 	}
 
 	f.Outflow = f.Outflow.Add(amount)
 	return nil
 }
+
+// Initializes an address's flow along a rate limited path
+func NewAddressFlow(denom, channelId, address string) AddressFlow {
+	return AddressFlow{
+		Denom:     denom,
+		ChannelId: channelId,
+		Address:   address,
+		Inflow:    sdkmath.ZeroInt(),
+		Outflow:   sdkmath.ZeroInt(),
+	}
+}
+
+// Adds an amount to an address's flow in either the SEND or RECV direction
+// Returns an error if the new flow will cause the address to exceed the quota's address sub-quota
+// The flow is measured against the channel value of the parent rate limit
+func (f *AddressFlow) AddFlow(direction PacketDirection, amount sdkmath.Int, quota Quota, channelValue sdkmath.Int) error {
+	flow := Flow{
+		Inflow:       f.Inflow,
+		Outflow:      f.Outflow,
+		ChannelValue: channelValue,
+	}
+
+	if quota.AddressQuota != nil && quota.AddressQuota.HasLimit(direction) {
+		var err error
+		if direction == PACKET_RECV {
+			err = flow.AddInflow(amount, quota.GetAddressSubQuota())
+		} else {
+			err = flow.AddOutflow(amount, quota.GetAddressSubQuota())
+		}
+		if err != nil {
+			return errorsmod.Wrapf(ErrPerAddressQuotaExceeded, "address %s: %s", f.Address, err.Error())
+		}
+	} else if direction == PACKET_RECV {
+		flow.Inflow = flow.Inflow.Add(amount)
+	} else {
+		flow.Outflow = flow.Outflow.Add(amount)
+	}
+
+	f.Inflow = flow.Inflow
+	f.Outflow = flow.Outflow
+	return nil
+}
+
+// Records an amount in the hourly flow for the given epoch hour, creating the hourly flow
+// if it's the first transfer of the hour
+// Used by sliding window quotas, after the amount has been added to the total inflow or outflow
+func (f *Flow) AddHourlyFlow(direction PacketDirection, amount sdkmath.Int, epochHour uint64) {
+	numHourlyFlows := len(f.HourlyFlows)
+	if numHourlyFlows == 0 || f.HourlyFlows[numHourlyFlows-1].EpochHour != epochHour {
+		f.HourlyFlows = append(f.HourlyFlows, HourlyFlow{
+			EpochHour: epochHour,
+			Inflow:    sdkmath.ZeroInt(),
+			Outflow:   sdkmath.ZeroInt(),
+		})
+	}
+
+	currentHourlyFlow := &f.HourlyFlows[len(f.HourlyFlows)-1]
+	if direction == PACKET_RECV {
+		currentHourlyFlow.Inflow = currentHourlyFlow.Inflow.Add(amount)
+	} else {
+		currentHourlyFlow.Outflow = currentHourlyFlow.Outflow.Add(amount)
+	}
+}
+
+// Removes an outflow from the hourly flow of the given epoch hour, as well as from the total outflow
+// If the hour has already left the window, the outflow is no longer counted, so nothing is removed
+// Returns whether the outflow was removed
+func (f *Flow) RemoveHourlyOutflow(amount sdkmath.Int, epochHour uint64) bool {
+	for i, hourlyFlow := range f.HourlyFlows {
+		if hourlyFlow.EpochHour == epochHour {
+			f.HourlyFlows[i].Outflow = hourlyFlow.Outflow.Sub(amount)
+			f.Outflow = f.Outflow.Sub(amount)
+			return true
+		}
+	}
+	return false
+}
+
+// Drops the hourly flows that fall outside of the window of the last durationHours epochs
+// (including the current epoch), and removes them from the total inflow and outflow
+func (f *Flow) PruneHourlyFlows(currentEpochHour uint64, durationHours uint64) {
+	remainingHourlyFlows := []HourlyFlow{}
+	for _, hourlyFlow := range f.HourlyFlows {
+		if hourlyFlow.EpochHour+durationHours > currentEpochHour {
+			remainingHourlyFlows = append(remainingHourlyFlows, hourlyFlow)
+			continue
+		}
+		f.Inflow = f.Inflow.Sub(hourlyFlow.Inflow)
+		f.Outflow = f.Outflow.Sub(hourlyFlow.Outflow)
+	}
+	f.HourlyFlows = remainingHourlyFlows
+}
+
+// Assigns any inflow or outflow that is not yet tracked in an hourly flow to the given epoch hour
+// This is used for sliding window rate limits imported from genesis with a flow but no hourly flows,
+// so that the flow is counted for the full window rather than dropped
+// (a quota can't otherwise switch to a sliding window with a flow, since UpdateRateLimit resets the flow)
+func (f *Flow) InitializeHourlyFlows(epochHour uint64) {
+	untrackedInflow := f.Inflow
+	untrackedOutflow := f.Outflow
+	for _, hourlyFlow := range f.HourlyFlows {
+		untrackedInflow = untrackedInflow.Sub(hourlyFlow.Inflow)
+		untrackedOutflow = untrackedOutflow.Sub(hourlyFlow.Outflow)
+	}
+
+	if untrackedInflow.IsPositive() {
+		f.AddHourlyFlow(PACKET_RECV, untrackedInflow, epochHour)
+	}
+	if untrackedOutflow.IsPositive() {
+		f.AddHourlyFlow(PACKET_SEND, untrackedOutflow, epochHour)
+	}
+}
+
+// Validates that the flow amounts are set and non-negative, and that the hourly flows
+// are ordered by epoch hour and sum to no more than the total inflow and outflow
+func (f *Flow) Validate() error {
+	if f.Inflow.IsNil() || f.Inflow.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "inflow must be non-negative, provided: %v", f.Inflow)
+	}
+	if f.Outflow.IsNil() || f.Outflow.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "outflow must be non-negative, provided: %v", f.Outflow)
+	}
+	if f.ChannelValue.IsNil() || f.ChannelValue.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "channel value must be non-negative, provided: %v", f.ChannelValue)
+	}
+
+	hourlyInflow := sdkmath.ZeroInt()
+	hourlyOutflow := sdkmath.ZeroInt()
+	for i, hourlyFlow := range f.HourlyFlows {
+		if i > 0 && hourlyFlow.EpochHour <= f.HourlyFlows[i-1].EpochHour {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "hourly flows must be in increasing order of epoch hour")
+		}
+		if hourlyFlow.Inflow.IsNil() || hourlyFlow.Inflow.IsNegative() ||
+			hourlyFlow.Outflow.IsNil() || hourlyFlow.Outflow.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "hourly flow for epoch hour %d must be non-negative", hourlyFlow.EpochHour)
+		}
+		hourlyInflow = hourlyInflow.Add(hourlyFlow.Inflow)
+		hourlyOutflow = hourlyOutflow.Add(hourlyFlow.Outflow)
+	}
+	if hourlyInflow.GT(f.Inflow) || hourlyOutflow.GT(f.Outflow) {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "hourly flows exceed the total inflow (%v) or outflow (%v)", f.Inflow, f.Outflow)
+	}
+
+	return nil
+}
@@ -226,3 +226,281 @@ func TestOutInflow(t *testing.T) {
 		})
 	}
 }
+
+func TestAddFlow_GrossMeasurement(t *testing.T) {
+	totalValue := sdkmath.NewInt(100)
+	netQuota := types.Quota{
+		MaxPercentRecv: sdkmath.NewInt(10),
+		MaxPercentSend: sdkmath.NewInt(10),
+		DurationHours:  uint64(1),
+	}
+	grossQuota := netQuota
+	grossQuota.FlowMeasurement = types.FLOW_GROSS
+
+	// With an inflow of 8 and outflow of 6, a transfer of 5 in either direction keeps the
+	// net flow under the threshold of 10, but puts the gross flow over it
+	newFlow := func() types.Flow {
+		return types.Flow{Inflow: sdkmath.NewInt(8), Outflow: sdkmath.NewInt(6), ChannelValue: totalValue}
+	}
+
+	flow := newFlow()
+	require.NoError(t, flow.AddInflow(sdkmath.NewInt(5), netQuota), "net inflow")
+	require.Equal(t, int64(13), flow.Inflow.Int64(), "net inflow")
+
+	flow = newFlow()
+	require.NoError(t, flow.AddOutflow(sdkmath.NewInt(5), netQuota), "net outflow")
+	require.Equal(t, int64(11), flow.Outflow.Int64(), "net outflow")
+
+	flow = newFlow()
+	require.ErrorContains(t, flow.AddInflow(sdkmath.NewInt(5), grossQuota), "Inflow exceeds quota - Gross Inflow: 13")
+	require.Equal(t, newFlow(), flow, "gross inflow")
+
+	flow = newFlow()
+	require.ErrorContains(t, flow.AddOutflow(sdkmath.NewInt(5), grossQuota), "Outflow exceeds quota - Gross Outflow: 11")
+	require.Equal(t, newFlow(), flow, "gross outflow")
+
+	// A transfer that keeps the gross flow under the threshold succeeds
+	flow = newFlow()
+	require.NoError(t, flow.AddOutflow(sdkmath.NewInt(4), grossQuota), "gross outflow under threshold")
+	require.Equal(t, int64(10), flow.Outflow.Int64(), "gross outflow under threshold")
+}
+
+func TestAddAddressFlow(t *testing.T) {
+	channelValue := sdkmath.NewInt(100)
+	quota := types.Quota{
+		MaxPercentSend: sdkmath.NewInt(10),
+		MaxPercentRecv: sdkmath.NewInt(10),
+		DurationHours:  uint64(1),
+		AddressQuota: &types.AddressQuota{
+			MaxPercentSend: sdkmath.NewInt(5),
+			MaxAmountRecv:  sdkmath.NewInt(3),
+		},
+	}
+
+	// The outflow is limited to 5% of the channel value
+	addressFlow := types.NewAddressFlow("denom", "channel-0", "address")
+	require.NoError(t, addressFlow.AddFlow(types.PACKET_SEND, sdkmath.NewInt(5), quota, channelValue), "outflow under quota")
+	err := addressFlow.AddFlow(types.PACKET_SEND, sdkmath.NewInt(1), quota, channelValue)
+	require.ErrorIs(t, err, types.ErrPerAddressQuotaExceeded, "outflow over quota")
+	require.ErrorContains(t, err, "address address: Outflow exceeds quota")
+	require.Equal(t, int64(5), addressFlow.Outflow.Int64(), "outflow")
+
+	// The net inflow is limited to 3 absolute
+	require.NoError(t, addressFlow.AddFlow(types.PACKET_RECV, sdkmath.NewInt(8), quota, channelValue), "inflow under quota")
+	err = addressFlow.AddFlow(types.PACKET_RECV, sdkmath.NewInt(1), quota, channelValue)
+	require.ErrorIs(t, err, types.ErrPerAddressQuotaExceeded, "inflow over quota")
+	require.Equal(t, int64(8), addressFlow.Inflow.Int64(), "inflow")
+
+	// A direction without an address threshold is tracked but not limited
+	quota.AddressQuota = &types.AddressQuota{MaxPercentRecv: sdkmath.NewInt(5)}
+	require.NoError(t, addressFlow.AddFlow(types.PACKET_SEND, sdkmath.NewInt(50), quota, channelValue), "unlimited outflow")
+	require.Equal(t, int64(55), addressFlow.Outflow.Int64(), "outflow")
+}
+
+func TestAddHourlyFlow(t *testing.T) {
+	hourlyFlow := func(epochHour uint64, inflow, outflow int64) types.HourlyFlow {
+		return types.HourlyFlow{EpochHour: epochHour, Inflow: sdkmath.NewInt(inflow), Outflow: sdkmath.NewInt(outflow)}
+	}
+
+	tests := []struct {
+		name                string
+		hourlyFlows         []types.HourlyFlow
+		direction           types.PacketDirection
+		epochHour           uint64
+		expectedHourlyFlows []types.HourlyFlow
+	}{
+		{
+			name:                "AddHourlyFlow__First hourly flow",
+			hourlyFlows:         []types.HourlyFlow{},
+			direction:           types.PACKET_RECV,
+			epochHour:           5,
+			expectedHourlyFlows: []types.HourlyFlow{hourlyFlow(5, 10, 0)},
+		},
+		{
+			name:                "AddHourlyFlow__Existing hourly flow",
+			hourlyFlows:         []types.HourlyFlow{hourlyFlow(4, 1, 1), hourlyFlow(5, 2, 2)},
+			direction:           types.PACKET_SEND,
+			epochHour:           5,
+			expectedHourlyFlows: []types.HourlyFlow{hourlyFlow(4, 1, 1), hourlyFlow(5, 2, 12)},
+		},
+		{
+			name:                "AddHourlyFlow__New hour",
+			hourlyFlows:         []types.HourlyFlow{hourlyFlow(4, 1, 1)},
+			direction:           types.PACKET_SEND,
+			epochHour:           5,
+			expectedHourlyFlows: []types.HourlyFlow{hourlyFlow(4, 1, 1), hourlyFlow(5, 0, 10)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			flow := types.Flow{HourlyFlows: test.hourlyFlows}
+			flow.AddHourlyFlow(test.direction, sdkmath.NewInt(10), test.epochHour)
+			require.Equal(t, test.expectedHourlyFlows, flow.HourlyFlows)
+		})
+	}
+}
+
+func TestPruneHourlyFlows(t *testing.T) {
+	hourlyFlows := []types.HourlyFlow{
+		{EpochHour: 3, Inflow: sdkmath.NewInt(1), Outflow: sdkmath.NewInt(10)},
+		{EpochHour: 4, Inflow: sdkmath.NewInt(2), Outflow: sdkmath.NewInt(20)},
+		{EpochHour: 6, Inflow: sdkmath.NewInt(3), Outflow: sdkmath.NewInt(30)},
+	}
+
+	tests := []struct {
+		name               string
+		currentEpochHour   uint64
+		durationHours      uint64
+		expectedEpochHours []uint64
+		expectedInflow     int64
+		expectedOutflow    int64
+	}{
+		{
+			name:               "PruneHourlyFlows__All hours in window",
+			currentEpochHour:   6,
+			durationHours:      4,
+			expectedEpochHours: []uint64{3, 4, 6},
+			expectedInflow:     6,
+			expectedOutflow:    60,
+		},
+		{
+			name:               "PruneHourlyFlows__Oldest hour leaves window",
+			currentEpochHour:   6,
+			durationHours:      3,
+			expectedEpochHours: []uint64{4, 6},
+			expectedInflow:     5,
+			expectedOutflow:    50,
+		},
+		{
+			name:               "PruneHourlyFlows__Only current hour in window",
+			currentEpochHour:   6,
+			durationHours:      1,
+			expectedEpochHours: []uint64{6},
+			expectedInflow:     3,
+			expectedOutflow:    30,
+		},
+		{
+			name:               "PruneHourlyFlows__No hours in window",
+			currentEpochHour:   10,
+			durationHours:      2,
+			expectedEpochHours: []uint64{},
+			expectedInflow:     0,
+			expectedOutflow:    0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			flow := types.Flow{
+				Inflow:      sdkmath.NewInt(6),
+				Outflow:     sdkmath.NewInt(60),
+				HourlyFlows: append([]types.HourlyFlow{}, hourlyFlows...),
+			}
+			flow.PruneHourlyFlows(test.currentEpochHour, test.durationHours)
+
+			actualEpochHours := []uint64{}
+			for _, hourlyFlow := range flow.HourlyFlows {
+				actualEpochHours = append(actualEpochHours, hourlyFlow.EpochHour)
+			}
+			require.Equal(t, test.expectedEpochHours, actualEpochHours, "epoch hours")
+			require.Equal(t, test.expectedInflow, flow.Inflow.Int64(), "inflow")
+			require.Equal(t, test.expectedOutflow, flow.Outflow.Int64(), "outflow")
+		})
+	}
+}
+
+func TestInitializeHourlyFlows(t *testing.T) {
+	// Flow that isn't tracked in an hourly flow (e.g. from genesis) is attributed to the given hour
+	flow := types.Flow{Inflow: sdkmath.NewInt(5), Outflow: sdkmath.NewInt(7)}
+	flow.InitializeHourlyFlows(3)
+	require.Equal(t, []types.HourlyFlow{{EpochHour: 3, Inflow: sdkmath.NewInt(5), Outflow: sdkmath.NewInt(7)}}, flow.HourlyFlows)
+
+	// Once all flow is tracked, initializing again has no effect
+	flow.InitializeHourlyFlows(4)
+	require.Equal(t, []types.HourlyFlow{{EpochHour: 3, Inflow: sdkmath.NewInt(5), Outflow: sdkmath.NewInt(7)}}, flow.HourlyFlows)
+	require.Equal(t, int64(5), flow.Inflow.Int64(), "inflow")
+	require.Equal(t, int64(7), flow.Outflow.Int64(), "outflow")
+}
+
+func TestValidateFlow(t *testing.T) {
+	validHourlyFlows := []types.HourlyFlow{
+		{EpochHour: 1, Inflow: sdkmath.NewInt(2), Outflow: sdkmath.NewInt(3)},
+		{EpochHour: 2, Inflow: sdkmath.NewInt(3), Outflow: sdkmath.NewInt(4)},
+	}
+
+	tests := []struct {
+		name string
+		flow types.Flow
+		err  string
+	}{
+		{
+			name: "valid flow",
+			flow: types.NewFlow(sdkmath.NewInt(100)),
+		},
+		{
+			name: "valid flow with hourly flows",
+			flow: types.Flow{
+				Inflow:       sdkmath.NewInt(5),
+				Outflow:      sdkmath.NewInt(8),
+				ChannelValue: sdkmath.NewInt(100),
+				HourlyFlows:  validHourlyFlows,
+			},
+		},
+		{
+			name: "negative inflow",
+			flow: types.Flow{Inflow: sdkmath.NewInt(-1), Outflow: sdkmath.ZeroInt(), ChannelValue: sdkmath.ZeroInt()},
+			err:  "inflow must be non-negative",
+		},
+		{
+			name: "missing outflow",
+			flow: types.Flow{Inflow: sdkmath.ZeroInt(), ChannelValue: sdkmath.ZeroInt()},
+			err:  "outflow must be non-negative",
+		},
+		{
+			name: "negative channel value",
+			flow: types.Flow{Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.ZeroInt(), ChannelValue: sdkmath.NewInt(-1)},
+			err:  "channel value must be non-negative",
+		},
+		{
+			name: "hourly flows out of order",
+			flow: types.Flow{
+				Inflow:       sdkmath.NewInt(5),
+				Outflow:      sdkmath.NewInt(7),
+				ChannelValue: sdkmath.NewInt(100),
+				HourlyFlows:  []types.HourlyFlow{validHourlyFlows[1], validHourlyFlows[0]},
+			},
+			err: "hourly flows must be in increasing order of epoch hour",
+		},
+		{
+			name: "negative hourly flow",
+			flow: types.Flow{
+				Inflow:       sdkmath.NewInt(5),
+				Outflow:      sdkmath.NewInt(7),
+				ChannelValue: sdkmath.NewInt(100),
+				HourlyFlows:  []types.HourlyFlow{{EpochHour: 1, Inflow: sdkmath.NewInt(-1), Outflow: sdkmath.ZeroInt()}},
+			},
+			err: "hourly flow for epoch hour 1 must be non-negative",
+		},
+		{
+			name: "hourly flows exceed total",
+			flow: types.Flow{
+				Inflow:       sdkmath.NewInt(4),
+				Outflow:      sdkmath.NewInt(7),
+				ChannelValue: sdkmath.NewInt(100),
+				HourlyFlows:  validHourlyFlows,
+			},
+			err: "hourly flows exceed the total inflow",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.flow.Validate(), "test: %s", test.name)
+			} else {
+				require.ErrorContains(t, test.flow.Validate(), test.err, "test: %s", test.name)
+			}
+		})
+	}
+}
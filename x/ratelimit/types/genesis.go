@@ -1,5 +1,9 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -11,5 +15,64 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	channelGroupNames := map[string]bool{}
+	for _, channelGroup := range gs.ChannelGroups {
+		if err := channelGroup.Validate(); err != nil {
+			return err
+		}
+		if channelGroupNames[channelGroup.Name] {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "duplicate channel group %s", channelGroup.Name)
+		}
+		channelGroupNames[channelGroup.Name] = true
+	}
+
+	rateLimitPaths := map[string]bool{}
+	for _, rateLimit := range gs.RateLimits {
+		if rateLimit.Path == nil || rateLimit.Quota == nil || rateLimit.Flow == nil {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "rate limit path, quota and flow must all be specified")
+		}
+		if rateLimit.Path.Denom == "" || rateLimit.Path.ChannelId == "" {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "rate limit denom and channel-id must be specified")
+		}
+		if groupName, isGroup := ParseChannelGroupChannelId(rateLimit.Path.ChannelId); isGroup && !channelGroupNames[groupName] {
+			return errorsmod.Wrapf(ErrChannelGroupNotFound, "rate limit on %s", rateLimit.Path.ChannelId)
+		}
+
+		pathKey := rateLimit.Path.Denom + "/" + rateLimit.Path.ChannelId
+		if rateLimitPaths[pathKey] {
+			return errorsmod.Wrapf(ErrRateLimitAlreadyExists, "duplicate rate limit for %s", pathKey)
+		}
+		rateLimitPaths[pathKey] = true
+
+		if err := rateLimit.Quota.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid quota for rate limit %s", pathKey)
+		}
+		if err := rateLimit.Flow.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid flow for rate limit %s", pathKey)
+		}
+	}
+
+	addressFlowKeys := map[string]bool{}
+	for _, addressFlow := range gs.AddressFlows {
+		if addressFlow.Denom == "" || addressFlow.ChannelId == "" || addressFlow.Address == "" {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "address flow denom, channel-id and address must be specified")
+		}
+
+		addressFlowKey := addressFlow.Denom + "/" + addressFlow.ChannelId + "/" + addressFlow.Address
+		if addressFlowKeys[addressFlowKey] {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "duplicate address flow for %s", addressFlowKey)
+		}
+		addressFlowKeys[addressFlowKey] = true
+
+		if addressFlow.Inflow.IsNil() || addressFlow.Inflow.IsNegative() ||
+			addressFlow.Outflow.IsNil() || addressFlow.Outflow.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "address flow for %s must be non-negative", addressFlowKey)
+		}
+	}
+
+	return nil
 }
@@ -30,6 +30,8 @@ type GenesisState struct {
 	WhitelistedAddressPairs          []WhitelistedAddressPair `protobuf:"bytes,3,rep,name=whitelisted_address_pairs,json=whitelistedAddressPairs,proto3" json:"whitelisted_address_pairs" yaml:"whitelisted_address_pairs"`
 	BlacklistedDenoms                []string                 `protobuf:"bytes,4,rep,name=blacklisted_denoms,json=blacklistedDenoms,proto3" json:"blacklisted_denoms,omitempty"`
 	PendingSendPacketSequenceNumbers []string                 `protobuf:"bytes,5,rep,name=pending_send_packet_sequence_numbers,json=pendingSendPacketSequenceNumbers,proto3" json:"pending_send_packet_sequence_numbers,omitempty"`
+	AddressFlows                     []AddressFlow            `protobuf:"bytes,6,rep,name=address_flows,json=addressFlows,proto3" json:"address_flows" yaml:"address_flows"`
+	ChannelGroups                    []ChannelGroup           `protobuf:"bytes,7,rep,name=channel_groups,json=channelGroups,proto3" json:"channel_groups" yaml:"channel_groups"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +102,20 @@ func (m *GenesisState) GetPendingSendPacketSequenceNumbers() []string {
 	return nil
 }
 
+func (m *GenesisState) GetAddressFlows() []AddressFlow {
+	if m != nil {
+		return m.AddressFlows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelGroups() []ChannelGroup {
+	if m != nil {
+		return m.ChannelGroups
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stride.ratelimit.GenesisState")
 }
@@ -107,33 +123,37 @@ func init() {
 func init() { proto.RegisterFile("stride/ratelimit/genesis.proto", fileDescriptor_9e224b293959881c) }
 
 var fileDescriptor_9e224b293959881c = []byte{
-	// 402 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x6b, 0xd4, 0x40,
-	0x18, 0x86, 0x37, 0xae, 0x16, 0x9c, 0x55, 0xd0, 0x41, 0x31, 0xae, 0x98, 0x86, 0xe0, 0x21, 0x97,
-	0x26, 0xda, 0xde, 0xbc, 0x19, 0x84, 0x5e, 0x6a, 0x59, 0x92, 0x83, 0xe2, 0x25, 0x4c, 0x32, 0x1f,
-	0xe9, 0xd0, 0xcd, 0x24, 0xce, 0x37, 0x6b, 0xed, 0x9f, 0x10, 0xff, 0x92, 0xb7, 0x1e, 0x7b, 0xf4,
-	0x54, 0x64, 0xf7, 0x1f, 0xf8, 0x0b, 0x24, 0x33, 0x63, 0x5d, 0x8c, 0xbd, 0x0d, 0xbc, 0xef, 0xf3,
-	0xbc, 0x21, 0x7c, 0x24, 0x40, 0xad, 0x04, 0x87, 0x54, 0x31, 0x0d, 0x4b, 0xd1, 0x0a, 0x9d, 0x36,
-	0x20, 0x01, 0x05, 0x26, 0xbd, 0xea, 0x74, 0x47, 0x1f, 0xd8, 0x3c, 0xb9, 0xce, 0xe7, 0x8f, 0x9a,
-	0xae, 0xe9, 0x4c, 0x98, 0x0e, 0x2f, 0xdb, 0x9b, 0x3f, 0x1f, 0x79, 0x7a, 0xa6, 0x58, 0xeb, 0x34,
-	0xf3, 0x70, 0x14, 0x5f, 0xbf, 0x6c, 0x23, 0xfa, 0x3e, 0x25, 0xf7, 0x0e, 0xed, 0x74, 0xa1, 0x99,
-	0x06, 0x7a, 0x48, 0x76, 0xac, 0xc2, 0xf7, 0x42, 0x2f, 0x9e, 0xed, 0xfb, 0xc9, 0xbf, 0x9f, 0x92,
-	0x2c, 0x4c, 0x9e, 0x3d, 0xbe, 0xb8, 0xda, 0x9d, 0xfc, 0xba, 0xda, 0xbd, 0x7f, 0xce, 0xda, 0xe5,
-	0xeb, 0xc8, 0x52, 0x51, 0xee, 0x70, 0xfa, 0x81, 0xcc, 0x06, 0xa4, 0x34, 0x0c, 0xfa, 0xb7, 0xc2,
-	0x69, 0x3c, 0xdb, 0x7f, 0x36, 0xb6, 0xe5, 0x4c, 0xc3, 0xd1, 0xf0, 0xca, 0xe6, 0x4e, 0x48, 0xad,
-	0x70, 0x8b, 0x8e, 0x72, 0xa2, 0xfe, 0xd4, 0x90, 0x7e, 0xf5, 0xc8, 0xd3, 0xb3, 0x13, 0x31, 0x08,
-	0x50, 0x03, 0x2f, 0x19, 0xe7, 0x0a, 0x10, 0xcb, 0x9e, 0x09, 0x85, 0xfe, 0xd4, 0x0c, 0xc5, 0xe3,
-	0xa1, 0xf7, 0x7f, 0x91, 0x37, 0x96, 0x58, 0x30, 0xa1, 0xb2, 0xd8, 0xad, 0x86, 0x76, 0xf5, 0x46,
-	0x71, 0x94, 0x3f, 0x39, 0xfb, 0xaf, 0x01, 0xe9, 0x1e, 0xa1, 0xd5, 0x92, 0xd5, 0xa7, 0x0e, 0xe3,
-	0x20, 0xbb, 0x16, 0xfd, 0xdb, 0xe1, 0x34, 0xbe, 0x9b, 0x3f, 0xdc, 0x4a, 0xde, 0x9a, 0x80, 0x1e,
-	0x93, 0x17, 0x3d, 0x48, 0x2e, 0x64, 0x53, 0x22, 0x48, 0x5e, 0xf6, 0xac, 0x3e, 0x05, 0x5d, 0x22,
-	0x7c, 0x5a, 0x81, 0xac, 0xa1, 0x94, 0xab, 0xb6, 0x02, 0x85, 0xfe, 0x1d, 0x23, 0x08, 0x5d, 0xb7,
-	0x00, 0xc9, 0x17, 0xa6, 0x59, 0xb8, 0xe2, 0xb1, 0xed, 0x65, 0xef, 0x2e, 0xd6, 0x81, 0x77, 0xb9,
-	0x0e, 0xbc, 0x9f, 0xeb, 0xc0, 0xfb, 0xb6, 0x09, 0x26, 0x97, 0x9b, 0x60, 0xf2, 0x63, 0x13, 0x4c,
-	0x3e, 0x1e, 0x34, 0x42, 0x9f, 0xac, 0xaa, 0xa4, 0xee, 0xda, 0xb4, 0x30, 0xff, 0x63, 0xef, 0x88,
-	0x55, 0x98, 0xba, 0xb3, 0xf8, 0xfc, 0xea, 0x65, 0xfa, 0x65, 0xeb, 0x38, 0xf4, 0x79, 0x0f, 0x58,
-	0xed, 0x98, 0xcb, 0x38, 0xf8, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x27, 0xad, 0x41, 0xff, 0xa4, 0x02,
-	0x00, 0x00,
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x42, 0x83, 0xd8, 0x34, 0x08, 0x56, 0xad, 0x30, 0x81, 0xb8, 0x96, 0xc5, 0x21,
+	0x97, 0xc6, 0xd0, 0xde, 0xb8, 0x61, 0x10, 0xb9, 0x94, 0x2a, 0x72, 0x0e, 0x20, 0x2e, 0x66, 0x63,
+	0x0f, 0x8e, 0x55, 0x7b, 0x6d, 0x76, 0x36, 0x84, 0x3e, 0x02, 0x17, 0xc4, 0x63, 0xf5, 0xd8, 0x23,
+	0xa7, 0x0a, 0x25, 0x6f, 0xc0, 0x13, 0x20, 0xef, 0x6e, 0xdb, 0x14, 0xc3, 0x6d, 0xa4, 0xff, 0xff,
+	0xbf, 0x7f, 0x76, 0x35, 0xc4, 0x41, 0x29, 0xb2, 0x04, 0x7c, 0xc1, 0x24, 0xe4, 0x59, 0x91, 0x49,
+	0x3f, 0x05, 0x0e, 0x98, 0xe1, 0xa8, 0x12, 0xa5, 0x2c, 0xe9, 0x7d, 0xad, 0x8f, 0xae, 0xf4, 0xfe,
+	0x4e, 0x5a, 0xa6, 0xa5, 0x12, 0xfd, 0x7a, 0xd2, 0xbe, 0xfe, 0xa0, 0xc1, 0xa9, 0x98, 0x60, 0x85,
+	0xc1, 0xf4, 0xdd, 0x86, 0x7c, 0x35, 0x69, 0x87, 0xf7, 0x6d, 0x8b, 0x6c, 0x8f, 0x75, 0xf5, 0x54,
+	0x32, 0x09, 0x74, 0x4c, 0x3a, 0x1a, 0x61, 0x5b, 0xae, 0x35, 0xec, 0x1e, 0xd8, 0xa3, 0xbf, 0x57,
+	0x19, 0x4d, 0x94, 0x1e, 0xec, 0x9e, 0x5d, 0xec, 0xb5, 0x7e, 0x5f, 0xec, 0xf5, 0x4e, 0x59, 0x91,
+	0xbf, 0xf0, 0x74, 0xca, 0x0b, 0x4d, 0x9c, 0xbe, 0x27, 0xdd, 0x3a, 0x12, 0xa9, 0x0c, 0xda, 0xb7,
+	0xdc, 0xf6, 0xb0, 0x7b, 0xf0, 0xb8, 0x49, 0x0b, 0x99, 0x84, 0xa3, 0x7a, 0x0a, 0xfa, 0x06, 0x48,
+	0x35, 0x70, 0x23, 0xed, 0x85, 0x44, 0x5c, 0xda, 0x90, 0x7e, 0xb7, 0xc8, 0xa3, 0xe5, 0x3c, 0xab,
+	0x01, 0x28, 0x21, 0x89, 0x58, 0x92, 0x08, 0x40, 0x8c, 0x2a, 0x96, 0x09, 0xb4, 0xdb, 0xaa, 0x68,
+	0xd8, 0x2c, 0x7a, 0x77, 0x1d, 0x79, 0xa9, 0x13, 0x13, 0x96, 0x89, 0x60, 0x68, 0x5a, 0x5d, 0xdd,
+	0xfa, 0x5f, 0xb0, 0x17, 0x3e, 0x5c, 0xfe, 0x93, 0x80, 0x74, 0x9f, 0xd0, 0x59, 0xce, 0xe2, 0x13,
+	0x13, 0x4b, 0x80, 0x97, 0x05, 0xda, 0xb7, 0xdd, 0xf6, 0xf0, 0x6e, 0xf8, 0x60, 0x43, 0x79, 0xad,
+	0x04, 0x7a, 0x4c, 0x9e, 0x56, 0xc0, 0x93, 0x8c, 0xa7, 0x11, 0x02, 0x4f, 0xa2, 0x8a, 0xc5, 0x27,
+	0x20, 0x23, 0x84, 0xcf, 0x0b, 0xe0, 0x31, 0x44, 0x7c, 0x51, 0xcc, 0x40, 0xa0, 0xbd, 0xa5, 0x00,
+	0xae, 0xf1, 0x4e, 0x81, 0x27, 0x13, 0xe5, 0x9c, 0x1a, 0xe3, 0xb1, 0xf6, 0xd1, 0x8f, 0xa4, 0x77,
+	0xb9, 0xe9, 0xa7, 0xbc, 0x5c, 0xa2, 0xdd, 0x51, 0x5f, 0x30, 0x68, 0x7e, 0x81, 0xd9, 0xfa, 0x4d,
+	0x5e, 0x2e, 0x83, 0x27, 0xe6, 0xdd, 0x3b, 0xfa, 0xdd, 0x37, 0x08, 0x5e, 0xb8, 0xcd, 0xae, 0xad,
+	0x48, 0x13, 0x72, 0x2f, 0x9e, 0x33, 0xce, 0x21, 0x8f, 0x52, 0x51, 0x2e, 0x2a, 0xb4, 0xef, 0xa8,
+	0x0a, 0xa7, 0x59, 0xf1, 0x4a, 0xfb, 0xc6, 0xb5, 0x2d, 0x18, 0x98, 0x8e, 0x5d, 0xdd, 0x71, 0x93,
+	0xe1, 0x85, 0xbd, 0x78, 0xc3, 0x8c, 0xc1, 0xdb, 0xb3, 0x95, 0x63, 0x9d, 0xaf, 0x1c, 0xeb, 0xd7,
+	0xca, 0xb1, 0x7e, 0xac, 0x9d, 0xd6, 0xf9, 0xda, 0x69, 0xfd, 0x5c, 0x3b, 0xad, 0x0f, 0x87, 0x69,
+	0x26, 0xe7, 0x8b, 0xd9, 0x28, 0x2e, 0x0b, 0x7f, 0xaa, 0x1a, 0xf7, 0x8f, 0xd8, 0x0c, 0x7d, 0x73,
+	0xde, 0x5f, 0x9e, 0x3f, 0xf3, 0xbf, 0x6e, 0x1c, 0xb9, 0x3c, 0xad, 0x00, 0x67, 0x1d, 0x75, 0xe1,
+	0x87, 0x7f, 0x06, 0x00, 0x8f, 0x73, 0xfa, 0xb1, 0x6c, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -156,6 +176,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChannelGroups) > 0 {
+		for iNdEx := len(m.ChannelGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AddressFlows) > 0 {
+		for iNdEx := len(m.AddressFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.PendingSendPacketSequenceNumbers) > 0 {
 		for iNdEx := len(m.PendingSendPacketSequenceNumbers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.PendingSendPacketSequenceNumbers[iNdEx])
@@ -258,6 +306,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AddressFlows) > 0 {
+		for _, e := range m.AddressFlows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelGroups) > 0 {
+		for _, e := range m.ChannelGroups {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -461,6 +521,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.PendingSendPacketSequenceNumbers = append(m.PendingSendPacketSequenceNumbers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressFlows = append(m.AddressFlows, AddressFlow{})
+			if err := m.AddressFlows[len(m.AddressFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelGroups = append(m.ChannelGroups, ChannelGroup{})
+			if err := m.ChannelGroups[len(m.ChannelGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
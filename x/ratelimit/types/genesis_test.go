@@ -0,0 +1,167 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v10/x/ratelimit/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	validQuota := types.Quota{
+		MaxPercentSend: sdkmath.NewInt(10),
+		MaxPercentRecv: sdkmath.NewInt(10),
+		DurationHours:  24,
+	}
+	validFlow := types.NewFlow(sdkmath.NewInt(100))
+
+	newRateLimit := func(denom, channelId string, quota types.Quota, flow types.Flow) types.RateLimit {
+		return types.RateLimit{
+			Path:  &types.Path{Denom: denom, ChannelId: channelId},
+			Quota: &quota,
+			Flow:  &flow,
+		}
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		err      string
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				RateLimits: []types.RateLimit{
+					newRateLimit("denom", "channel-0", validQuota, validFlow),
+					newRateLimit("denom", "channel-1", validQuota, validFlow),
+				},
+			},
+		},
+		{
+			desc: "valid address flows",
+			genState: &types.GenesisState{
+				AddressFlows: []types.AddressFlow{
+					types.NewAddressFlow("denom", "channel-0", "address-1"),
+					types.NewAddressFlow("denom", "channel-0", "address-2"),
+				},
+			},
+		},
+		{
+			desc: "duplicate address flow",
+			genState: &types.GenesisState{
+				AddressFlows: []types.AddressFlow{
+					types.NewAddressFlow("denom", "channel-0", "address-1"),
+					types.NewAddressFlow("denom", "channel-0", "address-1"),
+				},
+			},
+			err: "duplicate address flow for denom/channel-0/address-1",
+		},
+		{
+			desc: "missing address flow address",
+			genState: &types.GenesisState{
+				AddressFlows: []types.AddressFlow{types.NewAddressFlow("denom", "channel-0", "")},
+			},
+			err: "address flow denom, channel-id and address must be specified",
+		},
+		{
+			desc: "negative address flow",
+			genState: &types.GenesisState{
+				AddressFlows: []types.AddressFlow{
+					{Denom: "denom", ChannelId: "channel-0", Address: "address", Inflow: sdkmath.NewInt(-1), Outflow: sdkmath.ZeroInt()},
+				},
+			},
+			err: "address flow for denom/channel-0/address must be non-negative",
+		},
+		{
+			desc: "valid aggregate rate limits",
+			genState: &types.GenesisState{
+				ChannelGroups: []types.ChannelGroup{{Name: "osmosis", ChannelIds: []string{"channel-0", "channel-1"}}},
+				RateLimits: []types.RateLimit{
+					newRateLimit("denom", "*", validQuota, validFlow),
+					newRateLimit("denom", "group/osmosis", validQuota, validFlow),
+				},
+			},
+		},
+		{
+			desc: "invalid channel group",
+			genState: &types.GenesisState{
+				ChannelGroups: []types.ChannelGroup{{Name: "osmosis"}},
+			},
+			err: "channel group osmosis must have at least one channel",
+		},
+		{
+			desc: "duplicate channel group",
+			genState: &types.GenesisState{
+				ChannelGroups: []types.ChannelGroup{
+					{Name: "osmosis", ChannelIds: []string{"channel-0"}},
+					{Name: "osmosis", ChannelIds: []string{"channel-1"}},
+				},
+			},
+			err: "duplicate channel group osmosis",
+		},
+		{
+			desc: "rate limit on missing channel group",
+			genState: &types.GenesisState{
+				RateLimits: []types.RateLimit{newRateLimit("denom", "group/osmosis", validQuota, validFlow)},
+			},
+			err: "rate limit on group/osmosis",
+		},
+		{
+			desc: "missing quota",
+			genState: &types.GenesisState{
+				RateLimits: []types.RateLimit{{Path: &types.Path{Denom: "denom", ChannelId: "channel-0"}, Flow: &validFlow}},
+			},
+			err: "rate limit path, quota and flow must all be specified",
+		},
+		{
+			desc: "missing denom",
+			genState: &types.GenesisState{
+				RateLimits: []types.RateLimit{newRateLimit("", "channel-0", validQuota, validFlow)},
+			},
+			err: "rate limit denom and channel-id must be specified",
+		},
+		{
+			desc: "duplicate rate limit",
+			genState: &types.GenesisState{
+				RateLimits: []types.RateLimit{
+					newRateLimit("denom", "channel-0", validQuota, validFlow),
+					newRateLimit("denom", "channel-0", validQuota, validFlow),
+				},
+			},
+			err: "duplicate rate limit for denom/channel-0",
+		},
+		{
+			desc: "invalid quota",
+			genState: &types.GenesisState{
+				RateLimits: []types.RateLimit{
+					newRateLimit("denom", "channel-0", types.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentRecv: sdkmath.NewInt(10)}, validFlow),
+				},
+			},
+			err: "invalid quota for rate limit denom/channel-0",
+		},
+		{
+			desc: "invalid flow",
+			genState: &types.GenesisState{
+				RateLimits: []types.RateLimit{
+					newRateLimit("denom", "channel-0", validQuota, types.Flow{Inflow: sdkmath.NewInt(-1)}),
+				},
+			},
+			err: "invalid flow for rate limit denom/channel-0",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
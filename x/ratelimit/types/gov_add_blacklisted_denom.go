@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	ProposalTypeAddBlacklistedDenom = "AddBlacklistedDenom"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddBlacklistedDenom)
+}
+
+var (
+	_ govtypes.Content = &AddBlacklistedDenomProposal{}
+)
+
+func NewAddBlacklistedDenomProposal(title, description, denom string) govtypes.Content {
+	return &AddBlacklistedDenomProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+	}
+}
+
+func (p *AddBlacklistedDenomProposal) GetTitle() string { return p.Title }
+
+func (p *AddBlacklistedDenomProposal) GetDescription() string { return p.Description }
+
+func (p *AddBlacklistedDenomProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddBlacklistedDenomProposal) ProposalType() string {
+	return ProposalTypeAddBlacklistedDenom
+}
+
+func (p *AddBlacklistedDenomProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.Denom == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom (%s)", p.Denom)
+	}
+
+	return nil
+}
+
+func (p AddBlacklistedDenomProposal) String() string {
+	return fmt.Sprintf(`Add Blacklisted Denom Proposal:
+	Title:           %s
+	Description:     %s
+	Denom:           %s
+  `, p.Title, p.Description, p.Denom)
+}
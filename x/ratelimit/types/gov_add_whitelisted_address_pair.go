@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	ProposalTypeAddWhitelistedAddressPair = "AddWhitelistedAddressPair"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddWhitelistedAddressPair)
+}
+
+var (
+	_ govtypes.Content = &AddWhitelistedAddressPairProposal{}
+)
+
+func NewAddWhitelistedAddressPairProposal(title, description, sender, receiver string) govtypes.Content {
+	return &AddWhitelistedAddressPairProposal{
+		Title:       title,
+		Description: description,
+		Sender:      sender,
+		Receiver:    receiver,
+	}
+}
+
+func (p *AddWhitelistedAddressPairProposal) GetTitle() string { return p.Title }
+
+func (p *AddWhitelistedAddressPairProposal) GetDescription() string { return p.Description }
+
+func (p *AddWhitelistedAddressPairProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddWhitelistedAddressPairProposal) ProposalType() string {
+	return ProposalTypeAddWhitelistedAddressPair
+}
+
+func (p *AddWhitelistedAddressPairProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.Sender == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid sender (%s)", p.Sender)
+	}
+	if p.Receiver == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid receiver (%s)", p.Receiver)
+	}
+
+	return nil
+}
+
+func (p AddWhitelistedAddressPairProposal) String() string {
+	return fmt.Sprintf(`Add Whitelisted Address Pair Proposal:
+	Title:           %s
+	Description:     %s
+	Sender:          %s
+	Receiver:        %s
+  `, p.Title, p.Description, p.Sender, p.Receiver)
+}
@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v10/app/apptesting"
+	"github.com/Stride-Labs/stride/v10/x/ratelimit/types"
+)
+
+func TestGovAddWhitelistedAddressPair(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validTitle := "AddWhitelistedAddressPair"
+	validDescription := "Updating the whitelist"
+	validSender := "sender"
+	validReceiver := "receiver"
+
+	tests := []struct {
+		name     string
+		proposal types.AddWhitelistedAddressPairProposal
+		err      string
+	}{
+		{
+			name: "successful message",
+			proposal: types.AddWhitelistedAddressPairProposal{
+				Title:       validTitle,
+				Description: validDescription,
+				Sender:      validSender,
+				Receiver:    validReceiver,
+			},
+		},
+		{
+			name: "invalid title",
+			proposal: types.AddWhitelistedAddressPairProposal{
+				Title:       "",
+				Description: validDescription,
+				Sender:      validSender,
+				Receiver:    validReceiver,
+			},
+			err: "title cannot be blank",
+		},
+		{
+			name: "invalid description",
+			proposal: types.AddWhitelistedAddressPairProposal{
+				Title:       validTitle,
+				Description: "",
+				Sender:      validSender,
+				Receiver:    validReceiver,
+			},
+			err: "description cannot be blank",
+		},
+		{
+			name: "invalid sender",
+			proposal: types.AddWhitelistedAddressPairProposal{
+				Title:       validTitle,
+				Description: validDescription,
+				Sender:      "",
+				Receiver:    validReceiver,
+			},
+			err: "invalid sender",
+		},
+		{
+			name: "invalid receiver",
+			proposal: types.AddWhitelistedAddressPairProposal{
+				Title:       validTitle,
+				Description: validDescription,
+				Sender:      validSender,
+				Receiver:    "",
+			},
+			err: "invalid receiver",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.proposal.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.proposal.Sender, validSender, "sender")
+				require.Equal(t, test.proposal.Receiver, validReceiver, "receiver")
+			} else {
+				require.ErrorContains(t, test.proposal.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	ProposalTypeRemoveBlacklistedDenom = "RemoveBlacklistedDenom"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRemoveBlacklistedDenom)
+}
+
+var (
+	_ govtypes.Content = &RemoveBlacklistedDenomProposal{}
+)
+
+func NewRemoveBlacklistedDenomProposal(title, description, denom string) govtypes.Content {
+	return &RemoveBlacklistedDenomProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+	}
+}
+
+func (p *RemoveBlacklistedDenomProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveBlacklistedDenomProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveBlacklistedDenomProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveBlacklistedDenomProposal) ProposalType() string {
+	return ProposalTypeRemoveBlacklistedDenom
+}
+
+func (p *RemoveBlacklistedDenomProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.Denom == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom (%s)", p.Denom)
+	}
+
+	return nil
+}
+
+func (p RemoveBlacklistedDenomProposal) String() string {
+	return fmt.Sprintf(`Remove Blacklisted Denom Proposal:
+	Title:           %s
+	Description:     %s
+	Denom:           %s
+  `, p.Title, p.Description, p.Denom)
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v10/app/apptesting"
+	"github.com/Stride-Labs/stride/v10/x/ratelimit/types"
+)
+
+func TestGovRemoveBlacklistedDenom(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validTitle := "RemoveBlacklistedDenom"
+	validDescription := "Updating the blacklist"
+	validDenom := "denom"
+
+	tests := []struct {
+		name     string
+		proposal types.RemoveBlacklistedDenomProposal
+		err      string
+	}{
+		{
+			name: "successful message",
+			proposal: types.RemoveBlacklistedDenomProposal{
+				Title:       validTitle,
+				Description: validDescription,
+				Denom:       validDenom,
+			},
+		},
+		{
+			name: "invalid title",
+			proposal: types.RemoveBlacklistedDenomProposal{
+				Title:       "",
+				Description: validDescription,
+				Denom:       validDenom,
+			},
+			err: "title cannot be blank",
+		},
+		{
+			name: "invalid description",
+			proposal: types.RemoveBlacklistedDenomProposal{
+				Title:       validTitle,
+				Description: "",
+				Denom:       validDenom,
+			},
+			err: "description cannot be blank",
+		},
+		{
+			name: "invalid denom",
+			proposal: types.RemoveBlacklistedDenomProposal{
+				Title:       validTitle,
+				Description: validDescription,
+				Denom:       "",
+			},
+			err: "invalid denom",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.proposal.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.proposal.Denom, validDenom, "denom")
+			} else {
+				require.ErrorContains(t, test.proposal.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	ProposalTypeRemoveChannelGroup = "RemoveChannelGroup"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRemoveChannelGroup)
+}
+
+var (
+	_ govtypes.Content = &RemoveChannelGroupProposal{}
+)
+
+func NewRemoveChannelGroupProposal(title, description, name string) govtypes.Content {
+	return &RemoveChannelGroupProposal{
+		Title:       title,
+		Description: description,
+		Name:        name,
+	}
+}
+
+func (p *RemoveChannelGroupProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveChannelGroupProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveChannelGroupProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveChannelGroupProposal) ProposalType() string {
+	return ProposalTypeRemoveChannelGroup
+}
+
+func (p *RemoveChannelGroupProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return ValidateChannelGroupName(p.Name)
+}
+
+func (p RemoveChannelGroupProposal) String() string {
+	return fmt.Sprintf(`Remove Channel Group Proposal:
+	Title:           %s
+	Description:     %s
+	Name:            %s
+  `, p.Title, p.Description, p.Name)
+}
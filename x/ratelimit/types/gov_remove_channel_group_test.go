@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v10/app/apptesting"
+	"github.com/Stride-Labs/stride/v10/x/ratelimit/types"
+)
+
+func TestGovRemoveChannelGroup(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validTitle := "RemoveChannelGroup"
+	validDescription := "Removing a channel group"
+	validName := "osmosis"
+
+	tests := []struct {
+		name     string
+		proposal types.RemoveChannelGroupProposal
+		err      string
+	}{
+		{
+			name: "successful message",
+			proposal: types.RemoveChannelGroupProposal{
+				Title:       validTitle,
+				Description: validDescription,
+				Name:        validName,
+			},
+		},
+		{
+			name: "invalid title",
+			proposal: types.RemoveChannelGroupProposal{
+				Title:       "",
+				Description: validDescription,
+				Name:        validName,
+			},
+			err: "title cannot be blank",
+		},
+		{
+			name: "invalid description",
+			proposal: types.RemoveChannelGroupProposal{
+				Title:       validTitle,
+				Description: "",
+				Name:        validName,
+			},
+			err: "description cannot be blank",
+		},
+		{
+			name: "invalid name",
+			proposal: types.RemoveChannelGroupProposal{
+				Title:       validTitle,
+				Description: validDescription,
+				Name:        "",
+			},
+			err: "invalid channel group name",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.proposal.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.proposal.Name, validName, "name")
+			} else {
+				require.ErrorContains(t, test.proposal.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	ProposalTypeRemoveWhitelistedAddressPair = "RemoveWhitelistedAddressPair"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRemoveWhitelistedAddressPair)
+}
+
+var (
+	_ govtypes.Content = &RemoveWhitelistedAddressPairProposal{}
+)
+
+func NewRemoveWhitelistedAddressPairProposal(title, description, sender, receiver string) govtypes.Content {
+	return &RemoveWhitelistedAddressPairProposal{
+		Title:       title,
+		Description: description,
+		Sender:      sender,
+		Receiver:    receiver,
+	}
+}
+
+func (p *RemoveWhitelistedAddressPairProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveWhitelistedAddressPairProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveWhitelistedAddressPairProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveWhitelistedAddressPairProposal) ProposalType() string {
+	return ProposalTypeRemoveWhitelistedAddressPair
+}
+
+func (p *RemoveWhitelistedAddressPairProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.Sender == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid sender (%s)", p.Sender)
+	}
+	if p.Receiver == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid receiver (%s)", p.Receiver)
+	}
+
+	return nil
+}
+
+func (p RemoveWhitelistedAddressPairProposal) String() string {
+	return fmt.Sprintf(`Remove Whitelisted Address Pair Proposal:
+	Title:           %s
+	Description:     %s
+	Sender:          %s
+	Receiver:        %s
+  `, p.Title, p.Description, p.Sender, p.Receiver)
+}
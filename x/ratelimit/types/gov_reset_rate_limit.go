@@ -3,8 +3,6 @@ package types
 import (
 	"fmt"
 
-	"regexp"
-
 	errorsmod "cosmossdk.io/errors"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -51,12 +49,8 @@ func (p *ResetRateLimitProposal) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom (%s)", p.Denom)
 	}
 
-	matched, err := regexp.MatchString(`^channel-\d+$`, p.ChannelId)
-	if err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unable to verify channel-id (%s)", p.ChannelId)
-	}
-	if !matched {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel-id (%s), must be of the format 'channel-{N}'", p.ChannelId)
+	if err := ValidateRateLimitChannelId(p.ChannelId); err != nil {
+		return err
 	}
 
 	return nil
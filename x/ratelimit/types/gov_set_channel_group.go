@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	ProposalTypeSetChannelGroup = "SetChannelGroup"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetChannelGroup)
+}
+
+var (
+	_ govtypes.Content = &SetChannelGroupProposal{}
+)
+
+func NewSetChannelGroupProposal(title, description, name string, channelIds []string) govtypes.Content {
+	return &SetChannelGroupProposal{
+		Title:       title,
+		Description: description,
+		Name:        name,
+		ChannelIds:  channelIds,
+	}
+}
+
+func (p *SetChannelGroupProposal) GetTitle() string { return p.Title }
+
+func (p *SetChannelGroupProposal) GetDescription() string { return p.Description }
+
+func (p *SetChannelGroupProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetChannelGroupProposal) ProposalType() string {
+	return ProposalTypeSetChannelGroup
+}
+
+func (p *SetChannelGroupProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	channelGroup := ChannelGroup{Name: p.Name, ChannelIds: p.ChannelIds}
+	return channelGroup.Validate()
+}
+
+func (p SetChannelGroupProposal) String() string {
+	return fmt.Sprintf(`Set Channel Group Proposal:
+	Title:           %s
+	Description:     %s
+	Name:            %s
+	ChannelIds:      %v
+  `, p.Title, p.Description, p.Name, p.ChannelIds)
+}
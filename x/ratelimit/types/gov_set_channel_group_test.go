@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v10/app/apptesting"
+	"github.com/Stride-Labs/stride/v10/x/ratelimit/types"
+)
+
+func TestGovSetChannelGroup(t *testing.T) {
+	apptesting.SetupConfig()
+
+	validTitle := "SetChannelGroup"
+	validDescription := "Setting a channel group"
+	validName := "osmosis"
+	validChannelIds := []string{"channel-0", "channel-1"}
+
+	tests := []struct {
+		name     string
+		proposal types.SetChannelGroupProposal
+		err      string
+	}{
+		{
+			name: "successful message",
+			proposal: types.SetChannelGroupProposal{
+				Title:       validTitle,
+				Description: validDescription,
+				Name:        validName,
+				ChannelIds:  validChannelIds,
+			},
+		},
+		{
+			name: "invalid title",
+			proposal: types.SetChannelGroupProposal{
+				Title:       "",
+				Description: validDescription,
+				Name:        validName,
+				ChannelIds:  validChannelIds,
+			},
+			err: "title cannot be blank",
+		},
+		{
+			name: "invalid description",
+			proposal: types.SetChannelGroupProposal{
+				Title:       validTitle,
+				Description: "",
+				Name:        validName,
+				ChannelIds:  validChannelIds,
+			},
+			err: "description cannot be blank",
+		},
+		{
+			name: "invalid name",
+			proposal: types.SetChannelGroupProposal{
+				Title:       validTitle,
+				Description: validDescription,
+				Name:        "osmosis/pools",
+				ChannelIds:  validChannelIds,
+			},
+			err: "invalid channel group name",
+		},
+		{
+			name: "no channels",
+			proposal: types.SetChannelGroupProposal{
+				Title:       validTitle,
+				Description: validDescription,
+				Name:        validName,
+				ChannelIds:  []string{},
+			},
+			err: "must have at least one channel",
+		},
+		{
+			name: "invalid channel-id",
+			proposal: types.SetChannelGroupProposal{
+				Title:       validTitle,
+				Description: validDescription,
+				Name:        validName,
+				ChannelIds:  []string{"channel-0", "*"},
+			},
+			err: "invalid channel-id",
+		},
+		{
+			name: "duplicate channel-id",
+			proposal: types.SetChannelGroupProposal{
+				Title:       validTitle,
+				Description: validDescription,
+				Name:        validName,
+				ChannelIds:  []string{"channel-0", "channel-0"},
+			},
+			err: "duplicate channel-id",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.proposal.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.proposal.Name, validName, "name")
+				require.Equal(t, test.proposal.ChannelIds, validChannelIds, "channelIds")
+			} else {
+				require.ErrorContains(t, test.proposal.ValidateBasic(), test.err, "test: %v", test.name)
+			}
+		})
+	}
+}
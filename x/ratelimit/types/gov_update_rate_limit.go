@@ -3,8 +3,6 @@ package types
 import (
 	"fmt"
 
-	"regexp"
-
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -55,12 +53,8 @@ func (p *UpdateRateLimitProposal) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid denom (%s)", p.Denom)
 	}
 
-	matched, err := regexp.MatchString(`^channel-\d+$`, p.ChannelId)
-	if err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unable to verify channel-id (%s)", p.ChannelId)
-	}
-	if !matched {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel-id (%s), must be of the format 'channel-{N}'", p.ChannelId)
+	if err := ValidateRateLimitChannelId(p.ChannelId); err != nil {
+		return err
 	}
 
 	if p.MaxPercentSend.GT(sdkmath.NewInt(100)) || p.MaxPercentSend.LT(sdkmath.ZeroInt()) {
@@ -71,10 +65,32 @@ func (p *UpdateRateLimitProposal) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "max-percent-recv percent must be between 0 and 100 (inclusively), Provided: %v", p.MaxPercentRecv)
 	}
 
-	if p.MaxPercentRecv.IsZero() && p.MaxPercentSend.IsZero() {
+	if p.MaxAmountSend != nil && (p.MaxAmountSend.IsNil() || p.MaxAmountSend.IsNegative()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "max-amount-send cannot be negative, Provided: %v", p.MaxAmountSend)
+	}
+
+	if p.MaxAmountRecv != nil && (p.MaxAmountRecv.IsNil() || p.MaxAmountRecv.IsNegative()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "max-amount-recv cannot be negative, Provided: %v", p.MaxAmountRecv)
+	}
+
+	hasAmountCap := (p.MaxAmountSend != nil && p.MaxAmountSend.IsPositive()) || (p.MaxAmountRecv != nil && p.MaxAmountRecv.IsPositive())
+	if p.MaxPercentRecv.IsZero() && p.MaxPercentSend.IsZero() && !hasAmountCap {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "either the max send or max receive threshold must be greater than 0")
 	}
 
+	if _, ok := FlowMeasurement_name[int32(p.FlowMeasurement)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid flow measurement (%d)", p.FlowMeasurement)
+	}
+
+	if p.AddressQuota != nil {
+		if err := p.AddressQuota.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid address quota: %s", err.Error())
+		}
+		if p.SlidingWindow {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "an address quota cannot be used with a sliding window")
+		}
+	}
+
 	if p.DurationHours == 0 {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duration can not be zero")
 	}
@@ -91,5 +107,11 @@ func (p UpdateRateLimitProposal) String() string {
 	MaxPercentSend: %v
 	MaxPercentRecv: %v
 	DurationHours:  %d
-  `, p.Title, p.Description, p.Denom, p.ChannelId, p.MaxPercentSend, p.MaxPercentRecv, p.DurationHours)
+	SlidingWindow:  %v
+	MaxAmountSend:  %v
+	MaxAmountRecv:  %v
+	FlowMeasurement: %v
+	AddressQuota:   %v
+  `, p.Title, p.Description, p.Denom, p.ChannelId, p.MaxPercentSend, p.MaxPercentRecv, p.DurationHours, p.SlidingWindow,
+		p.MaxAmountSend, p.MaxAmountRecv, p.FlowMeasurement, p.AddressQuota)
 }
@@ -20,6 +20,8 @@ func TestGovUpdateRateLimit(t *testing.T) {
 	validMaxPercentSend := sdkmath.NewInt(10)
 	validMaxPercentRecv := sdkmath.NewInt(10)
 	validDurationHours := uint64(60)
+	validMaxAmount := sdkmath.NewInt(1000)
+	negativeMaxAmount := sdkmath.NewInt(-1)
 
 	tests := []struct {
 		name     string
@@ -168,6 +170,92 @@ func TestGovUpdateRateLimit(t *testing.T) {
 			},
 			err: "duration can not be zero",
 		},
+		{
+			name: "successful proposal with absolute caps and gross flow",
+			proposal: types.UpdateRateLimitProposal{
+				Title:           validTitle,
+				Description:     validDescription,
+				Denom:           validDenom,
+				ChannelId:       validChannelId,
+				MaxPercentSend:  validMaxPercentSend,
+				MaxPercentRecv:  validMaxPercentRecv,
+				DurationHours:   validDurationHours,
+				MaxAmountSend:   &validMaxAmount,
+				MaxAmountRecv:   &validMaxAmount,
+				FlowMeasurement: types.FLOW_GROSS,
+			},
+		},
+		{
+			name: "invalid send amount (lt 0)",
+			proposal: types.UpdateRateLimitProposal{
+				Title:          validTitle,
+				Description:    validDescription,
+				Denom:          validDenom,
+				ChannelId:      validChannelId,
+				MaxPercentSend: validMaxPercentSend,
+				MaxPercentRecv: validMaxPercentRecv,
+				DurationHours:  validDurationHours,
+				MaxAmountSend:  &negativeMaxAmount,
+			},
+			err: "max-amount-send cannot be negative",
+		},
+		{
+			name: "invalid receive amount (lt 0)",
+			proposal: types.UpdateRateLimitProposal{
+				Title:          validTitle,
+				Description:    validDescription,
+				Denom:          validDenom,
+				ChannelId:      validChannelId,
+				MaxPercentSend: validMaxPercentSend,
+				MaxPercentRecv: validMaxPercentRecv,
+				DurationHours:  validDurationHours,
+				MaxAmountRecv:  &negativeMaxAmount,
+			},
+			err: "max-amount-recv cannot be negative",
+		},
+		{
+			name: "invalid flow measurement",
+			proposal: types.UpdateRateLimitProposal{
+				Title:           validTitle,
+				Description:     validDescription,
+				Denom:           validDenom,
+				ChannelId:       validChannelId,
+				MaxPercentSend:  validMaxPercentSend,
+				MaxPercentRecv:  validMaxPercentRecv,
+				DurationHours:   validDurationHours,
+				FlowMeasurement: types.FlowMeasurement(2),
+			},
+			err: "invalid flow measurement",
+		},
+		{
+			name: "invalid address quota",
+			proposal: types.UpdateRateLimitProposal{
+				Title:          validTitle,
+				Description:    validDescription,
+				Denom:          validDenom,
+				ChannelId:      validChannelId,
+				MaxPercentSend: validMaxPercentSend,
+				MaxPercentRecv: validMaxPercentRecv,
+				DurationHours:  validDurationHours,
+				AddressQuota:   &types.AddressQuota{MaxPercentSend: sdkmath.NewInt(101)},
+			},
+			err: "invalid address quota",
+		},
+		{
+			name: "address quota with sliding window",
+			proposal: types.UpdateRateLimitProposal{
+				Title:          validTitle,
+				Description:    validDescription,
+				Denom:          validDenom,
+				ChannelId:      validChannelId,
+				MaxPercentSend: validMaxPercentSend,
+				MaxPercentRecv: validMaxPercentRecv,
+				DurationHours:  validDurationHours,
+				SlidingWindow:  true,
+				AddressQuota:   &types.AddressQuota{MaxPercentSend: sdkmath.NewInt(5)},
+			},
+			err: "an address quota cannot be used with a sliding window",
+		},
 	}
 
 	for _, test := range tests {
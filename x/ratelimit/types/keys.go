@@ -25,6 +25,11 @@ var (
 	PendingSendPacketPrefix   = KeyPrefix("pending-send-packet")
 	DenomBlacklistKeyPrefix   = KeyPrefix("denom-blacklist")
 	AddressWhitelistKeyPrefix = KeyPrefix("address-blacklist")
+	AddressFlowKeyPrefix      = KeyPrefix("address-flow")
+	ChannelGroupKeyPrefix     = KeyPrefix("channel-group")
+
+	RateLimitWindowKeyPrefix         = KeyPrefix("window-rate-limit")
+	PendingSendPacketWindowKeyPrefix = KeyPrefix("window-pending-send-packet")
 
 	PendingSendPacketChannelLength int = 16
 )
@@ -39,6 +44,23 @@ func GetPendingSendPacketKey(channelId string, sequenceNumber uint64) []byte {
 	return append(channelIdBz, sequenceNumberBz...)
 }
 
+// The windows of a pending packet are stored under the packet's key, followed by the
+// channel-id of each rate limit that counted the packet (the denom is the packet's denom)
+func GetPendingSendPacketWindowKey(channelId string, sequenceNumber uint64, rateLimitChannelId string) []byte {
+	return append(GetPendingSendPacketKey(channelId, sequenceNumber), KeyPrefix(rateLimitChannelId)...)
+}
+
 func GetAddressWhitelistKey(sender, receiver string) []byte {
 	return append(KeyPrefix(sender), KeyPrefix(receiver)...)
 }
+
+// The address flows of a rate limit are stored under the rate limit's denom and channel-id,
+// followed by a separator so that the prefix of one channel does not match another
+// (e.g. channel-1 and channel-10)
+func GetAddressFlowRateLimitPrefix(denom, channelId string) []byte {
+	return append(append(KeyPrefix(denom), KeyPrefix(channelId)...), '/')
+}
+
+func GetAddressFlowKey(denom, channelId, address string) []byte {
+	return append(GetAddressFlowRateLimitPrefix(denom, channelId), KeyPrefix(address)...)
+}
@@ -381,6 +381,174 @@ func (m *QueryRateLimitsByChannelIdResponse) GetRateLimits() []RateLimit {
 	return nil
 }
 
+type QueryAggregateRateLimitsRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryAggregateRateLimitsRequest) Reset()         { *m = QueryAggregateRateLimitsRequest{} }
+func (m *QueryAggregateRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateRateLimitsRequest) ProtoMessage()    {}
+func (*QueryAggregateRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a373ef8fcef03b, []int{8}
+}
+func (m *QueryAggregateRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregateRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregateRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAggregateRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryAggregateRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregateRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateRateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAggregateRateLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryAggregateRateLimitsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryAggregateRateLimitsResponse struct {
+	RateLimits []RateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+}
+
+func (m *QueryAggregateRateLimitsResponse) Reset()         { *m = QueryAggregateRateLimitsResponse{} }
+func (m *QueryAggregateRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateRateLimitsResponse) ProtoMessage()    {}
+func (*QueryAggregateRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a373ef8fcef03b, []int{9}
+}
+func (m *QueryAggregateRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregateRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregateRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAggregateRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryAggregateRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregateRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateRateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAggregateRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryAggregateRateLimitsResponse) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+type QueryAllChannelGroupsRequest struct {
+}
+
+func (m *QueryAllChannelGroupsRequest) Reset()         { *m = QueryAllChannelGroupsRequest{} }
+func (m *QueryAllChannelGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChannelGroupsRequest) ProtoMessage()    {}
+func (*QueryAllChannelGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a373ef8fcef03b, []int{10}
+}
+func (m *QueryAllChannelGroupsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChannelGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChannelGroupsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllChannelGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChannelGroupsRequest.Merge(m, src)
+}
+func (m *QueryAllChannelGroupsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChannelGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChannelGroupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllChannelGroupsRequest proto.InternalMessageInfo
+
+type QueryAllChannelGroupsResponse struct {
+	ChannelGroups []ChannelGroup `protobuf:"bytes,1,rep,name=channel_groups,json=channelGroups,proto3" json:"channel_groups"`
+}
+
+func (m *QueryAllChannelGroupsResponse) Reset()         { *m = QueryAllChannelGroupsResponse{} }
+func (m *QueryAllChannelGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChannelGroupsResponse) ProtoMessage()    {}
+func (*QueryAllChannelGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a373ef8fcef03b, []int{11}
+}
+func (m *QueryAllChannelGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChannelGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChannelGroupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllChannelGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChannelGroupsResponse.Merge(m, src)
+}
+func (m *QueryAllChannelGroupsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChannelGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChannelGroupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllChannelGroupsResponse proto.InternalMessageInfo
+
+func (m *QueryAllChannelGroupsResponse) GetChannelGroups() []ChannelGroup {
+	if m != nil {
+		return m.ChannelGroups
+	}
+	return nil
+}
+
 type QueryAllBlacklistedDenomsRequest struct {
 }
 
@@ -388,7 +556,7 @@ func (m *QueryAllBlacklistedDenomsRequest) Reset()         { *m = QueryAllBlackl
 func (m *QueryAllBlacklistedDenomsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBlacklistedDenomsRequest) ProtoMessage()    {}
 func (*QueryAllBlacklistedDenomsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97a373ef8fcef03b, []int{8}
+	return fileDescriptor_97a373ef8fcef03b, []int{12}
 }
 func (m *QueryAllBlacklistedDenomsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +593,7 @@ func (m *QueryAllBlacklistedDenomsResponse) Reset()         { *m = QueryAllBlack
 func (m *QueryAllBlacklistedDenomsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBlacklistedDenomsResponse) ProtoMessage()    {}
 func (*QueryAllBlacklistedDenomsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97a373ef8fcef03b, []int{9}
+	return fileDescriptor_97a373ef8fcef03b, []int{13}
 }
 func (m *QueryAllBlacklistedDenomsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,7 +636,7 @@ func (m *QueryAllWhitelistedAddressesRequest) Reset()         { *m = QueryAllWhi
 func (m *QueryAllWhitelistedAddressesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllWhitelistedAddressesRequest) ProtoMessage()    {}
 func (*QueryAllWhitelistedAddressesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97a373ef8fcef03b, []int{10}
+	return fileDescriptor_97a373ef8fcef03b, []int{14}
 }
 func (m *QueryAllWhitelistedAddressesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,7 +673,7 @@ func (m *QueryAllWhitelistedAddressesResponse) Reset()         { *m = QueryAllWh
 func (m *QueryAllWhitelistedAddressesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllWhitelistedAddressesResponse) ProtoMessage()    {}
 func (*QueryAllWhitelistedAddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97a373ef8fcef03b, []int{11}
+	return fileDescriptor_97a373ef8fcef03b, []int{15}
 }
 func (m *QueryAllWhitelistedAddressesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,6 +718,10 @@ func init() {
 	proto.RegisterType((*QueryRateLimitsByChainIdResponse)(nil), "stride.ratelimit.QueryRateLimitsByChainIdResponse")
 	proto.RegisterType((*QueryRateLimitsByChannelIdRequest)(nil), "stride.ratelimit.QueryRateLimitsByChannelIdRequest")
 	proto.RegisterType((*QueryRateLimitsByChannelIdResponse)(nil), "stride.ratelimit.QueryRateLimitsByChannelIdResponse")
+	proto.RegisterType((*QueryAggregateRateLimitsRequest)(nil), "stride.ratelimit.QueryAggregateRateLimitsRequest")
+	proto.RegisterType((*QueryAggregateRateLimitsResponse)(nil), "stride.ratelimit.QueryAggregateRateLimitsResponse")
+	proto.RegisterType((*QueryAllChannelGroupsRequest)(nil), "stride.ratelimit.QueryAllChannelGroupsRequest")
+	proto.RegisterType((*QueryAllChannelGroupsResponse)(nil), "stride.ratelimit.QueryAllChannelGroupsResponse")
 	proto.RegisterType((*QueryAllBlacklistedDenomsRequest)(nil), "stride.ratelimit.QueryAllBlacklistedDenomsRequest")
 	proto.RegisterType((*QueryAllBlacklistedDenomsResponse)(nil), "stride.ratelimit.QueryAllBlacklistedDenomsResponse")
 	proto.RegisterType((*QueryAllWhitelistedAddressesRequest)(nil), "stride.ratelimit.QueryAllWhitelistedAddressesRequest")
@@ -559,51 +731,58 @@ func init() {
 func init() { proto.RegisterFile("stride/ratelimit/query.proto", fileDescriptor_97a373ef8fcef03b) }
 
 var fileDescriptor_97a373ef8fcef03b = []byte{
-	// 692 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0x3b, 0xfc, 0x7e, 0x20, 0x7d, 0x90, 0xc4, 0x8c, 0x80, 0xb0, 0x60, 0xa9, 0xab, 0xc6,
-	0xc6, 0x3f, 0x5d, 0x68, 0x45, 0x8d, 0x48, 0x0c, 0x55, 0x0f, 0x24, 0x98, 0x68, 0x31, 0x31, 0xf1,
-	0x52, 0xa7, 0xdd, 0xb1, 0x9d, 0xb8, 0xec, 0x96, 0x9d, 0x45, 0x6d, 0x08, 0x17, 0x5f, 0x81, 0x89,
-	0x57, 0xaf, 0xbe, 0x08, 0x8f, 0x1e, 0x4c, 0x38, 0x12, 0xbd, 0x78, 0x32, 0x86, 0xfa, 0x42, 0xcc,
-	0xce, 0xcc, 0x6e, 0x6d, 0xbb, 0x5b, 0xba, 0x09, 0xb7, 0xe9, 0xcc, 0xf3, 0x7c, 0x9f, 0xcf, 0xf7,
-	0xd9, 0x79, 0x26, 0x85, 0x05, 0xee, 0xb9, 0xcc, 0xa4, 0x86, 0x4b, 0x3c, 0x6a, 0xb1, 0x6d, 0xe6,
-	0x19, 0x3b, 0xbb, 0xd4, 0x6d, 0xe5, 0x9b, 0xae, 0xe3, 0x39, 0xf8, 0x8c, 0x3c, 0xcd, 0x87, 0xa7,
-	0x5a, 0xb6, 0x2f, 0x3e, 0x5c, 0xc9, 0x1c, 0x6d, 0xa1, 0xee, 0x38, 0x75, 0x8b, 0x1a, 0xa4, 0xc9,
-	0x0c, 0x62, 0xdb, 0x8e, 0x47, 0x3c, 0xe6, 0xd8, 0x5c, 0x9d, 0x4e, 0xd5, 0x9d, 0xba, 0x23, 0x96,
-	0x86, 0xbf, 0x92, 0xbb, 0xfa, 0x3c, 0xcc, 0x3d, 0xf5, 0xcb, 0xae, 0x5b, 0x56, 0x99, 0x78, 0x74,
-	0xd3, 0x97, 0xe3, 0x65, 0xba, 0xb3, 0x4b, 0xb9, 0xa7, 0xbf, 0x04, 0x2d, 0xea, 0x90, 0x37, 0x1d,
-	0x9b, 0x53, 0x5c, 0x82, 0x09, 0x9f, 0xa0, 0x22, 0x10, 0xf8, 0x2c, 0xca, 0xfe, 0x97, 0x9b, 0x28,
-	0xcc, 0xe7, 0x7b, 0xc1, 0xf3, 0x61, 0x6a, 0xe9, 0xff, 0x83, 0x5f, 0x8b, 0xa9, 0x32, 0xb8, 0xa1,
-	0x96, 0xbe, 0x09, 0xd3, 0xa2, 0x42, 0x18, 0xa3, 0x4a, 0xe3, 0x29, 0x18, 0x35, 0xa9, 0xed, 0x6c,
-	0xcf, 0xa2, 0x2c, 0xca, 0xa5, 0xcb, 0xf2, 0x07, 0x3e, 0x0f, 0x50, 0x6b, 0x10, 0xdb, 0xa6, 0x56,
-	0x85, 0x99, 0xb3, 0x23, 0xe2, 0x28, 0xad, 0x76, 0x36, 0x4c, 0xfd, 0x19, 0xcc, 0xf4, 0xaa, 0x29,
-	0xd6, 0xbb, 0x00, 0x1d, 0x56, 0xa1, 0x39, 0x18, 0xb5, 0x9c, 0x0e, 0x21, 0xf5, 0x7b, 0xb0, 0xd8,
-	0xad, 0xca, 0x4b, 0xad, 0x07, 0x0d, 0xc2, 0xec, 0x0d, 0x33, 0xa0, 0x9d, 0x83, 0xf1, 0x9a, 0xbf,
-	0xe3, 0x53, 0x49, 0xe0, 0x53, 0x35, 0x19, 0xa1, 0xbf, 0x82, 0x6c, 0x7c, 0xf6, 0x09, 0x76, 0xb2,
-	0x04, 0x17, 0xa2, 0xea, 0xc8, 0xce, 0x04, 0x9c, 0xdd, 0xfd, 0x43, 0xbd, 0xfd, 0x6b, 0x80, 0x3e,
-	0x48, 0xe3, 0x04, 0x69, 0x75, 0xd5, 0x95, 0x75, 0xcb, 0x2a, 0x59, 0xa4, 0xf6, 0xda, 0x62, 0xdc,
-	0xa3, 0xe6, 0x43, 0xff, 0x23, 0x87, 0xb7, 0x6f, 0x55, 0x39, 0x8a, 0x8e, 0x51, 0x30, 0x33, 0x30,
-	0x26, 0xae, 0x86, 0xe4, 0x48, 0x97, 0xd5, 0x2f, 0xfd, 0x32, 0x5c, 0x0c, 0x92, 0x9f, 0x37, 0x98,
-	0x8f, 0xe4, 0x27, 0xaf, 0x9b, 0xa6, 0x4b, 0x39, 0xa7, 0x61, 0x8d, 0x3d, 0xb8, 0x34, 0x38, 0x4c,
-	0x95, 0xd9, 0x82, 0x49, 0x22, 0x37, 0x2b, 0x4d, 0xc2, 0xdc, 0xc0, 0x75, 0xae, 0xdf, 0x75, 0xbf,
-	0xcc, 0x13, 0xc2, 0x5c, 0xd5, 0x82, 0xd3, 0xa4, 0xb3, 0xc5, 0x0b, 0xdf, 0xc7, 0x61, 0x54, 0x54,
-	0xc7, 0x9f, 0x10, 0x4c, 0x76, 0x0d, 0x19, 0xbe, 0xd6, 0xaf, 0x1c, 0x3b, 0xa7, 0xda, 0xf5, 0xe1,
-	0x82, 0xa5, 0x17, 0x7d, 0xe9, 0xfd, 0x8f, 0x3f, 0x1f, 0x47, 0xae, 0xe2, 0x9c, 0xb1, 0x25, 0xb2,
-	0x6e, 0x6c, 0x92, 0x2a, 0x37, 0xe2, 0x5f, 0x17, 0x8e, 0x3f, 0x23, 0x48, 0x87, 0x42, 0xf8, 0x4a,
-	0x4c, 0xb5, 0xde, 0x19, 0xd6, 0x72, 0xc7, 0x07, 0x2a, 0xa4, 0x47, 0x02, 0xe9, 0x3e, 0x5e, 0x1b,
-	0x12, 0xc9, 0xd8, 0xeb, 0x5c, 0xe3, 0x7d, 0xa3, 0xda, 0xaa, 0xc8, 0xe7, 0xe1, 0x0b, 0x82, 0xb3,
-	0x11, 0x73, 0x86, 0x97, 0x8f, 0x03, 0xe9, 0x9b, 0x68, 0xad, 0x90, 0x24, 0x45, 0xb9, 0x58, 0x15,
-	0x2e, 0x56, 0x70, 0x71, 0xd8, 0xc6, 0x0a, 0x1b, 0xe2, 0xd5, 0xd8, 0xc7, 0x5f, 0x11, 0x4c, 0x47,
-	0xce, 0x1d, 0x2e, 0x0e, 0x87, 0xd2, 0x35, 0xe9, 0xda, 0xcd, 0x64, 0x49, 0xca, 0xc1, 0x9a, 0x70,
-	0x70, 0x1b, 0xaf, 0x24, 0x72, 0x10, 0x7c, 0x08, 0xbf, 0xff, 0x53, 0x51, 0xd3, 0x8a, 0x0b, 0xf1,
-	0x17, 0x34, 0x6e, 0xfc, 0xb5, 0x62, 0xa2, 0x1c, 0x65, 0xe0, 0x8e, 0x30, 0x50, 0xc0, 0x4b, 0x83,
-	0x0d, 0x54, 0x3b, 0x02, 0xf2, 0xea, 0x70, 0xfc, 0x0d, 0xc1, 0xb9, 0x98, 0x57, 0x00, 0xaf, 0xc4,
-	0xa3, 0x0c, 0x78, 0x5c, 0xb4, 0x5b, 0x49, 0xd3, 0x92, 0xdd, 0xa3, 0xb7, 0x1d, 0x8d, 0x0a, 0x09,
-	0x44, 0x4a, 0x8f, 0x0f, 0x8e, 0x32, 0xe8, 0xf0, 0x28, 0x83, 0x7e, 0x1f, 0x65, 0xd0, 0x87, 0x76,
-	0x26, 0x75, 0xd8, 0xce, 0xa4, 0x7e, 0xb6, 0x33, 0xa9, 0x17, 0xc5, 0x3a, 0xf3, 0x1a, 0xbb, 0xd5,
-	0x7c, 0xcd, 0xd9, 0x8e, 0x12, 0x7e, 0xb3, 0xbc, 0x64, 0xbc, 0xfb, 0x47, 0xde, 0x6b, 0x35, 0x29,
-	0xaf, 0x8e, 0x89, 0xbf, 0x09, 0xc5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0xd6, 0xdd, 0xa7,
-	0xae, 0x08, 0x00, 0x00,
+	// 808 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x4f, 0xd4, 0x5a,
+	0x14, 0xc7, 0xa7, 0xbc, 0x07, 0xef, 0xcd, 0xe1, 0xf1, 0x42, 0x2e, 0x3f, 0x84, 0x02, 0x65, 0xa8,
+	0x1a, 0x27, 0x8a, 0x53, 0x98, 0x01, 0x31, 0x22, 0x31, 0x0c, 0x1a, 0x43, 0xc4, 0x44, 0x07, 0x13,
+	0x13, 0x37, 0xe3, 0x9d, 0xe9, 0xb5, 0xd3, 0x58, 0xda, 0xa1, 0xb7, 0xa3, 0x4e, 0x08, 0x1b, 0xff,
+	0x02, 0x13, 0xb7, 0x6e, 0x5d, 0xf8, 0x27, 0xb8, 0xd4, 0xc4, 0x84, 0x25, 0x89, 0x1b, 0x57, 0xc6,
+	0x80, 0x7f, 0x88, 0xe9, 0xed, 0x6d, 0xe7, 0x57, 0x5b, 0xa6, 0x09, 0xbb, 0xce, 0x3d, 0xe7, 0x7c,
+	0xcf, 0xe7, 0x1c, 0xee, 0xfd, 0x06, 0x98, 0xa5, 0x8e, 0xad, 0xab, 0x44, 0xb1, 0xb1, 0x43, 0x0c,
+	0x7d, 0x4f, 0x77, 0x94, 0xfd, 0x06, 0xb1, 0x9b, 0xb9, 0xba, 0x6d, 0x39, 0x16, 0x1a, 0xf5, 0xa2,
+	0xb9, 0x20, 0x2a, 0x66, 0x7a, 0xf2, 0x83, 0x2f, 0xaf, 0x46, 0x9c, 0xd5, 0x2c, 0x4b, 0x33, 0x88,
+	0x82, 0xeb, 0xba, 0x82, 0x4d, 0xd3, 0x72, 0xb0, 0xa3, 0x5b, 0x26, 0xe5, 0xd1, 0x71, 0xcd, 0xd2,
+	0x2c, 0xf6, 0xa9, 0xb8, 0x5f, 0xde, 0xa9, 0x3c, 0x03, 0xd3, 0x8f, 0xdd, 0xb6, 0x9b, 0x86, 0x51,
+	0xc2, 0x0e, 0xd9, 0x71, 0xe5, 0x68, 0x89, 0xec, 0x37, 0x08, 0x75, 0xe4, 0xe7, 0x20, 0x86, 0x05,
+	0x69, 0xdd, 0x32, 0x29, 0x41, 0x45, 0x18, 0x76, 0x09, 0xca, 0x0c, 0x81, 0x4e, 0x09, 0x99, 0xbf,
+	0xb2, 0xc3, 0xf9, 0x99, 0x5c, 0x37, 0x78, 0x2e, 0x28, 0x2d, 0xfe, 0x7d, 0xf4, 0x73, 0x3e, 0x55,
+	0x02, 0x3b, 0xd0, 0x92, 0x77, 0x60, 0x82, 0x75, 0x08, 0x72, 0x78, 0x6b, 0x34, 0x0e, 0x83, 0x2a,
+	0x31, 0xad, 0xbd, 0x29, 0x21, 0x23, 0x64, 0xd3, 0x25, 0xef, 0x07, 0x9a, 0x03, 0xa8, 0xd6, 0xb0,
+	0x69, 0x12, 0xa3, 0xac, 0xab, 0x53, 0x03, 0x2c, 0x94, 0xe6, 0x27, 0xdb, 0xaa, 0xfc, 0x04, 0x26,
+	0xbb, 0xd5, 0x38, 0xeb, 0x2d, 0x80, 0x16, 0x2b, 0xd3, 0x8c, 0x47, 0x2d, 0xa5, 0x03, 0x48, 0xf9,
+	0x36, 0xcc, 0x77, 0xaa, 0xd2, 0x62, 0x73, 0xab, 0x86, 0x75, 0x73, 0x5b, 0xf5, 0x69, 0xa7, 0xe1,
+	0xdf, 0xaa, 0x7b, 0xe2, 0x52, 0x79, 0xc0, 0xff, 0x54, 0xbd, 0x0c, 0xf9, 0x05, 0x64, 0xa2, 0xab,
+	0xcf, 0x71, 0x93, 0x45, 0x58, 0x08, 0xeb, 0xe3, 0x6d, 0xc6, 0xe7, 0xec, 0xdc, 0x9f, 0xd0, 0xbd,
+	0xbf, 0x1a, 0xc8, 0x71, 0x1a, 0xe7, 0x48, 0xbb, 0xc6, 0x77, 0xba, 0xa9, 0x69, 0x36, 0xd1, 0xb0,
+	0x43, 0x7a, 0x2e, 0x5f, 0xf8, 0x0d, 0x08, 0xd6, 0x19, 0x5a, 0x78, 0x8e, 0x80, 0x12, 0xcc, 0xfa,
+	0x57, 0x9f, 0x6f, 0xe0, 0xbe, 0x6d, 0x35, 0xea, 0xc1, 0xd3, 0x30, 0x60, 0x2e, 0x22, 0xce, 0x21,
+	0x1e, 0xc0, 0xff, 0xfe, 0xaa, 0x35, 0x16, 0xe1, 0x1c, 0x52, 0x2f, 0x47, 0xbb, 0x00, 0x47, 0x19,
+	0xa9, 0xb6, 0x8b, 0xca, 0xb2, 0x3f, 0xb5, 0x61, 0x14, 0x0d, 0x5c, 0x7d, 0x69, 0xe8, 0xd4, 0x21,
+	0xea, 0x5d, 0x77, 0x23, 0x01, 0xd1, 0x3a, 0x2c, 0xc4, 0xe4, 0x70, 0xaa, 0x49, 0x18, 0x62, 0x7b,
+	0xf4, 0x68, 0xd2, 0x25, 0xfe, 0x4b, 0xbe, 0x0c, 0x17, 0xfd, 0xe2, 0xa7, 0x35, 0xdd, 0x21, 0x5e,
+	0xf1, 0xa6, 0xaa, 0xda, 0x84, 0x52, 0x12, 0xf4, 0x38, 0x80, 0x4b, 0xf1, 0x69, 0xbc, 0xcd, 0x2e,
+	0x8c, 0x60, 0xef, 0xb0, 0x5c, 0xc7, 0xba, 0xed, 0xcf, 0x9e, 0xed, 0x9d, 0xbd, 0x57, 0xe6, 0x11,
+	0xd6, 0x6d, 0xbe, 0x85, 0xff, 0x70, 0xeb, 0x88, 0xe6, 0xbf, 0x0e, 0xc3, 0x20, 0xeb, 0x8e, 0x3e,
+	0x08, 0x30, 0xd2, 0xe1, 0x49, 0xe8, 0x5a, 0xaf, 0x72, 0xa4, 0xad, 0x89, 0x8b, 0xfd, 0x25, 0x7b,
+	0xb3, 0xc8, 0x4b, 0x6f, 0xbf, 0xff, 0x7e, 0x3f, 0x70, 0x15, 0x65, 0x95, 0x5d, 0x56, 0x75, 0x7d,
+	0x07, 0x57, 0xa8, 0x12, 0x6d, 0xc6, 0x14, 0x7d, 0x14, 0x20, 0x1d, 0x08, 0xa1, 0x2b, 0x11, 0xdd,
+	0xba, 0x2d, 0x4f, 0xcc, 0x9e, 0x9d, 0xc8, 0x91, 0xee, 0x31, 0xa4, 0x3b, 0x68, 0xa3, 0x4f, 0x24,
+	0xe5, 0xa0, 0xf5, 0xea, 0x0f, 0x95, 0x4a, 0xb3, 0xec, 0xb9, 0xe9, 0x67, 0x01, 0xc6, 0x42, 0x6c,
+	0x09, 0x2d, 0x9f, 0x05, 0xd2, 0x63, 0x80, 0x62, 0x3e, 0x49, 0x09, 0x9f, 0x62, 0x9d, 0x4d, 0xb1,
+	0x8a, 0x0a, 0xfd, 0x2e, 0x56, 0x39, 0xf0, 0x4d, 0xf6, 0x10, 0x7d, 0x11, 0x60, 0x22, 0xd4, 0xa6,
+	0x50, 0xa1, 0x3f, 0x94, 0x0e, 0x63, 0x14, 0x57, 0x92, 0x15, 0xf1, 0x09, 0x36, 0xd8, 0x04, 0x6b,
+	0x68, 0x35, 0xd1, 0x04, 0xfe, 0x1f, 0xc2, 0x9d, 0x61, 0x2c, 0xc4, 0xc7, 0x22, 0xf7, 0x1f, 0x6d,
+	0x96, 0x62, 0x3e, 0x49, 0x09, 0xa7, 0xdf, 0x62, 0xf4, 0x1b, 0x68, 0x3d, 0x9e, 0x1e, 0xfb, 0x12,
+	0xe5, 0xb6, 0x39, 0x82, 0x3b, 0xf4, 0x49, 0x80, 0xd1, 0x6e, 0x0f, 0x44, 0xb9, 0xe8, 0x07, 0x16,
+	0x66, 0xa6, 0xa2, 0xd2, 0x77, 0x3e, 0x47, 0x5f, 0x61, 0xe8, 0x39, 0xb4, 0x18, 0x8f, 0xde, 0x69,
+	0xc0, 0xee, 0x7d, 0x1f, 0x0f, 0x73, 0x47, 0x94, 0x8f, 0xee, 0x1f, 0x65, 0xb7, 0x62, 0x21, 0x51,
+	0x0d, 0xe7, 0xbe, 0xc9, 0xb8, 0xf3, 0x68, 0x29, 0x9e, 0xbb, 0xd2, 0x12, 0xf0, 0xd6, 0x4c, 0xd1,
+	0x37, 0x01, 0x2e, 0x44, 0xb8, 0x2e, 0x5a, 0x8d, 0x46, 0x89, 0x31, 0x73, 0xf1, 0x46, 0xd2, 0xb2,
+	0x64, 0xef, 0xf6, 0x75, 0x4b, 0xa3, 0x8c, 0x7d, 0x91, 0xe2, 0xc3, 0xa3, 0x13, 0x49, 0x38, 0x3e,
+	0x91, 0x84, 0x5f, 0x27, 0x92, 0xf0, 0xee, 0x54, 0x4a, 0x1d, 0x9f, 0x4a, 0xa9, 0x1f, 0xa7, 0x52,
+	0xea, 0x59, 0x41, 0xd3, 0x9d, 0x5a, 0xa3, 0x92, 0xab, 0x5a, 0x7b, 0x61, 0xc2, 0xaf, 0x96, 0x97,
+	0x94, 0x37, 0x6d, 0xf2, 0x4e, 0xb3, 0x4e, 0x68, 0x65, 0x88, 0xfd, 0x17, 0x5b, 0xf8, 0x33, 0x00,
+	0x68, 0xa8, 0x28, 0xcd, 0x4d, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -622,6 +801,8 @@ type QueryClient interface {
 	RateLimit(ctx context.Context, in *QueryRateLimitRequest, opts ...grpc.CallOption) (*QueryRateLimitResponse, error)
 	RateLimitsByChainId(ctx context.Context, in *QueryRateLimitsByChainIdRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChainIdResponse, error)
 	RateLimitsByChannelId(ctx context.Context, in *QueryRateLimitsByChannelIdRequest, opts ...grpc.CallOption) (*QueryRateLimitsByChannelIdResponse, error)
+	AggregateRateLimits(ctx context.Context, in *QueryAggregateRateLimitsRequest, opts ...grpc.CallOption) (*QueryAggregateRateLimitsResponse, error)
+	AllChannelGroups(ctx context.Context, in *QueryAllChannelGroupsRequest, opts ...grpc.CallOption) (*QueryAllChannelGroupsResponse, error)
 	AllBlacklistedDenoms(ctx context.Context, in *QueryAllBlacklistedDenomsRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedDenomsResponse, error)
 	AllWhitelistedAddresses(ctx context.Context, in *QueryAllWhitelistedAddressesRequest, opts ...grpc.CallOption) (*QueryAllWhitelistedAddressesResponse, error)
 }
@@ -670,6 +851,24 @@ func (c *queryClient) RateLimitsByChannelId(ctx context.Context, in *QueryRateLi
 	return out, nil
 }
 
+func (c *queryClient) AggregateRateLimits(ctx context.Context, in *QueryAggregateRateLimitsRequest, opts ...grpc.CallOption) (*QueryAggregateRateLimitsResponse, error) {
+	out := new(QueryAggregateRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/stride.ratelimit.Query/AggregateRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllChannelGroups(ctx context.Context, in *QueryAllChannelGroupsRequest, opts ...grpc.CallOption) (*QueryAllChannelGroupsResponse, error) {
+	out := new(QueryAllChannelGroupsResponse)
+	err := c.cc.Invoke(ctx, "/stride.ratelimit.Query/AllChannelGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AllBlacklistedDenoms(ctx context.Context, in *QueryAllBlacklistedDenomsRequest, opts ...grpc.CallOption) (*QueryAllBlacklistedDenomsResponse, error) {
 	out := new(QueryAllBlacklistedDenomsResponse)
 	err := c.cc.Invoke(ctx, "/stride.ratelimit.Query/AllBlacklistedDenoms", in, out, opts...)
@@ -694,6 +893,8 @@ type QueryServer interface {
 	RateLimit(context.Context, *QueryRateLimitRequest) (*QueryRateLimitResponse, error)
 	RateLimitsByChainId(context.Context, *QueryRateLimitsByChainIdRequest) (*QueryRateLimitsByChainIdResponse, error)
 	RateLimitsByChannelId(context.Context, *QueryRateLimitsByChannelIdRequest) (*QueryRateLimitsByChannelIdResponse, error)
+	AggregateRateLimits(context.Context, *QueryAggregateRateLimitsRequest) (*QueryAggregateRateLimitsResponse, error)
+	AllChannelGroups(context.Context, *QueryAllChannelGroupsRequest) (*QueryAllChannelGroupsResponse, error)
 	AllBlacklistedDenoms(context.Context, *QueryAllBlacklistedDenomsRequest) (*QueryAllBlacklistedDenomsResponse, error)
 	AllWhitelistedAddresses(context.Context, *QueryAllWhitelistedAddressesRequest) (*QueryAllWhitelistedAddressesResponse, error)
 }
@@ -714,6 +915,12 @@ func (*UnimplementedQueryServer) RateLimitsByChainId(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) RateLimitsByChannelId(ctx context.Context, req *QueryRateLimitsByChannelIdRequest) (*QueryRateLimitsByChannelIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RateLimitsByChannelId not implemented")
 }
+func (*UnimplementedQueryServer) AggregateRateLimits(ctx context.Context, req *QueryAggregateRateLimitsRequest) (*QueryAggregateRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateRateLimits not implemented")
+}
+func (*UnimplementedQueryServer) AllChannelGroups(ctx context.Context, req *QueryAllChannelGroupsRequest) (*QueryAllChannelGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllChannelGroups not implemented")
+}
 func (*UnimplementedQueryServer) AllBlacklistedDenoms(ctx context.Context, req *QueryAllBlacklistedDenomsRequest) (*QueryAllBlacklistedDenomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllBlacklistedDenoms not implemented")
 }
@@ -797,6 +1004,42 @@ func _Query_RateLimitsByChannelId_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AggregateRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregateRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregateRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.ratelimit.Query/AggregateRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregateRateLimits(ctx, req.(*QueryAggregateRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllChannelGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChannelGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllChannelGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.ratelimit.Query/AllChannelGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllChannelGroups(ctx, req.(*QueryAllChannelGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AllBlacklistedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAllBlacklistedDenomsRequest)
 	if err := dec(in); err != nil {
@@ -853,6 +1096,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RateLimitsByChannelId",
 			Handler:    _Query_RateLimitsByChannelId_Handler,
 		},
+		{
+			MethodName: "AggregateRateLimits",
+			Handler:    _Query_AggregateRateLimits_Handler,
+		},
+		{
+			MethodName: "AllChannelGroups",
+			Handler:    _Query_AllChannelGroups_Handler,
+		},
 		{
 			MethodName: "AllBlacklistedDenoms",
 			Handler:    _Query_AllBlacklistedDenoms_Handler,
@@ -1132,7 +1383,7 @@ func (m *QueryRateLimitsByChannelIdResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlacklistedDenomsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregateRateLimitsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1142,20 +1393,27 @@ func (m *QueryAllBlacklistedDenomsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlacklistedDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregateRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlacklistedDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregateRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlacklistedDenomsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregateRateLimitsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1165,21 +1423,26 @@ func (m *QueryAllBlacklistedDenomsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlacklistedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregateRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlacklistedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregateRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denoms) > 0 {
-		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denoms[iNdEx])
-			copy(dAtA[i:], m.Denoms[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1187,7 +1450,7 @@ func (m *QueryAllBlacklistedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllWhitelistedAddressesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllChannelGroupsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1197,12 +1460,12 @@ func (m *QueryAllWhitelistedAddressesRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllWhitelistedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllChannelGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllWhitelistedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllChannelGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1210,7 +1473,7 @@ func (m *QueryAllWhitelistedAddressesRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllWhitelistedAddressesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllChannelGroupsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1220,20 +1483,20 @@ func (m *QueryAllWhitelistedAddressesResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllWhitelistedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllChannelGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllWhitelistedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllChannelGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AddressPairs) > 0 {
-		for iNdEx := len(m.AddressPairs) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ChannelGroups) > 0 {
+		for iNdEx := len(m.ChannelGroups) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AddressPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ChannelGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1247,42 +1510,157 @@ func (m *QueryAllWhitelistedAddressesResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAllBlacklistedDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAllRateLimitsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryAllBlacklistedDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlacklistedDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllRateLimitsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllBlacklistedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlacklistedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlacklistedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RateLimits) > 0 {
-		for _, e := range m.RateLimits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRateLimitRequest) Size() (n int) {
+func (m *QueryAllWhitelistedAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllWhitelistedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllWhitelistedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllWhitelistedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllWhitelistedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllWhitelistedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddressPairs) > 0 {
+		for iNdEx := len(m.AddressPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRateLimitRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1368,6 +1746,58 @@ func (m *QueryRateLimitsByChannelIdResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAggregateRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregateRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllChannelGroupsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllChannelGroupsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChannelGroups) > 0 {
+		for _, e := range m.ChannelGroups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryAllBlacklistedDenomsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2088,6 +2518,306 @@ func (m *QueryRateLimitsByChannelIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAggregateRateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChannelGroupsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChannelGroupsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChannelGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChannelGroupsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChannelGroupsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChannelGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelGroups = append(m.ChannelGroups, ChannelGroup{})
+			if err := m.ChannelGroups[len(m.ChannelGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAllBlacklistedDenomsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
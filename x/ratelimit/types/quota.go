@@ -1,22 +1,131 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 )
 
 // CheckExceedsQuota checks if new in/out flow is going to reach the max in/out or not
+// The flow exceeds the quota if it's greater than either the percentage threshold of the
+// channel value or the absolute cap (if one is set)
 func (q *Quota) CheckExceedsQuota(direction PacketDirection, amount sdkmath.Int, totalValue sdkmath.Int) bool {
+	var maxPercent, maxAmount sdkmath.Int
+	if direction == PACKET_RECV {
+		maxPercent, maxAmount = q.MaxPercentRecv, q.MaxAmountRecv
+	} else {
+		maxPercent, maxAmount = q.MaxPercentSend, q.MaxAmountSend
+	}
+
+	// The absolute cap does not depend on the channel value, so it's enforced even if
+	// there's no supply of the asset yet
+	if isCapSet(maxAmount) {
+		if amount.GT(maxAmount) {
+			return true
+		}
+		// If there's no percentage threshold for this direction, the absolute cap is the only limit
+		if !isCapSet(maxPercent) {
+			return false
+		}
+	}
+
 	// If there's no channel value (this should be almost impossible), it means there is no
 	// supply of the asset, so we shoudn't prevent inflows/outflows
 	if totalValue.IsZero() {
 		return false
 	}
-	var threshold sdkmath.Int
+	threshold := totalValue.Mul(maxPercent).Quo(sdkmath.NewInt(100))
+
+	return amount.GT(threshold)
+}
+
+// Returns true if the quota has an absolute cap in either direction
+func (q *Quota) HasAmountCap() bool {
+	return isCapSet(q.MaxAmountSend) || isCapSet(q.MaxAmountRecv)
+}
+
+// Validates the thresholds and duration of a quota
+func (q *Quota) Validate() error {
+	if q.MaxPercentSend.IsNil() || q.MaxPercentSend.GT(sdkmath.NewInt(100)) || q.MaxPercentSend.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "max percent send must be between 0 and 100 (inclusively), provided: %v", q.MaxPercentSend)
+	}
+	if q.MaxPercentRecv.IsNil() || q.MaxPercentRecv.GT(sdkmath.NewInt(100)) || q.MaxPercentRecv.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "max percent recv must be between 0 and 100 (inclusively), provided: %v", q.MaxPercentRecv)
+	}
+	if !q.MaxAmountSend.IsNil() && q.MaxAmountSend.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "max amount send cannot be negative, provided: %v", q.MaxAmountSend)
+	}
+	if !q.MaxAmountRecv.IsNil() && q.MaxAmountRecv.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "max amount recv cannot be negative, provided: %v", q.MaxAmountRecv)
+	}
+	if q.MaxPercentSend.IsZero() && q.MaxPercentRecv.IsZero() && !q.HasAmountCap() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "either a max percent or max amount threshold must be greater than 0")
+	}
+	if q.DurationHours == 0 {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "duration can not be zero")
+	}
+	if _, ok := FlowMeasurement_name[int32(q.FlowMeasurement)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "invalid flow measurement (%d)", q.FlowMeasurement)
+	}
+	if q.AddressQuota != nil {
+		if err := q.AddressQuota.Validate(); err != nil {
+			return err
+		}
+		// Address flows are not tracked hourly, so they can't be held to a sliding window
+		if q.SlidingWindow {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "an address quota cannot be used with a sliding window")
+		}
+	}
+	return nil
+}
+
+// Returns the quota that each address is held to, using the address thresholds
+// with the parent quota's flow measurement
+func (q *Quota) GetAddressSubQuota() Quota {
+	return Quota{
+		MaxPercentSend:  q.AddressQuota.MaxPercentSend,
+		MaxPercentRecv:  q.AddressQuota.MaxPercentRecv,
+		MaxAmountSend:   q.AddressQuota.MaxAmountSend,
+		MaxAmountRecv:   q.AddressQuota.MaxAmountRecv,
+		DurationHours:   q.DurationHours,
+		FlowMeasurement: q.FlowMeasurement,
+	}
+}
+
+// Returns true if transfers in the given direction are limited per address
+func (q *AddressQuota) HasLimit(direction PacketDirection) bool {
 	if direction == PACKET_RECV {
-		threshold = totalValue.Mul(q.MaxPercentRecv).Quo(sdkmath.NewInt(100))
-	} else {
-		threshold = totalValue.Mul(q.MaxPercentSend).Quo(sdkmath.NewInt(100))
+		return isCapSet(q.MaxPercentRecv) || isCapSet(q.MaxAmountRecv)
+	}
+	return isCapSet(q.MaxPercentSend) || isCapSet(q.MaxAmountSend)
+}
+
+// Validates the thresholds of an address sub-quota
+func (q *AddressQuota) Validate() error {
+	for _, maxPercent := range []sdkmath.Int{q.MaxPercentSend, q.MaxPercentRecv} {
+		if !maxPercent.IsNil() && (maxPercent.GT(sdkmath.NewInt(100)) || maxPercent.IsNegative()) {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "address max percent must be between 0 and 100 (inclusively), provided: %v", maxPercent)
+		}
+	}
+	for _, maxAmount := range []sdkmath.Int{q.MaxAmountSend, q.MaxAmountRecv} {
+		if !maxAmount.IsNil() && maxAmount.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "address max amount cannot be negative, provided: %v", maxAmount)
+		}
 	}
+	if !q.HasLimit(PACKET_SEND) && !q.HasLimit(PACKET_RECV) {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "address quota must have a send or receive threshold greater than 0")
+	}
+	return nil
+}
 
-	return amount.GT(threshold)
+// An absolute cap is optional, and is only enforced if it's been set to a positive amount
+func isCapSet(maxAmount sdkmath.Int) bool {
+	return !maxAmount.IsNil() && maxAmount.IsPositive()
+}
+
+// Returns the label used to describe the measured flow in quota errors
+func flowMeasurementLabel(flowMeasurement FlowMeasurement) string {
+	if flowMeasurement == FLOW_GROSS {
+		return "Gross"
+	}
+	return "Net"
 }
@@ -77,3 +77,219 @@ func TestCheckExceedsQuota(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckExceedsQuota_AbsoluteCap(t *testing.T) {
+	totalValue := sdkmath.NewInt(1000)
+
+	tests := []struct {
+		name       string
+		quota      types.Quota
+		direction  types.PacketDirection
+		amount     sdkmath.Int
+		totalValue sdkmath.Int
+		exceeded   bool
+	}{
+		{
+			name:       "absolute cap exceeded before percent threshold",
+			quota:      types.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxAmountSend: sdkmath.NewInt(50)},
+			direction:  types.PACKET_SEND,
+			amount:     sdkmath.NewInt(60),
+			totalValue: totalValue,
+			exceeded:   true,
+		},
+		{
+			name:       "percent threshold exceeded before absolute cap",
+			quota:      types.Quota{MaxPercentRecv: sdkmath.NewInt(10), MaxAmountRecv: sdkmath.NewInt(500)},
+			direction:  types.PACKET_RECV,
+			amount:     sdkmath.NewInt(101),
+			totalValue: totalValue,
+			exceeded:   true,
+		},
+		{
+			name:       "under both thresholds",
+			quota:      types.Quota{MaxPercentRecv: sdkmath.NewInt(10), MaxAmountRecv: sdkmath.NewInt(50)},
+			direction:  types.PACKET_RECV,
+			amount:     sdkmath.NewInt(50),
+			totalValue: totalValue,
+			exceeded:   false,
+		},
+		{
+			name:       "absolute cap only, under cap",
+			quota:      types.Quota{MaxPercentSend: sdkmath.ZeroInt(), MaxAmountSend: sdkmath.NewInt(5000)},
+			direction:  types.PACKET_SEND,
+			amount:     sdkmath.NewInt(4000),
+			totalValue: totalValue,
+			exceeded:   false,
+		},
+		{
+			name:       "absolute cap only, over cap",
+			quota:      types.Quota{MaxPercentSend: sdkmath.ZeroInt(), MaxAmountSend: sdkmath.NewInt(5000)},
+			direction:  types.PACKET_SEND,
+			amount:     sdkmath.NewInt(5001),
+			totalValue: totalValue,
+			exceeded:   true,
+		},
+		{
+			name:       "absolute cap enforced with zero channel value",
+			quota:      types.Quota{MaxPercentRecv: sdkmath.NewInt(10), MaxAmountRecv: sdkmath.NewInt(50)},
+			direction:  types.PACKET_RECV,
+			amount:     sdkmath.NewInt(51),
+			totalValue: sdkmath.ZeroInt(),
+			exceeded:   true,
+		},
+		{
+			name:       "zero percent without absolute cap blocks direction",
+			quota:      types.Quota{MaxPercentSend: sdkmath.ZeroInt(), MaxAmountRecv: sdkmath.NewInt(50)},
+			direction:  types.PACKET_SEND,
+			amount:     sdkmath.NewInt(1),
+			totalValue: totalValue,
+			exceeded:   true,
+		},
+		{
+			name:       "unset absolute cap",
+			quota:      types.Quota{MaxPercentSend: sdkmath.NewInt(10)},
+			direction:  types.PACKET_SEND,
+			amount:     sdkmath.NewInt(100),
+			totalValue: totalValue,
+			exceeded:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := test.quota.CheckExceedsQuota(test.direction, test.amount, test.totalValue)
+			require.Equal(t, test.exceeded, res, "test: %s", test.name)
+		})
+	}
+}
+
+func TestValidateQuota(t *testing.T) {
+	validPercent := sdkmath.NewInt(10)
+
+	tests := []struct {
+		name  string
+		quota types.Quota
+		err   string
+	}{
+		{
+			name:  "valid percent quota",
+			quota: types.Quota{MaxPercentSend: validPercent, MaxPercentRecv: validPercent, DurationHours: 24},
+		},
+		{
+			name: "valid absolute cap quota",
+			quota: types.Quota{
+				MaxPercentSend:  sdkmath.ZeroInt(),
+				MaxPercentRecv:  sdkmath.ZeroInt(),
+				MaxAmountSend:   sdkmath.NewInt(100),
+				DurationHours:   24,
+				FlowMeasurement: types.FLOW_GROSS,
+			},
+		},
+		{
+			name:  "invalid send percent",
+			quota: types.Quota{MaxPercentSend: sdkmath.NewInt(101), MaxPercentRecv: validPercent, DurationHours: 24},
+			err:   "max percent send must be between 0 and 100",
+		},
+		{
+			name:  "invalid recv percent",
+			quota: types.Quota{MaxPercentSend: validPercent, MaxPercentRecv: sdkmath.NewInt(-1), DurationHours: 24},
+			err:   "max percent recv must be between 0 and 100",
+		},
+		{
+			name:  "missing percent",
+			quota: types.Quota{MaxPercentSend: validPercent, DurationHours: 24},
+			err:   "max percent recv must be between 0 and 100",
+		},
+		{
+			name: "negative send amount",
+			quota: types.Quota{
+				MaxPercentSend: validPercent, MaxPercentRecv: validPercent, MaxAmountSend: sdkmath.NewInt(-1), DurationHours: 24,
+			},
+			err: "max amount send cannot be negative",
+		},
+		{
+			name: "negative recv amount",
+			quota: types.Quota{
+				MaxPercentSend: validPercent, MaxPercentRecv: validPercent, MaxAmountRecv: sdkmath.NewInt(-1), DurationHours: 24,
+			},
+			err: "max amount recv cannot be negative",
+		},
+		{
+			name:  "no thresholds",
+			quota: types.Quota{MaxPercentSend: sdkmath.ZeroInt(), MaxPercentRecv: sdkmath.ZeroInt(), DurationHours: 24},
+			err:   "either a max percent or max amount threshold must be greater than 0",
+		},
+		{
+			name:  "zero duration",
+			quota: types.Quota{MaxPercentSend: validPercent, MaxPercentRecv: validPercent},
+			err:   "duration can not be zero",
+		},
+		{
+			name: "invalid flow measurement",
+			quota: types.Quota{
+				MaxPercentSend: validPercent, MaxPercentRecv: validPercent, DurationHours: 24, FlowMeasurement: types.FlowMeasurement(2),
+			},
+			err: "invalid flow measurement",
+		},
+		{
+			name: "address quota with sliding window",
+			quota: types.Quota{
+				MaxPercentSend: validPercent, MaxPercentRecv: validPercent, DurationHours: 24, SlidingWindow: true,
+				AddressQuota: &types.AddressQuota{MaxPercentSend: validPercent},
+			},
+			err: "an address quota cannot be used with a sliding window",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.quota.Validate(), "test: %s", test.name)
+			} else {
+				require.ErrorContains(t, test.quota.Validate(), test.err, "test: %s", test.name)
+			}
+		})
+	}
+}
+
+func TestValidateAddressQuota(t *testing.T) {
+	tests := []struct {
+		name         string
+		addressQuota types.AddressQuota
+		err          string
+	}{
+		{
+			name:         "valid percent",
+			addressQuota: types.AddressQuota{MaxPercentSend: sdkmath.NewInt(5)},
+		},
+		{
+			name:         "valid amount",
+			addressQuota: types.AddressQuota{MaxAmountRecv: sdkmath.NewInt(1000)},
+		},
+		{
+			name:         "invalid percent",
+			addressQuota: types.AddressQuota{MaxPercentRecv: sdkmath.NewInt(101)},
+			err:          "address max percent must be between 0 and 100",
+		},
+		{
+			name:         "negative amount",
+			addressQuota: types.AddressQuota{MaxPercentSend: sdkmath.NewInt(5), MaxAmountSend: sdkmath.NewInt(-1)},
+			err:          "address max amount cannot be negative",
+		},
+		{
+			name:         "no thresholds",
+			addressQuota: types.AddressQuota{MaxPercentSend: sdkmath.ZeroInt()},
+			err:          "address quota must have a send or receive threshold greater than 0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.err == "" {
+				require.NoError(t, test.addressQuota.Validate(), "test: %s", test.name)
+			} else {
+				require.ErrorContains(t, test.addressQuota.Validate(), test.err, "test: %s", test.name)
+			}
+		})
+	}
+}
@@ -49,8 +49,40 @@ func (PacketDirection) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a3e00ee2c967d747, []int{0}
 }
 
+// Determines how the flow is measured when checking a quota
+type FlowMeasurement int32
+
+const (
+	// the net flow in the direction of the transfer (e.g. outflow minus inflow
+	// for a send) is compared against the quota
+	FLOW_NET FlowMeasurement = 0
+	// the total flow in the direction of the transfer is compared against the
+	// quota, without being offset by transfers in the opposite direction
+	FLOW_GROSS FlowMeasurement = 1
+)
+
+var FlowMeasurement_name = map[int32]string{
+	0: "FLOW_NET",
+	1: "FLOW_GROSS",
+}
+
+var FlowMeasurement_value = map[string]int32{
+	"FLOW_NET":   0,
+	"FLOW_GROSS": 1,
+}
+
+func (x FlowMeasurement) String() string {
+	return proto.EnumName(FlowMeasurement_name, int32(x))
+}
+
+func (FlowMeasurement) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a3e00ee2c967d747, []int{1}
+}
+
 type Path struct {
-	Denom     string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// either a channel-id, "*" for an aggregate rate limit across every channel,
+	// or "group/{name}" for an aggregate rate limit across a channel group
 	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
@@ -105,6 +137,19 @@ type Quota struct {
 	MaxPercentSend github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=max_percent_send,json=maxPercentSend,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_percent_send"`
 	MaxPercentRecv github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_percent_recv,json=maxPercentRecv,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_percent_recv"`
 	DurationHours  uint64                                 `protobuf:"varint,3,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	// if enabled, the flow is compared against the quota over a rolling window of
+	// the last duration_hours hour epochs, rather than resetting at the end of
+	// each window
+	SlidingWindow bool `protobuf:"varint,4,opt,name=sliding_window,json=slidingWindow,proto3" json:"sliding_window,omitempty"`
+	// optional absolute caps on the flow in each direction (in the base denom)
+	// a cap of zero is not enforced, and if the percent for that direction is
+	// also zero, the direction is only limited by the absolute cap
+	MaxAmountSend   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=max_amount_send,json=maxAmountSend,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount_send"`
+	MaxAmountRecv   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=max_amount_recv,json=maxAmountRecv,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount_recv"`
+	FlowMeasurement FlowMeasurement                        `protobuf:"varint,7,opt,name=flow_measurement,json=flowMeasurement,proto3,enum=stride.ratelimit.FlowMeasurement" json:"flow_measurement,omitempty"`
+	// optional sub-quota applied to each individual address (the sender for
+	// outflows and the receiver for inflows), which resets with the parent quota
+	AddressQuota *AddressQuota `protobuf:"bytes,8,opt,name=address_quota,json=addressQuota,proto3" json:"address_quota,omitempty"`
 }
 
 func (m *Quota) Reset()         { *m = Quota{} }
@@ -147,17 +192,84 @@ func (m *Quota) GetDurationHours() uint64 {
 	return 0
 }
 
+func (m *Quota) GetSlidingWindow() bool {
+	if m != nil {
+		return m.SlidingWindow
+	}
+	return false
+}
+
+func (m *Quota) GetFlowMeasurement() FlowMeasurement {
+	if m != nil {
+		return m.FlowMeasurement
+	}
+	return FLOW_NET
+}
+
+func (m *Quota) GetAddressQuota() *AddressQuota {
+	if m != nil {
+		return m.AddressQuota
+	}
+	return nil
+}
+
+// Per-address thresholds, as a percentage of the channel value and/or as an
+// absolute cap
+// A direction with neither threshold set is not limited per address
+type AddressQuota struct {
+	MaxPercentSend github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=max_percent_send,json=maxPercentSend,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_percent_send"`
+	MaxPercentRecv github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_percent_recv,json=maxPercentRecv,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_percent_recv"`
+	MaxAmountSend  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_amount_send,json=maxAmountSend,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount_send"`
+	MaxAmountRecv  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_amount_recv,json=maxAmountRecv,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount_recv"`
+}
+
+func (m *AddressQuota) Reset()         { *m = AddressQuota{} }
+func (m *AddressQuota) String() string { return proto.CompactTextString(m) }
+func (*AddressQuota) ProtoMessage()    {}
+func (*AddressQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3e00ee2c967d747, []int{2}
+}
+func (m *AddressQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressQuota.Merge(m, src)
+}
+func (m *AddressQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressQuota proto.InternalMessageInfo
+
 type Flow struct {
 	Inflow       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=inflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow"`
 	Outflow      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=outflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow"`
 	ChannelValue github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=channel_value,json=channelValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"channel_value"`
+	// for sliding window quotas, the inflow and outflow of each hour epoch in the
+	// window (the inflow and outflow above are the sums across these buckets)
+	HourlyFlows []HourlyFlow `protobuf:"bytes,4,rep,name=hourly_flows,json=hourlyFlows,proto3" json:"hourly_flows"`
 }
 
 func (m *Flow) Reset()         { *m = Flow{} }
 func (m *Flow) String() string { return proto.CompactTextString(m) }
 func (*Flow) ProtoMessage()    {}
 func (*Flow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3e00ee2c967d747, []int{2}
+	return fileDescriptor_a3e00ee2c967d747, []int{3}
 }
 func (m *Flow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -186,6 +298,123 @@ func (m *Flow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Flow proto.InternalMessageInfo
 
+func (m *Flow) GetHourlyFlows() []HourlyFlow {
+	if m != nil {
+		return m.HourlyFlows
+	}
+	return nil
+}
+
+type HourlyFlow struct {
+	EpochHour uint64                                 `protobuf:"varint,1,opt,name=epoch_hour,json=epochHour,proto3" json:"epoch_hour,omitempty"`
+	Inflow    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=inflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow"`
+	Outflow   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=outflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow"`
+}
+
+func (m *HourlyFlow) Reset()         { *m = HourlyFlow{} }
+func (m *HourlyFlow) String() string { return proto.CompactTextString(m) }
+func (*HourlyFlow) ProtoMessage()    {}
+func (*HourlyFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3e00ee2c967d747, []int{4}
+}
+func (m *HourlyFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HourlyFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HourlyFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HourlyFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HourlyFlow.Merge(m, src)
+}
+func (m *HourlyFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *HourlyFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_HourlyFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HourlyFlow proto.InternalMessageInfo
+
+func (m *HourlyFlow) GetEpochHour() uint64 {
+	if m != nil {
+		return m.EpochHour
+	}
+	return 0
+}
+
+// The flow of an individual address along a rate limited path, used to enforce
+// the quota's address sub-quota
+type AddressFlow struct {
+	Denom     string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelId string                                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Address   string                                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Inflow    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=inflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow"`
+	Outflow   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=outflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow"`
+}
+
+func (m *AddressFlow) Reset()         { *m = AddressFlow{} }
+func (m *AddressFlow) String() string { return proto.CompactTextString(m) }
+func (*AddressFlow) ProtoMessage()    {}
+func (*AddressFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3e00ee2c967d747, []int{5}
+}
+func (m *AddressFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressFlow.Merge(m, src)
+}
+func (m *AddressFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressFlow proto.InternalMessageInfo
+
+func (m *AddressFlow) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AddressFlow) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *AddressFlow) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 type RateLimit struct {
 	Path  *Path  `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	Quota *Quota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
@@ -196,7 +425,7 @@ func (m *RateLimit) Reset()         { *m = RateLimit{} }
 func (m *RateLimit) String() string { return proto.CompactTextString(m) }
 func (*RateLimit) ProtoMessage()    {}
 func (*RateLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3e00ee2c967d747, []int{3}
+	return fileDescriptor_a3e00ee2c967d747, []int{6}
 }
 func (m *RateLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -246,6 +475,59 @@ func (m *RateLimit) GetFlow() *Flow {
 	return nil
 }
 
+// A named set of channels that can share an aggregate rate limit
+type ChannelGroup struct {
+	Name       string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ChannelIds []string `protobuf:"bytes,2,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+}
+
+func (m *ChannelGroup) Reset()         { *m = ChannelGroup{} }
+func (m *ChannelGroup) String() string { return proto.CompactTextString(m) }
+func (*ChannelGroup) ProtoMessage()    {}
+func (*ChannelGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3e00ee2c967d747, []int{7}
+}
+func (m *ChannelGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelGroup.Merge(m, src)
+}
+func (m *ChannelGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelGroup proto.InternalMessageInfo
+
+func (m *ChannelGroup) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ChannelGroup) GetChannelIds() []string {
+	if m != nil {
+		return m.ChannelIds
+	}
+	return nil
+}
+
 type WhitelistedAddressPair struct {
 	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
@@ -255,7 +537,7 @@ func (m *WhitelistedAddressPair) Reset()         { *m = WhitelistedAddressPair{}
 func (m *WhitelistedAddressPair) String() string { return proto.CompactTextString(m) }
 func (*WhitelistedAddressPair) ProtoMessage()    {}
 func (*WhitelistedAddressPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3e00ee2c967d747, []int{4}
+	return fileDescriptor_a3e00ee2c967d747, []int{8}
 }
 func (m *WhitelistedAddressPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -300,52 +582,109 @@ func (m *WhitelistedAddressPair) GetReceiver() string {
 
 func init() {
 	proto.RegisterEnum("stride.ratelimit.PacketDirection", PacketDirection_name, PacketDirection_value)
+	proto.RegisterEnum("stride.ratelimit.FlowMeasurement", FlowMeasurement_name, FlowMeasurement_value)
 	proto.RegisterType((*Path)(nil), "stride.ratelimit.Path")
 	proto.RegisterType((*Quota)(nil), "stride.ratelimit.Quota")
+	proto.RegisterType((*AddressQuota)(nil), "stride.ratelimit.AddressQuota")
 	proto.RegisterType((*Flow)(nil), "stride.ratelimit.Flow")
+	proto.RegisterType((*HourlyFlow)(nil), "stride.ratelimit.HourlyFlow")
+	proto.RegisterType((*AddressFlow)(nil), "stride.ratelimit.AddressFlow")
 	proto.RegisterType((*RateLimit)(nil), "stride.ratelimit.RateLimit")
+	proto.RegisterType((*ChannelGroup)(nil), "stride.ratelimit.ChannelGroup")
 	proto.RegisterType((*WhitelistedAddressPair)(nil), "stride.ratelimit.WhitelistedAddressPair")
 }
 
 func init() { proto.RegisterFile("stride/ratelimit/ratelimit.proto", fileDescriptor_a3e00ee2c967d747) }
 
 var fileDescriptor_a3e00ee2c967d747 = []byte{
-	// 520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xb5, 0xbf, 0x26, 0xf9, 0xc8, 0x84, 0xb6, 0xd1, 0xa8, 0x0a, 0x51, 0x24, 0xdc, 0x28, 0x12,
-	0xa8, 0xaa, 0x14, 0x1b, 0xda, 0x15, 0x62, 0xd5, 0x9f, 0x54, 0xad, 0x08, 0x28, 0x38, 0xa8, 0x20,
-	0x36, 0xd1, 0xc4, 0x73, 0x89, 0x47, 0x8d, 0x3d, 0x61, 0x66, 0xec, 0x86, 0x37, 0x60, 0x89, 0x78,
-	0x05, 0x5e, 0xa6, 0xcb, 0x2e, 0x11, 0x8b, 0x0a, 0x25, 0x6b, 0xde, 0x01, 0xcd, 0xd8, 0x29, 0x51,
-	0x11, 0x0b, 0xca, 0xca, 0x73, 0xcf, 0x3d, 0x73, 0xe4, 0x73, 0xe7, 0x5c, 0xd4, 0x94, 0x4a, 0x30,
-	0x0a, 0x9e, 0x20, 0x0a, 0xc6, 0x2c, 0x62, 0xea, 0xd7, 0xc9, 0x9d, 0x08, 0xae, 0x38, 0xae, 0x66,
-	0x0c, 0xf7, 0x1a, 0x6f, 0x6c, 0x8c, 0xf8, 0x88, 0x9b, 0xa6, 0xa7, 0x4f, 0x19, 0xaf, 0xf5, 0x14,
-	0x15, 0x7a, 0x44, 0x85, 0x78, 0x03, 0x15, 0x29, 0xc4, 0x3c, 0xaa, 0xdb, 0x4d, 0x7b, 0xab, 0xec,
-	0x67, 0x05, 0xbe, 0x8f, 0x50, 0x10, 0x92, 0x38, 0x86, 0xf1, 0x80, 0xd1, 0xfa, 0x7f, 0xa6, 0x55,
-	0xce, 0x91, 0x13, 0xda, 0x9a, 0xd9, 0xa8, 0xf8, 0x32, 0xe1, 0x8a, 0xe0, 0x37, 0xa8, 0x1a, 0x91,
-	0xe9, 0x60, 0x02, 0x22, 0x80, 0x58, 0x0d, 0x24, 0xc4, 0x34, 0x53, 0xda, 0x77, 0x2f, 0xae, 0x36,
-	0xad, 0x6f, 0x57, 0x9b, 0x0f, 0x47, 0x4c, 0x85, 0xc9, 0xd0, 0x0d, 0x78, 0xe4, 0x05, 0x5c, 0x46,
-	0x5c, 0xe6, 0x9f, 0xb6, 0xa4, 0x67, 0x9e, 0xfa, 0x30, 0x01, 0xe9, 0x9e, 0xc4, 0xca, 0x5f, 0x8b,
-	0xc8, 0xb4, 0x97, 0xc9, 0xf4, 0x21, 0xa6, 0x37, 0x95, 0x05, 0x04, 0x69, 0xf6, 0x23, 0xff, 0xa2,
-	0xec, 0x43, 0x90, 0xe2, 0x07, 0x68, 0x8d, 0x26, 0x82, 0x28, 0xc6, 0xe3, 0x41, 0xc8, 0x13, 0x21,
-	0xeb, 0x2b, 0x4d, 0x7b, 0xab, 0xe0, 0xaf, 0x2e, 0xd0, 0x63, 0x0d, 0xb6, 0x7e, 0xd8, 0xa8, 0x70,
-	0x34, 0xe6, 0xe7, 0xf8, 0x08, 0x95, 0x58, 0xfc, 0x6e, 0xcc, 0xcf, 0x6f, 0xe9, 0x2c, 0xbf, 0x8d,
-	0x8f, 0xd1, 0xff, 0x3c, 0x51, 0x46, 0xe8, 0x76, 0x46, 0x16, 0xd7, 0x71, 0x1f, 0xad, 0x2e, 0x9e,
-	0x27, 0x25, 0xe3, 0x04, 0x8c, 0x81, 0xbf, 0xd7, 0xbb, 0x9b, 0x8b, 0x9c, 0x6a, 0x8d, 0xd6, 0x67,
-	0x1b, 0x95, 0x7d, 0xa2, 0xa0, 0xab, 0x53, 0x83, 0xb7, 0x51, 0x61, 0x42, 0x54, 0x68, 0x2c, 0x57,
-	0x76, 0x6a, 0xee, 0xcd, 0x58, 0xb9, 0x3a, 0x3d, 0xbe, 0xe1, 0xe0, 0x36, 0x2a, 0xbe, 0xd7, 0x69,
-	0x30, 0xb6, 0x2a, 0x3b, 0xf7, 0x7e, 0x27, 0x9b, 0xb0, 0xf8, 0x19, 0x4b, 0x4b, 0x9b, 0x21, 0xac,
-	0xfc, 0x49, 0x5a, 0x4f, 0xdd, 0x37, 0x9c, 0x56, 0x17, 0xd5, 0x5e, 0x87, 0x4c, 0x37, 0xa4, 0x02,
-	0xba, 0x47, 0xa9, 0x00, 0x29, 0x7b, 0x84, 0x09, 0x5c, 0x43, 0x25, 0x9d, 0x36, 0x10, 0x79, 0x72,
-	0xf3, 0x0a, 0x37, 0xd0, 0x1d, 0x01, 0x01, 0xb0, 0x14, 0x44, 0x1e, 0xdc, 0xeb, 0x7a, 0xfb, 0x09,
-	0x5a, 0xef, 0x91, 0xe0, 0x0c, 0xd4, 0x21, 0x13, 0x10, 0xe8, 0xa7, 0xc6, 0xeb, 0xa8, 0xd2, 0xdb,
-	0x3b, 0x78, 0xd6, 0x79, 0x35, 0xe8, 0x77, 0x5e, 0x1c, 0x56, 0xad, 0x25, 0xc0, 0xef, 0x1c, 0x9c,
-	0x56, 0xed, 0x46, 0xe1, 0xe3, 0x17, 0xc7, 0xda, 0x7f, 0x7e, 0x31, 0x73, 0xec, 0xcb, 0x99, 0x63,
-	0x7f, 0x9f, 0x39, 0xf6, 0xa7, 0xb9, 0x63, 0x5d, 0xce, 0x1d, 0xeb, 0xeb, 0xdc, 0xb1, 0xde, 0xee,
-	0x2e, 0x4d, 0xbb, 0x6f, 0xac, 0xb4, 0xbb, 0x64, 0x28, 0xbd, 0x7c, 0x55, 0xd3, 0xc7, 0x8f, 0xbc,
-	0xe9, 0xd2, 0xc2, 0x9a, 0xf1, 0x0f, 0x4b, 0x66, 0x0b, 0x77, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0x6e, 0x65, 0xef, 0xd1, 0xd1, 0x03, 0x00, 0x00,
+	// 829 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x13, 0xa7, 0x6d, 0x5e, 0x92, 0x26, 0x1a, 0xad, 0x8a, 0x55, 0x81, 0x6b, 0x2c, 0x81,
+	0xa2, 0x4a, 0x4d, 0xa0, 0x2b, 0x0e, 0xc0, 0xa9, 0xcd, 0xa6, 0xdb, 0x15, 0xd9, 0xdd, 0x30, 0x59,
+	0xb5, 0x88, 0x8b, 0x35, 0xb5, 0x67, 0x63, 0x6b, 0x63, 0x4f, 0xf0, 0x8c, 0x93, 0xec, 0x7f, 0xc0,
+	0x11, 0xf1, 0x17, 0x20, 0xf1, 0x2f, 0x70, 0xe5, 0xbe, 0x07, 0x0e, 0x7b, 0x44, 0x1c, 0x56, 0xa8,
+	0xbd, 0x70, 0xe2, 0xcc, 0x11, 0xcd, 0xd8, 0xf9, 0xb1, 0xcd, 0x9e, 0x92, 0x72, 0xe0, 0x14, 0xbf,
+	0xe7, 0xcf, 0x5f, 0xe6, 0x7d, 0xdf, 0x9b, 0x37, 0x03, 0x16, 0x17, 0x71, 0xe0, 0xd1, 0x56, 0x4c,
+	0x04, 0x1d, 0x06, 0x61, 0x20, 0x16, 0x4f, 0xcd, 0x51, 0xcc, 0x04, 0x43, 0xf5, 0x14, 0xd1, 0x9c,
+	0xe7, 0xf7, 0xef, 0x0d, 0xd8, 0x80, 0xa9, 0x97, 0x2d, 0xf9, 0x94, 0xe2, 0xec, 0x2f, 0x41, 0xef,
+	0x11, 0xe1, 0xa3, 0x7b, 0x50, 0xf4, 0x68, 0xc4, 0x42, 0x43, 0xb3, 0xb4, 0x46, 0x09, 0xa7, 0x01,
+	0xfa, 0x00, 0xc0, 0xf5, 0x49, 0x14, 0xd1, 0xa1, 0x13, 0x78, 0x46, 0x5e, 0xbd, 0x2a, 0x65, 0x99,
+	0x47, 0x9e, 0xfd, 0x9b, 0x0e, 0xc5, 0xaf, 0x13, 0x26, 0x08, 0xfa, 0x06, 0xea, 0x21, 0x99, 0x3a,
+	0x23, 0x1a, 0xbb, 0x34, 0x12, 0x0e, 0xa7, 0x91, 0x97, 0x32, 0x9d, 0x36, 0x5f, 0xbd, 0x39, 0xc8,
+	0xfd, 0xf1, 0xe6, 0xe0, 0xe3, 0x41, 0x20, 0xfc, 0xe4, 0xaa, 0xe9, 0xb2, 0xb0, 0xe5, 0x32, 0x1e,
+	0x32, 0x9e, 0xfd, 0x1c, 0x71, 0xef, 0x45, 0x4b, 0xbc, 0x1c, 0x51, 0xde, 0x7c, 0x14, 0x09, 0xbc,
+	0x1b, 0x92, 0x69, 0x2f, 0xa5, 0xe9, 0xd3, 0xc8, 0xbb, 0xcd, 0x1c, 0x53, 0x77, 0x6c, 0xe4, 0x37,
+	0x65, 0xc6, 0xd4, 0x1d, 0xa3, 0x8f, 0x60, 0xd7, 0x4b, 0x62, 0x22, 0x02, 0x16, 0x39, 0x3e, 0x4b,
+	0x62, 0x6e, 0x14, 0x2c, 0xad, 0xa1, 0xe3, 0xea, 0x2c, 0x7b, 0x2e, 0x93, 0x12, 0xc6, 0x87, 0x81,
+	0x17, 0x44, 0x03, 0x67, 0x12, 0x44, 0x1e, 0x9b, 0x18, 0xba, 0xa5, 0x35, 0x76, 0x70, 0x35, 0xcb,
+	0x5e, 0xaa, 0x24, 0xba, 0x80, 0x9a, 0x5c, 0x27, 0x09, 0x59, 0x32, 0x13, 0xa0, 0xb8, 0xd6, 0x32,
+	0xab, 0x21, 0x99, 0x9e, 0x28, 0x16, 0x55, 0xff, 0xdb, 0xbc, 0xaa, 0xfc, 0xad, 0x0d, 0x79, 0x55,
+	0xf5, 0x5d, 0xa8, 0x3f, 0x1f, 0xb2, 0x89, 0x13, 0x52, 0xc2, 0x93, 0x98, 0x86, 0x34, 0x12, 0xc6,
+	0xb6, 0xa5, 0x35, 0x76, 0x8f, 0x3f, 0x6c, 0xde, 0xee, 0x9d, 0xe6, 0xd9, 0x90, 0x4d, 0x1e, 0x2f,
+	0x80, 0xb8, 0xf6, 0xfc, 0xed, 0x04, 0x6a, 0x43, 0x95, 0x78, 0x5e, 0x4c, 0x39, 0x77, 0xbe, 0x93,
+	0x0d, 0x61, 0xec, 0x58, 0x5a, 0xa3, 0x7c, 0x6c, 0xae, 0x52, 0x9d, 0xa4, 0x30, 0xd5, 0x36, 0xb8,
+	0x42, 0x96, 0x22, 0xfb, 0x9f, 0x3c, 0x54, 0x96, 0x5f, 0xff, 0x2f, 0xbb, 0xea, 0x1d, 0x7d, 0x50,
+	0xf8, 0x8f, 0xfa, 0x40, 0xbf, 0x83, 0x3e, 0xf8, 0x42, 0xff, 0xeb, 0xa7, 0x03, 0xcd, 0xfe, 0x25,
+	0x0f, 0xba, 0x34, 0x19, 0x9d, 0xc1, 0x56, 0x10, 0x49, 0x77, 0xd7, 0x14, 0x3a, 0xfb, 0x1a, 0x9d,
+	0xc3, 0x36, 0x4b, 0x84, 0x22, 0x5a, 0x4f, 0xd7, 0xd9, 0xe7, 0xa8, 0x0f, 0xd5, 0xd9, 0x0c, 0x1a,
+	0x93, 0x61, 0x42, 0xd7, 0x94, 0xb3, 0x92, 0x91, 0x5c, 0x48, 0x0e, 0xd4, 0x81, 0x8a, 0xdc, 0xf2,
+	0xc3, 0x97, 0x8e, 0xfc, 0x0f, 0x6e, 0xe8, 0x56, 0xa1, 0x51, 0x3e, 0x7e, 0x7f, 0xb5, 0x5d, 0xcf,
+	0x15, 0x4a, 0x4a, 0x73, 0xaa, 0xcb, 0x7f, 0xc4, 0x65, 0x7f, 0x9e, 0xe1, 0xf6, 0xaf, 0x1a, 0xc0,
+	0x02, 0x21, 0xc7, 0x25, 0x1d, 0x31, 0xd7, 0x57, 0xe3, 0x44, 0x09, 0xa8, 0xe3, 0x92, 0xca, 0x48,
+	0xd0, 0x92, 0xb6, 0xf9, 0xbb, 0xd2, 0xb6, 0xb0, 0x91, 0xb6, 0xf6, 0xdf, 0x1a, 0x94, 0xb3, 0x1d,
+	0xa7, 0x0a, 0x58, 0xe7, 0x14, 0x40, 0x06, 0x6c, 0x67, 0xdb, 0x38, 0x5d, 0x0e, 0x9e, 0x85, 0x4b,
+	0x05, 0xeb, 0x77, 0x55, 0x70, 0x71, 0xb3, 0x82, 0x7f, 0xd4, 0xa0, 0x84, 0x89, 0xa0, 0x5d, 0x69,
+	0x2e, 0x3a, 0x04, 0x7d, 0x44, 0x84, 0xaf, 0xaa, 0x2d, 0x1f, 0xef, 0xad, 0xba, 0x2f, 0x8f, 0x46,
+	0xac, 0x30, 0xe8, 0x08, 0x8a, 0xe9, 0x64, 0xcb, 0x2b, 0xf0, 0x7b, 0xab, 0xe0, 0x74, 0xa4, 0xa5,
+	0x28, 0x49, 0x3d, 0x37, 0xe8, 0x9d, 0xd4, 0x52, 0x6f, 0xac, 0x30, 0x76, 0x1b, 0x2a, 0xed, 0x54,
+	0xcd, 0x87, 0x31, 0x4b, 0x46, 0x08, 0x81, 0x1e, 0x91, 0x90, 0x66, 0x26, 0xa8, 0x67, 0x74, 0x00,
+	0xe5, 0x85, 0x07, 0xdc, 0xc8, 0x5b, 0x85, 0x46, 0x09, 0xc3, 0xdc, 0x04, 0x6e, 0x77, 0x61, 0xef,
+	0xd2, 0x0f, 0x24, 0x3b, 0x17, 0xd4, 0xcb, 0x4c, 0xed, 0x91, 0x20, 0x46, 0x7b, 0xb0, 0x25, 0xc7,
+	0x10, 0x8d, 0x33, 0xc2, 0x2c, 0x42, 0xfb, 0xb0, 0x13, 0x53, 0x97, 0x06, 0x63, 0x1a, 0x67, 0xa6,
+	0xce, 0xe3, 0xc3, 0xcf, 0xa1, 0xd6, 0x23, 0xee, 0x0b, 0x2a, 0x1e, 0x04, 0x31, 0x75, 0xe5, 0x61,
+	0x88, 0x6a, 0x50, 0xee, 0x9d, 0xb4, 0xbf, 0xea, 0x3c, 0x73, 0xfa, 0x9d, 0x27, 0x0f, 0xea, 0xb9,
+	0xa5, 0x04, 0xee, 0xb4, 0x2f, 0xea, 0xda, 0xbe, 0xfe, 0xfd, 0xcf, 0x66, 0xee, 0xf0, 0x33, 0xa8,
+	0xdd, 0x3a, 0x2e, 0x50, 0x05, 0x76, 0xce, 0xba, 0x4f, 0x2f, 0x9d, 0x27, 0x9d, 0x67, 0xf5, 0x1c,
+	0xda, 0x05, 0x50, 0xd1, 0x43, 0xfc, 0xb4, 0xdf, 0x9f, 0x7d, 0x76, 0xfa, 0xf8, 0xd5, 0xb5, 0xa9,
+	0xbd, 0xbe, 0x36, 0xb5, 0x3f, 0xaf, 0x4d, 0xed, 0x87, 0x1b, 0x33, 0xf7, 0xfa, 0xc6, 0xcc, 0xfd,
+	0x7e, 0x63, 0xe6, 0xbe, 0xbd, 0xbf, 0x64, 0x72, 0x5f, 0xc9, 0x78, 0xd4, 0x25, 0x57, 0xbc, 0x95,
+	0xdd, 0x81, 0xc6, 0x9f, 0x7e, 0xd2, 0x9a, 0x2e, 0xdd, 0x84, 0x94, 0xeb, 0x57, 0x5b, 0xea, 0x7a,
+	0x73, 0xff, 0xdf, 0x01, 0x00, 0xfc, 0xd6, 0xc4, 0xd5, 0x2a, 0x09, 0x00, 0x00,
 }
 
+func (this *AddressQuota) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressQuota)
+	if !ok {
+		that2, ok := that.(AddressQuota)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MaxPercentSend.Equal(that1.MaxPercentSend) {
+		return false
+	}
+	if !this.MaxPercentRecv.Equal(that1.MaxPercentRecv) {
+		return false
+	}
+	if !this.MaxAmountSend.Equal(that1.MaxAmountSend) {
+		return false
+	}
+	if !this.MaxAmountRecv.Equal(that1.MaxAmountRecv) {
+		return false
+	}
+	return true
+}
 func (m *Path) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -403,6 +742,53 @@ func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AddressQuota != nil {
+		{
+			size, err := m.AddressQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRatelimit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.FlowMeasurement != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.FlowMeasurement))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MaxAmountRecv.Size()
+		i -= size
+		if _, err := m.MaxAmountRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxAmountSend.Size()
+		i -= size
+		if _, err := m.MaxAmountSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.SlidingWindow {
+		i--
+		if m.SlidingWindow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.DurationHours != 0 {
 		i = encodeVarintRatelimit(dAtA, i, uint64(m.DurationHours))
 		i--
@@ -431,6 +817,69 @@ func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AddressQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxAmountRecv.Size()
+		i -= size
+		if _, err := m.MaxAmountRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxAmountSend.Size()
+		i -= size
+		if _, err := m.MaxAmountSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxPercentRecv.Size()
+		i -= size
+		if _, err := m.MaxPercentRecv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxPercentSend.Size()
+		i -= size
+		if _, err := m.MaxPercentSend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Flow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -451,6 +900,20 @@ func (m *Flow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HourlyFlows) > 0 {
+		for iNdEx := len(m.HourlyFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HourlyFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRatelimit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size := m.ChannelValue.Size()
 		i -= size
@@ -484,7 +947,7 @@ func (m *Flow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+func (m *HourlyFlow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -494,12 +957,124 @@ func (m *RateLimit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+func (m *HourlyFlow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HourlyFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EpochHour != 0 {
+		i = encodeVarintRatelimit(dAtA, i, uint64(m.EpochHour))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRatelimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -543,6 +1118,45 @@ func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChannelGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelIds) > 0 {
+		for iNdEx := len(m.ChannelIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChannelIds[iNdEx])
+			copy(dAtA[i:], m.ChannelIds[iNdEx])
+			i = encodeVarintRatelimit(dAtA, i, uint64(len(m.ChannelIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRatelimit(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WhitelistedAddressPair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -621,6 +1235,37 @@ func (m *Quota) Size() (n int) {
 	if m.DurationHours != 0 {
 		n += 1 + sovRatelimit(uint64(m.DurationHours))
 	}
+	if m.SlidingWindow {
+		n += 2
+	}
+	l = m.MaxAmountSend.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
+	l = m.MaxAmountRecv.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
+	if m.FlowMeasurement != 0 {
+		n += 1 + sovRatelimit(uint64(m.FlowMeasurement))
+	}
+	if m.AddressQuota != nil {
+		l = m.AddressQuota.Size()
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	return n
+}
+
+func (m *AddressQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxPercentSend.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
+	l = m.MaxPercentRecv.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
+	l = m.MaxAmountSend.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
+	l = m.MaxAmountRecv.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
 	return n
 }
 
@@ -636,6 +1281,53 @@ func (m *Flow) Size() (n int) {
 	n += 1 + l + sovRatelimit(uint64(l))
 	l = m.ChannelValue.Size()
 	n += 1 + l + sovRatelimit(uint64(l))
+	if len(m.HourlyFlows) > 0 {
+		for _, e := range m.HourlyFlows {
+			l = e.Size()
+			n += 1 + l + sovRatelimit(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HourlyFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochHour != 0 {
+		n += 1 + sovRatelimit(uint64(m.EpochHour))
+	}
+	l = m.Inflow.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
+	return n
+}
+
+func (m *AddressFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	l = m.Inflow.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovRatelimit(uint64(l))
 	return n
 }
 
@@ -660,6 +1352,25 @@ func (m *RateLimit) Size() (n int) {
 	return n
 }
 
+func (m *ChannelGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRatelimit(uint64(l))
+	}
+	if len(m.ChannelIds) > 0 {
+		for _, s := range m.ChannelIds {
+			l = len(s)
+			n += 1 + l + sovRatelimit(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *WhitelistedAddressPair) Size() (n int) {
 	if m == nil {
 		return 0
@@ -742,13 +1453,663 @@ func (m *Path) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Quota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Quota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Quota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
+			}
+			m.DurationHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlidingWindow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SlidingWindow = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowMeasurement", wireType)
+			}
+			m.FlowMeasurement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlowMeasurement |= FlowMeasurement(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AddressQuota == nil {
+				m.AddressQuota = &AddressQuota{}
+			}
+			if err := m.AddressQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountSend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountRecv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Flow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Flow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Flow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChannelValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyFlows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRatelimit
@@ -758,23 +2119,25 @@ func (m *Path) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRatelimit
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRatelimit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.HourlyFlows = append(m.HourlyFlows, HourlyFlow{})
+			if err := m.HourlyFlows[len(m.HourlyFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -797,7 +2160,7 @@ func (m *Path) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Quota) Unmarshal(dAtA []byte) error {
+func (m *HourlyFlow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -820,15 +2183,34 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Quota: wiretype end group for non-group")
+			return fmt.Errorf("proto: HourlyFlow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Quota: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HourlyFlow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochHour", wireType)
+			}
+			m.EpochHour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochHour |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentSend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -856,13 +2238,13 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPercentSend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPercentRecv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -890,29 +2272,10 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPercentRecv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationHours", wireType)
-			}
-			m.DurationHours = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRatelimit
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DurationHours |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRatelimit(dAtA[iNdEx:])
@@ -934,7 +2297,7 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Flow) Unmarshal(dAtA []byte) error {
+func (m *AddressFlow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -957,15 +2320,15 @@ func (m *Flow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Flow: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressFlow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Flow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressFlow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -993,13 +2356,11 @@ func (m *Flow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1027,13 +2388,77 @@ func (m *Flow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1061,7 +2486,7 @@ func (m *Flow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChannelValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1244,6 +2669,120 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChannelGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatelimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatelimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelIds = append(m.ChannelIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatelimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatelimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *WhitelistedAddressPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
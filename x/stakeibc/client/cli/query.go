@@ -33,6 +33,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListEpochTracker())
 	cmd.AddCommand(CmdShowEpochTracker())
 	cmd.AddCommand(CmdNextPacketSequence())
+	cmd.AddCommand(CmdRedemptionRateHistory())
+	cmd.AddCommand(CmdShowHostZoneSunset())
+	cmd.AddCommand(CmdRedemptionEstimate())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
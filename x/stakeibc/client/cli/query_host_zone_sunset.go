@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func CmdShowHostZoneSunset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host-zone-sunset [chain-id]",
+		Short: "shows the progress of a host zone sunset",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryHostZoneSunsetRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.HostZoneSunset(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
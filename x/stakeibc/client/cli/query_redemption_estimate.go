@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func CmdRedemptionEstimate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemption-estimate [chain-id] [st-token-amount]",
+		Short: "estimates the native amount and claimable time of a redemption",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRedemptionEstimateRequest{
+				ChainId:       args[0],
+				StTokenAmount: args[1],
+			}
+
+			res, err := queryClient.RedemptionEstimate(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
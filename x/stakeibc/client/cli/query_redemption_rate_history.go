@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+const (
+	FlagStartEpoch = "start-epoch"
+	FlagEndEpoch   = "end-epoch"
+)
+
+func CmdRedemptionRateHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemption-rate-history [chain-id]",
+		Short: "shows the redemption rate history of a host zone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			startEpoch, err := cmd.Flags().GetUint64(FlagStartEpoch)
+			if err != nil {
+				return err
+			}
+			endEpoch, err := cmd.Flags().GetUint64(FlagEndEpoch)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRedemptionRateHistoryRequest{
+				ChainId:    args[0],
+				StartEpoch: startEpoch,
+				EndEpoch:   endEpoch,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RedemptionRateHistory(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagStartEpoch, 0, "First stride epoch to include")
+	cmd.Flags().Uint64(FlagEndEpoch, 0, "Last stride epoch to include (0 includes all remaining epochs)")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -27,6 +27,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdLiquidStake())
 	cmd.AddCommand(CmdRegisterHostZone())
 	cmd.AddCommand(CmdRedeemStake())
+	cmd.AddCommand(CmdBatchRedeemStake())
 	cmd.AddCommand(CmdClaimUndelegatedTokens())
 	cmd.AddCommand(CmdRebalanceValidators())
 	cmd.AddCommand(CmdAddValidators())
@@ -35,6 +36,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdRestoreInterchainAccount())
 	cmd.AddCommand(CmdUpdateValidatorSharesExchRate())
 	cmd.AddCommand(CmdClearBalance())
+	cmd.AddCommand(CmdResumeHostZone())
+	cmd.AddCommand(CmdSetAutoClaim())
+	cmd.AddCommand(CmdSetValidatorSelectionStrategy())
 
 	return cmd
 }
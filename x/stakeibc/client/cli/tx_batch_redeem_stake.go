@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func CmdBatchRedeemStake() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-redeem-stake [amount:hostZoneID:receiver]...",
+		Short: "Broadcast message batch-redeem-stake",
+		Long: strings.TrimSpace(`Redeems stTokens from one or more host zones atomically.
+Each redemption is specified as amount:hostZoneID:receiver
+
+Example:
+$ strided tx stakeibc batch-redeem-stake 1000000:cosmoshub-4:cosmos1... 2000000:osmosis-1:osmo1... --from=<key_or_address>
+`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			redemptions := []types.BatchRedemption{}
+			for _, arg := range args {
+				fields := strings.Split(arg, ":")
+				if len(fields) != 3 {
+					return fmt.Errorf("invalid redemption %s, must be of the form amount:hostZoneID:receiver", arg)
+				}
+				amount, found := sdk.NewIntFromString(fields[0])
+				if !found {
+					return errorsmod.Wrap(sdkerrors.ErrInvalidType, "can not convert string to int")
+				}
+				redemptions = append(redemptions, types.BatchRedemption{
+					Amount:   amount,
+					HostZone: fields[1],
+					Receiver: fields[2],
+				})
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchRedeemStake(
+				clientCtx.GetFromAddress().String(),
+				redemptions,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -17,6 +17,10 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const (
+	FlagMinStTokenOut = "min-st-token-out"
+)
+
 func CmdLiquidStake() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "liquid-stake [amount] [hostDenom]",
@@ -39,6 +43,19 @@ func CmdLiquidStake() *cobra.Command {
 				argAmount,
 				argHostDenom,
 			)
+
+			minStTokenOutStr, err := cmd.Flags().GetString(FlagMinStTokenOut)
+			if err != nil {
+				return err
+			}
+			if minStTokenOutStr != "" {
+				minStTokenOut, found := sdk.NewIntFromString(minStTokenOutStr)
+				if !found {
+					return errorsmod.Wrap(sdkerrors.ErrInvalidType, "can not convert min-st-token-out to int")
+				}
+				msg.MinStTokenOut = &minStTokenOut
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -46,6 +63,7 @@ func CmdLiquidStake() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagMinStTokenOut, "", "minimum number of stTokens to mint, otherwise the liquid stake fails")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
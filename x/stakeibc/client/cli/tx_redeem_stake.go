@@ -17,6 +17,10 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const (
+	FlagMinNativeOut = "min-native-out"
+)
+
 func CmdRedeemStake() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "redeem-stake [amount] [hostZoneID] [receiver]",
@@ -42,6 +46,19 @@ func CmdRedeemStake() *cobra.Command {
 				hostZoneID,
 				argReceiver,
 			)
+
+			minNativeOutStr, err := cmd.Flags().GetString(FlagMinNativeOut)
+			if err != nil {
+				return err
+			}
+			if minNativeOutStr != "" {
+				minNativeOut, found := sdk.NewIntFromString(minNativeOutStr)
+				if !found {
+					return errorsmod.Wrap(sdkerrors.ErrInvalidType, "can not convert min-native-out to int")
+				}
+				msg.MinNativeOut = &minNativeOut
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -49,6 +66,7 @@ func CmdRedeemStake() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagMinNativeOut, "", "minimum number of native tokens to redeem, otherwise the redemption fails")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func CmdSetValidatorSelectionStrategy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-validator-selection-strategy [chain-id] [strategy]",
+		Short: "Broadcast message set-validator-selection-strategy",
+		Long:  "Sets how the weights of a host zone's validators are determined (STATIC, EQUAL or SCORED)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainId := args[0]
+			argStrategy, ok := types.ValidatorSelectionStrategy_value[strings.ToUpper(args[1])]
+			if !ok {
+				return fmt.Errorf("invalid validator selection strategy %s", args[1])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetValidatorSelectionStrategy(
+				clientCtx.GetFromAddress().String(),
+				argChainId,
+				types.ValidatorSelectionStrategy(argStrategy),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -16,6 +16,15 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, epochTracker := range genState.EpochTrackerList {
 		k.SetEpochTracker(ctx, epochTracker)
 	}
+	for _, redemptionRateRecord := range genState.RedemptionRateHistory {
+		k.SetRedemptionRateRecord(ctx, redemptionRateRecord)
+	}
+	for _, redelegationTracker := range genState.RedelegationTrackers {
+		k.SetRedelegationTracker(ctx, redelegationTracker)
+	}
+	for _, hostZoneSunset := range genState.HostZoneSunsets {
+		k.SetHostZoneSunset(ctx, hostZoneSunset)
+	}
 
 	k.SetParams(ctx, genState.Params)
 }
@@ -27,6 +36,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 	genesis.HostZoneList = k.GetAllHostZone(ctx)
 	genesis.EpochTrackerList = k.GetAllEpochTracker(ctx)
+	genesis.RedemptionRateHistory = k.GetAllRedemptionRateRecords(ctx)
+	genesis.RedelegationTrackers = k.GetAllRedelegationTrackers(ctx)
+	genesis.HostZoneSunsets = k.GetAllHostZoneSunsets(ctx)
 
 	return genesis
 }
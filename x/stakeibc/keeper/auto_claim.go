@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	icacallbackstypes "github.com/Stride-Labs/stride/v10/x/icacallbacks/types"
+	recordstypes "github.com/Stride-Labs/stride/v10/x/records/types"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// Returns the user redemption records for a host zone that can be claimed (i.e. their host zone unbonding
+// is CLAIMABLE and they're not already pending a claim), capped at maxRecords
+func (k Keeper) GetAutoClaimableRedemptionRecords(ctx sdk.Context, chainId string, maxRecords uint64) []recordstypes.UserRedemptionRecord {
+	claimableRecords := []recordstypes.UserRedemptionRecord{}
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		for _, hostZoneUnbonding := range epochUnbondingRecord.HostZoneUnbondings {
+			if hostZoneUnbonding.HostZoneId != chainId || hostZoneUnbonding.Status != recordstypes.HostZoneUnbonding_CLAIMABLE {
+				continue
+			}
+
+			for _, userRedemptionRecordId := range hostZoneUnbonding.UserRedemptionRecords {
+				if uint64(len(claimableRecords)) >= maxRecords {
+					return claimableRecords
+				}
+
+				// Records that have already been claimed will have been removed from the store
+				userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, userRedemptionRecordId)
+				if !found || userRedemptionRecord.ClaimIsPending {
+					continue
+				}
+				claimableRecords = append(claimableRecords, userRedemptionRecord)
+			}
+		}
+	}
+	return claimableRecords
+}
+
+// Sends the claimable redemptions on a host zone to their receivers in a single ICA tx from the redemption account
+// Records without any tokens to claim are removed directly since there's nothing to send
+func (k Keeper) AutoClaimUndelegatedTokens(ctx sdk.Context, hostZone types.HostZone) (numClaims int, err error) {
+	maxClaims := k.GetParam(ctx, types.KeyMaxAutoClaimsPerEpoch)
+	userRedemptionRecords := k.GetAutoClaimableRedemptionRecords(ctx, hostZone.ChainId, maxClaims)
+
+	var icaTx *IcaTx
+	autoClaimCallback := types.AutoClaimCallback{}
+	for _, userRedemptionRecord := range userRedemptionRecords {
+		userRedemptionRecord := userRedemptionRecord
+		claimCallback := types.ClaimCallback{
+			UserRedemptionRecordId: userRedemptionRecord.Id,
+			ChainId:                hostZone.ChainId,
+			EpochNumber:            userRedemptionRecord.EpochNumber,
+		}
+
+		if userRedemptionRecord.Amount.IsZero() {
+			if err := k.HandleClaimAcknowledgement(ctx, claimCallback, icacallbackstypes.AckResponseStatus_SUCCESS); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		redemptionTx, err := k.GetRedemptionTransferMsg(ctx, &userRedemptionRecord, hostZone.ChainId)
+		if err != nil {
+			return 0, err
+		}
+		if icaTx == nil {
+			icaTx = redemptionTx
+		} else {
+			icaTx.Msgs = append(icaTx.Msgs, redemptionTx.Msgs...)
+		}
+		autoClaimCallback.Claims = append(autoClaimCallback.Claims, claimCallback)
+	}
+
+	if icaTx == nil {
+		return 0, nil
+	}
+
+	marshalledCallbackArgs, err := proto.Marshal(&autoClaimCallback)
+	if err != nil {
+		return 0, err
+	}
+	_, err = k.SubmitTxs(ctx, icaTx.ConnectionId, icaTx.Msgs, icaTx.Account, icaTx.Timeout, ICACallbackID_AutoClaim, marshalledCallbackArgs)
+	if err != nil {
+		return 0, err
+	}
+
+	// Set claimIsPending to true, so that the records can't be double claimed
+	for _, claimCallback := range autoClaimCallback.Claims {
+		userRedemptionRecord, _ := k.RecordsKeeper.GetUserRedemptionRecord(ctx, claimCallback.UserRedemptionRecordId)
+		userRedemptionRecord.ClaimIsPending = true
+		k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+	}
+
+	return len(autoClaimCallback.Claims), nil
+}
+
+// Automatically claims redemptions for each active host zone that has opted in to auto claim
+func (k Keeper) AutoClaimAllHostZones(ctx sdk.Context) {
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		if !hostZone.AutoClaimEnabled {
+			continue
+		}
+
+		// Claim in a cached context so that a failure does not leave records partially updated
+		cacheCtx, writeCache := ctx.CacheContext()
+		numClaims, err := k.AutoClaimUndelegatedTokens(cacheCtx, hostZone)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to auto claim redemptions for host zone %s, err: %s", hostZone.ChainId, err.Error()))
+			continue
+		}
+		writeCache()
+
+		if numClaims == 0 {
+			continue
+		}
+
+		k.Logger(ctx).Info(fmt.Sprintf("Auto claimed %d redemptions on host zone %s", numClaims, hostZone.ChainId))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoClaim,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+				sdk.NewAttribute(types.AttributeKeyNumClaims, fmt.Sprintf("%d", numClaims)),
+			),
+		)
+	}
+}
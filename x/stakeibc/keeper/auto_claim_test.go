@@ -0,0 +1,277 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	_ "github.com/stretchr/testify/suite"
+
+	epochtypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
+	icacallbacktypes "github.com/Stride-Labs/stride/v10/x/icacallbacks/types"
+	recordtypes "github.com/Stride-Labs/stride/v10/x/records/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v10/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+type AutoClaimTestCase struct {
+	hostZone             types.HostZone
+	claimableRecordIds   []string
+	unclaimableRecordIds []string
+	redemptionPortId     string
+	redemptionChannelId  string
+}
+
+// Creates 4 redemption records:
+//   - two claimable records on a CLAIMABLE host zone unbonding in epoch 1
+//   - one record that already has a pending claim in epoch 1
+//   - one record on a host zone unbonding in epoch 2 that's still unbonding
+func (s *KeeperTestSuite) SetupAutoClaim() AutoClaimTestCase {
+	redemptionIcaOwner := types.FormatICAAccountOwner(HostChainId, types.ICAAccountType_REDEMPTION)
+	redemptionChannelId := s.CreateICAChannel(redemptionIcaOwner)
+	redemptionPortId := fmt.Sprintf("icacontroller-%s", redemptionIcaOwner)
+
+	hostZone := types.HostZone{
+		ChainId:          HostChainId,
+		ConnectionId:     ibctesting.FirstConnectionID,
+		AutoClaimEnabled: true,
+		RedemptionAccount: &types.ICAAccount{
+			Address: s.IcaAddresses[redemptionIcaOwner],
+			Target:  types.ICAAccountType_REDEMPTION,
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier:    epochtypes.STRIDE_EPOCH,
+		EpochNumber:        1,
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 30_000_000_000), // dictates timeouts
+	})
+
+	newRecord := func(epochNumber uint64, sender string, claimIsPending bool) string {
+		recordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, epochNumber, sender)
+		s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{
+			Id:             recordId,
+			HostZoneId:     HostChainId,
+			EpochNumber:    epochNumber,
+			Sender:         sender,
+			Receiver:       "cosmos_RECEIVER",
+			Denom:          Atom,
+			Amount:         sdkmath.NewInt(1000),
+			ClaimIsPending: claimIsPending,
+		})
+		return recordId
+	}
+	claimableRecordId1 := newRecord(1, "sender1", false)
+	claimableRecordId2 := newRecord(1, "sender2", false)
+	pendingRecordId := newRecord(1, "sender3", true)
+	unbondingRecordId := newRecord(2, "sender1", false)
+
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: 1,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{{
+			HostZoneId:            HostChainId,
+			Status:                recordtypes.HostZoneUnbonding_CLAIMABLE,
+			UserRedemptionRecords: []string{claimableRecordId1, claimableRecordId2, pendingRecordId},
+			NativeTokenAmount:     sdkmath.NewInt(3000),
+		}},
+	})
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: 2,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{{
+			HostZoneId:            HostChainId,
+			Status:                recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS,
+			UserRedemptionRecords: []string{unbondingRecordId},
+			NativeTokenAmount:     sdkmath.NewInt(1000),
+		}},
+	})
+
+	return AutoClaimTestCase{
+		hostZone:             hostZone,
+		claimableRecordIds:   []string{claimableRecordId1, claimableRecordId2},
+		unclaimableRecordIds: []string{pendingRecordId, unbondingRecordId},
+		redemptionPortId:     redemptionPortId,
+		redemptionChannelId:  redemptionChannelId,
+	}
+}
+
+func (s *KeeperTestSuite) TestAutoClaimAllHostZones_Successful() {
+	tc := s.SetupAutoClaim()
+
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.redemptionPortId, tc.redemptionChannelId)
+	s.Require().True(found, "sequence number not found before auto claim")
+
+	s.App.StakeibcKeeper.AutoClaimAllHostZones(s.Ctx)
+
+	// A single ICA tx should have been sent with a callback for both claimable records
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.redemptionPortId, tc.redemptionChannelId)
+	s.Require().True(found, "sequence number not found after auto claim")
+	s.Require().Equal(startSequence+1, endSequence, "sequence number should have incremented once")
+
+	callbackKey := icacallbacktypes.PacketID(tc.redemptionPortId, tc.redemptionChannelId, startSequence)
+	callbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx, callbackKey)
+	s.Require().True(found, "callback data should have been stored")
+	s.Require().Equal(stakeibckeeper.ICACallbackID_AutoClaim, callbackData.CallbackId, "callback id")
+
+	var autoClaimCallback types.AutoClaimCallback
+	s.Require().NoError(proto.Unmarshal(callbackData.CallbackArgs, &autoClaimCallback), "unmarshal callback args")
+	s.Require().Len(autoClaimCallback.Claims, 2, "number of claims in callback")
+	for i, claim := range autoClaimCallback.Claims {
+		s.Require().Equal(tc.claimableRecordIds[i], claim.UserRedemptionRecordId, "callback record id %d", i)
+		s.Require().Equal(HostChainId, claim.ChainId, "callback chain id %d", i)
+		s.Require().Equal(uint64(1), claim.EpochNumber, "callback epoch number %d", i)
+	}
+
+	// The claimed records should now be pending
+	for _, recordId := range tc.claimableRecordIds {
+		record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+		s.Require().True(found, "record %s should exist", recordId)
+		s.Require().True(record.ClaimIsPending, "record %s should be pending", recordId)
+	}
+
+	// The record that's still unbonding should not have been touched
+	record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.unclaimableRecordIds[1])
+	s.Require().True(found, "unbonding record should exist")
+	s.Require().False(record.ClaimIsPending, "unbonding record should not be pending")
+}
+
+func (s *KeeperTestSuite) TestAutoClaimAllHostZones_NotEnabled() {
+	tc := s.SetupAutoClaim()
+
+	tc.hostZone.AutoClaimEnabled = false
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, tc.hostZone)
+
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.redemptionPortId, tc.redemptionChannelId)
+	s.Require().True(found, "sequence number not found before auto claim")
+
+	s.App.StakeibcKeeper.AutoClaimAllHostZones(s.Ctx)
+
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.redemptionPortId, tc.redemptionChannelId)
+	s.Require().True(found, "sequence number not found after auto claim")
+	s.Require().Equal(startSequence, endSequence, "no ICA should have been sent")
+
+	for _, recordId := range tc.claimableRecordIds {
+		record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+		s.Require().True(found, "record %s should exist", recordId)
+		s.Require().False(record.ClaimIsPending, "record %s should not be pending", recordId)
+	}
+}
+
+func (s *KeeperTestSuite) TestAutoClaimAllHostZones_CappedPerEpoch() {
+	tc := s.SetupAutoClaim()
+
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.MaxAutoClaimsPerEpoch = 1
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	// Only the first record should be claimed in the first epoch
+	s.App.StakeibcKeeper.AutoClaimAllHostZones(s.Ctx)
+
+	record1, _ := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.claimableRecordIds[0])
+	record2, _ := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.claimableRecordIds[1])
+	s.Require().True(record1.ClaimIsPending, "first record should be pending")
+	s.Require().False(record2.ClaimIsPending, "second record should not be pending")
+
+	// The second record should be claimed in the next epoch
+	s.App.StakeibcKeeper.AutoClaimAllHostZones(s.Ctx)
+
+	record2, _ = s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.claimableRecordIds[1])
+	s.Require().True(record2.ClaimIsPending, "second record should be pending")
+}
+
+func (s *KeeperTestSuite) TestAutoClaimAllHostZones_ZeroAmountRecord() {
+	tc := s.SetupAutoClaim()
+
+	// Zero out the first record - it should be removed without being sent
+	record, _ := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.claimableRecordIds[0])
+	record.Amount = sdkmath.ZeroInt()
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, record)
+
+	s.App.StakeibcKeeper.AutoClaimAllHostZones(s.Ctx)
+
+	_, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.claimableRecordIds[0])
+	s.Require().False(found, "zero amount record should have been removed")
+
+	record, _ = s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.claimableRecordIds[1])
+	s.Require().True(record.ClaimIsPending, "second record should be pending")
+}
+
+func (s *KeeperTestSuite) TestAutoClaimAllHostZones_MissingRedemptionAccount() {
+	tc := s.SetupAutoClaim()
+
+	tc.hostZone.RedemptionAccount = nil
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, tc.hostZone)
+
+	// The failure should be logged without updating any records
+	s.App.StakeibcKeeper.AutoClaimAllHostZones(s.Ctx)
+
+	for _, recordId := range tc.claimableRecordIds {
+		record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+		s.Require().True(found, "record %s should exist", recordId)
+		s.Require().False(record.ClaimIsPending, "record %s should not be pending", recordId)
+	}
+}
+
+func (s *KeeperTestSuite) TestAutoClaimCallback() {
+	tc := s.SetupAutoClaim()
+	s.App.StakeibcKeeper.AutoClaimAllHostZones(s.Ctx)
+
+	callbackArgs, err := proto.Marshal(&types.AutoClaimCallback{
+		Claims: []types.ClaimCallback{
+			{UserRedemptionRecordId: tc.claimableRecordIds[0], ChainId: HostChainId, EpochNumber: 1},
+			{UserRedemptionRecordId: tc.claimableRecordIds[1], ChainId: HostChainId, EpochNumber: 1},
+		},
+	})
+	s.Require().NoError(err, "marshal callback args")
+
+	// On failure, both records should be reset so they can be retried
+	ackFailure := &icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_FAILURE}
+	err = stakeibckeeper.AutoClaimCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, ackFailure, callbackArgs)
+	s.Require().NoError(err, "auto claim callback failure")
+
+	for _, recordId := range tc.claimableRecordIds {
+		record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+		s.Require().True(found, "record %s should exist after failure", recordId)
+		s.Require().False(record.ClaimIsPending, "record %s should no longer be pending", recordId)
+	}
+
+	// On success, both records should be removed and the host zone unbonding decremented
+	ackSuccess := &icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_SUCCESS}
+	err = stakeibckeeper.AutoClaimCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, ackSuccess, callbackArgs)
+	s.Require().NoError(err, "auto claim callback success")
+
+	for _, recordId := range tc.claimableRecordIds {
+		_, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+		s.Require().False(found, "record %s should have been removed", recordId)
+	}
+
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, 1, HostChainId)
+	s.Require().True(found, "host zone unbonding found")
+	s.Require().Equal(sdkmath.NewInt(1000), hostZoneUnbonding.NativeTokenAmount, "host zone unbonding amount")
+}
+
+func (s *KeeperTestSuite) TestSetAutoClaim() {
+	tc := s.SetupAutoClaim()
+	adminAddress := "stride_ADMIN"
+
+	_, err := s.GetMsgServer().SetAutoClaim(sdk.WrapSDKContext(s.Ctx), &types.MsgSetAutoClaim{
+		Creator: adminAddress,
+		ChainId: HostChainId,
+		Enabled: false,
+	})
+	s.Require().NoError(err, "disable auto claim")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, tc.hostZone.ChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().False(hostZone.AutoClaimEnabled, "auto claim should be disabled")
+
+	_, err = s.GetMsgServer().SetAutoClaim(sdk.WrapSDKContext(s.Ctx), &types.MsgSetAutoClaim{
+		Creator: adminAddress,
+		ChainId: "fake_host_zone",
+		Enabled: true,
+	})
+	s.Require().ErrorContains(err, "host zone fake_host_zone not found")
+}
@@ -1,8 +1,14 @@
 package keeper
 
 import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
+	recordstypes "github.com/Stride-Labs/stride/v10/x/records/types"
 	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
 )
 
@@ -15,3 +21,130 @@ func (k Keeper) AddValidatorsProposal(ctx sdk.Context, msg *types.AddValidatorsP
 
 	return nil
 }
+
+// Updates the redemption rate bounds, unbonding frequency and/or transfer channel of a host zone
+// Any field that is left empty in the proposal is unchanged
+func (k Keeper) UpdateHostZoneProposal(ctx sdk.Context, p *types.UpdateHostZoneProposal) error {
+	hostZone, found := k.GetHostZone(ctx, p.HostZone)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidHostZone, "host zone %s not found", p.HostZone)
+	}
+
+	previousTransferChannelId := hostZone.TransferChannelId
+
+	// Track each field that was changed, so that they can be included in the event
+	changedAttributes := []sdk.Attribute{}
+	recordChange := func(key, previousValue, newValue string) {
+		changedAttributes = append(changedAttributes,
+			sdk.NewAttribute(key, newValue),
+			sdk.NewAttribute(types.AttributeKeyPreviousValuePrefix+key, previousValue),
+		)
+	}
+
+	// Update the redemption rate bounds and confirm the current redemption rate is still within range
+	// (otherwise the host zone would be halted at the next redemption rate safety check)
+	// Unset bounds fall back to the default thresholds from the params, the same as in the safety check
+	boundsChanged := false
+	if !p.MinRedemptionRate.IsNil() && !p.MinRedemptionRate.IsZero() && !p.MinRedemptionRate.Equal(hostZone.MinRedemptionRate) {
+		recordChange(types.AttributeKeyMinRedemptionRate, hostZone.MinRedemptionRate.String(), p.MinRedemptionRate.String())
+		hostZone.MinRedemptionRate = p.MinRedemptionRate
+		boundsChanged = true
+	}
+	if !p.MaxRedemptionRate.IsNil() && !p.MaxRedemptionRate.IsZero() && !p.MaxRedemptionRate.Equal(hostZone.MaxRedemptionRate) {
+		recordChange(types.AttributeKeyMaxRedemptionRate, hostZone.MaxRedemptionRate.String(), p.MaxRedemptionRate.String())
+		hostZone.MaxRedemptionRate = p.MaxRedemptionRate
+		boundsChanged = true
+	}
+	if boundsChanged {
+		minRedemptionRate, maxRedemptionRate := k.GetRedemptionRateSafetyBounds(ctx, hostZone)
+		if minRedemptionRate.GTE(maxRedemptionRate) {
+			return errorsmod.Wrapf(types.ErrInvalidHostZone, "min redemption rate (%v) must be less than max redemption rate (%v)",
+				minRedemptionRate, maxRedemptionRate)
+		}
+		if hostZone.RedemptionRate.LT(minRedemptionRate) || hostZone.RedemptionRate.GT(maxRedemptionRate) {
+			return errorsmod.Wrapf(types.ErrInvalidHostZone, "current redemption rate (%v) is outside of the new bounds [%v, %v]",
+				hostZone.RedemptionRate, minRedemptionRate, maxRedemptionRate)
+		}
+	}
+
+	// Update the unbonding frequency
+	if p.UnbondingFrequency != 0 && p.UnbondingFrequency != hostZone.UnbondingFrequency {
+		recordChange(types.AttributeKeyUnbondingFrequency,
+			strconv.FormatUint(hostZone.UnbondingFrequency, 10), strconv.FormatUint(p.UnbondingFrequency, 10))
+		hostZone.UnbondingFrequency = p.UnbondingFrequency
+	}
+
+	// Update the transfer channel, which must be an open transfer channel on the host zone's connection
+	// Since the ibc denom is derived from the channel, it must also be updated
+	// The channel can't be changed while there are deposits waiting to be transferred, since they're held
+	// under the previous ibc denom
+	if p.TransferChannelId != "" && p.TransferChannelId != hostZone.TransferChannelId {
+		for _, depositRecord := range k.RecordsKeeper.GetAllDepositRecord(ctx) {
+			isPendingTransfer := depositRecord.Status == recordstypes.DepositRecord_TRANSFER_QUEUE ||
+				depositRecord.Status == recordstypes.DepositRecord_TRANSFER_IN_PROGRESS
+			if depositRecord.HostZoneId == hostZone.ChainId && isPendingTransfer && depositRecord.Amount.IsPositive() {
+				return errorsmod.Wrapf(types.ErrInvalidHostZone,
+					"transfer channel cannot be updated while deposit record %d has a pending transfer of %v%s",
+					depositRecord.Id, depositRecord.Amount, hostZone.IbcDenom)
+			}
+		}
+
+		channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, transfertypes.PortID, p.TransferChannelId)
+		if !found {
+			return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "transfer channel %s not found", p.TransferChannelId)
+		}
+		if channel.State != channeltypes.OPEN {
+			return errorsmod.Wrapf(channeltypes.ErrInvalidChannelState, "transfer channel %s is not open", p.TransferChannelId)
+		}
+		if len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != hostZone.ConnectionId {
+			return errorsmod.Wrapf(types.ErrInvalidHostZone, "transfer channel %s is not on the host zone's connection (%s)",
+				p.TransferChannelId, hostZone.ConnectionId)
+		}
+
+		ibcDenom := transfertypes.ParseDenomTrace(
+			transfertypes.GetPrefixedDenom(transfertypes.PortID, p.TransferChannelId, hostZone.HostDenom),
+		).IBCDenom()
+
+		recordChange(types.AttributeKeyTransferChannelId, hostZone.TransferChannelId, p.TransferChannelId)
+		recordChange(types.AttributeKeyIBCDenom, hostZone.IbcDenom, ibcDenom)
+		hostZone.TransferChannelId = p.TransferChannelId
+		hostZone.IbcDenom = ibcDenom
+	}
+
+	// Confirm no other host zone uses the same transfer channel or bech32 prefix
+	for _, otherHostZone := range k.GetAllHostZone(ctx) {
+		if otherHostZone.ChainId == hostZone.ChainId {
+			continue
+		}
+		if otherHostZone.TransferChannelId == hostZone.TransferChannelId {
+			return errorsmod.Wrapf(types.ErrInvalidHostZone, "transfer channel %s already registered to %s",
+				hostZone.TransferChannelId, otherHostZone.ChainId)
+		}
+		if otherHostZone.Bech32Prefix == hostZone.Bech32Prefix {
+			return errorsmod.Wrapf(types.ErrInvalidHostZone, "bech32prefix %s already registered to %s",
+				hostZone.Bech32Prefix, otherHostZone.ChainId)
+		}
+	}
+
+	// If the transfer channel was updated, move the stToken rate limit to the new channel
+	if hostZone.TransferChannelId != previousTransferChannelId {
+		stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+		if err := k.RatelimitKeeper.MoveRateLimit(ctx, stDenom, previousTransferChannelId, hostZone.TransferChannelId); err != nil {
+			return err
+		}
+	}
+
+	k.SetHostZone(ctx, hostZone)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateHostZone,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			}, changedAttributes...)...,
+		),
+	)
+
+	return nil
+}
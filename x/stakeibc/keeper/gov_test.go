@@ -0,0 +1,278 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	_ "github.com/stretchr/testify/suite"
+
+	ratelimittypes "github.com/Stride-Labs/stride/v10/x/ratelimit/types"
+	recordtypes "github.com/Stride-Labs/stride/v10/x/records/types"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+const updatedTransferChannelId = "channel-5"
+
+func (s *KeeperTestSuite) SetupUpdateHostZoneProposal() types.HostZone {
+	s.CreateTransferChannel(HostChainId)
+
+	// Add a second open transfer channel on the same connection
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, transfertypes.PortID, updatedTransferChannelId, channeltypes.Channel{
+		State:          channeltypes.OPEN,
+		ConnectionHops: []string{ibctesting.FirstConnectionID},
+	})
+
+	hostZone := types.HostZone{
+		ChainId:            HostChainId,
+		HostDenom:          Atom,
+		Bech32Prefix:       "cosmos",
+		ConnectionId:       ibctesting.FirstConnectionID,
+		TransferChannelId:  ibctesting.FirstChannelID,
+		IbcDenom:           IbcAtom,
+		UnbondingFrequency: 3,
+		RedemptionRate:     sdk.MustNewDecFromStr("1.1"),
+		MinRedemptionRate:  sdk.MustNewDecFromStr("0.9"),
+		MaxRedemptionRate:  sdk.MustNewDecFromStr("1.5"),
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	return hostZone
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneProposal_Successful() {
+	s.SetupUpdateHostZoneProposal()
+
+	proposal := types.UpdateHostZoneProposal{
+		HostZone:           HostChainId,
+		MinRedemptionRate:  sdk.MustNewDecFromStr("1.0"),
+		MaxRedemptionRate:  sdk.MustNewDecFromStr("1.2"),
+		UnbondingFrequency: 5,
+		TransferChannelId:  updatedTransferChannelId,
+	}
+	err := s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+	s.Require().NoError(err, "no error expected")
+
+	expectedIbcDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(transfertypes.PortID, updatedTransferChannelId, Atom),
+	).IBCDenom()
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone should have been found")
+	s.Require().Equal(sdk.MustNewDecFromStr("1.0"), hostZone.MinRedemptionRate, "min redemption rate")
+	s.Require().Equal(sdk.MustNewDecFromStr("1.2"), hostZone.MaxRedemptionRate, "max redemption rate")
+	s.Require().Equal(uint64(5), hostZone.UnbondingFrequency, "unbonding frequency")
+	s.Require().Equal(updatedTransferChannelId, hostZone.TransferChannelId, "transfer channel")
+	s.Require().Equal(expectedIbcDenom, hostZone.IbcDenom, "ibc denom")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneProposal_PartialUpdate() {
+	initialHostZone := s.SetupUpdateHostZoneProposal()
+
+	// Only update the max redemption rate, all other fields should be unchanged
+	proposal := types.UpdateHostZoneProposal{
+		HostZone:          HostChainId,
+		MaxRedemptionRate: sdk.MustNewDecFromStr("2.0"),
+	}
+	err := s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+	s.Require().NoError(err, "no error expected")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone should have been found")
+	s.Require().Equal(initialHostZone.MinRedemptionRate, hostZone.MinRedemptionRate, "min redemption rate")
+	s.Require().Equal(sdk.MustNewDecFromStr("2.0"), hostZone.MaxRedemptionRate, "max redemption rate")
+	s.Require().Equal(initialHostZone.UnbondingFrequency, hostZone.UnbondingFrequency, "unbonding frequency")
+	s.Require().Equal(initialHostZone.TransferChannelId, hostZone.TransferChannelId, "transfer channel")
+	s.Require().Equal(initialHostZone.IbcDenom, hostZone.IbcDenom, "ibc denom")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneProposal_HostZoneNotFound() {
+	s.SetupUpdateHostZoneProposal()
+
+	proposal := types.UpdateHostZoneProposal{HostZone: "fake_host_zone", UnbondingFrequency: 5}
+	err := s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+	s.Require().ErrorContains(err, "host zone fake_host_zone not found")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneProposal_MinRateAboveExistingMax() {
+	s.SetupUpdateHostZoneProposal()
+
+	// Only the min rate is updated, so it's checked against the current max rate of 1.5
+	proposal := types.UpdateHostZoneProposal{HostZone: HostChainId, MinRedemptionRate: sdk.MustNewDecFromStr("1.6")}
+	err := s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+	s.Require().ErrorContains(err, "must be less than max redemption rate")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneProposal_RedemptionRateOutsideNewBounds() {
+	s.SetupUpdateHostZoneProposal()
+
+	// The current redemption rate is 1.1
+	proposal := types.UpdateHostZoneProposal{
+		HostZone:          HostChainId,
+		MinRedemptionRate: sdk.MustNewDecFromStr("1.2"),
+		MaxRedemptionRate: sdk.MustNewDecFromStr("1.3"),
+	}
+	err := s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+	s.Require().ErrorContains(err, "current redemption rate (1.100000000000000000) is outside of the new bounds")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneProposal_TransferChannelNotFound() {
+	s.SetupUpdateHostZoneProposal()
+
+	proposal := types.UpdateHostZoneProposal{HostZone: HostChainId, TransferChannelId: "channel-10"}
+	err := s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+	s.Require().ErrorContains(err, "transfer channel channel-10 not found")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneProposal_TransferChannelClosed() {
+	s.SetupUpdateHostZoneProposal()
+
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, transfertypes.PortID, updatedTransferChannelId, channeltypes.Channel{
+		State:          channeltypes.CLOSED,
+		ConnectionHops: []string{ibctesting.FirstConnectionID},
+	})
+
+	proposal := types.UpdateHostZoneProposal{HostZone: HostChainId, TransferChannelId: updatedTransferChannelId}
+	err := s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+	s.Require().ErrorContains(err, "transfer channel channel-5 is not open")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneProposal_TransferChannelOnDifferentConnection() {
+	s.SetupUpdateHostZoneProposal()
+
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, transfertypes.PortID, updatedTransferChannelId, channeltypes.Channel{
+		State:          channeltypes.OPEN,
+		ConnectionHops: []string{"connection-2"},
+	})
+
+	proposal := types.UpdateHostZoneProposal{HostZone: HostChainId, TransferChannelId: updatedTransferChannelId}
+	err := s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+	s.Require().ErrorContains(err, "is not on the host zone's connection")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneProposal_TransferChannelAlreadyRegistered() {
+	s.SetupUpdateHostZoneProposal()
+
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:           "OSMO",
+		Bech32Prefix:      "osmo",
+		TransferChannelId: updatedTransferChannelId,
+	})
+
+	proposal := types.UpdateHostZoneProposal{HostZone: HostChainId, TransferChannelId: updatedTransferChannelId}
+	err := s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+	s.Require().ErrorContains(err, "transfer channel channel-5 already registered to OSMO")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneProposal_PendingDepositTransfer() {
+	s.SetupUpdateHostZoneProposal()
+
+	// Deposits that aren't waiting to be transferred should not block the channel update
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordtypes.DepositRecord{
+		Id:         1,
+		HostZoneId: HostChainId,
+		Amount:     sdkmath.NewInt(1000),
+		Status:     recordtypes.DepositRecord_DELEGATION_QUEUE,
+	})
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordtypes.DepositRecord{
+		Id:         2,
+		HostZoneId: HostChainId,
+		Amount:     sdkmath.ZeroInt(),
+		Status:     recordtypes.DepositRecord_TRANSFER_QUEUE,
+	})
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordtypes.DepositRecord{
+		Id:         3,
+		HostZoneId: "OSMO",
+		Amount:     sdkmath.NewInt(1000),
+		Status:     recordtypes.DepositRecord_TRANSFER_QUEUE,
+	})
+
+	// A deposit that's still waiting to be transferred is held under the current ibc denom,
+	// so the channel update should be rejected
+	for _, status := range []recordtypes.DepositRecord_Status{
+		recordtypes.DepositRecord_TRANSFER_QUEUE,
+		recordtypes.DepositRecord_TRANSFER_IN_PROGRESS,
+	} {
+		s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordtypes.DepositRecord{
+			Id:         4,
+			HostZoneId: HostChainId,
+			Amount:     sdkmath.NewInt(1000),
+			Status:     status,
+		})
+
+		proposal := types.UpdateHostZoneProposal{HostZone: HostChainId, TransferChannelId: updatedTransferChannelId}
+		err := s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+		s.Require().ErrorContains(err, "transfer channel cannot be updated while deposit record 4 has a pending transfer",
+			"status %s", status.String())
+
+		hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+		s.Require().True(found, "host zone should have been found")
+		s.Require().Equal(ibctesting.FirstChannelID, hostZone.TransferChannelId, "transfer channel should not change")
+		s.Require().Equal(IbcAtom, hostZone.IbcDenom, "ibc denom should not change")
+	}
+
+	// Once the deposit has been transferred, the channel can be updated
+	s.App.RecordsKeeper.RemoveDepositRecord(s.Ctx, 4)
+
+	proposal := types.UpdateHostZoneProposal{HostZone: HostChainId, TransferChannelId: updatedTransferChannelId}
+	err := s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+	s.Require().NoError(err, "no error expected after the pending transfer completes")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneProposal_MovesStTokenRateLimit() {
+	s.SetupUpdateHostZoneProposal()
+
+	quota := ratelimittypes.Quota{
+		MaxPercentSend: sdkmath.NewInt(10),
+		MaxPercentRecv: sdkmath.NewInt(10),
+		DurationHours:  24,
+	}
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, ratelimittypes.RateLimit{
+		Path:  &ratelimittypes.Path{Denom: StAtom, ChannelId: ibctesting.FirstChannelID},
+		Quota: &quota,
+		Flow:  &ratelimittypes.Flow{Inflow: sdkmath.NewInt(5), Outflow: sdkmath.NewInt(5), ChannelValue: sdkmath.NewInt(100)},
+	})
+
+	proposal := types.UpdateHostZoneProposal{HostZone: HostChainId, TransferChannelId: updatedTransferChannelId}
+	err := s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+	s.Require().NoError(err, "no error expected")
+
+	// The rate limit should be removed from the old channel and added to the new channel with a reset flow
+	_, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, StAtom, ibctesting.FirstChannelID)
+	s.Require().False(found, "rate limit should have been removed from the old channel")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, StAtom, updatedTransferChannelId)
+	s.Require().True(found, "rate limit should have been moved to the new channel")
+	s.Require().Equal(quota, *rateLimit.Quota, "quota")
+	s.Require().Zero(rateLimit.Flow.Inflow.Int64(), "inflow")
+	s.Require().Zero(rateLimit.Flow.Outflow.Int64(), "outflow")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneProposal_DefaultRedemptionRateBounds() {
+	// Remove the bounds from the host zone so that the default thresholds from the params (0.9 and 1.5) apply
+	hostZone := s.SetupUpdateHostZoneProposal()
+	hostZone.MinRedemptionRate = sdk.ZeroDec()
+	hostZone.MaxRedemptionRate = sdk.ZeroDec()
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// Updates that don't change the bounds should not be blocked by the unset bounds
+	proposal := types.UpdateHostZoneProposal{HostZone: HostChainId, TransferChannelId: updatedTransferChannelId}
+	err := s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+	s.Require().NoError(err, "no error expected for a channel-only update")
+
+	// A max bound below the default min should be rejected
+	proposal = types.UpdateHostZoneProposal{HostZone: HostChainId, MaxRedemptionRate: sdk.MustNewDecFromStr("0.8")}
+	err = s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+	s.Require().ErrorContains(err, "min redemption rate (0.900000000000000000) must be less than max redemption rate (0.800000000000000000)")
+
+	// A max bound that keeps the current redemption rate (1.1) above the default min should be accepted
+	proposal = types.UpdateHostZoneProposal{HostZone: HostChainId, MaxRedemptionRate: sdk.MustNewDecFromStr("1.2")}
+	err = s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+	s.Require().NoError(err, "no error expected when only updating the max bound")
+
+	updatedHostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone should have been found")
+	s.Require().True(updatedHostZone.MinRedemptionRate.IsZero(), "min redemption rate should still be unset")
+	s.Require().Equal(sdk.MustNewDecFromStr("1.2"), updatedHostZone.MaxRedemptionRate, "max redemption rate")
+}
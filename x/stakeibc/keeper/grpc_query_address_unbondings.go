@@ -34,7 +34,6 @@ func (k Keeper) AddressUnbondings(c context.Context, req *types.QueryAddressUnbo
 	if !found {
 		return nil, sdkerrors.ErrKeyNotFound
 	}
-	currentDay := dayEpochTracker.EpochNumber
 
 	epochUnbondingRecords := k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx)
 
@@ -60,10 +59,7 @@ func (k Keeper) AddressUnbondings(c context.Context, req *types.QueryAddressUnbo
 						if !found {
 							return nil, sdkerrors.ErrKeyNotFound
 						}
-						daysUntilUnbonding := hostZone.UnbondingFrequency - (currentDay % hostZone.UnbondingFrequency)
-						unbondingStartTime := dayEpochTracker.NextEpochStartTime + ((daysUntilUnbonding - 1) * nanosecondsInDay)
-						unbondingDurationEstimate := (hostZone.UnbondingFrequency - 1) * 7
-						unbondingTime = unbondingStartTime + (unbondingDurationEstimate * nanosecondsInDay)
+						unbondingTime = EstimateUnbondingCompletionTime(hostZone, dayEpochTracker)
 					}
 					unbondingTime = unbondingTime + nanosecondsInDay
 					unbondingTimeStr := time.Unix(0, int64(unbondingTime)).UTC().String()
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func (k Keeper) HostZoneSunset(c context.Context, req *types.QueryHostZoneSunsetRequest) (*types.QueryHostZoneSunsetResponse, error) {
+	if req == nil || req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sunset, found := k.GetHostZoneSunset(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "host zone sunset not found for %s", req.ChainId)
+	}
+
+	// Once the sunset is complete, the host zone has been removed and no stTokens remain redeemable
+	remainingStTokenSupply := sdkmath.ZeroInt()
+	if hostZone, found := k.GetHostZone(ctx, req.ChainId); found {
+		circulatingStTokens, err := k.GetCirculatingStTokenSupply(ctx, hostZone)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		remainingStTokenSupply = circulatingStTokens
+	}
+
+	return &types.QueryHostZoneSunsetResponse{HostZoneSunset: sunset, RemainingStTokenSupply: remainingStTokenSupply}, nil
+}
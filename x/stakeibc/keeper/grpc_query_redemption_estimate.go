@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	epochtypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// Estimates the native amount of a redemption, the day epoch in which it would be undelegated,
+// and when it could be claimed (one day after the unbonding completes, once the tokens are swept)
+func (k Keeper) RedemptionEstimate(c context.Context, req *types.QueryRedemptionEstimateRequest) (*types.QueryRedemptionEstimateResponse, error) {
+	if req == nil || req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stTokenAmount, ok := sdkmath.NewIntFromString(req.StTokenAmount)
+	if !ok || !stTokenAmount.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid stToken amount (%s)", req.StTokenAmount)
+	}
+
+	hostZone, found := k.GetHostZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "host zone %s not found", req.ChainId)
+	}
+	if hostZone.Halted {
+		return nil, status.Errorf(codes.FailedPrecondition, "host zone %s is halted", req.ChainId)
+	}
+
+	// Once a sunset has begun unbonding, redemptions are paid out of the sunset unbonding at the final rate
+	if k.IsHostZoneSunsetUnbonding(ctx, req.ChainId) {
+		sunset, _ := k.GetActiveHostZoneSunset(ctx, req.ChainId)
+		nativeAmount := sdk.NewDecFromInt(stTokenAmount).Mul(sunset.FinalRedemptionRate).TruncateInt()
+
+		unbondingTime := uint64(ctx.BlockTime().UnixNano()) + GetUnbondingDurationEstimate(hostZone)
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, sunset.UnbondingEpochNumber, req.ChainId)
+		if found && hostZoneUnbonding.UnbondingTime != 0 {
+			unbondingTime = hostZoneUnbonding.UnbondingTime
+		}
+		claimableTime := unbondingTime + nanosecondsInDay
+
+		return &types.QueryRedemptionEstimateResponse{
+			NativeAmount:           nativeAmount,
+			UnbondingEpochNumber:   sunset.UnbondingEpochNumber,
+			EstimatedClaimableTime: time.Unix(0, int64(claimableTime)).UTC().String(),
+		}, nil
+	}
+
+	dayEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.DAY_EPOCH)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "epoch tracker %s not found", epochtypes.DAY_EPOCH)
+	}
+
+	nativeAmount := sdk.NewDecFromInt(stTokenAmount).Mul(hostZone.RedemptionRate).RoundInt()
+	unbondingEpochNumber := GetNextUnbondingEpoch(dayEpochTracker.EpochNumber, hostZone.UnbondingFrequency)
+	claimableTime := EstimateUnbondingCompletionTime(hostZone, dayEpochTracker) + nanosecondsInDay
+
+	return &types.QueryRedemptionEstimateResponse{
+		NativeAmount:           nativeAmount,
+		UnbondingEpochNumber:   unbondingEpochNumber,
+		EstimatedClaimableTime: time.Unix(0, int64(claimableTime)).UTC().String(),
+	}, nil
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	epochtypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
+	recordtypes "github.com/Stride-Labs/stride/v10/x/records/types"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+const nanosecondsInDay = uint64(24 * time.Hour)
+
+// The current day epoch is 5 and the host zone unbonds every 4 days,
+// so the next unbonding is in epoch 8 (3 days from now, or 2 days after the next epoch starts)
+func (s *KeeperTestSuite) SetupRedemptionEstimate(unbondingPeriod uint64) (nextEpochStartTime uint64) {
+	nextEpochStartTime = uint64(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano())
+
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:            HostChainId,
+		HostDenom:          Atom,
+		RedemptionRate:     sdk.MustNewDecFromStr("1.5"),
+		UnbondingFrequency: 4,
+		UnbondingPeriod:    unbondingPeriod,
+	})
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier:    epochtypes.DAY_EPOCH,
+		EpochNumber:        5,
+		NextEpochStartTime: nextEpochStartTime,
+	})
+
+	return nextEpochStartTime
+}
+
+func (s *KeeperTestSuite) TestRedemptionEstimate_Successful() {
+	unbondingPeriodSeconds := uint64(10 * 24 * 60 * 60)
+	nextEpochStartTime := s.SetupRedemptionEstimate(unbondingPeriodSeconds)
+
+	res, err := s.App.StakeibcKeeper.RedemptionEstimate(sdk.WrapSDKContext(s.Ctx), &types.QueryRedemptionEstimateRequest{
+		ChainId:       HostChainId,
+		StTokenAmount: "1000",
+	})
+	s.Require().NoError(err, "no error expected when estimating redemption")
+
+	// Unbonding starts 2 days after the next epoch, takes 10 days, and is swept the following day
+	expectedClaimableTime := nextEpochStartTime + (2+10+1)*nanosecondsInDay
+	s.Require().Equal(sdkmath.NewInt(1500), res.NativeAmount, "native amount")
+	s.Require().Equal(uint64(8), res.UnbondingEpochNumber, "unbonding epoch number")
+	s.Require().Equal(time.Unix(0, int64(expectedClaimableTime)).UTC().String(), res.EstimatedClaimableTime, "claimable time")
+}
+
+func (s *KeeperTestSuite) TestRedemptionEstimate_UnknownUnbondingPeriod() {
+	nextEpochStartTime := s.SetupRedemptionEstimate(0)
+
+	res, err := s.App.StakeibcKeeper.RedemptionEstimate(sdk.WrapSDKContext(s.Ctx), &types.QueryRedemptionEstimateRequest{
+		ChainId:       HostChainId,
+		StTokenAmount: "1000",
+	})
+	s.Require().NoError(err, "no error expected when estimating redemption")
+
+	// Without the unbonding period, the unbonding is estimated to take (4 - 1) * 7 = 21 days
+	expectedClaimableTime := nextEpochStartTime + (2+21+1)*nanosecondsInDay
+	s.Require().Equal(uint64(8), res.UnbondingEpochNumber, "unbonding epoch number")
+	s.Require().Equal(time.Unix(0, int64(expectedClaimableTime)).UTC().String(), res.EstimatedClaimableTime, "claimable time")
+}
+
+func (s *KeeperTestSuite) TestRedemptionEstimate_SunsetUnbonding() {
+	s.SetupRedemptionEstimate(uint64(10 * 24 * 60 * 60))
+
+	s.App.StakeibcKeeper.SetHostZoneSunset(s.Ctx, types.HostZoneSunset{
+		ChainId:              HostChainId,
+		Status:               types.HostZoneSunsetStatus_UNBONDING,
+		FinalRedemptionRate:  sdk.MustNewDecFromStr("1.2"),
+		UnbondingEpochNumber: 4,
+		RedeemableAmount:     sdkmath.NewInt(10_000),
+	})
+
+	unbondingTime := uint64(time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC).UnixNano())
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: 4,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{{
+			HostZoneId:    HostChainId,
+			UnbondingTime: unbondingTime,
+			Status:        recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS,
+		}},
+	})
+
+	// The sunset redemption uses the final rate and the sunset unbonding
+	res, err := s.App.StakeibcKeeper.RedemptionEstimate(sdk.WrapSDKContext(s.Ctx), &types.QueryRedemptionEstimateRequest{
+		ChainId:       HostChainId,
+		StTokenAmount: "1000",
+	})
+	s.Require().NoError(err, "no error expected when estimating redemption")
+
+	expectedClaimableTime := unbondingTime + nanosecondsInDay
+	s.Require().Equal(sdkmath.NewInt(1200), res.NativeAmount, "native amount")
+	s.Require().Equal(uint64(4), res.UnbondingEpochNumber, "unbonding epoch number")
+	s.Require().Equal(time.Unix(0, int64(expectedClaimableTime)).UTC().String(), res.EstimatedClaimableTime, "claimable time")
+}
+
+func (s *KeeperTestSuite) TestRedemptionEstimate_Failures() {
+	s.SetupRedemptionEstimate(0)
+	ctx := sdk.WrapSDKContext(s.Ctx)
+
+	_, err := s.App.StakeibcKeeper.RedemptionEstimate(ctx, &types.QueryRedemptionEstimateRequest{ChainId: HostChainId, StTokenAmount: "abc"})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err), "invalid amount")
+
+	_, err = s.App.StakeibcKeeper.RedemptionEstimate(ctx, &types.QueryRedemptionEstimateRequest{ChainId: HostChainId, StTokenAmount: "0"})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err), "zero amount")
+
+	_, err = s.App.StakeibcKeeper.RedemptionEstimate(ctx, &types.QueryRedemptionEstimateRequest{ChainId: "fake_host_zone", StTokenAmount: "1000"})
+	s.Require().Equal(codes.NotFound, status.Code(err), "host zone not found")
+
+	hostZone, _ := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	hostZone.Halted = true
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err = s.App.StakeibcKeeper.RedemptionEstimate(ctx, &types.QueryRedemptionEstimateRequest{ChainId: HostChainId, StTokenAmount: "1000"})
+	s.Require().Equal(codes.FailedPrecondition, status.Code(err), "halted host zone")
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func (k Keeper) RedemptionRateHistory(c context.Context, req *types.QueryRedemptionRateHistoryRequest) (*types.QueryRedemptionRateHistoryResponse, error) {
+	if req == nil || req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.EndEpoch != 0 && req.EndEpoch < req.StartEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "end epoch (%d) must be greater than or equal to start epoch (%d)", req.EndEpoch, req.StartEpoch)
+	}
+
+	var redemptionRateRecords []types.RedemptionRateRecord
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	redemptionRateRecordStore := prefix.NewStore(store, types.RedemptionRateRecordKeyPrefixByChainId(req.ChainId))
+
+	pageRes, err := query.FilteredPaginate(redemptionRateRecordStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		epochNumber := sdk.BigEndianToUint64(key)
+		if epochNumber < req.StartEpoch || (req.EndEpoch != 0 && epochNumber > req.EndEpoch) {
+			return false, nil
+		}
+
+		if accumulate {
+			var redemptionRateRecord types.RedemptionRateRecord
+			if err := k.cdc.Unmarshal(value, &redemptionRateRecord); err != nil {
+				return false, err
+			}
+			redemptionRateRecords = append(redemptionRateRecords, redemptionRateRecord)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedemptionRateHistoryResponse{RedemptionRateRecords: redemptionRateRecords, Pagination: pageRes}, nil
+}
@@ -22,5 +22,8 @@ func (k Keeper) Validators(c context.Context, req *types.QueryGetValidatorsReque
 		return nil, sdkerrors.ErrKeyNotFound
 	}
 
-	return &types.QueryGetValidatorsResponse{Validators: hostZone.Validators}, nil
+	return &types.QueryGetValidatorsResponse{
+		Validators:       hostZone.Validators,
+		UnbondingEntries: k.GetValidatorUnbondingEntries(ctx, hostZone),
+	}, nil
 }
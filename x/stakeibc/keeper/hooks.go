@@ -23,14 +23,25 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInf
 
 	// Day Epoch - Process Unbondings
 	if epochInfo.Identifier == epochstypes.DAY_EPOCH {
+		// Refresh validator weights for host zones that don't use static weights
+		k.UpdateValidatorWeightsForAllHostZones(ctx)
+		// Refresh each host's unbonding period, used to estimate when redemptions can be claimed
+		k.SubmitStakingParamsICQForAllHostZones(ctx)
 		// Initiate unbondings from any hostZone where it's appropriate
 		k.InitiateAllHostZoneUnbondings(ctx, epochNumber)
+		// Redelegate toward the target weights for any host zone that has drifted too far from them
+		// (host zones with unbondings in flight are skipped until the unbondings are acknowledged)
+		k.AutoRebalanceAllHostZones(ctx)
 		// Check previous epochs to see if unbondings finished, and sweep the tokens if so
 		k.SweepAllUnbondedTokens(ctx)
+		// Send claimable redemptions to their receivers for host zones that opted in to auto claim
+		k.AutoClaimAllHostZones(ctx)
 		// Cleanup any records that are no longer needed
 		k.CleanupEpochUnbondingRecords(ctx, epochNumber)
 		// Create an empty unbonding record for this epoch
 		k.CreateEpochUnbondingRecord(ctx, epochNumber)
+		// Progress any host zones that are being sunset (this may add to the new unbonding record)
+		k.ProcessAllHostZoneSunsets(ctx, epochNumber)
 	}
 
 	// Stride Epoch - Process Deposits and Delegations
@@ -45,12 +56,15 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInf
 		k.CreateDepositRecordsForEpoch(ctx, epochNumber)
 		depositRecords := k.RecordsKeeper.GetAllDepositRecord(ctx)
 
+		// Re-open any ICA channels that were closed (e.g. from a timeout)
+		k.RestoreClosedICAChannels(ctx)
+
 		// TODO: move this to an external function that anyone can call, so that we don't have to call it every epoch
 		k.SetWithdrawalAddress(ctx)
 
 		// Update the redemption rate
 		if epochNumber%redemptionRateInterval == 0 {
-			k.UpdateRedemptionRates(ctx, depositRecords)
+			k.UpdateRedemptionRates(ctx, epochNumber, depositRecords)
 		}
 
 		// Transfer deposited funds from the controller account to the delegation account on the host zone
@@ -139,11 +153,16 @@ func (k Keeper) SetWithdrawalAddress(ctx sdk.Context) {
 // The redemption rate equation is:
 //
 //	(Unbonded Balance + Staked Balance + Module Account Balance) / (stToken Supply)
-func (k Keeper) UpdateRedemptionRates(ctx sdk.Context, depositRecords []recordstypes.DepositRecord) {
+func (k Keeper) UpdateRedemptionRates(ctx sdk.Context, epochNumber uint64, depositRecords []recordstypes.DepositRecord) {
 	k.Logger(ctx).Info("Updating Redemption Rates...")
 
 	// Update the redemption rate for each host zone
 	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		// The redemption rate of a sunset host zone is frozen once it begins unbonding
+		if k.IsHostZoneSunsetUnbonding(ctx, hostZone.ChainId) {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Host zone is being sunset - redemption rate is unchanged"))
+			continue
+		}
 
 		// Gather redemption rate components
 		stSupply := k.bankKeeper.GetSupply(ctx, types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)).Amount
@@ -167,6 +186,20 @@ func (k Keeper) UpdateRedemptionRates(ctx sdk.Context, depositRecords []recordst
 		hostZone.LastRedemptionRate = hostZone.RedemptionRate
 		hostZone.RedemptionRate = redemptionRate
 		k.SetHostZone(ctx, hostZone)
+
+		// Store a snapshot of the new rate in the redemption rate history, and prune any expired records
+		k.SetRedemptionRateRecord(ctx, types.RedemptionRateRecord{
+			ChainId:              hostZone.ChainId,
+			EpochNumber:          epochNumber,
+			BlockHeight:          ctx.BlockHeight(),
+			BlockTime:            ctx.BlockTime().Unix(),
+			RedemptionRate:       redemptionRate,
+			UndelegatedBalance:   undelegatedBalance,
+			StakedBalance:        stakedBalance,
+			ModuleAccountBalance: moduleAcctBalance,
+			StTokenSupply:        stSupply,
+		})
+		k.PruneRedemptionRateHistory(ctx, hostZone.ChainId, epochNumber)
 	}
 }
 
@@ -204,6 +237,12 @@ func (k Keeper) ReinvestRewards(ctx sdk.Context) {
 	k.Logger(ctx).Info("Reinvesting tokens...")
 
 	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		// rewards are not reinvested once a host zone is being sunset
+		if _, sunset := k.GetActiveHostZoneSunset(ctx, hostZone.ChainId); sunset {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Host zone is being sunset - not reinvesting rewards"))
+			continue
+		}
+
 		// only process host zones once withdrawal accounts are registered
 		withdrawalAccount := hostZone.WithdrawalAccount
 		if withdrawalAccount == nil || withdrawalAccount.Address == "" {
@@ -224,5 +263,11 @@ func (k Keeper) ReinvestRewards(ctx sdk.Context) {
 			k.Logger(ctx).Error(fmt.Sprintf("Error updating withdrawal balance for host zone %s: %s", hostZone.ConnectionId, err.Error()))
 			continue
 		}
+
+		err = k.UpdateFeeBalance(ctx, hostZone)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Error updating fee balance for host zone %s: %s", hostZone.ConnectionId, err.Error()))
+			continue
+		}
 	}
 }
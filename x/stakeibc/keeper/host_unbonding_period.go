@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Stride-Labs/stride/v10/utils"
+	epochstypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
+	icqtypes "github.com/Stride-Labs/stride/v10/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// Submits an ICQ for the host's staking params, which is used to track the host's unbonding period
+// The unbonding period rarely changes, so the query expires at the start of the next day epoch
+func (k Keeper) SubmitStakingParamsICQ(ctx sdk.Context, hostZone types.HostZone) error {
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Submitting ICQ for staking params"))
+
+	ttl, err := k.GetStartTimeNextEpoch(ctx, epochstypes.DAY_EPOCH)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "could not get start time for next epoch: %s", err.Error())
+	}
+
+	return k.InterchainQueryKeeper.MakeRequest(
+		ctx,
+		types.ModuleName,
+		ICQCallbackID_StakingParams,
+		hostZone.ChainId,
+		hostZone.ConnectionId,
+		icqtypes.STAKING_STORE_QUERY_WITH_PROOF,
+		stakingtypes.ParamsKey,
+		ttl,
+		icqtypes.TimeoutPolicy_REJECT_QUERY_RESPONSE,
+	)
+}
+
+// Refreshes the unbonding period of each active host zone
+func (k Keeper) SubmitStakingParamsICQForAllHostZones(ctx sdk.Context) {
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		if err := k.SubmitStakingParamsICQ(ctx, hostZone); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to query staking params for host zone %s, err: %s", hostZone.ChainId, err.Error()))
+		}
+	}
+}
+
+// Returns the first day epoch after the current one in which the host zone unbonds
+// (i.e. the epoch in which a redemption submitted now will be undelegated)
+func GetNextUnbondingEpoch(currentDay uint64, unbondingFrequency uint64) uint64 {
+	return currentDay + unbondingFrequency - (currentDay % unbondingFrequency)
+}
+
+// Returns the expected duration of an unbonding on the host in nanoseconds
+// If the unbonding period has not yet been queried, it's estimated from the unbonding frequency
+func GetUnbondingDurationEstimate(hostZone types.HostZone) uint64 {
+	if hostZone.UnbondingPeriod != 0 {
+		return hostZone.UnbondingPeriod * 1_000_000_000
+	}
+	return (hostZone.UnbondingFrequency - 1) * 7 * nanosecondsInDay
+}
+
+// Estimates the time (in unix nanoseconds) at which an unbonding that has not yet been
+// initiated will complete on the host, based on the next epoch in which the host zone unbonds
+func EstimateUnbondingCompletionTime(hostZone types.HostZone, dayEpochTracker types.EpochTracker) uint64 {
+	currentDay := dayEpochTracker.EpochNumber
+	daysUntilUnbonding := GetNextUnbondingEpoch(currentDay, hostZone.UnbondingFrequency) - currentDay
+	unbondingStartTime := dayEpochTracker.NextEpochStartTime + ((daysUntilUnbonding - 1) * nanosecondsInDay)
+	return unbondingStartTime + GetUnbondingDurationEstimate(hostZone)
+}
@@ -0,0 +1,426 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cast"
+
+	"github.com/Stride-Labs/stride/v10/utils"
+	recordstypes "github.com/Stride-Labs/stride/v10/x/records/types"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// SetHostZoneSunset set a specific hostZoneSunset in the store
+func (k Keeper) SetHostZoneSunset(ctx sdk.Context, hostZoneSunset types.HostZoneSunset) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HostZoneSunsetKeyPrefix))
+	b := k.cdc.MustMarshal(&hostZoneSunset)
+	store.Set([]byte(hostZoneSunset.ChainId), b)
+}
+
+// GetHostZoneSunset returns a hostZoneSunset from its chain ID
+func (k Keeper) GetHostZoneSunset(ctx sdk.Context, chainId string) (val types.HostZoneSunset, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HostZoneSunsetKeyPrefix))
+	b := store.Get([]byte(chainId))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllHostZoneSunsets returns all hostZoneSunsets
+func (k Keeper) GetAllHostZoneSunsets(ctx sdk.Context) (list []types.HostZoneSunset) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HostZoneSunsetKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.HostZoneSunset
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// Returns the sunset of a host zone if the host zone is currently being sunset
+// (a completed sunset is kept for reference but no longer applies to the host zone)
+func (k Keeper) GetActiveHostZoneSunset(ctx sdk.Context, chainId string) (sunset types.HostZoneSunset, isSunsetting bool) {
+	sunset, found := k.GetHostZoneSunset(ctx, chainId)
+	if !found || sunset.Status == types.HostZoneSunsetStatus_COMPLETE {
+		return sunset, false
+	}
+	return sunset, true
+}
+
+// Returns true if the host zone is being sunset and has started unbonding its staked balance,
+// at which point redemptions use the final redemption rate and the stake is no longer managed
+func (k Keeper) IsHostZoneSunsetUnbonding(ctx sdk.Context, chainId string) bool {
+	sunset, isSunsetting := k.GetActiveHostZoneSunset(ctx, chainId)
+	return isSunsetting && sunset.Status != types.HostZoneSunsetStatus_DRAINING_DEPOSITS
+}
+
+// Returns the stTokens for a host zone that are held by users
+// (i.e. excluding the stTokens escrowed by redemptions that have not yet been burned)
+func (k Keeper) GetCirculatingStTokenSupply(ctx sdk.Context, hostZone types.HostZone) (sdkmath.Int, error) {
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	stSupply := k.bankKeeper.GetSupply(ctx, stDenom).Amount
+
+	zoneAddress, err := sdk.AccAddressFromBech32(hostZone.Address)
+	if err != nil {
+		return sdkmath.ZeroInt(), errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid host zone address %s: %s", hostZone.Address, err.Error())
+	}
+	escrowedStTokens := k.bankKeeper.GetBalance(ctx, zoneAddress, stDenom).Amount
+
+	return stSupply.Sub(escrowedStTokens), nil
+}
+
+// Returns true if any of a host zone's deposit records still have tokens that have not been delegated
+func (k Keeper) HasPendingDeposits(ctx sdk.Context, chainId string) bool {
+	for _, depositRecord := range k.RecordsKeeper.GetAllDepositRecord(ctx) {
+		if depositRecord.HostZoneId == chainId && depositRecord.Amount.IsPositive() {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if a host zone has any user redemption records that have not been claimed
+func (k Keeper) HasUserRedemptionRecords(ctx sdk.Context, chainId string) bool {
+	for _, userRedemptionRecord := range k.RecordsKeeper.GetAllUserRedemptionRecord(ctx) {
+		if userRedemptionRecord.HostZoneId == chainId {
+			return true
+		}
+	}
+	return false
+}
+
+// Undelegates a sunset host zone's full staked balance through the normal unbonding flow
+// by adding the portion of the staked balance that isn't owed to existing redemptions to
+// the current epoch's host zone unbonding. The final redemption rate splits that portion
+// across the stTokens that have not yet been redeemed
+func (k Keeper) BeginHostZoneSunsetUnbonding(ctx sdk.Context, hostZone types.HostZone, sunset types.HostZoneSunset, epochNumber uint64) (types.HostZoneSunset, error) {
+	chainId := hostZone.ChainId
+
+	// Existing redemptions that are still queued are included in the staked balance
+	// Redemptions that are already unbonding are not, since the staked balance is
+	// decremented when the undelegation ICA is acknowledged
+	queuedUnbondings := sdkmath.ZeroInt()
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, chainId)
+		if !found {
+			continue
+		}
+		if hostZoneUnbonding.Status == recordstypes.HostZoneUnbonding_UNBONDING_QUEUE {
+			queuedUnbondings = queuedUnbondings.Add(hostZoneUnbonding.NativeTokenAmount)
+		}
+	}
+	unbondAmount := hostZone.StakedBal.Sub(queuedUnbondings)
+	if unbondAmount.IsNegative() {
+		return sunset, errorsmod.Wrapf(types.ErrInvalidAmount,
+			"queued unbondings (%v) exceed the staked balance (%v) on %s", queuedUnbondings, hostZone.StakedBal, chainId)
+	}
+
+	circulatingStTokens, err := k.GetCirculatingStTokenSupply(ctx, hostZone)
+	if err != nil {
+		return sunset, err
+	}
+	finalRedemptionRate := hostZone.RedemptionRate
+	if circulatingStTokens.IsPositive() {
+		finalRedemptionRate = sdk.NewDecFromInt(unbondAmount).Quo(sdk.NewDecFromInt(circulatingStTokens))
+	}
+
+	sunset.FinalRedemptionRate = finalRedemptionRate
+	sunset.UnbondingEpochNumber = epochNumber
+	sunset.RedeemableAmount = unbondAmount
+
+	// If there's nothing left to unbond, the host zone can move straight to redemptions
+	if unbondAmount.IsZero() {
+		sunset.Status = types.HostZoneSunsetStatus_REDEEMING
+		return sunset, nil
+	}
+
+	hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochNumber, chainId)
+	if !found {
+		return sunset, errorsmod.Wrapf(types.ErrRecordNotFound,
+			"host zone unbonding not found for %s in epoch %d", chainId, epochNumber)
+	}
+	hostZoneUnbonding.NativeTokenAmount = hostZoneUnbonding.NativeTokenAmount.Add(unbondAmount)
+
+	updatedEpochUnbondingRecord, success := k.RecordsKeeper.AddHostZoneToEpochUnbondingRecord(ctx, epochNumber, chainId, hostZoneUnbonding)
+	if !success {
+		return sunset, errorsmod.Wrapf(types.ErrEpochNotFound, "couldn't set host zone epoch unbonding record for epoch %d", epochNumber)
+	}
+	k.RecordsKeeper.SetEpochUnbondingRecord(ctx, *updatedEpochUnbondingRecord)
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Sunset - unbonding %v%s at a final redemption rate of %v",
+		unbondAmount, hostZone.HostDenom, finalRedemptionRate))
+
+	sunset.Status = types.HostZoneSunsetStatus_UNBONDING
+	return sunset, nil
+}
+
+// Removes a sunset host zone along with its records, its queries, and its stToken's rate limits
+// This should only be called once every user redemption record for the host zone has been claimed
+// (and therefore removed), so that no user loses their claim
+func (k Keeper) RemoveSunsetHostZone(ctx sdk.Context, hostZone types.HostZone) {
+	chainId := hostZone.ChainId
+
+	for _, depositRecord := range k.RecordsKeeper.GetAllDepositRecord(ctx) {
+		if depositRecord.HostZoneId == chainId {
+			k.RecordsKeeper.RemoveDepositRecord(ctx, depositRecord.Id)
+		}
+	}
+
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		remainingHostZoneUnbondings := []*recordstypes.HostZoneUnbonding{}
+		for _, hostZoneUnbonding := range epochUnbondingRecord.HostZoneUnbondings {
+			if hostZoneUnbonding.HostZoneId != chainId {
+				remainingHostZoneUnbondings = append(remainingHostZoneUnbondings, hostZoneUnbonding)
+			}
+		}
+
+		if len(remainingHostZoneUnbondings) == len(epochUnbondingRecord.HostZoneUnbondings) {
+			continue
+		}
+		if len(remainingHostZoneUnbondings) == 0 {
+			k.RecordsKeeper.RemoveEpochUnbondingRecord(ctx, epochUnbondingRecord.EpochNumber)
+			continue
+		}
+		epochUnbondingRecord.HostZoneUnbondings = remainingHostZoneUnbondings
+		k.RecordsKeeper.SetEpochUnbondingRecord(ctx, epochUnbondingRecord)
+	}
+
+	for _, redelegationTracker := range k.GetAllRedelegationTrackersForHostZone(ctx, chainId) {
+		k.RemoveRedelegationTracker(ctx, chainId, redelegationTracker.SrcValidator, redelegationTracker.DstValidator)
+	}
+
+	for _, redemptionRateRecord := range k.GetAllRedemptionRateRecordsForHostZone(ctx, chainId) {
+		k.RemoveRedemptionRateRecord(ctx, chainId, redemptionRateRecord.EpochNumber)
+	}
+
+	// Stop the periodic balance queries, and drop any pending or failed queries
+	k.InterchainQueryKeeper.RemoveAllChainQueries(ctx, chainId)
+
+	// Remove the stToken's rate limits and blacklist entry, as well as the whitelisted transfers
+	// that were added when the fee and delegation accounts were registered
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	k.RatelimitKeeper.RemoveDenomRateLimits(ctx, stDenom)
+	k.RatelimitKeeper.RemoveDenomFromBlacklist(ctx, stDenom)
+	if hostZone.FeeAccount != nil && hostZone.FeeAccount.Address != "" {
+		rewardCollectorAddress := k.AccountKeeper.GetModuleAccount(ctx, types.RewardCollectorName).GetAddress()
+		k.RatelimitKeeper.RemoveWhitelistedAddressPair(ctx, hostZone.FeeAccount.Address, rewardCollectorAddress.String())
+	}
+	if hostZone.DelegationAccount != nil && hostZone.DelegationAccount.Address != "" {
+		k.RatelimitKeeper.RemoveWhitelistedAddressPair(ctx, hostZone.Address, hostZone.DelegationAccount.Address)
+	}
+
+	k.RemoveHostZone(ctx, chainId)
+}
+
+// Moves a sunset host zone to the next stage once the current stage is finished:
+//   - DRAINING_DEPOSITS -> UNBONDING: once every deposit has been delegated
+//   - UNBONDING -> REDEEMING: once the undelegated balance is claimable
+//   - REDEEMING -> COMPLETE: once every stToken has been redeemed (or the deadline has passed) and every redemption has been claimed
+func (k Keeper) ProcessHostZoneSunset(ctx sdk.Context, sunset types.HostZoneSunset, epochNumber uint64) error {
+	chainId := sunset.ChainId
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", chainId)
+	}
+
+	initialStatus := sunset.Status
+	switch sunset.Status {
+	case types.HostZoneSunsetStatus_DRAINING_DEPOSITS:
+		if k.HasPendingDeposits(ctx, chainId) {
+			k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Sunset - waiting for the remaining deposits to be delegated"))
+			return nil
+		}
+
+		var err error
+		sunset, err = k.BeginHostZoneSunsetUnbonding(ctx, hostZone, sunset, epochNumber)
+		if err != nil {
+			return err
+		}
+
+	case types.HostZoneSunsetStatus_UNBONDING:
+		// If the unbonding record is gone, it was fully claimed and cleaned up
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, sunset.UnbondingEpochNumber, chainId)
+		if found && hostZoneUnbonding.Status != recordstypes.HostZoneUnbonding_CLAIMABLE {
+			k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Sunset - waiting for the undelegated balance to be claimable"))
+			return nil
+		}
+		sunset.Status = types.HostZoneSunsetStatus_REDEEMING
+
+	case types.HostZoneSunsetStatus_REDEEMING:
+		circulatingStTokens, err := k.GetCirculatingStTokenSupply(ctx, hostZone)
+		if err != nil {
+			return err
+		}
+		// The deadline only stops new redemptions - existing redemptions must still be claimed
+		// before the host zone and its records can be removed
+		if !circulatingStTokens.IsZero() && !isRedemptionDeadlinePassed(ctx, sunset) {
+			k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Sunset - %v st%s have not been redeemed", circulatingStTokens, hostZone.HostDenom))
+			return nil
+		}
+		if k.HasUserRedemptionRecords(ctx, chainId) {
+			k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Sunset - waiting for the remaining redemptions to be claimed"))
+			return nil
+		}
+
+		k.RemoveSunsetHostZone(ctx, hostZone)
+		sunset.Status = types.HostZoneSunsetStatus_COMPLETE
+	}
+
+	if sunset.Status == initialStatus {
+		return nil
+	}
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Sunset - status updated from %s to %s", initialStatus, sunset.Status))
+	k.SetHostZoneSunset(ctx, sunset)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateHostZoneSunset,
+			sdk.NewAttribute(types.AttributeKeyHostZone, chainId),
+			sdk.NewAttribute(types.AttributeKeySunsetStatus, sunset.Status.String()),
+		),
+	)
+
+	return nil
+}
+
+// Returns true if the sunset's redemption deadline has passed, after which no new redemptions are accepted
+func isRedemptionDeadlinePassed(ctx sdk.Context, sunset types.HostZoneSunset) bool {
+	return cast.ToUint64(ctx.BlockTime().Unix()) >= sunset.RedemptionDeadline
+}
+
+// Progresses each host zone that is being sunset
+func (k Keeper) ProcessAllHostZoneSunsets(ctx sdk.Context, epochNumber uint64) {
+	for _, sunset := range k.GetAllHostZoneSunsets(ctx) {
+		if sunset.Status == types.HostZoneSunsetStatus_COMPLETE {
+			continue
+		}
+
+		// Process in a cached context so that a failure does not leave partial state
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.ProcessHostZoneSunset(cacheCtx, sunset, epochNumber); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to process sunset for host zone %s, err: %s", sunset.ChainId, err.Error()))
+			continue
+		}
+		writeCache()
+	}
+}
+
+// Redeems stTokens from a host zone that has begun unbonding its full staked balance as part of a sunset
+// The stTokens are burned immediately and the redemption is paid out of the sunset unbonding
+// at the final redemption rate, meaning it can be claimed as soon as that unbonding completes
+func (k Keeper) RedeemStakeFromSunsetHostZone(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	amount sdkmath.Int,
+	hostZone types.HostZone,
+	receiver string,
+	minNativeOut sdkmath.Int,
+) (string, sdkmath.Int, error) {
+	chainId := hostZone.ChainId
+	sunset, active := k.GetActiveHostZoneSunset(ctx, chainId)
+	if !active {
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrHostZoneSunsetNotFound, "no active sunset for host zone %s", chainId)
+	}
+	if isRedemptionDeadlinePassed(ctx, sunset) {
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrHostZoneSunset,
+			"redemption deadline (%d) for host zone %s has passed", sunset.RedemptionDeadline, chainId)
+	}
+
+	if _, err := utils.AccAddressFromBech32(receiver, hostZone.Bech32Prefix); err != nil {
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+
+	nativeAmount := sdk.NewDecFromInt(amount).Mul(sunset.FinalRedemptionRate).TruncateInt()
+	if !nativeAmount.IsPositive() {
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be greater than 0. found: %v", amount)
+	}
+	if nativeAmount.LT(minNativeOut) {
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrMinOutputNotMet,
+			"redemption would return %v%s, which is less than the minimum of %v", nativeAmount, hostZone.HostDenom, minNativeOut)
+	}
+	if nativeAmount.GT(sunset.RedeemableAmount) {
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrInvalidAmount,
+			"cannot redeem %v%s, only %v%s remains redeemable", nativeAmount, hostZone.HostDenom, sunset.RedeemableAmount, hostZone.HostDenom)
+	}
+
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	balance := k.bankKeeper.GetBalance(ctx, sender, stDenom)
+	if balance.Amount.LT(amount) {
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "balance is lower than redemption amount. redemption amount: %v, balance %v: ", amount, balance.Amount)
+	}
+
+	// Redemptions are recorded against the sunset unbonding, which already includes the native tokens
+	epochNumber := sunset.UnbondingEpochNumber
+	epochUnbondingRecord, found := k.RecordsKeeper.GetEpochUnbondingRecord(ctx, epochNumber)
+	if !found {
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(recordstypes.ErrEpochUnbondingRecordNotFound, "sunset epoch unbonding record not found for epoch %d", epochNumber)
+	}
+	hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochNumber, chainId)
+	if !found {
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrRecordNotFound, "sunset host zone unbonding not found for %s in epoch %d", chainId, epochNumber)
+	}
+
+	senderAddr := sender.String()
+	redemptionId := recordstypes.UserRedemptionRecordKeyFormatter(chainId, epochNumber, senderAddr)
+	userRedemptionRecord, redemptionRecordExists := k.RecordsKeeper.GetUserRedemptionRecord(ctx, redemptionId)
+	if redemptionRecordExists {
+		if userRedemptionRecord.Receiver != receiver {
+			return "", sdkmath.ZeroInt(), errorsmod.Wrapf(recordstypes.ErrRedemptionAlreadyExists,
+				"user already redeemed from the sunset to a different receiver (%s): %s", userRedemptionRecord.Receiver, redemptionId)
+		}
+		if userRedemptionRecord.ClaimIsPending {
+			return "", sdkmath.ZeroInt(), errorsmod.Wrapf(recordstypes.ErrRedemptionAlreadyExists,
+				"user has a pending claim for their sunset redemption: %s", redemptionId)
+		}
+		userRedemptionRecord.Amount = userRedemptionRecord.Amount.Add(nativeAmount)
+	} else {
+		userRedemptionRecord = recordstypes.UserRedemptionRecord{
+			Id:             redemptionId,
+			Sender:         senderAddr,
+			Receiver:       receiver,
+			Amount:         nativeAmount,
+			Denom:          hostZone.HostDenom,
+			HostZoneId:     chainId,
+			EpochNumber:    epochNumber,
+			ClaimIsPending: false,
+		}
+		hostZoneUnbonding.UserRedemptionRecords = append(hostZoneUnbonding.UserRedemptionRecords, redemptionId)
+	}
+
+	// Burn the redeemed stTokens
+	stCoins := sdk.NewCoins(sdk.NewCoin(stDenom, amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, stCoins); err != nil {
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrInsufficientFunds, "couldn't send %v%s to module account. err: %s", amount, stDenom, err.Error())
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, stCoins); err != nil {
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrInsufficientFunds, "unable to burn %v%s. err: %s", amount, stDenom, err.Error())
+	}
+
+	k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+
+	updatedEpochUnbondingRecord, success := k.RecordsKeeper.AddHostZoneToEpochUnbondingRecord(ctx, epochUnbondingRecord.EpochNumber, chainId, hostZoneUnbonding)
+	if !success {
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrEpochNotFound, "couldn't set host zone epoch unbonding record for epoch %d", epochUnbondingRecord.EpochNumber)
+	}
+	k.RecordsKeeper.SetEpochUnbondingRecord(ctx, *updatedEpochUnbondingRecord)
+
+	sunset.RedeemableAmount = sunset.RedeemableAmount.Sub(nativeAmount)
+	k.SetHostZoneSunset(ctx, sunset)
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Sunset - redeemed %v%s for %v%s", amount, stDenom, nativeAmount, hostZone.HostDenom))
+
+	return redemptionId, nativeAmount, nil
+}
@@ -0,0 +1,623 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	icqtypes "github.com/Stride-Labs/stride/v10/x/interchainquery/types"
+	ratelimittypes "github.com/Stride-Labs/stride/v10/x/ratelimit/types"
+	recordtypes "github.com/Stride-Labs/stride/v10/x/records/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v10/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+const (
+	SunsetChainId         = HostChainId
+	OtherChainId          = "OSMO"
+	SunsetEpochNumber     = uint64(2)
+	SunsetRedemptionEpoch = uint64(1)
+)
+
+type HostZoneSunsetTestCase struct {
+	user        sdk.AccAddress
+	zoneAddress sdk.AccAddress
+	deadline    uint64
+}
+
+// Sets up a host zone with:
+//   - a staked balance of 12,000uatom
+//   - a pending redemption of 2,000uatom in epoch 1, backed by 2,000stuatom escrowed in the zone account
+//   - 8,000stuatom held by a user
+//   - an empty unbonding record for the current epoch (epoch 2) and an empty deposit record
+//
+// The final redemption rate of the host zone is therefore (12,000 - 2,000) / 8,000 = 1.25
+// Records are also added for a second host zone to confirm they're untouched by the sunset
+func (s *KeeperTestSuite) SetupHostZoneSunset() HostZoneSunsetTestCase {
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.Ctx = s.Ctx.WithBlockTime(blockTime)
+
+	user := s.TestAccs[0]
+	zoneAddress := types.NewZoneAddress(SunsetChainId)
+	s.FundAccount(user, sdk.NewInt64Coin(StAtom, 8_000))
+	s.FundAccount(zoneAddress, sdk.NewInt64Coin(StAtom, 2_000))
+
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:        SunsetChainId,
+		HostDenom:      Atom,
+		IbcDenom:       IbcAtom,
+		Bech32Prefix:   "cosmos",
+		Address:        zoneAddress.String(),
+		RedemptionRate: sdk.MustNewDecFromStr("1.2"),
+		StakedBal:      sdkmath.NewInt(12_000),
+	})
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:   OtherChainId,
+		HostDenom: Osmo,
+	})
+
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordtypes.DepositRecord{
+		Id:         1,
+		HostZoneId: SunsetChainId,
+		Amount:     sdkmath.ZeroInt(),
+		Status:     recordtypes.DepositRecord_TRANSFER_QUEUE,
+	})
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordtypes.DepositRecord{
+		Id:         2,
+		HostZoneId: OtherChainId,
+		Amount:     sdkmath.NewInt(1000),
+		Status:     recordtypes.DepositRecord_TRANSFER_QUEUE,
+	})
+
+	pendingRedemptionId := recordtypes.UserRedemptionRecordKeyFormatter(SunsetChainId, SunsetRedemptionEpoch, "sender")
+	otherRedemptionId := recordtypes.UserRedemptionRecordKeyFormatter(OtherChainId, SunsetRedemptionEpoch, "sender")
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{
+		Id:         pendingRedemptionId,
+		HostZoneId: SunsetChainId,
+		Amount:     sdkmath.NewInt(2_000),
+	})
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{
+		Id:         otherRedemptionId,
+		HostZoneId: OtherChainId,
+		Amount:     sdkmath.NewInt(1_000),
+	})
+
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: SunsetRedemptionEpoch,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{
+				HostZoneId:            SunsetChainId,
+				Status:                recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+				NativeTokenAmount:     sdkmath.NewInt(2_000),
+				StTokenAmount:         sdkmath.NewInt(2_000),
+				UserRedemptionRecords: []string{pendingRedemptionId},
+			},
+			{
+				HostZoneId:            OtherChainId,
+				Status:                recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+				NativeTokenAmount:     sdkmath.NewInt(1_000),
+				StTokenAmount:         sdkmath.NewInt(1_000),
+				UserRedemptionRecords: []string{otherRedemptionId},
+			},
+		},
+	})
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: SunsetEpochNumber,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{
+				HostZoneId:        SunsetChainId,
+				Status:            recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+				NativeTokenAmount: sdkmath.ZeroInt(),
+				StTokenAmount:     sdkmath.ZeroInt(),
+			},
+		},
+	})
+
+	deadline := uint64(blockTime.Add(time.Hour * 24 * 30).Unix())
+	msg := types.MsgSunsetHostZone{
+		Authority:          s.App.StakeibcKeeper.GetAuthority(),
+		ChainId:            SunsetChainId,
+		RedemptionDeadline: deadline,
+	}
+	_, err := s.GetMsgServer().SunsetHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when sunsetting host zone")
+
+	return HostZoneSunsetTestCase{
+		user:        user,
+		zoneAddress: zoneAddress,
+		deadline:    deadline,
+	}
+}
+
+// Processes the sunset and returns the updated sunset
+func (s *KeeperTestSuite) processHostZoneSunset() types.HostZoneSunset {
+	s.App.StakeibcKeeper.ProcessAllHostZoneSunsets(s.Ctx, SunsetEpochNumber)
+
+	sunset, found := s.App.StakeibcKeeper.GetHostZoneSunset(s.Ctx, SunsetChainId)
+	s.Require().True(found, "host zone sunset should have been found")
+	return sunset
+}
+
+// Processes the sunset up to the redemption stage
+func (s *KeeperTestSuite) setHostZoneSunsetRedeeming() {
+	sunset := s.processHostZoneSunset()
+	s.Require().Equal(types.HostZoneSunsetStatus_UNBONDING, sunset.Status, "status after unbonding")
+
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, SunsetEpochNumber, SunsetChainId)
+	s.Require().True(found, "sunset host zone unbonding should have been found")
+	hostZoneUnbonding.Status = recordtypes.HostZoneUnbonding_CLAIMABLE
+	updatedEpochUnbondingRecord, success := s.App.RecordsKeeper.AddHostZoneToEpochUnbondingRecord(s.Ctx, SunsetEpochNumber, SunsetChainId, hostZoneUnbonding)
+	s.Require().True(success, "updating host zone unbonding")
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, *updatedEpochUnbondingRecord)
+
+	sunset = s.processHostZoneSunset()
+	s.Require().Equal(types.HostZoneSunsetStatus_REDEEMING, sunset.Status, "status after redeeming")
+}
+
+func (s *KeeperTestSuite) TestSunsetHostZone_Successful() {
+	tc := s.SetupHostZoneSunset()
+
+	sunset, found := s.App.StakeibcKeeper.GetHostZoneSunset(s.Ctx, SunsetChainId)
+	s.Require().True(found, "host zone sunset should have been found")
+	s.Require().Equal(types.HostZoneSunset{
+		ChainId:             SunsetChainId,
+		Status:              types.HostZoneSunsetStatus_DRAINING_DEPOSITS,
+		RedemptionDeadline:  tc.deadline,
+		FinalRedemptionRate: sdk.ZeroDec(),
+		RedeemableAmount:    sdkmath.ZeroInt(),
+	}, sunset, "host zone sunset")
+}
+
+func (s *KeeperTestSuite) TestSunsetHostZone_InvalidAuthority() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: SunsetChainId})
+
+	msg := types.MsgSunsetHostZone{
+		Authority:          s.TestAccs[0].String(),
+		ChainId:            SunsetChainId,
+		RedemptionDeadline: uint64(s.Ctx.BlockTime().Unix() + 1),
+	}
+	_, err := s.GetMsgServer().SunsetHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "invalid authority")
+}
+
+func (s *KeeperTestSuite) TestSunsetHostZone_HostZoneNotFound() {
+	msg := types.MsgSunsetHostZone{
+		Authority:          s.App.StakeibcKeeper.GetAuthority(),
+		ChainId:            "fake_host_zone",
+		RedemptionDeadline: uint64(s.Ctx.BlockTime().Unix() + 1),
+	}
+	_, err := s.GetMsgServer().SunsetHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "host zone fake_host_zone not found")
+}
+
+func (s *KeeperTestSuite) TestSunsetHostZone_AlreadySunset() {
+	tc := s.SetupHostZoneSunset()
+
+	msg := types.MsgSunsetHostZone{
+		Authority:          s.App.StakeibcKeeper.GetAuthority(),
+		ChainId:            SunsetChainId,
+		RedemptionDeadline: tc.deadline,
+	}
+	_, err := s.GetMsgServer().SunsetHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "is already being sunset")
+}
+
+func (s *KeeperTestSuite) TestSunsetHostZone_DeadlinePassed() {
+	s.Ctx = s.Ctx.WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: SunsetChainId})
+
+	msg := types.MsgSunsetHostZone{
+		Authority:          s.App.StakeibcKeeper.GetAuthority(),
+		ChainId:            SunsetChainId,
+		RedemptionDeadline: uint64(s.Ctx.BlockTime().Unix()),
+	}
+	_, err := s.GetMsgServer().SunsetHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "must be after the current block time")
+}
+
+func (s *KeeperTestSuite) TestSunsetHostZone_LiquidStakeClosed() {
+	tc := s.SetupHostZoneSunset()
+
+	msg := types.MsgLiquidStake{
+		Creator:   tc.user.String(),
+		Amount:    sdkmath.NewInt(1_000),
+		HostDenom: Atom,
+	}
+	_, err := s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorIs(err, types.ErrHostZoneSunset)
+}
+
+func (s *KeeperTestSuite) TestProcessHostZoneSunset_PendingDeposits() {
+	s.SetupHostZoneSunset()
+
+	depositRecord, found := s.App.RecordsKeeper.GetDepositRecord(s.Ctx, 1)
+	s.Require().True(found, "deposit record should have been found")
+	depositRecord.Amount = sdkmath.NewInt(100)
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, depositRecord)
+
+	// The sunset should wait until the deposit has been delegated
+	sunset := s.processHostZoneSunset()
+	s.Require().Equal(types.HostZoneSunsetStatus_DRAINING_DEPOSITS, sunset.Status, "status")
+}
+
+func (s *KeeperTestSuite) TestProcessHostZoneSunset_BeginUnbonding() {
+	s.SetupHostZoneSunset()
+
+	sunset := s.processHostZoneSunset()
+	s.Require().Equal(types.HostZoneSunsetStatus_UNBONDING, sunset.Status, "status")
+	s.Require().Equal(SunsetEpochNumber, sunset.UnbondingEpochNumber, "unbonding epoch number")
+	s.Require().Equal(sdk.MustNewDecFromStr("1.25"), sunset.FinalRedemptionRate, "final redemption rate")
+	s.Require().Equal(sdkmath.NewInt(10_000), sunset.RedeemableAmount, "redeemable amount")
+
+	// The staked balance, minus the pending redemption, should be added to the current epoch's unbonding
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, SunsetEpochNumber, SunsetChainId)
+	s.Require().True(found, "sunset host zone unbonding should have been found")
+	s.Require().Equal(sdkmath.NewInt(10_000), hostZoneUnbonding.NativeTokenAmount, "sunset unbonding amount")
+	s.Require().Equal(sdkmath.ZeroInt(), hostZoneUnbonding.StTokenAmount, "sunset unbonding st amount")
+}
+
+func (s *KeeperTestSuite) TestProcessHostZoneSunset_BeginUnbonding_RedemptionInProgress() {
+	s.SetupHostZoneSunset()
+
+	// Mark the pending redemption as unbonding, and reduce the staked balance
+	// the same way the undelegation callback would have
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, SunsetRedemptionEpoch, SunsetChainId)
+	s.Require().True(found, "pending host zone unbonding should have been found")
+	hostZoneUnbonding.Status = recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS
+	updatedEpochUnbondingRecord, success := s.App.RecordsKeeper.AddHostZoneToEpochUnbondingRecord(s.Ctx, SunsetRedemptionEpoch, SunsetChainId, hostZoneUnbonding)
+	s.Require().True(success, "updating host zone unbonding")
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, *updatedEpochUnbondingRecord)
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, SunsetChainId)
+	s.Require().True(found, "host zone should have been found")
+	hostZone.StakedBal = sdkmath.NewInt(10_000)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// The in progress redemption has already been removed from the staked balance,
+	// so the full remaining staked balance should be unbonded
+	sunset := s.processHostZoneSunset()
+	s.Require().Equal(types.HostZoneSunsetStatus_UNBONDING, sunset.Status, "status")
+	s.Require().Equal(sdk.MustNewDecFromStr("1.25"), sunset.FinalRedemptionRate, "final redemption rate")
+	s.Require().Equal(sdkmath.NewInt(10_000), sunset.RedeemableAmount, "redeemable amount")
+
+	hostZoneUnbonding, found = s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, SunsetEpochNumber, SunsetChainId)
+	s.Require().True(found, "sunset host zone unbonding should have been found")
+	s.Require().Equal(sdkmath.NewInt(10_000), hostZoneUnbonding.NativeTokenAmount, "sunset unbonding amount")
+}
+
+func (s *KeeperTestSuite) TestProcessHostZoneSunset_NothingToUnbond() {
+	s.SetupHostZoneSunset()
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, SunsetChainId)
+	s.Require().True(found, "host zone should have been found")
+	hostZone.StakedBal = sdkmath.NewInt(2_000)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// With nothing left to unbond, the sunset should move straight to redemptions
+	sunset := s.processHostZoneSunset()
+	s.Require().Equal(types.HostZoneSunsetStatus_REDEEMING, sunset.Status, "status")
+	s.Require().Equal(sdkmath.ZeroInt(), sunset.RedeemableAmount, "redeemable amount")
+}
+
+func (s *KeeperTestSuite) TestProcessHostZoneSunset_WaitForUnbonding() {
+	s.SetupHostZoneSunset()
+
+	sunset := s.processHostZoneSunset()
+	s.Require().Equal(types.HostZoneSunsetStatus_UNBONDING, sunset.Status, "status after first epoch")
+
+	// The sunset should stay in UNBONDING until the unbonding is claimable
+	sunset = s.processHostZoneSunset()
+	s.Require().Equal(types.HostZoneSunsetStatus_UNBONDING, sunset.Status, "status after second epoch")
+
+	s.setHostZoneSunsetRedeeming()
+}
+
+func (s *KeeperTestSuite) TestProcessHostZoneSunset_WaitForRedemptions() {
+	s.SetupHostZoneSunset()
+	s.setHostZoneSunsetRedeeming()
+
+	// stTokens are still circulating, so the host zone should not be removed
+	sunset := s.processHostZoneSunset()
+	s.Require().Equal(types.HostZoneSunsetStatus_REDEEMING, sunset.Status, "status")
+
+	_, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, SunsetChainId)
+	s.Require().True(found, "host zone should not have been removed")
+}
+
+func (s *KeeperTestSuite) TestProcessHostZoneSunset_DeadlinePassed() {
+	tc := s.SetupHostZoneSunset()
+	s.setHostZoneSunsetRedeeming()
+
+	// Redeem some of the stTokens before the deadline
+	receiver := "cosmos1g6qdx6kdhpf000afvvpte7hp0vnpzapuyxp8uf"
+	redeemMsg := types.MsgRedeemStake{
+		Creator:  tc.user.String(),
+		Amount:   sdkmath.NewInt(1_000),
+		HostZone: SunsetChainId,
+		Receiver: receiver,
+	}
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &redeemMsg)
+	s.Require().NoError(err, "no error expected when redeeming before the deadline")
+
+	// Once the deadline has passed, new redemptions should be rejected
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(int64(tc.deadline), 0))
+	_, err = s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &redeemMsg)
+	s.Require().ErrorContains(err, "redemption deadline")
+
+	// The sunset should not complete while there are unclaimed redemptions, both from before the
+	// sunset and from the sunset itself, and their records should be kept so they can still be claimed
+	sunset := s.processHostZoneSunset()
+	s.Require().Equal(types.HostZoneSunsetStatus_REDEEMING, sunset.Status, "status with unclaimed redemptions")
+
+	_, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, SunsetChainId)
+	s.Require().True(found, "host zone should not have been removed")
+
+	pendingRedemptionId := recordtypes.UserRedemptionRecordKeyFormatter(SunsetChainId, SunsetRedemptionEpoch, "sender")
+	sunsetRedemptionId := recordtypes.UserRedemptionRecordKeyFormatter(SunsetChainId, SunsetEpochNumber, tc.user.String())
+	for _, redemptionId := range []string{pendingRedemptionId, sunsetRedemptionId} {
+		_, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, redemptionId)
+		s.Require().True(found, "user redemption record %s should not have been removed", redemptionId)
+	}
+	_, found = s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, SunsetEpochNumber, SunsetChainId)
+	s.Require().True(found, "sunset host zone unbonding should not have been removed")
+
+	// Claim the redemptions - the host zone should then be removed, even though stTokens are still circulating
+	for _, redemptionId := range []string{pendingRedemptionId, sunsetRedemptionId} {
+		s.App.RecordsKeeper.RemoveUserRedemptionRecord(s.Ctx, redemptionId)
+	}
+
+	sunset = s.processHostZoneSunset()
+	s.Require().Equal(types.HostZoneSunsetStatus_COMPLETE, sunset.Status, "status after claims")
+
+	// The host zone and all of its records should be removed
+	_, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx, SunsetChainId)
+	s.Require().False(found, "host zone should have been removed")
+
+	depositRecords := s.App.RecordsKeeper.GetAllDepositRecord(s.Ctx)
+	s.Require().Len(depositRecords, 1, "number of deposit records")
+	s.Require().Equal(OtherChainId, depositRecords[0].HostZoneId, "remaining deposit record")
+
+	userRedemptionRecords := s.App.RecordsKeeper.GetAllUserRedemptionRecord(s.Ctx)
+	s.Require().Len(userRedemptionRecords, 1, "number of user redemption records")
+	s.Require().Equal(OtherChainId, userRedemptionRecords[0].HostZoneId, "remaining user redemption record")
+
+	// The epoch 2 record only had the sunset host zone, so it should be removed entirely
+	epochUnbondingRecords := s.App.RecordsKeeper.GetAllEpochUnbondingRecord(s.Ctx)
+	s.Require().Len(epochUnbondingRecords, 1, "number of epoch unbonding records")
+	s.Require().Equal(SunsetRedemptionEpoch, epochUnbondingRecords[0].EpochNumber, "remaining epoch unbonding record")
+	s.Require().Len(epochUnbondingRecords[0].HostZoneUnbondings, 1, "number of remaining host zone unbondings")
+	s.Require().Equal(OtherChainId, epochUnbondingRecords[0].HostZoneUnbondings[0].HostZoneId, "remaining host zone unbonding")
+
+	// Once complete, the sunset should no longer be processed
+	sunset = s.processHostZoneSunset()
+	s.Require().Equal(types.HostZoneSunsetStatus_COMPLETE, sunset.Status, "status after completion")
+}
+
+func (s *KeeperTestSuite) TestProcessHostZoneSunset_FullyRedeemed() {
+	tc := s.SetupHostZoneSunset()
+	s.setHostZoneSunsetRedeeming()
+
+	// Redeem the remaining stTokens, burn the escrowed tokens, and remove the redemption records
+	// as if they had all been claimed
+	redeemMsg := types.MsgRedeemStake{
+		Creator:  tc.user.String(),
+		Amount:   sdkmath.NewInt(8_000),
+		HostZone: SunsetChainId,
+		Receiver: "cosmos1g6qdx6kdhpf000afvvpte7hp0vnpzapuyxp8uf",
+	}
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &redeemMsg)
+	s.Require().NoError(err, "no error expected when redeeming")
+
+	escrowedStTokens := sdk.NewCoins(sdk.NewInt64Coin(StAtom, 2_000))
+	err = s.App.BankKeeper.SendCoinsFromAccountToModule(s.Ctx, tc.zoneAddress, types.ModuleName, escrowedStTokens)
+	s.Require().NoError(err, "no error expected when sending escrowed stTokens")
+	err = s.App.BankKeeper.BurnCoins(s.Ctx, types.ModuleName, escrowedStTokens)
+	s.Require().NoError(err, "no error expected when burning escrowed stTokens")
+
+	// The host zone should not be removed until the redemptions have been claimed
+	sunset := s.processHostZoneSunset()
+	s.Require().Equal(types.HostZoneSunsetStatus_REDEEMING, sunset.Status, "status before claims")
+
+	for _, record := range s.App.RecordsKeeper.GetAllUserRedemptionRecord(s.Ctx) {
+		if record.HostZoneId == SunsetChainId {
+			s.App.RecordsKeeper.RemoveUserRedemptionRecord(s.Ctx, record.Id)
+		}
+	}
+
+	sunset = s.processHostZoneSunset()
+	s.Require().Equal(types.HostZoneSunsetStatus_COMPLETE, sunset.Status, "status after claims")
+
+	_, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, SunsetChainId)
+	s.Require().False(found, "host zone should have been removed")
+}
+
+func (s *KeeperTestSuite) TestRemoveSunsetHostZone() {
+	s.Ctx = s.Ctx.WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	zoneAddress := types.NewZoneAddress(SunsetChainId).String()
+	feeAddress := "cosmos_FEE"
+	delegationAddress := "cosmos_DELEGATION"
+	rewardCollectorAddress := s.App.AccountKeeper.GetModuleAccount(s.Ctx, types.RewardCollectorName).GetAddress().String()
+
+	hostZone := types.HostZone{
+		ChainId:           SunsetChainId,
+		HostDenom:         Atom,
+		Address:           zoneAddress,
+		FeeAccount:        &types.ICAAccount{Address: feeAddress, Target: types.ICAAccountType_FEE},
+		DelegationAccount: &types.ICAAccount{Address: delegationAddress, Target: types.ICAAccountType_DELEGATION},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: OtherChainId, HostDenom: Osmo})
+
+	// Store state for both the sunset host zone and another host zone that should be untouched
+	for _, chain := range []struct {
+		chainId string
+		stDenom string
+	}{
+		{chainId: SunsetChainId, stDenom: StAtom},
+		{chainId: OtherChainId, stDenom: StOsmo},
+	} {
+		s.App.StakeibcKeeper.SetRedemptionRateRecord(s.Ctx, types.RedemptionRateRecord{ChainId: chain.chainId, EpochNumber: 1})
+		s.App.StakeibcKeeper.SetRedelegationTracker(s.Ctx, types.RedelegationTracker{
+			ChainId: chain.chainId, SrcValidator: "val1", DstValidator: "val2",
+		})
+
+		// A periodic query submits a pending query when it's registered
+		err := s.App.InterchainqueryKeeper.RegisterPeriodicQuery(s.Ctx, types.ModuleName, stakeibckeeper.ICQCallbackID_WithdrawalBalance,
+			chain.chainId, "connection-0", icqtypes.BANK_STORE_QUERY_WITH_PROOF, []byte(chain.chainId), "", 1,
+			uint64(time.Hour.Nanoseconds()), icqtypes.TimeoutPolicy_REJECT_QUERY_RESPONSE)
+		s.Require().NoError(err, "no error expected when registering periodic query for %s", chain.chainId)
+		s.App.InterchainqueryKeeper.AddFailedQuery(s.Ctx, icqtypes.Query{Id: chain.chainId, ChainId: chain.chainId}, "error")
+
+		for _, channelId := range []string{"channel-0", ratelimittypes.AnyChannelId} {
+			s.App.RatelimitKeeper.SetRateLimit(s.Ctx, ratelimittypes.RateLimit{
+				Path: &ratelimittypes.Path{Denom: chain.stDenom, ChannelId: channelId},
+			})
+			s.App.RatelimitKeeper.SetAddressFlow(s.Ctx, ratelimittypes.NewAddressFlow(chain.stDenom, channelId, "sender"))
+		}
+		s.App.RatelimitKeeper.AddDenomToBlacklist(s.Ctx, chain.stDenom)
+	}
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, ratelimittypes.WhitelistedAddressPair{
+		Sender: feeAddress, Receiver: rewardCollectorAddress,
+	})
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, ratelimittypes.WhitelistedAddressPair{
+		Sender: zoneAddress, Receiver: delegationAddress,
+	})
+	s.App.RatelimitKeeper.SetWhitelistedAddressPair(s.Ctx, ratelimittypes.WhitelistedAddressPair{
+		Sender: "other_sender", Receiver: "other_receiver",
+	})
+
+	s.App.StakeibcKeeper.RemoveSunsetHostZone(s.Ctx, hostZone)
+
+	// Nothing should remain for the sunset host zone
+	_, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, SunsetChainId)
+	s.Require().False(found, "host zone should have been removed")
+
+	redemptionRateRecords := s.App.StakeibcKeeper.GetAllRedemptionRateRecords(s.Ctx)
+	s.Require().Len(redemptionRateRecords, 1, "number of redemption rate records")
+	s.Require().Equal(OtherChainId, redemptionRateRecords[0].ChainId, "remaining redemption rate record")
+
+	redelegationTrackers := s.App.StakeibcKeeper.GetAllRedelegationTrackers(s.Ctx)
+	s.Require().Len(redelegationTrackers, 1, "number of redelegation trackers")
+	s.Require().Equal(OtherChainId, redelegationTrackers[0].ChainId, "remaining redelegation tracker")
+
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "number of pending queries")
+	s.Require().Equal(OtherChainId, queries[0].ChainId, "remaining pending query")
+
+	periodicQueries := s.App.InterchainqueryKeeper.AllPeriodicQueries(s.Ctx)
+	s.Require().Len(periodicQueries, 1, "number of periodic queries")
+	s.Require().Equal(OtherChainId, periodicQueries[0].ChainId, "remaining periodic query")
+
+	failedQueries := s.App.InterchainqueryKeeper.AllFailedQueries(s.Ctx)
+	s.Require().Len(failedQueries, 1, "number of failed queries")
+	s.Require().Equal(OtherChainId, failedQueries[0].Query.ChainId, "remaining failed query")
+
+	for _, rateLimit := range s.App.RatelimitKeeper.GetAllRateLimits(s.Ctx) {
+		s.Require().Equal(StOsmo, rateLimit.Path.Denom, "remaining rate limit denom")
+	}
+	s.Require().Len(s.App.RatelimitKeeper.GetAllRateLimits(s.Ctx), 2, "number of rate limits")
+	for _, addressFlow := range s.App.RatelimitKeeper.GetAllAddressFlows(s.Ctx) {
+		s.Require().Equal(StOsmo, addressFlow.Denom, "remaining address flow denom")
+	}
+	s.Require().Len(s.App.RatelimitKeeper.GetAllAddressFlows(s.Ctx), 2, "number of address flows")
+
+	s.Require().Equal([]string{StOsmo}, s.App.RatelimitKeeper.GetAllBlacklistedDenoms(s.Ctx), "blacklisted denoms")
+
+	whitelist := s.App.RatelimitKeeper.GetAllWhitelistedAddressPairs(s.Ctx)
+	s.Require().Len(whitelist, 1, "number of whitelisted address pairs")
+	s.Require().Equal("other_sender", whitelist[0].Sender, "remaining whitelisted address pair")
+}
+
+func (s *KeeperTestSuite) TestRedeemStakeFromSunsetHostZone_Successful() {
+	tc := s.SetupHostZoneSunset()
+	s.processHostZoneSunset()
+
+	receiver := "cosmos1g6qdx6kdhpf000afvvpte7hp0vnpzapuyxp8uf"
+	redeemMsg := types.MsgRedeemStake{
+		Creator:  tc.user.String(),
+		Amount:   sdkmath.NewInt(1_000),
+		HostZone: SunsetChainId,
+		Receiver: receiver,
+	}
+
+	// Redeem twice to confirm the second redemption tops up the first
+	for i := 0; i < 2; i++ {
+		_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &redeemMsg)
+		s.Require().NoError(err, "no error expected when redeeming %d", i)
+	}
+
+	// The stTokens should be burned immediately
+	userBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.user, StAtom)
+	s.Require().Equal(int64(6_000), userBalance.Amount.Int64(), "user stToken balance")
+	stSupply := s.App.BankKeeper.GetSupply(s.Ctx, StAtom)
+	s.Require().Equal(int64(8_000), stSupply.Amount.Int64(), "stToken supply")
+
+	// The redemption should be recorded on the sunset unbonding at the final redemption rate
+	redemptionId := recordtypes.UserRedemptionRecordKeyFormatter(SunsetChainId, SunsetEpochNumber, tc.user.String())
+	userRedemptionRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, redemptionId)
+	s.Require().True(found, "user redemption record should have been found")
+	s.Require().Equal(sdkmath.NewInt(2_500), userRedemptionRecord.Amount, "redemption amount")
+	s.Require().Equal(receiver, userRedemptionRecord.Receiver, "redemption receiver")
+	s.Require().Equal(SunsetEpochNumber, userRedemptionRecord.EpochNumber, "redemption epoch number")
+
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, SunsetEpochNumber, SunsetChainId)
+	s.Require().True(found, "sunset host zone unbonding should have been found")
+	s.Require().Equal([]string{redemptionId}, hostZoneUnbonding.UserRedemptionRecords, "host zone unbonding redemptions")
+	s.Require().Equal(sdkmath.NewInt(10_000), hostZoneUnbonding.NativeTokenAmount, "host zone unbonding amount")
+
+	sunset, found := s.App.StakeibcKeeper.GetHostZoneSunset(s.Ctx, SunsetChainId)
+	s.Require().True(found, "host zone sunset should have been found")
+	s.Require().Equal(sdkmath.NewInt(7_500), sunset.RedeemableAmount, "remaining redeemable amount")
+
+	// The query should reflect the remaining supply
+	res, err := s.App.StakeibcKeeper.HostZoneSunset(sdk.WrapSDKContext(s.Ctx), &types.QueryHostZoneSunsetRequest{ChainId: SunsetChainId})
+	s.Require().NoError(err, "no error expected when querying host zone sunset")
+	s.Require().Equal(sunset, res.HostZoneSunset, "queried host zone sunset")
+	s.Require().Equal(sdkmath.NewInt(6_000), res.RemainingStTokenSupply, "queried remaining stToken supply")
+}
+
+func (s *KeeperTestSuite) TestRedeemStakeFromSunsetHostZone_ExceedsRedeemableAmount() {
+	tc := s.SetupHostZoneSunset()
+	s.processHostZoneSunset()
+
+	// Lower the redeemable amount below the user's balance
+	sunset, found := s.App.StakeibcKeeper.GetHostZoneSunset(s.Ctx, SunsetChainId)
+	s.Require().True(found, "host zone sunset should have been found")
+	sunset.RedeemableAmount = sdkmath.NewInt(1_000)
+	s.App.StakeibcKeeper.SetHostZoneSunset(s.Ctx, sunset)
+
+	redeemMsg := types.MsgRedeemStake{
+		Creator:  tc.user.String(),
+		Amount:   sdkmath.NewInt(1_000),
+		HostZone: SunsetChainId,
+		Receiver: "cosmos1g6qdx6kdhpf000afvvpte7hp0vnpzapuyxp8uf",
+	}
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &redeemMsg)
+	s.Require().ErrorContains(err, "only 1000uatom remains redeemable")
+}
+
+func (s *KeeperTestSuite) TestRedeemStakeFromSunsetHostZone_DifferentReceiver() {
+	tc := s.SetupHostZoneSunset()
+	s.processHostZoneSunset()
+
+	redeemMsg := types.MsgRedeemStake{
+		Creator:  tc.user.String(),
+		Amount:   sdkmath.NewInt(1_000),
+		HostZone: SunsetChainId,
+		Receiver: "cosmos1g6qdx6kdhpf000afvvpte7hp0vnpzapuyxp8uf",
+	}
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &redeemMsg)
+	s.Require().NoError(err, "no error expected when redeeming")
+
+	redeemMsg.Receiver = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+	_, err = s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &redeemMsg)
+	s.Require().ErrorContains(err, "user already redeemed from the sunset to a different receiver")
+}
+
+func (s *KeeperTestSuite) TestQueryHostZoneSunset_NotFound() {
+	_, err := s.App.StakeibcKeeper.HostZoneSunset(sdk.WrapSDKContext(s.Ctx), &types.QueryHostZoneSunsetRequest{ChainId: SunsetChainId})
+	s.Require().ErrorContains(err, "host zone sunset not found")
+}
@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	recordtypes "github.com/Stride-Labs/stride/v10/x/records/types"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// The ICA accounts that are automatically restored if their channel closes
+var RestorableICAAccountTypes = []types.ICAAccountType{
+	types.ICAAccountType_DELEGATION,
+	types.ICAAccountType_FEE,
+	types.ICAAccountType_WITHDRAWAL,
+	types.ICAAccountType_REDEMPTION,
+}
+
+// Re-registers an existing ICA account (opening a new channel on the same port)
+// and reverts any records that were stuck waiting on the closed channel
+func (k Keeper) RestoreICAChannel(ctx sdk.Context, hostZone types.HostZone, accountType types.ICAAccountType) error {
+	// Get ConnectionEnd (for counterparty connection)
+	connectionEnd, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, hostZone.ConnectionId)
+	if !found {
+		errMsg := fmt.Sprintf("invalid connection id from host %s, %s not found", hostZone.ChainId, hostZone.ConnectionId)
+		k.Logger(ctx).Error(errMsg)
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, errMsg)
+	}
+	counterpartyConnection := connectionEnd.Counterparty
+
+	// only allow restoring an account if it already exists
+	owner := types.FormatICAAccountOwner(hostZone.ChainId, accountType)
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		errMsg := fmt.Sprintf("could not create portID for ICA controller account address: %s", owner)
+		k.Logger(ctx).Error(errMsg)
+		return err
+	}
+	_, exists := k.ICAControllerKeeper.GetInterchainAccountAddress(ctx, hostZone.ConnectionId, portID)
+	if !exists {
+		errMsg := fmt.Sprintf("ICA controller account address not found: %s", owner)
+		k.Logger(ctx).Error(errMsg)
+		return errorsmod.Wrapf(types.ErrInvalidInterchainAccountAddress, errMsg)
+	}
+
+	appVersion := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: hostZone.ConnectionId,
+		HostConnectionId:       counterpartyConnection.ConnectionId,
+		Encoding:               icatypes.EncodingProtobuf,
+		TxType:                 icatypes.TxTypeSDKMultiMsg,
+	}))
+
+	if err := k.ICAControllerKeeper.RegisterInterchainAccount(ctx, hostZone.ConnectionId, owner, appVersion); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("unable to register %s account : %s", accountType.String(), err))
+		return err
+	}
+
+	return k.RevertInProgressRecords(ctx, hostZone, accountType)
+}
+
+// Reverts records that are stuck in an IN_PROGRESS state because the delegation ICA channel they were waiting on closed
+// DELEGATION_IN_PROGRESS deposit records, and UNBONDING_IN_PROGRESS and EXIT_TRANSFER_IN_PROGRESS
+// host zone unbondings are reverted to their respective QUEUE state
+func (k Keeper) RevertInProgressRecords(ctx sdk.Context, hostZone types.HostZone, accountType types.ICAAccountType) error {
+	// Only the delegation account has associated records
+	if accountType != types.ICAAccountType_DELEGATION {
+		return nil
+	}
+
+	// revert DELEGATION_IN_PROGRESS records for the closed ICA channel (so that they can be staked)
+	depositRecords := k.RecordsKeeper.GetAllDepositRecord(ctx)
+	for _, depositRecord := range depositRecords {
+		// only revert records for the select host zone
+		if depositRecord.HostZoneId == hostZone.ChainId && depositRecord.Status == recordtypes.DepositRecord_DELEGATION_IN_PROGRESS {
+			depositRecord.Status = recordtypes.DepositRecord_DELEGATION_QUEUE
+			k.Logger(ctx).Info(fmt.Sprintf("Setting DepositRecord %d to status DepositRecord_DELEGATION_QUEUE", depositRecord.Id))
+			k.RecordsKeeper.SetDepositRecord(ctx, depositRecord)
+		}
+	}
+
+	// revert epoch unbonding records for the closed ICA channel
+	epochUnbondingRecords := k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx)
+	epochNumberForPendingUnbondingRecords := []uint64{}
+	epochNumberForPendingTransferRecords := []uint64{}
+	for _, epochUnbondingRecord := range epochUnbondingRecords {
+		// only revert records for the select host zone
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, hostZone.ChainId)
+		if !found {
+			k.Logger(ctx).Info(fmt.Sprintf("No HostZoneUnbonding found for chainId: %s, epoch: %d", hostZone.ChainId, epochUnbondingRecord.EpochNumber))
+			continue
+		}
+
+		// Revert UNBONDING_IN_PROGRESS and EXIT_TRANSFER_IN_PROGRESS records
+		if hostZoneUnbonding.Status == recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS {
+			k.Logger(ctx).Info(fmt.Sprintf("HostZoneUnbonding for %s at EpochNumber %d is stuck in status %s",
+				hostZone.ChainId, epochUnbondingRecord.EpochNumber, recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS.String(),
+			))
+			epochNumberForPendingUnbondingRecords = append(epochNumberForPendingUnbondingRecords, epochUnbondingRecord.EpochNumber)
+
+		} else if hostZoneUnbonding.Status == recordtypes.HostZoneUnbonding_EXIT_TRANSFER_IN_PROGRESS {
+			k.Logger(ctx).Info(fmt.Sprintf("HostZoneUnbonding for %s at EpochNumber %d to in status %s",
+				hostZone.ChainId, epochUnbondingRecord.EpochNumber, recordtypes.HostZoneUnbonding_EXIT_TRANSFER_IN_PROGRESS.String(),
+			))
+			epochNumberForPendingTransferRecords = append(epochNumberForPendingTransferRecords, epochUnbondingRecord.EpochNumber)
+		}
+	}
+	// Revert UNBONDING_IN_PROGRESS records to UNBONDING_QUEUE
+	err := k.RecordsKeeper.SetHostZoneUnbondings(ctx, hostZone.ChainId, epochNumberForPendingUnbondingRecords, recordtypes.HostZoneUnbonding_UNBONDING_QUEUE)
+	if err != nil {
+		errMsg := fmt.Sprintf("unable to update host zone unbonding record status to %s for chainId: %s and epochUnbondingRecordIds: %v, err: %s",
+			recordtypes.HostZoneUnbonding_UNBONDING_QUEUE.String(), hostZone.ChainId, epochNumberForPendingUnbondingRecords, err)
+		k.Logger(ctx).Error(errMsg)
+		return err
+	}
+
+	// Revert EXIT_TRANSFER_IN_PROGRESS records to EXIT_TRANSFER_QUEUE
+	err = k.RecordsKeeper.SetHostZoneUnbondings(ctx, hostZone.ChainId, epochNumberForPendingTransferRecords, recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE)
+	if err != nil {
+		errMsg := fmt.Sprintf("unable to update host zone unbonding record status to %s for chainId: %s and epochUnbondingRecordIds: %v, err: %s",
+			recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE.String(), hostZone.ChainId, epochNumberForPendingTransferRecords, err)
+		k.Logger(ctx).Error(errMsg)
+		return err
+	}
+
+	return nil
+}
+
+// Resets the pending claims on a host zone's user redemption records so that the claims can be retried
+// This is only used when the redemption ICA channel is restored automatically, since the claim
+// packets were lost with the closed channel
+func (k Keeper) ResetPendingClaims(ctx sdk.Context, hostZone types.HostZone) {
+	for _, userRedemptionRecord := range k.RecordsKeeper.GetAllUserRedemptionRecord(ctx) {
+		if userRedemptionRecord.HostZoneId == hostZone.ChainId && userRedemptionRecord.ClaimIsPending {
+			userRedemptionRecord.ClaimIsPending = false
+			k.Logger(ctx).Info(fmt.Sprintf("Resetting pending claim for UserRedemptionRecord %s", userRedemptionRecord.Id))
+			k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+		}
+	}
+}
+
+// Checks whether a new channel handshake has already been started on the given port
+// (i.e. the account was re-registered, but the new channel has not yet been opened)
+func (k Keeper) isICAChannelHandshakeInProgress(ctx sdk.Context, portID string) bool {
+	for _, channel := range k.IBCKeeper.ChannelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
+		if channel.PortId == portID && (channel.State == channeltypes.INIT || channel.State == channeltypes.TRYOPEN) {
+			return true
+		}
+	}
+	return false
+}
+
+// Checks each host zone for ICA accounts whose channel was closed (e.g. from a packet timeout on the ordered channel)
+// and re-registers the account so that a new channel is opened
+// Records that were stuck waiting on the closed channel are reverted so they can be retried
+func (k Keeper) RestoreClosedICAChannels(ctx sdk.Context) {
+	for _, hostZone := range k.GetAllHostZone(ctx) {
+		for _, accountType := range RestorableICAAccountTypes {
+			owner := types.FormatICAAccountOwner(hostZone.ChainId, accountType)
+			portID, err := icatypes.NewControllerPortID(owner)
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("could not create portID for ICA controller account address: %s", owner))
+				continue
+			}
+
+			// Only restore accounts that were registered and whose active channel has since closed
+			if !k.ICAControllerKeeper.IsActiveChannelClosed(ctx, hostZone.ConnectionId, portID) {
+				continue
+			}
+			// Skip the account if it was already re-registered and the new channel is still opening
+			if k.isICAChannelHandshakeInProgress(ctx, portID) {
+				continue
+			}
+			closedChannelId, _ := k.ICAControllerKeeper.GetActiveChannelID(ctx, hostZone.ConnectionId, portID)
+
+			k.Logger(ctx).Info(fmt.Sprintf("Restoring closed ICA channel %s for %s", closedChannelId, owner))
+
+			// Restore in a cached context so that a failure does not leave records partially reverted
+			cacheCtx, writeCache := ctx.CacheContext()
+			if err := k.RestoreICAChannel(cacheCtx, hostZone, accountType); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Unable to restore ICA channel %s for %s, err: %s", closedChannelId, owner, err.Error()))
+				continue
+			}
+			if accountType == types.ICAAccountType_REDEMPTION {
+				k.ResetPendingClaims(cacheCtx, hostZone)
+			}
+			writeCache()
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeRestoreICAChannel,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+					sdk.NewAttribute(types.AttributeKeyICAAccountType, accountType.String()),
+					sdk.NewAttribute(types.AttributeKeyClosedChannelId, closedChannelId),
+				),
+			)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	recordtypes "github.com/Stride-Labs/stride/v10/x/records/types"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// Helper function to close an ICA channel, simulating a packet timeout on the ordered channel
+func (s *KeeperTestSuite) closeICAChannel(portID, channelID string) {
+	channel, found := s.App.IBCKeeper.ChannelKeeper.GetChannel(s.Ctx, portID, channelID)
+	s.Require().True(found, "channel %s found", channelID)
+	channel.State = channeltypes.CLOSED
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, portID, channelID, channel)
+}
+
+// Helper function to count the number of channels on a port in the given state
+func (s *KeeperTestSuite) countChannels(portID string, state channeltypes.State) int {
+	count := 0
+	for _, channel := range s.App.IBCKeeper.ChannelKeeper.GetAllChannels(s.Ctx) {
+		if channel.PortId == portID && channel.State == state {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *KeeperTestSuite) TestRestoreClosedICAChannels() {
+	tc := s.SetupRestoreInterchainAccount()
+
+	delegationOwner := types.FormatICAAccountOwner(HostChainId, types.ICAAccountType_DELEGATION)
+	redemptionOwner := types.FormatICAAccountOwner(HostChainId, types.ICAAccountType_REDEMPTION)
+	withdrawalOwner := types.FormatICAAccountOwner(HostChainId, types.ICAAccountType_WITHDRAWAL)
+	delegationPort := icatypes.ControllerPortPrefix + delegationOwner
+	redemptionPort := icatypes.ControllerPortPrefix + redemptionOwner
+	withdrawalPort := icatypes.ControllerPortPrefix + withdrawalOwner
+
+	delegationChannel := s.CreateICAChannel(delegationOwner)
+	redemptionChannel := s.CreateICAChannel(redemptionOwner)
+	s.CreateICAChannel(withdrawalOwner)
+
+	// Store a pending claim on the host zone, and one on a different host zone
+	userRedemptionRecords := []recordtypes.UserRedemptionRecord{
+		{Id: "record-0", HostZoneId: HostChainId, ClaimIsPending: true},
+		{Id: "record-1", HostZoneId: "different_host_zone", ClaimIsPending: true},
+	}
+	for _, userRedemptionRecord := range userRedemptionRecords {
+		s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, userRedemptionRecord)
+	}
+
+	// Close the delegation and redemption channels, the withdrawal channel stays open
+	s.closeICAChannel(delegationPort, delegationChannel)
+	s.closeICAChannel(redemptionPort, redemptionChannel)
+
+	s.App.StakeibcKeeper.RestoreClosedICAChannels(s.Ctx)
+
+	// A new channel should be opening for each closed account
+	s.Require().Equal(1, s.countChannels(delegationPort, channeltypes.INIT), "delegation channel restored")
+	s.Require().Equal(1, s.countChannels(redemptionPort, channeltypes.INIT), "redemption channel restored")
+	s.Require().Equal(0, s.countChannels(withdrawalPort, channeltypes.INIT), "withdrawal channel not restored")
+
+	// The delegation records and pending claim should be reverted
+	s.VerifyDepositRecordsStatus(tc.depositRecordStatusUpdates, true)
+	s.VerifyHostZoneUnbondingStatus(tc.unbondingRecordStatusUpdate, true)
+
+	restoredRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, "record-0")
+	s.Require().True(found)
+	s.Require().False(restoredRecord.ClaimIsPending, "claim should no longer be pending")
+
+	otherHostRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, "record-1")
+	s.Require().True(found)
+	s.Require().True(otherHostRecord.ClaimIsPending, "claim on other host should still be pending")
+
+	// An event should be emitted for each restored channel
+	restoredAccountTypes := []string{}
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != types.EventTypeRestoreICAChannel {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if attribute.Key == types.AttributeKeyICAAccountType {
+				restoredAccountTypes = append(restoredAccountTypes, attribute.Value)
+			}
+		}
+	}
+	s.Require().ElementsMatch([]string{
+		types.ICAAccountType_DELEGATION.String(),
+		types.ICAAccountType_REDEMPTION.String(),
+	}, restoredAccountTypes, "restore events")
+
+	// Running again before the handshake completes should not open another channel
+	s.App.StakeibcKeeper.RestoreClosedICAChannels(s.Ctx)
+	s.Require().Equal(1, s.countChannels(delegationPort, channeltypes.INIT), "delegation channel only restored once")
+	s.Require().Equal(1, s.countChannels(redemptionPort, channeltypes.INIT), "redemption channel only restored once")
+}
+
+func (s *KeeperTestSuite) TestRestoreClosedICAChannels_NoClosedChannels() {
+	tc := s.SetupRestoreInterchainAccount()
+
+	delegationOwner := types.FormatICAAccountOwner(HostChainId, types.ICAAccountType_DELEGATION)
+	s.CreateICAChannel(delegationOwner)
+
+	channelsBefore := s.App.IBCKeeper.ChannelKeeper.GetAllChannels(s.Ctx)
+
+	s.App.StakeibcKeeper.RestoreClosedICAChannels(s.Ctx)
+
+	// No new channels should be created and the records should be untouched
+	channelsAfter := s.App.IBCKeeper.ChannelKeeper.GetAllChannels(s.Ctx)
+	s.Require().Equal(len(channelsBefore), len(channelsAfter), "no channels restored")
+
+	s.VerifyDepositRecordsStatus(tc.depositRecordStatusUpdates, false)
+	s.VerifyHostZoneUnbondingStatus(tc.unbondingRecordStatusUpdate, false)
+}
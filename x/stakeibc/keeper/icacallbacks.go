@@ -10,6 +10,7 @@ import (
 const (
 	ICACallbackID_Delegate   = "delegate"
 	ICACallbackID_Claim      = "claim"
+	ICACallbackID_AutoClaim  = "auto_claim"
 	ICACallbackID_Undelegate = "undelegate"
 	ICACallbackID_Reinvest   = "reinvest"
 	ICACallbackID_Redemption = "redemption"
@@ -48,6 +49,7 @@ func (c ICACallbacks) RegisterICACallbacks() icacallbackstypes.ICACallbackHandle
 	a := c.
 		AddICACallback(ICACallbackID_Delegate, ICACallback(DelegateCallback)).
 		AddICACallback(ICACallbackID_Claim, ICACallback(ClaimCallback)).
+		AddICACallback(ICACallbackID_AutoClaim, ICACallback(AutoClaimCallback)).
 		AddICACallback(ICACallbackID_Undelegate, ICACallback(UndelegateCallback)).
 		AddICACallback(ICACallbackID_Reinvest, ICACallback(ReinvestCallback)).
 		AddICACallback(ICACallbackID_Redemption, ICACallback(RedemptionCallback)).
@@ -50,43 +50,71 @@ func ClaimCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackRes
 	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_Claim,
 		"Starting claim callback for Redemption Record: %s", claimCallback.UserRedemptionRecordId))
 
-	// Grab the associated user redemption record
-	userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, claimCallback.GetUserRedemptionRecordId())
-	if !found {
-		return errorsmod.Wrapf(types.ErrRecordNotFound, "user redemption record not found %s", claimCallback.GetUserRedemptionRecordId())
+	if ackResponse.Status == icacallbackstypes.AckResponseStatus_SUCCESS {
+		k.Logger(ctx).Info(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_Claim, ackResponse.Status, packet))
+	} else {
+		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_Claim, ackResponse.Status, packet))
 	}
 
-	// Check for a timeout
-	// If the ICA timed out, update the redemption record so the user can retry the claim
-	if ackResponse.Status == icacallbackstypes.AckResponseStatus_TIMEOUT {
-		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_Claim,
-			icacallbackstypes.AckResponseStatus_TIMEOUT, packet))
+	return k.HandleClaimAcknowledgement(ctx, *claimCallback, ackResponse.Status)
+}
 
-		userRedemptionRecord.ClaimIsPending = false
-		k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+// ICA Callback after automatically claiming a batch of unbonded tokens
+// Each claim in the batch is processed the same way as an individual claim (see ClaimCallback)
+func AutoClaimCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
+	// Fetch callback args
+	autoClaimCallback := types.AutoClaimCallback{}
+	if err := proto.Unmarshal(args, &autoClaimCallback); err != nil {
+		return errorsmod.Wrapf(types.ErrUnmarshalFailure, fmt.Sprintf("Unable to unmarshal auto claim callback args: %s", err.Error()))
+	}
+	if len(autoClaimCallback.Claims) == 0 {
 		return nil
 	}
+	chainId := autoClaimCallback.Claims[0].ChainId
+	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_AutoClaim,
+		"Starting auto claim callback for %d Redemption Records", len(autoClaimCallback.Claims)))
+
+	if ackResponse.Status == icacallbackstypes.AckResponseStatus_SUCCESS {
+		k.Logger(ctx).Info(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_AutoClaim, ackResponse.Status, packet))
+	} else {
+		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_AutoClaim, ackResponse.Status, packet))
+	}
+
+	for _, claimCallback := range autoClaimCallback.Claims {
+		if err := k.HandleClaimAcknowledgement(ctx, claimCallback, ackResponse.Status); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
-	// Check for a failed transaction (ack error)
-	// Upon failure, update the redemption record to allow the user to retry the claim
-	if ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE {
-		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_Claim,
-			icacallbackstypes.AckResponseStatus_FAILURE, packet))
+// Updates a user redemption record after the ack from a claim
+//
+//	If successful:
+//	  * Removes the user redemption record and decrements the host zone unbonding
+//	If timeout/failure:
+//	  * Reverts pending flag in the user redemption record so the claim can be re-tried
+func (k Keeper) HandleClaimAcknowledgement(ctx sdk.Context, claimCallback types.ClaimCallback, ackStatus icacallbackstypes.AckResponseStatus) error {
+	// Grab the associated user redemption record
+	userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, claimCallback.GetUserRedemptionRecordId())
+	if !found {
+		return errorsmod.Wrapf(types.ErrRecordNotFound, "user redemption record not found %s", claimCallback.GetUserRedemptionRecordId())
+	}
 
-		// after an error, a user should be able to retry the claim
+	// Check for a timeout or failed transaction (ack error)
+	// If the ICA did not succeed, update the redemption record so the claim can be retried
+	if ackStatus == icacallbackstypes.AckResponseStatus_TIMEOUT || ackStatus == icacallbackstypes.AckResponseStatus_FAILURE {
 		userRedemptionRecord.ClaimIsPending = false
 		k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
 		return nil
 	}
 
-	k.Logger(ctx).Info(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_Claim,
-		icacallbackstypes.AckResponseStatus_SUCCESS, packet))
-
 	// Upon success, remove the record and decrement the unbonded amount on the host zone unbonding record
 	k.RecordsKeeper.RemoveUserRedemptionRecord(ctx, claimCallback.GetUserRedemptionRecordId())
-	err = k.DecrementHostZoneUnbonding(ctx, userRedemptionRecord, *claimCallback)
-	if err != nil {
-		k.Logger(ctx).Error(fmt.Sprintf("ClaimCallback failed (DecrementHostZoneUnbonding), packet %v, err: %s", packet, err.Error()))
+	if err := k.DecrementHostZoneUnbonding(ctx, userRedemptionRecord, claimCallback); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Claim failed (DecrementHostZoneUnbonding), record %s, err: %s",
+			claimCallback.GetUserRedemptionRecordId(), err.Error()))
 		return err
 	}
 
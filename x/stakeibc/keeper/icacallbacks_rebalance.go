@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Stride-Labs/stride/v10/utils"
 	icacallbackstypes "github.com/Stride-Labs/stride/v10/x/icacallbacks/types"
@@ -10,6 +11,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/gogoproto/proto"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 )
@@ -38,6 +40,7 @@ func (k Keeper) UnmarshalRebalanceCallbackArgs(ctx sdk.Context, rebalanceCallbac
 //
 //	If successful:
 //	  * Updates relevant validator delegations on the host zone struct
+//	  * Records the completion time of each redelegation so future rebalances respect the host's redelegation constraints
 //	If timeout/failure:
 //	  * Does nothing
 func RebalanceCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
@@ -68,6 +71,20 @@ func RebalanceCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ac
 	k.Logger(ctx).Info(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_Rebalance,
 		icacallbackstypes.AckResponseStatus_SUCCESS, packet))
 
+	// Grab the completion time of each redelegation (the msg responses are in the same order as the rebalancings)
+	if len(ackResponse.MsgResponses) != len(rebalanceCallback.Rebalancings) {
+		return errorsmod.Wrapf(types.ErrInvalidPacketCompletionTime, "expected %d redelegation responses, but received %d",
+			len(rebalanceCallback.Rebalancings), len(ackResponse.MsgResponses))
+	}
+	completionTimes := []time.Time{}
+	for _, msgResponse := range ackResponse.MsgResponses {
+		var redelegateResponse stakingtypes.MsgBeginRedelegateResponse
+		if err := proto.Unmarshal(msgResponse, &redelegateResponse); err != nil {
+			return errorsmod.Wrapf(types.ErrUnmarshalFailure, "Unable to unmarshal redelegation tx response: %s", err.Error())
+		}
+		completionTimes = append(completionTimes, redelegateResponse.CompletionTime)
+	}
+
 	// Confirm the host zone exists
 	hostZone, found := k.GetHostZone(ctx, chainId)
 	if !found {
@@ -101,5 +118,10 @@ func RebalanceCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ac
 	}
 	k.SetHostZone(ctx, hostZone)
 
+	// Track the in-flight redelegations so future rebalances respect the host's redelegation constraints
+	for i, rebalancing := range rebalanceCallback.Rebalancings {
+		k.AddRedelegationEntry(ctx, chainId, rebalancing.SrcValidator, rebalancing.DstValidator, completionTimes[i])
+	}
+
 	return nil
 }
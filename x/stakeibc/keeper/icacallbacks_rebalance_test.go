@@ -1,7 +1,11 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	_ "github.com/stretchr/testify/suite"
 
@@ -23,15 +27,31 @@ type RebalanceCallbackArgs struct {
 }
 
 type RebalanceCallbackTestCase struct {
-	initialState RebalanceCallbackState
-	validArgs    RebalanceCallbackArgs
+	initialState    RebalanceCallbackState
+	validArgs       RebalanceCallbackArgs
+	completionTimes []time.Time
 }
 
 func (s *KeeperTestSuite) SetupRebalanceCallback() RebalanceCallbackTestCase {
 	rebalanceValidatorsTestCase := s.SetupRebalanceValidators()
 
 	packet := channeltypes.Packet{}
-	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_SUCCESS}
+	// Each redelegation on the host returns its completion time
+	completionTimes := []time.Time{
+		s.Ctx.BlockTime().Add(time.Hour),
+		s.Ctx.BlockTime().Add(2 * time.Hour),
+	}
+	msgResponses := [][]byte{}
+	for _, completionTime := range completionTimes {
+		msgResponse, err := proto.Marshal(&stakingtypes.MsgBeginRedelegateResponse{CompletionTime: completionTime})
+		s.Require().NoError(err, "no error expected when marshalling redelegate response")
+		msgResponses = append(msgResponses, msgResponse)
+	}
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{
+		Status:       icacallbacktypes.AckResponseStatus_SUCCESS,
+		MsgResponses: msgResponses,
+	}
 	callbackArgs := types.RebalanceCallback{
 		HostZoneId: HostChainId,
 		Rebalancings: []*types.Rebalancing{
@@ -60,6 +80,7 @@ func (s *KeeperTestSuite) SetupRebalanceCallback() RebalanceCallbackTestCase {
 			ackResponse: &ackResponse,
 			args:        args,
 		},
+		completionTimes: completionTimes,
 	}
 }
 
@@ -80,6 +101,33 @@ func (s *KeeperTestSuite) TestRebalanceCallback_Successful() {
 	s.Require().Equal(sdkmath.NewInt(96), validators[2].DelegationAmt, "validator 3 stake")
 	s.Require().Equal(sdkmath.NewInt(387), validators[3].DelegationAmt, "validator 4 stake")
 	s.Require().Equal(sdkmath.NewInt(400), validators[4].DelegationAmt, "validator 5 stake")
+
+	// Confirm each redelegation is tracked with its completion time
+	expectedTrackers := []struct {
+		srcValidator   string
+		completionTime time.Time
+	}{
+		{srcValidator: "stride_VAL3", completionTime: tc.completionTimes[0]},
+		{srcValidator: "stride_VAL4", completionTime: tc.completionTimes[1]},
+	}
+	for _, expected := range expectedTrackers {
+		tracker, found := s.App.StakeibcKeeper.GetRedelegationTracker(s.Ctx, HostChainId, expected.srcValidator, "stride_VAL1")
+		s.Require().True(found, "redelegation tracker from %s", expected.srcValidator)
+		s.Require().Equal([]uint64{uint64(expected.completionTime.UnixNano())}, tracker.CompletionTimes, "completion times from %s", expected.srcValidator)
+	}
+}
+
+func (s *KeeperTestSuite) TestRebalanceCallback_MissingMsgResponses() {
+	tc := s.SetupRebalanceCallback()
+
+	// Drop one of the redelegation responses
+	invalidArgs := tc.validArgs
+	invalidArgs.ackResponse.MsgResponses = invalidArgs.ackResponse.MsgResponses[:1]
+
+	err := stakeibckeeper.RebalanceCallback(s.App.StakeibcKeeper, s.Ctx, invalidArgs.packet, invalidArgs.ackResponse, invalidArgs.args)
+	s.Require().ErrorContains(err, "expected 2 redelegation responses, but received 1")
+	s.checkDelegationStateIfCallbackFailed()
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllRedelegationTrackers(s.Ctx), "no redelegations tracked")
 }
 
 func (s *KeeperTestSuite) checkDelegationStateIfCallbackFailed() {
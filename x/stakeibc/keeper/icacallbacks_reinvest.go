@@ -3,12 +3,6 @@ package keeper
 import (
 	"fmt"
 
-	"github.com/cosmos/cosmos-sdk/types/bech32"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-
-	icqtypes "github.com/Stride-Labs/stride/v10/x/interchainquery/types"
-
 	"github.com/Stride-Labs/stride/v10/utils"
 	epochtypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
 	icacallbackstypes "github.com/Stride-Labs/stride/v10/x/icacallbacks/types"
@@ -45,7 +39,7 @@ func (k Keeper) UnmarshalReinvestCallbackArgs(ctx sdk.Context, reinvestCallback
 //
 //	If successful:
 //	  * Creates a new DepositRecord with the reinvestment amount
-//	  * Issues an ICQ to query the rewards balance
+//	    (the fee account balance is queried periodically, see UpdateFeeBalance)
 //	If timeout/failure:
 //	  * Does nothing
 func ReinvestCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
@@ -58,7 +52,7 @@ func ReinvestCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ack
 	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_Reinvest, "Starting reinvest callback"))
 
 	// Grab the associated host zone
-	hostZone, found := k.GetHostZone(ctx, chainId)
+	_, found := k.GetHostZone(ctx, chainId)
 	if !found {
 		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", chainId)
 	}
@@ -100,34 +94,5 @@ func ReinvestCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ack
 	}
 	k.RecordsKeeper.AppendDepositRecord(ctx, record)
 
-	// Encode the fee account address for the query request
-	// The query request consists of the fee account address and denom
-	feeAccount := hostZone.FeeAccount
-	if feeAccount == nil || feeAccount.Address == "" {
-		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no fee account found for %s", chainId)
-	}
-	_, feeAddressBz, err := bech32.DecodeAndConvert(feeAccount.Address)
-	if err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid fee account address, could not decode (%s)", err.Error())
-	}
-	queryData := append(banktypes.CreateAccountBalancesPrefix(feeAddressBz), []byte(hostZone.HostDenom)...)
-
-	// The query should timeout before the next epoch
-	timeout, err := k.GetICATimeoutNanos(ctx, epochtypes.STRIDE_EPOCH)
-	if err != nil {
-		return errorsmod.Wrapf(err, "Failed to get ICATimeout from %s epoch", epochtypes.STRIDE_EPOCH)
-	}
-
-	// Submit an ICQ for the rewards balance in the fee account
-	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_Reinvest, "Submitting ICQ for fee account balance"))
-	return k.InterchainQueryKeeper.MakeRequest(
-		ctx,
-		types.ModuleName,
-		ICQCallbackID_FeeBalance,
-		chainId,
-		hostZone.ConnectionId,
-		icqtypes.BANK_STORE_QUERY_WITH_PROOF,
-		queryData,
-		timeout,
-	)
+	return nil
 }
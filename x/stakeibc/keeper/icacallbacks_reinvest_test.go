@@ -9,7 +9,6 @@ import (
 
 	"github.com/Stride-Labs/stride/v10/app/apptesting"
 	epochtypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
-	icqtypes "github.com/Stride-Labs/stride/v10/x/interchainquery/types"
 
 	icacallbacktypes "github.com/Stride-Labs/stride/v10/x/icacallbacks/types"
 	recordtypes "github.com/Stride-Labs/stride/v10/x/records/types"
@@ -20,11 +19,10 @@ import (
 )
 
 type ReinvestCallbackState struct {
-	hostZone       stakeibctypes.HostZone
-	reinvestAmt    sdkmath.Int
-	callbackArgs   types.ReinvestCallback
-	depositRecord  recordtypes.DepositRecord
-	icaTimeoutTime int64
+	hostZone      stakeibctypes.HostZone
+	reinvestAmt   sdkmath.Int
+	callbackArgs  types.ReinvestCallback
+	depositRecord recordtypes.DepositRecord
 }
 
 type ReinvestCallbackArgs struct {
@@ -42,10 +40,6 @@ func (s *KeeperTestSuite) SetupReinvestCallback() ReinvestCallbackTestCase {
 	reinvestAmt := sdkmath.NewInt(1_000)
 	feeAddress := apptesting.CreateRandomAccounts(1)[0].String() // must be valid bech32 address
 
-	epochEndTime := uint64(100)
-	buffer := uint64(10)
-	icaTimeoutTime := int64(90)
-
 	hostZone := stakeibctypes.HostZone{
 		ChainId:        HostChainId,
 		HostDenom:      Atom,
@@ -65,18 +59,12 @@ func (s *KeeperTestSuite) SetupReinvestCallback() ReinvestCallbackTestCase {
 		Source:             recordtypes.DepositRecord_WITHDRAWAL_ICA,
 	}
 	epochTracker := stakeibctypes.EpochTracker{
-		EpochIdentifier:    epochtypes.STRIDE_EPOCH,
-		EpochNumber:        1,
-		NextEpochStartTime: epochEndTime,
-		Duration:           epochEndTime,
+		EpochIdentifier: epochtypes.STRIDE_EPOCH,
+		EpochNumber:     1,
 	}
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
 	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, epochTracker)
 
-	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
-	params.BufferSize = buffer
-	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
-
 	packet := channeltypes.Packet{}
 	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_SUCCESS}
 	callbackArgs := types.ReinvestCallback{
@@ -88,11 +76,10 @@ func (s *KeeperTestSuite) SetupReinvestCallback() ReinvestCallbackTestCase {
 
 	return ReinvestCallbackTestCase{
 		initialState: ReinvestCallbackState{
-			hostZone:       hostZone,
-			reinvestAmt:    reinvestAmt,
-			callbackArgs:   callbackArgs,
-			depositRecord:  expectedNewDepositRecord,
-			icaTimeoutTime: icaTimeoutTime,
+			hostZone:      hostZone,
+			reinvestAmt:   reinvestAmt,
+			callbackArgs:  callbackArgs,
+			depositRecord: expectedNewDepositRecord,
 		},
 		validArgs: ReinvestCallbackArgs{
 			packet:      packet,
@@ -124,16 +111,9 @@ func (s *KeeperTestSuite) TestReinvestCallback_Successful() {
 	s.Require().Equal(expectedRecord.Source, record.Source, "deposit record Source")
 	s.Require().Equal(int64(expectedRecord.DepositEpochNumber), int64(record.DepositEpochNumber), "deposit record DepositEpochNumber")
 
-	// Confirm an interchain query was submitted for the fee account balance
+	// Confirm no interchain query was submitted, since the fee account balance is queried periodically
 	allQueries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
-	s.Require().Len(allQueries, 1, "should be 1 query submitted")
-
-	query := allQueries[0]
-	s.Require().Equal(stakeibckeeper.ICQCallbackID_FeeBalance, query.CallbackId, "query callback ID")
-	s.Require().Equal(HostChainId, query.ChainId, "query chain ID")
-	s.Require().Equal(ibctesting.FirstConnectionID, query.ConnectionId, "query connection ID")
-	s.Require().Equal(icqtypes.BANK_STORE_QUERY_WITH_PROOF, query.QueryType, "query type")
-	s.Require().Equal(tc.initialState.icaTimeoutTime, int64(query.Ttl), "query timeout")
+	s.Require().Len(allQueries, 0, "no queries should be submitted")
 }
 
 func (s *KeeperTestSuite) checkReinvestStateIfCallbackFailed(tc ReinvestCallbackTestCase) {
@@ -187,30 +167,6 @@ func (s *KeeperTestSuite) TestReinvestCallback_HostZoneNotFound() {
 	s.Require().ErrorContains(err, "host zone GAIA not found: host zone not found")
 }
 
-func (s *KeeperTestSuite) TestReinvestCallback_NoFeeAccount() {
-	tc := s.SetupReinvestCallback()
-
-	// Remove the fee account
-	badHostZone := tc.initialState.hostZone
-	badHostZone.FeeAccount = nil
-	s.App.StakeibcKeeper.SetHostZone(s.Ctx, badHostZone)
-
-	err := stakeibckeeper.ReinvestCallback(s.App.StakeibcKeeper, s.Ctx, tc.validArgs.packet, tc.validArgs.ackResponse, tc.validArgs.args)
-	s.Require().EqualError(err, "no fee account found for GAIA: ICA acccount not found on host zone")
-}
-
-func (s *KeeperTestSuite) TestReinvestCallback_InvalidFeeAccountAddress() {
-	tc := s.SetupReinvestCallback()
-
-	// Remove the fee account
-	badHostZone := tc.initialState.hostZone
-	badHostZone.FeeAccount.Address = "invalid_fee_account"
-	s.App.StakeibcKeeper.SetHostZone(s.Ctx, badHostZone)
-
-	err := stakeibckeeper.ReinvestCallback(s.App.StakeibcKeeper, s.Ctx, tc.validArgs.packet, tc.validArgs.ackResponse, tc.validArgs.args)
-	s.Require().ErrorContains(err, "invalid fee account address, could not decode")
-}
-
 func (s *KeeperTestSuite) TestReinvestCallback_MissingEpoch() {
 	tc := s.SetupReinvestCallback()
 	invalidArgs := tc.validArgs
@@ -221,16 +177,3 @@ func (s *KeeperTestSuite) TestReinvestCallback_MissingEpoch() {
 	err := stakeibckeeper.ReinvestCallback(s.App.StakeibcKeeper, s.Ctx, invalidArgs.packet, invalidArgs.ackResponse, invalidArgs.args)
 	s.Require().ErrorContains(err, "no number for epoch (stride_epoch)")
 }
-
-func (s *KeeperTestSuite) TestReinvestCallback_FailedToSubmitQuery() {
-	tc := s.SetupReinvestCallback()
-	invalidArgs := tc.validArgs
-
-	// Remove the connection ID from the host zone so that the query submission fails
-	badHostZone := tc.initialState.hostZone
-	badHostZone.ConnectionId = ""
-	s.App.StakeibcKeeper.SetHostZone(s.Ctx, badHostZone)
-
-	err := stakeibckeeper.ReinvestCallback(s.App.StakeibcKeeper, s.Ctx, invalidArgs.packet, invalidArgs.ackResponse, invalidArgs.args)
-	s.Require().EqualError(err, "[ICQ Validation Check] Failed! connection id cannot be empty: invalid request")
-}
@@ -45,6 +45,7 @@ func (k Keeper) UnmarshalUndelegateCallbackArgs(ctx sdk.Context, undelegateCallb
 //	If successful:
 //	  * Updates epoch unbonding record status
 //	  * Records delegation changes on the host zone and validators,
+//	  * Records the unbonding entry on each validator
 //	  * Burns stTokens
 //	If timeout:
 //	  * Does nothing
@@ -85,6 +86,13 @@ func UndelegateCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, a
 	k.Logger(ctx).Info(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_Undelegate,
 		icacallbackstypes.AckResponseStatus_SUCCESS, packet))
 
+	// Get the completion time of each undelegation (to track the unbonding entries on each validator)
+	completionTimes, err := k.GetUndelegateCompletionTimes(ctx, ackResponse.MsgResponses)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("UndelegateCallback | %s", err.Error()))
+		return err
+	}
+
 	// Update delegation balances
 	hostZone, found := k.GetHostZone(ctx, undelegateCallback.HostZoneId)
 	if !found {
@@ -103,6 +111,13 @@ func UndelegateCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, a
 		return err
 	}
 
+	// Record the unbonding entries so that future unbondings stay under the host's limit
+	err = k.AddValidatorUnbondingEntries(ctx, chainId, undelegateCallback.SplitDelegations, completionTimes)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("UndelegateCallback | %s", err.Error()))
+		return err
+	}
+
 	// Burn the stTokens
 	stTokenBurnAmount, err := k.UpdateHostZoneUnbondings(ctx, *latestCompletionTime, chainId, undelegateCallback)
 	if err != nil {
@@ -145,12 +160,9 @@ func (k Keeper) UpdateDelegationBalances(ctx sdk.Context, zone types.HostZone, u
 	return nil
 }
 
-// Get the latest completion time across each MsgUndelegate in the ICA transaction
-// The time is used to set the
-func (k Keeper) GetLatestCompletionTime(ctx sdk.Context, msgResponses [][]byte) (*time.Time, error) {
-	// Update the completion time using the latest completion time across each message within the transaction
-	latestCompletionTime := time.Time{}
-
+// Get the completion time of each MsgUndelegate in the ICA transaction
+func (k Keeper) GetUndelegateCompletionTimes(ctx sdk.Context, msgResponses [][]byte) ([]time.Time, error) {
+	completionTimes := []time.Time{}
 	for _, msgResponse := range msgResponses {
 		// unmarshall the ack response into a MsgUndelegateResponse and grab the completion time
 		var undelegateResponse stakingtypes.MsgUndelegateResponse
@@ -158,8 +170,24 @@ func (k Keeper) GetLatestCompletionTime(ctx sdk.Context, msgResponses [][]byte)
 		if err != nil {
 			return nil, errorsmod.Wrapf(types.ErrUnmarshalFailure, "Unable to unmarshal undelegation tx response: %s", err.Error())
 		}
-		if undelegateResponse.CompletionTime.After(latestCompletionTime) {
-			latestCompletionTime = undelegateResponse.CompletionTime
+		completionTimes = append(completionTimes, undelegateResponse.CompletionTime)
+	}
+	return completionTimes, nil
+}
+
+// Get the latest completion time across each MsgUndelegate in the ICA transaction
+// The time is used to set the
+func (k Keeper) GetLatestCompletionTime(ctx sdk.Context, msgResponses [][]byte) (*time.Time, error) {
+	completionTimes, err := k.GetUndelegateCompletionTimes(ctx, msgResponses)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the completion time using the latest completion time across each message within the transaction
+	latestCompletionTime := time.Time{}
+	for _, completionTime := range completionTimes {
+		if completionTime.After(latestCompletionTime) {
+			latestCompletionTime = completionTime
 		}
 	}
 
@@ -102,7 +102,7 @@ func (s *KeeperTestSuite) SetupUndelegateCallback() UndelegateCallbackTestCase {
 
 	ackResponse := icacallbacktypes.AcknowledgementResponse{
 		Status:       icacallbacktypes.AckResponseStatus_SUCCESS,
-		MsgResponses: [][]byte{msgsUndelegateResponseBz},
+		MsgResponses: [][]byte{msgsUndelegateResponseBz, msgsUndelegateResponseBz},
 	}
 
 	// Mock callback args
@@ -165,6 +165,11 @@ func (s *KeeperTestSuite) TestUndelegateCallback_Successful() {
 	// Check that the host zone unbonding records have been updated
 	s.Require().Equal(val2.DelegationAmt, initialState.val2Bal.Sub(tc.val2UndelegationAmount), "val2 delegation has decreased")
 
+	// Check that the unbonding entries were recorded on each validator
+	expectedCompletionTimes := []uint64{uint64(initialState.completionTime.UnixNano())}
+	s.Require().Equal(expectedCompletionTimes, val1.UnbondingCompletionTimes, "val1 unbonding entries")
+	s.Require().Equal(expectedCompletionTimes, val2.UnbondingCompletionTimes, "val2 unbonding entries")
+
 	epochUnbondingRecord, found := s.App.RecordsKeeper.GetEpochUnbondingRecord(s.Ctx, initialState.epochNumber)
 	s.Require().True(found, "epoch unbonding record found")
 	s.Require().Equal(len(epochUnbondingRecord.HostZoneUnbondings), 1, "1 host zone unbonding found")
@@ -248,6 +253,16 @@ func (s *KeeperTestSuite) TestUndelegateCallback_HostNotFound() {
 	s.Require().EqualError(err, "Host zone not found: GAIA: key not found")
 }
 
+func (s *KeeperTestSuite) TestUndelegateCallback_MissingMsgResponses() {
+	tc := s.SetupUndelegateCallback()
+
+	// Only one response for the two undelegations
+	tc.validArgs.ackResponse.MsgResponses = tc.validArgs.ackResponse.MsgResponses[:1]
+
+	err := stakeibckeeper.UndelegateCallback(s.App.StakeibcKeeper, s.Ctx, tc.validArgs.packet, tc.validArgs.ackResponse, tc.validArgs.args)
+	s.Require().ErrorContains(err, "expected 2 undelegation completion times, but received 1")
+}
+
 // UpdateDelegationBalances tests
 func (s *KeeperTestSuite) TestUpdateDelegationBalances_Success() {
 	tc := s.SetupUndelegateCallback()
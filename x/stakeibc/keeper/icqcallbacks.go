@@ -7,10 +7,13 @@ import (
 )
 
 const (
-	ICQCallbackID_WithdrawalBalance = "withdrawalbalance"
-	ICQCallbackID_FeeBalance        = "feebalance"
-	ICQCallbackID_Delegation        = "delegation"
-	ICQCallbackID_Validator         = "validator"
+	ICQCallbackID_WithdrawalBalance    = "withdrawalbalance"
+	ICQCallbackID_FeeBalance           = "feebalance"
+	ICQCallbackID_Delegation           = "delegation"
+	ICQCallbackID_Validator            = "validator"
+	ICQCallbackID_ValidatorMetrics     = "validatormetrics"
+	ICQCallbackID_ValidatorSigningInfo = "validatorsigninginfo"
+	ICQCallbackID_StakingParams        = "stakingparams"
 )
 
 // ICQCallbacks wrapper struct for stakeibc keeper
@@ -46,5 +49,8 @@ func (c ICQCallbacks) RegisterICQCallbacks() icqtypes.QueryCallbacks {
 		AddICQCallback(ICQCallbackID_WithdrawalBalance, ICQCallback(WithdrawalBalanceCallback)).
 		AddICQCallback(ICQCallbackID_FeeBalance, ICQCallback(FeeBalanceCallback)).
 		AddICQCallback(ICQCallbackID_Delegation, ICQCallback(DelegatorSharesCallback)).
-		AddICQCallback(ICQCallbackID_Validator, ICQCallback(ValidatorExchangeRateCallback))
+		AddICQCallback(ICQCallbackID_Validator, ICQCallback(ValidatorExchangeRateCallback)).
+		AddICQCallback(ICQCallbackID_ValidatorMetrics, ICQCallback(ValidatorMetricsCallback)).
+		AddICQCallback(ICQCallbackID_ValidatorSigningInfo, ICQCallback(ValidatorSigningInfoCallback)).
+		AddICQCallback(ICQCallbackID_StakingParams, ICQCallback(StakingParamsCallback))
 }
@@ -33,6 +33,13 @@ func FeeBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Q
 		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "no registered zone for queried chain ID (%s)", chainId)
 	}
 
+	// The query is re-submitted periodically, so ignore responses for halted host zones
+	if hostZone.Halted {
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_FeeBalance,
+			"Host zone is halted, skipping fee balance callback"))
+		return nil
+	}
+
 	// Unmarshal the query response args to determine the balance
 	feeBalanceAmount, err := icqkeeper.UnmarshalAmountFromBalanceQuery(k.cdc, args)
 	if err != nil {
@@ -224,3 +224,64 @@ func (s *KeeperTestSuite) TestFeeBalanceCallback_FailedSubmitTx() {
 	s.Require().ErrorContains(err, "Failed to SubmitTxs")
 	s.Require().ErrorContains(err, "invalid connection id, connection-X not found")
 }
+
+func (s *KeeperTestSuite) TestUpdateFeeBalance_RegistersPeriodicQuery() {
+	tc := s.SetupFeeBalanceCallbackTest()
+
+	err := s.App.StakeibcKeeper.UpdateFeeBalance(s.Ctx, tc.initialState.hostZone)
+	s.Require().NoError(err, "no error expected when updating fee balance")
+
+	// Confirm the fee balance query was registered to be re-submitted each reinvest interval
+	periodicQueries := s.App.InterchainqueryKeeper.AllPeriodicQueries(s.Ctx)
+	s.Require().Len(periodicQueries, 1, "number of periodic queries")
+
+	periodicQuery := periodicQueries[0]
+	s.Require().Equal(stakeibckeeper.ICQCallbackID_FeeBalance, periodicQuery.CallbackId, "query callback ID")
+	s.Require().Equal(HostChainId, periodicQuery.ChainId, "query chain ID")
+	s.Require().Equal(epochtypes.STRIDE_EPOCH, periodicQuery.EpochIdentifier, "query epoch identifier")
+	s.Require().Equal(s.App.StakeibcKeeper.GetParam(s.Ctx, stakeibctypes.KeyReinvestInterval), periodicQuery.Period, "query period")
+
+	// The query should also have been submitted immediately
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "number of queries submitted")
+
+	// Re-registering with the same reinvest interval should not re-submit the query
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	err = s.App.StakeibcKeeper.UpdateFeeBalance(s.Ctx, tc.initialState.hostZone)
+	s.Require().NoError(err, "no error expected when updating fee balance a second time")
+
+	periodicQuery, found := s.App.InterchainqueryKeeper.GetPeriodicQuery(s.Ctx, periodicQuery.Id)
+	s.Require().True(found, "periodic query should still be registered")
+	s.Require().NotEqual(s.Ctx.BlockHeight(), periodicQuery.LastSubmissionHeight, "query should not have been re-submitted")
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeBalance_NoFeeAccount() {
+	tc := s.SetupFeeBalanceCallbackTest()
+
+	badHostZone := tc.initialState.hostZone
+	badHostZone.FeeAccount = nil
+
+	err := s.App.StakeibcKeeper.UpdateFeeBalance(s.Ctx, badHostZone)
+	s.Require().EqualError(err, "no fee account found for GAIA: ICA acccount not found on host zone")
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeBalance_InvalidFeeAccountAddress() {
+	tc := s.SetupFeeBalanceCallbackTest()
+
+	badHostZone := tc.initialState.hostZone
+	badHostZone.FeeAccount.Address = "invalid_fee_account"
+
+	err := s.App.StakeibcKeeper.UpdateFeeBalance(s.Ctx, badHostZone)
+	s.Require().ErrorContains(err, "invalid account address, could not decode")
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeBalance_FailedToSubmitQuery() {
+	tc := s.SetupFeeBalanceCallbackTest()
+
+	// Remove the connection ID from the host zone so that the query submission fails
+	badHostZone := tc.initialState.hostZone
+	badHostZone.ConnectionId = ""
+
+	err := s.App.StakeibcKeeper.UpdateFeeBalance(s.Ctx, badHostZone)
+	s.Require().EqualError(err, "[ICQ Validation Check] Failed! connection id cannot be empty: invalid request")
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Stride-Labs/stride/v10/utils"
+	icqtypes "github.com/Stride-Labs/stride/v10/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// StakingParamsCallback is a callback handler for host staking params queries
+// The unbonding period is stored on the host zone and used to estimate when redemptions can be claimed
+// Hosts on SDK versions before v0.47 keep their staking params in the params module, in which case
+// the query returns no data and the unbonding period is left unchanged
+func StakingParamsCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_StakingParams,
+		"Starting staking params callback, QueryId: %vs, QueryType: %s, Connection: %s", query.Id, query.QueryType, query.ConnectionId))
+
+	// Confirm host exists
+	chainId := query.ChainId
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "no registered zone for queried chain ID (%s)", chainId)
+	}
+
+	if len(args) == 0 {
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_StakingParams,
+			"No staking params found on host, leaving the unbonding period unchanged"))
+		return nil
+	}
+
+	// Unmarshal the query response args into a staking Params struct
+	params := stakingtypes.Params{}
+	if err := k.cdc.Unmarshal(args, &params); err != nil {
+		return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to unmarshal query response into Params type, err: %s", err.Error())
+	}
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_StakingParams,
+		"Query response - Unbonding Time: %v", params.UnbondingTime))
+
+	if params.UnbondingTime <= 0 {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "invalid unbonding time in staking params: %v", params.UnbondingTime)
+	}
+
+	hostZone.UnbondingPeriod = uint64(params.UnbondingTime.Seconds())
+	k.SetHostZone(ctx, hostZone)
+
+	return nil
+}
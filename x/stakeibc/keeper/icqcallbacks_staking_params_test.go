@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"time"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	icqtypes "github.com/Stride-Labs/stride/v10/x/interchainquery/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v10/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+type StakingParamsICQCallbackTestCase struct {
+	query        icqtypes.Query
+	callbackArgs []byte
+}
+
+func (s *KeeperTestSuite) SetupStakingParamsICQCallback() StakingParamsICQCallbackTestCase {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+		ChainId:         HostChainId,
+		UnbondingPeriod: 100,
+	})
+
+	params := stakingtypes.DefaultParams()
+	params.UnbondingTime = time.Hour * 24 * 21
+
+	return StakingParamsICQCallbackTestCase{
+		query: icqtypes.Query{
+			ChainId: HostChainId,
+			Request: stakingtypes.ParamsKey,
+		},
+		callbackArgs: s.App.RecordsKeeper.Cdc.MustMarshal(&params),
+	}
+}
+
+func (s *KeeperTestSuite) TestStakingParamsCallback_Successful() {
+	tc := s.SetupStakingParamsICQCallback()
+
+	err := stakeibckeeper.StakingParamsCallback(s.App.StakeibcKeeper, s.Ctx, tc.callbackArgs, tc.query)
+	s.Require().NoError(err, "staking params callback error")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(uint64(21*24*60*60), hostZone.UnbondingPeriod, "unbonding period")
+}
+
+func (s *KeeperTestSuite) TestStakingParamsCallback_EmptyResponse() {
+	tc := s.SetupStakingParamsICQCallback()
+
+	// Hosts that store their staking params in the params module return no data
+	err := stakeibckeeper.StakingParamsCallback(s.App.StakeibcKeeper, s.Ctx, []byte{}, tc.query)
+	s.Require().NoError(err, "staking params callback error")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(uint64(100), hostZone.UnbondingPeriod, "unbonding period should not change")
+}
+
+func (s *KeeperTestSuite) TestStakingParamsCallback_HostZoneNotFound() {
+	tc := s.SetupStakingParamsICQCallback()
+
+	badQuery := tc.query
+	badQuery.ChainId = "fake_host_zone"
+	err := stakeibckeeper.StakingParamsCallback(s.App.StakeibcKeeper, s.Ctx, tc.callbackArgs, badQuery)
+	s.Require().ErrorContains(err, "no registered zone for queried chain ID (fake_host_zone)")
+}
+
+func (s *KeeperTestSuite) TestStakingParamsCallback_InvalidArgs() {
+	tc := s.SetupStakingParamsICQCallback()
+
+	err := stakeibckeeper.StakingParamsCallback(s.App.StakeibcKeeper, s.Ctx, []byte("random bytes"), tc.query)
+	s.Require().ErrorContains(err, "unable to unmarshal query response into Params type")
+}
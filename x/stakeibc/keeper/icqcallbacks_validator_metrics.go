@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Stride-Labs/stride/v10/utils"
+	epochtypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
+	icqtypes "github.com/Stride-Labs/stride/v10/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// ValidatorMetricsCallback is a callback handler for validator metrics queries, used to score validators
+// for host zones with the SCORED selection strategy
+//
+// Scoring a validator requires two queries:
+//  1. the validator's commission, tokens and jailed status (from the staking store)
+//  2. the validator's signing info, to determine uptime (from the slashing store)
+//
+// This is the callback from query #1, which also submits query #2 since the signing info
+// is keyed by the validator's consensus address (which is only known from the validator)
+func ValidatorMetricsCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_ValidatorMetrics,
+		"Starting validator metrics callback, QueryId: %vs, QueryType: %s, Connection: %s", query.Id, query.QueryType, query.ConnectionId))
+
+	// Confirm host exists
+	chainId := query.ChainId
+	hostZone, found := k.GetHostZone(ctx, query.ChainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "no registered zone for queried chain ID (%s)", chainId)
+	}
+
+	// Unmarshal the query response args into a Validator struct
+	queriedValidator := stakingtypes.Validator{}
+	if err := k.cdc.Unmarshal(args, &queriedValidator); err != nil {
+		return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to unmarshal query response into Validator type, err: %s", err.Error())
+	}
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_ValidatorMetrics, "Query response - Validator: %s, Jailed: %v, Tokens: %v, Commission: %v",
+		queriedValidator.OperatorAddress, queriedValidator.Jailed, queriedValidator.Tokens, queriedValidator.Commission.Rate))
+
+	// Get the validator from the host zone
+	validator, valIndex, found := GetValidatorFromAddress(hostZone.Validators, queriedValidator.OperatorAddress)
+	if !found {
+		return errorsmod.Wrapf(types.ErrValidatorNotFound, "no registered validator for address (%s)", queriedValidator.OperatorAddress)
+	}
+
+	// The consensus pubkey is stored as an Any, and must be unpacked before the consensus address can be derived
+	if queriedValidator.ConsensusPubkey == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "unable to determine validator consensus address, no consensus pubkey for %s",
+			queriedValidator.OperatorAddress)
+	}
+	if err := queriedValidator.UnpackInterfaces(k.cdc); err != nil {
+		return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to unpack validator consensus pubkey, err: %s", err.Error())
+	}
+	consAddress, err := queriedValidator.GetConsAddr()
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "unable to determine validator consensus address, err: %s", err.Error())
+	}
+	consAddressBech32, err := bech32.ConvertAndEncode(hostZone.Bech32Prefix+sdk.PrefixValidator+sdk.PrefixConsensus, consAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "unable to encode validator consensus address, err: %s", err.Error())
+	}
+
+	// Get the day epoch number
+	dayEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.DAY_EPOCH)
+	if !found {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "no epoch number for epoch (%s)", epochtypes.DAY_EPOCH)
+	}
+
+	// Uptime from the previous query is carried over until it's refreshed by the signing info callback
+	metrics := types.ValidatorMetrics{
+		CommissionRate:   queriedValidator.Commission.Rate,
+		Tokens:           queriedValidator.Tokens,
+		Jailed:           queriedValidator.Jailed,
+		EpochNumber:      dayEpochTracker.EpochNumber,
+		ConsensusAddress: consAddressBech32,
+	}
+	if validator.Metrics != nil {
+		metrics.MissedBlocksCounter = validator.Metrics.MissedBlocksCounter
+		metrics.Tombstoned = validator.Metrics.Tombstoned
+	}
+	validator.Metrics = &metrics
+	hostZone.Validators[valIndex] = &validator
+	k.SetHostZone(ctx, hostZone)
+
+	// Query the validator's signing info to get the validator's uptime
+	if err := k.SubmitValidatorSigningInfoICQ(ctx, hostZone, consAddress); err != nil {
+		return errorsmod.Wrapf(types.ErrICQFailed, "Failed to query validator signing info, err: %s", err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	epochtypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
+	icqtypes "github.com/Stride-Labs/stride/v10/x/interchainquery/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v10/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+type ValidatorMetricsICQCallbackTestCase struct {
+	hostZone          stakeibctypes.HostZone
+	query             icqtypes.Query
+	callbackArgs      []byte
+	consAddress       sdk.ConsAddress
+	consAddressBech32 string
+	dayEpochNumber    uint64
+}
+
+func (s *KeeperTestSuite) SetupValidatorMetricsICQCallback() ValidatorMetricsICQCallbackTestCase {
+	// We need IBC support to submit the follow up signing info query
+	s.CreateTransferChannel(HostChainId)
+
+	dayEpochNumber := uint64(4)
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{
+		EpochIdentifier:    epochtypes.DAY_EPOCH,
+		EpochNumber:        dayEpochNumber,
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 1_000_000_000),
+	})
+
+	hostZone := s.SetupValidatorSelectionStrategy(stakeibctypes.ValidatorSelectionStrategy_SCORED)
+
+	// The queried validator has previously recorded uptime that should be carried over
+	hostZone.Validators[0].Metrics = &stakeibctypes.ValidatorMetrics{
+		CommissionRate:      sdk.MustNewDecFromStr("0.5"),
+		Tokens:              sdkmath.NewInt(1),
+		MissedBlocksCounter: 7,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+	s.Require().NoError(err, "no error expected when packing pubkey")
+
+	consAddress := sdk.ConsAddress(pubKey.Address())
+	consAddressBech32, err := bech32.ConvertAndEncode("cosmosvalcons", consAddress)
+	s.Require().NoError(err, "no error expected when encoding consensus address")
+
+	validator := stakingtypes.Validator{
+		OperatorAddress: hostZone.Validators[0].Address,
+		ConsensusPubkey: pubKeyAny,
+		Jailed:          true,
+		Tokens:          sdkmath.NewInt(1000),
+		DelegatorShares: sdk.NewDec(1000),
+		Commission:      stakingtypes.NewCommission(sdk.MustNewDecFromStr("0.05"), sdk.OneDec(), sdk.OneDec()),
+	}
+
+	return ValidatorMetricsICQCallbackTestCase{
+		hostZone:          hostZone,
+		query:             icqtypes.Query{ChainId: HostChainId},
+		callbackArgs:      s.App.RecordsKeeper.Cdc.MustMarshal(&validator),
+		consAddress:       consAddress,
+		consAddressBech32: consAddressBech32,
+		dayEpochNumber:    dayEpochNumber,
+	}
+}
+
+func (s *KeeperTestSuite) TestValidatorMetricsCallback_Successful() {
+	tc := s.SetupValidatorMetricsICQCallback()
+
+	err := stakeibckeeper.ValidatorMetricsCallback(s.App.StakeibcKeeper, s.Ctx, tc.callbackArgs, tc.query)
+	s.Require().NoError(err, "validator metrics callback error")
+
+	// Confirm the validator's metrics were updated
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+
+	metrics := hostZone.Validators[0].Metrics
+	s.Require().NotNil(metrics, "validator metrics")
+	s.Require().Equal(sdk.MustNewDecFromStr("0.05"), metrics.CommissionRate, "commission rate")
+	s.Require().Equal(sdkmath.NewInt(1000), metrics.Tokens, "tokens")
+	s.Require().True(metrics.Jailed, "jailed")
+	s.Require().Equal(int64(7), metrics.MissedBlocksCounter, "missed blocks carried over")
+	s.Require().Equal(tc.dayEpochNumber, metrics.EpochNumber, "epoch number")
+	s.Require().Equal(tc.consAddressBech32, metrics.ConsensusAddress, "consensus address")
+
+	// Confirm the other validators were not updated
+	s.Require().Nil(hostZone.Validators[1].Metrics, "val2 metrics")
+	s.Require().Nil(hostZone.Validators[2].Metrics, "val3 metrics")
+
+	// Confirm the signing info query was submitted
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "number of queries submitted")
+	s.Require().Equal(stakeibckeeper.ICQCallbackID_ValidatorSigningInfo, queries[0].CallbackId, "query callback id")
+	s.Require().Equal(icqtypes.SLASHING_STORE_QUERY_WITH_PROOF, queries[0].QueryType, "query type")
+	s.Require().Equal(slashingtypes.ValidatorSigningInfoKey(tc.consAddress), queries[0].Request, "query request")
+}
+
+func (s *KeeperTestSuite) TestValidatorMetricsCallback_HostZoneNotFound() {
+	tc := s.SetupValidatorMetricsICQCallback()
+
+	badQuery := tc.query
+	badQuery.ChainId = "fake_host_zone"
+
+	err := stakeibckeeper.ValidatorMetricsCallback(s.App.StakeibcKeeper, s.Ctx, tc.callbackArgs, badQuery)
+	s.Require().ErrorContains(err, "no registered zone for queried chain ID (fake_host_zone)")
+}
+
+func (s *KeeperTestSuite) TestValidatorMetricsCallback_InvalidCallbackArgs() {
+	tc := s.SetupValidatorMetricsICQCallback()
+
+	err := stakeibckeeper.ValidatorMetricsCallback(s.App.StakeibcKeeper, s.Ctx, []byte("random bytes"), tc.query)
+	s.Require().ErrorContains(err, "unable to unmarshal query response into Validator type")
+}
+
+func (s *KeeperTestSuite) TestValidatorMetricsCallback_ValidatorNotFound() {
+	tc := s.SetupValidatorMetricsICQCallback()
+
+	// Remove the queried validator from the host zone
+	hostZone := tc.hostZone
+	hostZone.Validators = hostZone.Validators[1:]
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err := stakeibckeeper.ValidatorMetricsCallback(s.App.StakeibcKeeper, s.Ctx, tc.callbackArgs, tc.query)
+	s.Require().ErrorContains(err, "no registered validator for address")
+}
+
+func (s *KeeperTestSuite) TestValidatorMetricsCallback_MissingPubKey() {
+	tc := s.SetupValidatorMetricsICQCallback()
+
+	validator := stakingtypes.Validator{
+		OperatorAddress: tc.hostZone.Validators[0].Address,
+		Tokens:          sdkmath.NewInt(1000),
+		DelegatorShares: sdk.NewDec(1000),
+	}
+	callbackArgs := s.App.RecordsKeeper.Cdc.MustMarshal(&validator)
+
+	err := stakeibckeeper.ValidatorMetricsCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, tc.query)
+	s.Require().ErrorContains(err, "unable to determine validator consensus address")
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	"github.com/Stride-Labs/stride/v10/utils"
+	icqtypes "github.com/Stride-Labs/stride/v10/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// ValidatorSigningInfoCallback is a callback handler for validator signing info queries
+// The missed blocks counter and tombstoned status are stored on the validator's metrics, which are
+// used to determine the validator's uptime when scoring validators
+//
+// This is the callback from query #2 (see ValidatorMetricsCallback)
+func ValidatorSigningInfoCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_ValidatorSigningInfo,
+		"Starting validator signing info callback, QueryId: %vs, QueryType: %s, Connection: %s", query.Id, query.QueryType, query.ConnectionId))
+
+	// Confirm host exists
+	chainId := query.ChainId
+	hostZone, found := k.GetHostZone(ctx, query.ChainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "no registered zone for queried chain ID (%s)", chainId)
+	}
+
+	// Unmarshal the query response args into a ValidatorSigningInfo struct
+	signingInfo := slashingtypes.ValidatorSigningInfo{}
+	if err := k.cdc.Unmarshal(args, &signingInfo); err != nil {
+		return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to unmarshal query response into ValidatorSigningInfo type, err: %s", err.Error())
+	}
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_ValidatorSigningInfo, "Query response - Address: %s, MissedBlocks: %d, Tombstoned: %v",
+		signingInfo.Address, signingInfo.MissedBlocksCounter, signingInfo.Tombstoned))
+
+	// The signing info is keyed by the consensus address, which is used to identify the validator
+	queriedConsAddress := slashingtypes.ValidatorSigningInfoAddress(query.Request)
+	for _, validator := range hostZone.Validators {
+		if validator.Metrics == nil || validator.Metrics.ConsensusAddress == "" {
+			continue
+		}
+		_, consAddress, err := bech32.DecodeAndConvert(validator.Metrics.ConsensusAddress)
+		if err != nil || !queriedConsAddress.Equals(sdk.ConsAddress(consAddress)) {
+			continue
+		}
+
+		validator.Metrics.MissedBlocksCounter = signingInfo.MissedBlocksCounter
+		validator.Metrics.Tombstoned = signingInfo.Tombstoned
+		k.SetHostZone(ctx, hostZone)
+
+		return nil
+	}
+
+	return errorsmod.Wrapf(types.ErrValidatorNotFound, "no registered validator for consensus address (%X)", queriedConsAddress.Bytes())
+}
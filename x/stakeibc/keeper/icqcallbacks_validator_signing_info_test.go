@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	icqtypes "github.com/Stride-Labs/stride/v10/x/interchainquery/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v10/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+type ValidatorSigningInfoICQCallbackTestCase struct {
+	query        icqtypes.Query
+	callbackArgs []byte
+}
+
+func (s *KeeperTestSuite) SetupValidatorSigningInfoICQCallback() ValidatorSigningInfoICQCallbackTestCase {
+	hostZone := s.SetupValidatorSelectionStrategy(stakeibctypes.ValidatorSelectionStrategy_SCORED)
+
+	// Give each validator a consensus address, the second validator is the one being queried
+	consAddresses := []sdk.ConsAddress{}
+	for _, validator := range hostZone.Validators {
+		consAddress := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+		consAddressBech32, err := bech32.ConvertAndEncode("cosmosvalcons", consAddress)
+		s.Require().NoError(err, "no error expected when encoding consensus address")
+
+		validator.Metrics = &stakeibctypes.ValidatorMetrics{
+			CommissionRate:   sdk.MustNewDecFromStr("0.05"),
+			Tokens:           sdkmath.NewInt(1000),
+			ConsensusAddress: consAddressBech32,
+		}
+		consAddresses = append(consAddresses, consAddress)
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	signingInfo := slashingtypes.ValidatorSigningInfo{
+		Address:             hostZone.Validators[1].Metrics.ConsensusAddress,
+		MissedBlocksCounter: 25,
+		Tombstoned:          true,
+	}
+
+	return ValidatorSigningInfoICQCallbackTestCase{
+		query: icqtypes.Query{
+			ChainId: HostChainId,
+			Request: slashingtypes.ValidatorSigningInfoKey(consAddresses[1]),
+		},
+		callbackArgs: s.App.RecordsKeeper.Cdc.MustMarshal(&signingInfo),
+	}
+}
+
+func (s *KeeperTestSuite) TestValidatorSigningInfoCallback_Successful() {
+	tc := s.SetupValidatorSigningInfoICQCallback()
+
+	err := stakeibckeeper.ValidatorSigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, tc.callbackArgs, tc.query)
+	s.Require().NoError(err, "validator signing info callback error")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+
+	// Only the queried validator should be updated
+	for i, validator := range hostZone.Validators {
+		if i == 1 {
+			s.Require().Equal(int64(25), validator.Metrics.MissedBlocksCounter, "missed blocks for queried validator")
+			s.Require().True(validator.Metrics.Tombstoned, "tombstoned for queried validator")
+		} else {
+			s.Require().Zero(validator.Metrics.MissedBlocksCounter, "missed blocks for validator %d", i)
+			s.Require().False(validator.Metrics.Tombstoned, "tombstoned for validator %d", i)
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestValidatorSigningInfoCallback_HostZoneNotFound() {
+	tc := s.SetupValidatorSigningInfoICQCallback()
+
+	badQuery := tc.query
+	badQuery.ChainId = "fake_host_zone"
+
+	err := stakeibckeeper.ValidatorSigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, tc.callbackArgs, badQuery)
+	s.Require().ErrorContains(err, "no registered zone for queried chain ID (fake_host_zone)")
+}
+
+func (s *KeeperTestSuite) TestValidatorSigningInfoCallback_InvalidCallbackArgs() {
+	tc := s.SetupValidatorSigningInfoICQCallback()
+
+	err := stakeibckeeper.ValidatorSigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, []byte("random bytes"), tc.query)
+	s.Require().ErrorContains(err, "unable to unmarshal query response into ValidatorSigningInfo type")
+}
+
+func (s *KeeperTestSuite) TestValidatorSigningInfoCallback_ValidatorNotFound() {
+	tc := s.SetupValidatorSigningInfoICQCallback()
+
+	// Query a consensus address that doesn't belong to any of the validators
+	badQuery := tc.query
+	badQuery.Request = slashingtypes.ValidatorSigningInfoKey(sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()))
+
+	err := stakeibckeeper.ValidatorSigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, tc.callbackArgs, badQuery)
+	s.Require().ErrorContains(err, "no registered validator for consensus address")
+}
@@ -36,6 +36,13 @@ func WithdrawalBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icq
 		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "no registered zone for queried chain ID (%s)", chainId)
 	}
 
+	// The query is re-submitted periodically, so ignore responses for halted host zones
+	if hostZone.Halted {
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_WithdrawalBalance,
+			"Host zone is halted, skipping withdrawal balance callback"))
+		return nil
+	}
+
 	// Unmarshal the query response args to determine the balance
 	withdrawalBalanceAmount, err := icqkeeper.UnmarshalAmountFromBalanceQuery(k.cdc, args)
 	if err != nil {
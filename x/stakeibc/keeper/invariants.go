@@ -1,20 +1,183 @@
 package keeper
 
-// DONTCOVER
-
 import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	recordstypes "github.com/Stride-Labs/stride/v10/x/records/types"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
 )
 
-// RegisterInvariants registers all governance invariants.
+// RegisterInvariants registers all stakeibc invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "redemption-rate", RedemptionRateInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "staked-balance", StakedBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "sttoken-supply", StTokenSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "deposit-records", DepositRecordsInvariant(k))
 }
 
 // AllInvariants runs all invariants of the stakeibc module
 func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		// msg, broke := RedemptionRateInvariant(k)(ctx)
-		// note: once we have >1 invariant here, follow the pattern from staking module invariants here: https://github.com/cosmos/cosmos-sdk/blob/v0.46.0/x/staking/keeper/invariants.go
-		return "", false
+		res, stop := RedemptionRateInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = StakedBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = StTokenSupplyInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return DepositRecordsInvariant(k)(ctx)
+	}
+}
+
+// Checks that the redemption rate of each active host zone is within the safety bounds
+// Halted host zones are excluded since they've already been flagged in the BeginBlocker
+func RedemptionRateInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+			if _, err := k.IsRedemptionRateWithinSafetyBounds(ctx, hostZone); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\thost zone %s redemption rate %v is outside the safety bounds\n",
+					hostZone.ChainId, hostZone.RedemptionRate)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "redemption rate",
+			fmt.Sprintf("found host zones with a redemption rate outside the safety bounds\n%s", msg)), broken
+	}
+}
+
+// Checks that the staked balance on each host zone equals the sum of the delegations across its validators
+func StakedBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, hostZone := range k.GetAllHostZone(ctx) {
+			totalDelegations := sdkmath.ZeroInt()
+			for _, validator := range hostZone.Validators {
+				if validator.DelegationAmt.IsNegative() {
+					broken = true
+					msg += fmt.Sprintf("\thost zone %s validator %s has a negative delegation %v\n",
+						hostZone.ChainId, validator.Address, validator.DelegationAmt)
+				}
+				totalDelegations = totalDelegations.Add(validator.DelegationAmt)
+			}
+
+			if !hostZone.StakedBal.Equal(totalDelegations) {
+				broken = true
+				msg += fmt.Sprintf("\thost zone %s staked balance %v does not equal the sum of validator delegations %v\n",
+					hostZone.ChainId, hostZone.StakedBal, totalDelegations)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "staked balance",
+			fmt.Sprintf("found host zones with an inconsistent staked balance\n%s", msg)), broken
+	}
+}
+
+// Checks that the stTokens escrowed by redemptions are still backed
+// Redeemed stTokens are held in the host zone account until the undelegation succeeds, at which point they're burned
+// As a result, the escrow account must hold at least the stTokens from each unbonding that has not yet been burned,
+// and that escrowed amount can never exceed the total supply (i.e. the circulating supply is non-negative)
+func StTokenSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		// Sum the stTokens that are pending a burn for each host zone
+		pendingBurnByHostZone := map[string]sdkmath.Int{}
+		for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+			for _, hostZoneUnbonding := range epochUnbondingRecord.HostZoneUnbondings {
+				if hostZoneUnbonding.StTokenAmount.IsNegative() {
+					broken = true
+					msg += fmt.Sprintf("\thost zone %s unbonding for epoch %d has a negative stToken amount %v\n",
+						hostZoneUnbonding.HostZoneId, epochUnbondingRecord.EpochNumber, hostZoneUnbonding.StTokenAmount)
+					continue
+				}
+				if hostZoneUnbonding.Status != recordstypes.HostZoneUnbonding_UNBONDING_QUEUE &&
+					hostZoneUnbonding.Status != recordstypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS {
+					continue
+				}
+				pendingBurn, ok := pendingBurnByHostZone[hostZoneUnbonding.HostZoneId]
+				if !ok {
+					pendingBurn = sdkmath.ZeroInt()
+				}
+				pendingBurnByHostZone[hostZoneUnbonding.HostZoneId] = pendingBurn.Add(hostZoneUnbonding.StTokenAmount)
+			}
+		}
+
+		for _, hostZone := range k.GetAllHostZone(ctx) {
+			pendingBurn, ok := pendingBurnByHostZone[hostZone.ChainId]
+			if !ok || pendingBurn.IsZero() {
+				continue
+			}
+
+			stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+			supply := k.bankKeeper.GetSupply(ctx, stDenom).Amount
+
+			escrowAddress, err := sdk.AccAddressFromBech32(hostZone.Address)
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\thost zone %s has %v%s pending a burn but an invalid escrow address %s\n",
+					hostZone.ChainId, pendingBurn, stDenom, hostZone.Address)
+				continue
+			}
+			escrowed := k.bankKeeper.GetBalance(ctx, escrowAddress, stDenom).Amount
+
+			if escrowed.LT(pendingBurn) {
+				broken = true
+				msg += fmt.Sprintf("\thost zone %s escrow balance %v%s is less than the amount pending a burn %v%s\n",
+					hostZone.ChainId, escrowed, stDenom, pendingBurn, stDenom)
+			}
+			if escrowed.GT(supply) {
+				broken = true
+				msg += fmt.Sprintf("\thost zone %s escrow balance %v%s is greater than the total supply %v%s\n",
+					hostZone.ChainId, escrowed, stDenom, supply, stDenom)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "stToken supply",
+			fmt.Sprintf("found host zones with unbacked stToken redemptions\n%s", msg)), broken
+	}
+}
+
+// Checks that no deposit record holds a negative amount
+func DepositRecordsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, depositRecord := range k.RecordsKeeper.GetAllDepositRecord(ctx) {
+			if depositRecord.Amount.IsNegative() {
+				broken = true
+				msg += fmt.Sprintf("\tdeposit record %d for host zone %s has a negative amount %v\n",
+					depositRecord.Id, depositRecord.HostZoneId, depositRecord.Amount)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "deposit records",
+			fmt.Sprintf("found deposit records with a negative amount\n%s", msg)), broken
 	}
 }
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	recordtypes "github.com/Stride-Labs/stride/v10/x/records/types"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) SetupInvariants() stakeibctypes.HostZone {
+	zoneAddress := stakeibctypes.NewZoneAddress(HostChainId)
+
+	// Host zone with a consistent staked balance and redemption rate
+	hostZone := stakeibctypes.HostZone{
+		ChainId:           HostChainId,
+		HostDenom:         Atom,
+		Address:           zoneAddress.String(),
+		RedemptionRate:    sdk.OneDec(),
+		MinRedemptionRate: sdk.MustNewDecFromStr("0.9"),
+		MaxRedemptionRate: sdk.MustNewDecFromStr("1.5"),
+		StakedBal:         sdkmath.NewInt(300),
+		Validators: []*stakeibctypes.Validator{
+			{Address: "val1", DelegationAmt: sdkmath.NewInt(100)},
+			{Address: "val2", DelegationAmt: sdkmath.NewInt(200)},
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// Escrow 100 stTokens from a redemption that's pending a burn
+	s.FundAccount(zoneAddress, sdk.NewInt64Coin(StAtom, 100))
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: 1,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{
+				HostZoneId:        HostChainId,
+				StTokenAmount:     sdkmath.NewInt(100),
+				NativeTokenAmount: sdkmath.NewInt(100),
+				Status:            recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+			},
+		},
+	})
+
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordtypes.DepositRecord{
+		Id:         1,
+		HostZoneId: HostChainId,
+		Amount:     sdkmath.NewInt(1000),
+	})
+
+	return hostZone
+}
+
+func (s *KeeperTestSuite) TestAllInvariants_Successful() {
+	s.SetupInvariants()
+
+	_, broken := keeper.AllInvariants(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, "no invariant should be broken")
+}
+
+func (s *KeeperTestSuite) TestRedemptionRateInvariant() {
+	hostZone := s.SetupInvariants()
+
+	_, broken := keeper.RedemptionRateInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, "redemption rate invariant should not be broken")
+
+	// Halted host zones are ignored
+	hostZone.RedemptionRate = sdk.MustNewDecFromStr("2.0")
+	hostZone.Halted = true
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, broken = keeper.RedemptionRateInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, "redemption rate invariant should not be broken for halted zone")
+
+	// Active host zones with a rate outside the bounds break the invariant
+	hostZone.Halted = false
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	msg, broken := keeper.RedemptionRateInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().True(broken, "redemption rate invariant should be broken")
+	s.Require().Contains(msg, "host zone GAIA redemption rate 2.000000000000000000 is outside the safety bounds")
+}
+
+func (s *KeeperTestSuite) TestStakedBalanceInvariant() {
+	hostZone := s.SetupInvariants()
+
+	_, broken := keeper.StakedBalanceInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, "staked balance invariant should not be broken")
+
+	// Update a delegation without updating the staked balance
+	hostZone.Validators[0].DelegationAmt = sdkmath.NewInt(150)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	msg, broken := keeper.StakedBalanceInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().True(broken, "staked balance invariant should be broken")
+	s.Require().Contains(msg, "host zone GAIA staked balance 300 does not equal the sum of validator delegations 350")
+}
+
+func (s *KeeperTestSuite) TestStTokenSupplyInvariant() {
+	hostZone := s.SetupInvariants()
+
+	_, broken := keeper.StTokenSupplyInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, "stToken supply invariant should not be broken")
+
+	// Unbondings that have already been burned are not included
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: 2,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{
+				HostZoneId:        HostChainId,
+				StTokenAmount:     sdkmath.NewInt(500),
+				NativeTokenAmount: sdkmath.NewInt(500),
+				Status:            recordtypes.HostZoneUnbonding_CLAIMABLE,
+			},
+		},
+	})
+
+	_, broken = keeper.StTokenSupplyInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, "stToken supply invariant should not be broken after burn")
+
+	// Remove escrowed tokens from the host zone account
+	zoneAddress := sdk.MustAccAddressFromBech32(hostZone.Address)
+	err := s.App.BankKeeper.SendCoins(s.Ctx, zoneAddress, s.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin(StAtom, 50)))
+	s.Require().NoError(err)
+
+	msg, broken := keeper.StTokenSupplyInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().True(broken, "stToken supply invariant should be broken")
+	s.Require().Contains(msg, "host zone GAIA escrow balance 50stuatom is less than the amount pending a burn 100stuatom")
+}
+
+func (s *KeeperTestSuite) TestDepositRecordsInvariant() {
+	s.SetupInvariants()
+
+	_, broken := keeper.DepositRecordsInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, "deposit records invariant should not be broken")
+
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordtypes.DepositRecord{
+		Id:         2,
+		HostZoneId: HostChainId,
+		Amount:     sdkmath.NewInt(-1),
+	})
+
+	msg, broken := keeper.DepositRecordsInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().True(broken, "deposit records invariant should be broken")
+	s.Require().Contains(msg, "deposit record 2 for host zone GAIA has a negative amount -1")
+}
@@ -45,6 +45,7 @@ type (
 		hooks                 types.StakeIBCHooks
 		AccountKeeper         types.AccountKeeper
 		RatelimitKeeper       types.RatelimitKeeper
+		authority             string
 	}
 )
 
@@ -62,6 +63,7 @@ func NewKeeper(
 	StakingKeeper stakingkeeper.Keeper,
 	ICACallbacksKeeper icacallbackskeeper.Keeper,
 	RatelimitKeeper types.RatelimitKeeper,
+	authority string,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -82,9 +84,15 @@ func NewKeeper(
 		StakingKeeper:         StakingKeeper,
 		ICACallbacksKeeper:    ICACallbacksKeeper,
 		RatelimitKeeper:       RatelimitKeeper,
+		authority:             authority,
 	}
 }
 
+// GetAuthority returns the address that's permitted to execute governance-only messages
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -246,22 +254,30 @@ func (k Keeper) GetICATimeoutNanos(ctx sdk.Context, epochType string) (uint64, e
 	return timeoutNanosUint64, nil
 }
 
-// safety check: ensure the redemption rate is NOT below our min safety threshold && NOT above our max safety threshold on host zone
-func (k Keeper) IsRedemptionRateWithinSafetyBounds(ctx sdk.Context, zone types.HostZone) (bool, error) {
+// Returns the redemption rate safety bounds of a host zone, falling back to the default
+// thresholds from the params for any bound that has not been set on the host zone
+func (k Keeper) GetRedemptionRateSafetyBounds(ctx sdk.Context, zone types.HostZone) (minSafetyThreshold sdk.Dec, maxSafetyThreshold sdk.Dec) {
 	minSafetyThresholdInt := k.GetParam(ctx, types.KeyDefaultMinRedemptionRateThreshold)
-	minSafetyThreshold := sdk.NewDec(int64(minSafetyThresholdInt)).Quo(sdk.NewDec(100))
+	minSafetyThreshold = sdk.NewDec(int64(minSafetyThresholdInt)).Quo(sdk.NewDec(100))
 
 	if !zone.MinRedemptionRate.IsNil() && zone.MinRedemptionRate.IsPositive() {
 		minSafetyThreshold = zone.MinRedemptionRate
 	}
 
 	maxSafetyThresholdInt := k.GetParam(ctx, types.KeyDefaultMaxRedemptionRateThreshold)
-	maxSafetyThreshold := sdk.NewDec(int64(maxSafetyThresholdInt)).Quo(sdk.NewDec(100))
+	maxSafetyThreshold = sdk.NewDec(int64(maxSafetyThresholdInt)).Quo(sdk.NewDec(100))
 
 	if !zone.MaxRedemptionRate.IsNil() && zone.MaxRedemptionRate.IsPositive() {
 		maxSafetyThreshold = zone.MaxRedemptionRate
 	}
 
+	return minSafetyThreshold, maxSafetyThreshold
+}
+
+// safety check: ensure the redemption rate is NOT below our min safety threshold && NOT above our max safety threshold on host zone
+func (k Keeper) IsRedemptionRateWithinSafetyBounds(ctx sdk.Context, zone types.HostZone) (bool, error) {
+	minSafetyThreshold, maxSafetyThreshold := k.GetRedemptionRateSafetyBounds(ctx, zone)
+
 	redemptionRate := zone.RedemptionRate
 
 	if redemptionRate.LT(minSafetyThreshold) || redemptionRate.GT(maxSafetyThreshold) {
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// Redeems stTokens from multiple host zones in a single message
+// Each redemption is processed the same way as a RedeemStake, and if any redemption fails, the whole batch is reverted
+func (k msgServer) BatchRedeemStake(goCtx context.Context, msg *types.MsgBatchRedeemStake) (*types.MsgBatchRedeemStakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.Logger(ctx).Info(fmt.Sprintf("batch redeem stake: %s", msg.String()))
+
+	sender, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "creator address is invalid: %s. err: %s", msg.Creator, err.Error())
+	}
+
+	for i, redemption := range msg.Redemptions {
+		if _, _, err := k.RedeemStakeFromHostZone(ctx, sender, redemption.Amount, redemption.HostZone, redemption.Receiver, sdkmath.ZeroInt()); err != nil {
+			return nil, errorsmod.Wrapf(err, "unable to process redemption %d from host zone %s", i, redemption.HostZone)
+		}
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("executed batch redeem stake: %s", msg.String()))
+	return &types.MsgBatchRedeemStakeResponse{}, nil
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	recordtypes "github.com/Stride-Labs/stride/v10/x/records/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+const (
+	osmoReceiverAddr  = "osmo1g6qdx6kdhpf000afvvpte7hp0vnpzapuvajh2m"
+	stOsmoDenom       = "stuosmo"
+	osmoHostZoneDenom = "uosmo"
+)
+
+type BatchRedeemStakeTestCase struct {
+	redeemTestCase RedeemStakeTestCase
+	validMsg       stakeibctypes.MsgBatchRedeemStake
+}
+
+// Extends the RedeemStake setup with a second host zone, OSMO, so that stATOM and stOSMO can be redeemed together
+func (s *KeeperTestSuite) SetupBatchRedeemStake() BatchRedeemStakeTestCase {
+	tc := s.SetupRedeemStake()
+
+	s.FundAccount(tc.user.acc, sdk.NewInt64Coin(stOsmoDenom, 10_000_000))
+
+	osmoZoneAddress := stakeibctypes.NewZoneAddress(OsmoChainId)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+		ChainId:        OsmoChainId,
+		HostDenom:      osmoHostZoneDenom,
+		Bech32Prefix:   "osmo",
+		RedemptionRate: sdk.MustNewDecFromStr("1.5"),
+		StakedBal:      sdkmath.NewInt(1234567890),
+		Address:        osmoZoneAddress.String(),
+	})
+
+	epochUnbondingRecord, found := s.App.RecordsKeeper.GetEpochUnbondingRecord(s.Ctx, tc.initialState.epochNumber)
+	s.Require().True(found, "epoch unbonding record")
+	epochUnbondingRecord.HostZoneUnbondings = append(epochUnbondingRecord.HostZoneUnbondings, &recordtypes.HostZoneUnbonding{
+		NativeTokenAmount: sdkmath.ZeroInt(),
+		StTokenAmount:     sdkmath.ZeroInt(),
+		Denom:             osmoHostZoneDenom,
+		HostZoneId:        OsmoChainId,
+		Status:            recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+	})
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, epochUnbondingRecord)
+
+	return BatchRedeemStakeTestCase{
+		redeemTestCase: tc,
+		validMsg: stakeibctypes.MsgBatchRedeemStake{
+			Creator: tc.user.acc.String(),
+			Redemptions: []stakeibctypes.BatchRedemption{
+				{Amount: sdkmath.NewInt(1_000_000), HostZone: HostChainId, Receiver: tc.validMsg.Receiver},
+				{Amount: sdkmath.NewInt(2_000_000), HostZone: OsmoChainId, Receiver: osmoReceiverAddr},
+			},
+		},
+	}
+}
+
+func (s *KeeperTestSuite) TestBatchRedeemStake_Successful() {
+	tc := s.SetupBatchRedeemStake()
+	user := tc.redeemTestCase.user
+	epochNumber := tc.redeemTestCase.initialState.epochNumber
+
+	_, err := s.GetMsgServer().BatchRedeemStake(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().NoError(err, "no error expected during batch redeem")
+
+	// Both stTokens should have been escrowed
+	s.CompareCoins(sdk.NewInt64Coin("stuatom", 9_000_000), s.App.BankKeeper.GetBalance(s.Ctx, user.acc, "stuatom"), "user stuatom balance")
+	s.CompareCoins(sdk.NewInt64Coin(stOsmoDenom, 8_000_000), s.App.BankKeeper.GetBalance(s.Ctx, user.acc, stOsmoDenom), "user stuosmo balance")
+
+	// Each host zone unbonding should track its own stToken and native amounts
+	// GAIA has a redemption rate of 1 and OSMO has a redemption rate of 1.5
+	expectedUnbondings := []struct {
+		chainId       string
+		stTokenAmount sdkmath.Int
+		nativeAmount  sdkmath.Int
+		receiver      string
+	}{
+		{chainId: HostChainId, stTokenAmount: sdkmath.NewInt(1_000_000), nativeAmount: sdkmath.NewInt(1_000_000), receiver: tc.redeemTestCase.validMsg.Receiver},
+		{chainId: OsmoChainId, stTokenAmount: sdkmath.NewInt(2_000_000), nativeAmount: sdkmath.NewInt(3_000_000), receiver: osmoReceiverAddr},
+	}
+	for _, expected := range expectedUnbondings {
+		hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, epochNumber, expected.chainId)
+		s.Require().True(found, "%s host zone unbonding", expected.chainId)
+		s.Require().Equal(expected.stTokenAmount, hostZoneUnbonding.StTokenAmount, "%s stToken amount", expected.chainId)
+		s.Require().Equal(expected.nativeAmount, hostZoneUnbonding.NativeTokenAmount, "%s native amount", expected.chainId)
+		s.Require().Len(hostZoneUnbonding.UserRedemptionRecords, 1, "%s number of redemption records", expected.chainId)
+
+		userRedemptionRecordId := recordtypes.UserRedemptionRecordKeyFormatter(expected.chainId, epochNumber, user.acc.String())
+		s.Require().Equal(userRedemptionRecordId, hostZoneUnbonding.UserRedemptionRecords[0], "%s redemption record id", expected.chainId)
+
+		userRedemptionRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, userRedemptionRecordId)
+		s.Require().True(found, "%s redemption record", expected.chainId)
+		s.Require().Equal(expected.nativeAmount, userRedemptionRecord.Amount, "%s redemption record amount", expected.chainId)
+		s.Require().Equal(expected.receiver, userRedemptionRecord.Receiver, "%s redemption record receiver", expected.chainId)
+	}
+}
+
+func (s *KeeperTestSuite) TestBatchRedeemStake_SameHostZoneTwice() {
+	tc := s.SetupBatchRedeemStake()
+	epochNumber := tc.redeemTestCase.initialState.epochNumber
+
+	// Two redemptions from the same host zone should accumulate into a single record
+	msg := tc.validMsg
+	msg.Redemptions = []stakeibctypes.BatchRedemption{
+		{Amount: sdkmath.NewInt(1_000_000), HostZone: OsmoChainId, Receiver: osmoReceiverAddr},
+		{Amount: sdkmath.NewInt(3_000_000), HostZone: OsmoChainId, Receiver: osmoReceiverAddr},
+	}
+	_, err := s.GetMsgServer().BatchRedeemStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected during batch redeem")
+
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, epochNumber, OsmoChainId)
+	s.Require().True(found, "host zone unbonding")
+	s.Require().Equal(sdkmath.NewInt(4_000_000), hostZoneUnbonding.StTokenAmount, "stToken amount")
+	s.Require().Equal(sdkmath.NewInt(6_000_000), hostZoneUnbonding.NativeTokenAmount, "native amount")
+	s.Require().Len(hostZoneUnbonding.UserRedemptionRecords, 1, "number of redemption records")
+}
+
+func (s *KeeperTestSuite) TestBatchRedeemStake_OneRedemptionFails() {
+	tc := s.SetupBatchRedeemStake()
+	user := tc.redeemTestCase.user
+	epochNumber := tc.redeemTestCase.initialState.epochNumber
+
+	// Halt the OSMO host zone so that the second redemption fails
+	osmoHostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, OsmoChainId)
+	s.Require().True(found, "osmo host zone")
+	osmoHostZone.Halted = true
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, osmoHostZone)
+
+	// Process the batch in a cached context (mimicking tx execution) so the failed msg's state changes are discarded
+	cacheCtx, _ := s.Ctx.CacheContext()
+	_, err := s.GetMsgServer().BatchRedeemStake(sdk.WrapSDKContext(cacheCtx), &tc.validMsg)
+	s.Require().ErrorContains(err, "unable to process redemption 1 from host zone OSMO")
+	s.Require().ErrorContains(err, "halted host zone found for zone (OSMO)")
+
+	// Neither redemption should have been applied
+	s.CompareCoins(user.stAtomBalance, s.App.BankKeeper.GetBalance(s.Ctx, user.acc, "stuatom"), "user stuatom balance")
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, epochNumber, HostChainId)
+	s.Require().True(found, "host zone unbonding")
+	s.Require().Empty(hostZoneUnbonding.UserRedemptionRecords, "no redemption records should be added")
+}
@@ -19,6 +19,12 @@ func (k msgServer) ChangeValidatorWeight(goCtx context.Context, msg *types.MsgCh
 		return nil, types.ErrInvalidHostZone
 	}
 
+	// Weights can only be set manually when they're not being set automatically by the selection strategy
+	if hostZone.ValidatorSelectionStrategy != types.ValidatorSelectionStrategy_STATIC {
+		return nil, errorsmod.Wrapf(types.ErrWeightsManagedByStrategy,
+			"host zone %s uses the %s strategy", hostZone.ChainId, hostZone.ValidatorSelectionStrategy)
+	}
+
 	validators := hostZone.Validators
 	for _, validator := range validators {
 		if validator.GetAddress() == msg.ValAddr {
@@ -57,7 +57,7 @@ func (k msgServer) ClaimUndelegatedTokens(goCtx context.Context, msg *types.MsgC
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "unable to marshal claim callback args")
 	}
-	_, err = k.SubmitTxs(ctx, icaTx.ConnectionId, icaTx.Msgs, icaTx.Account, icaTx.Timeout, ICACallbackID_Claim, marshalledCallbackArgs)
+	sequence, err := k.SubmitTxs(ctx, icaTx.ConnectionId, icaTx.Msgs, icaTx.Account, icaTx.Timeout, ICACallbackID_Claim, marshalledCallbackArgs)
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("Submit tx error: %s", err.Error()))
 		return nil, errorsmod.Wrap(err, "unable to submit ICA redemption tx")
@@ -67,7 +67,7 @@ func (k msgServer) ClaimUndelegatedTokens(goCtx context.Context, msg *types.MsgC
 	userRedemptionRecord.ClaimIsPending = true
 	k.RecordsKeeper.SetUserRedemptionRecord(ctx, *userRedemptionRecord)
 
-	return &types.MsgClaimUndelegatedTokensResponse{}, nil
+	return &types.MsgClaimUndelegatedTokensResponse{Sequence: sequence}, nil
 }
 
 func (k Keeper) GetClaimableRedemptionRecord(ctx sdk.Context, msg *types.MsgClaimUndelegatedTokens) (*recordstypes.UserRedemptionRecord, error) {
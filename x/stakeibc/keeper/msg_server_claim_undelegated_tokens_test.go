@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	proto "github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 	_ "github.com/stretchr/testify/suite"
 
@@ -24,14 +25,16 @@ type ClaimUndelegatedState struct {
 }
 
 type ClaimUndelegatedTestCase struct {
-	validMsg       stakeibctypes.MsgClaimUndelegatedTokens
-	initialState   ClaimUndelegatedState
-	expectedIcaMsg stakeibckeeper.IcaTx
+	validMsg            stakeibctypes.MsgClaimUndelegatedTokens
+	initialState        ClaimUndelegatedState
+	expectedIcaMsg      stakeibckeeper.IcaTx
+	redemptionPortId    string
+	redemptionChannelId string
 }
 
 func (s *KeeperTestSuite) SetupClaimUndelegatedTokens() ClaimUndelegatedTestCase {
 	redemptionIcaOwner := "GAIA.REDEMPTION"
-	s.CreateICAChannel(redemptionIcaOwner)
+	redemptionChannelId := s.CreateICAChannel(redemptionIcaOwner)
 
 	epochNumber := uint64(1)
 	senderAddr := "stride_SENDER"
@@ -104,6 +107,8 @@ func (s *KeeperTestSuite) SetupClaimUndelegatedTokens() ClaimUndelegatedTestCase
 			Account: redemptionAccount,
 			Timeout: uint64(stakeibctypes.DefaultICATimeoutNanos),
 		},
+		redemptionPortId:    icatypes.ControllerPortPrefix + redemptionIcaOwner,
+		redemptionChannelId: redemptionChannelId,
 	}
 }
 
@@ -112,8 +117,12 @@ func (s *KeeperTestSuite) TestClaimUndelegatedTokens_Successful() {
 	redemptionRecordId := tc.initialState.redemptionRecordId
 	expectedRedemptionRecord := tc.initialState.redemptionRecord
 
-	_, err := s.GetMsgServer().ClaimUndelegatedTokens(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.redemptionPortId, tc.redemptionChannelId)
+	s.Require().True(found, "sequence number not found before claim")
+
+	res, err := s.GetMsgServer().ClaimUndelegatedTokens(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
 	s.Require().NoError(err, "claim undelegated tokens")
+	s.Require().Equal(startSequence, res.Sequence, "ICA sequence in response")
 
 	actualRedemptionRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, redemptionRecordId)
 	s.Require().True(found, "redemption record found")
@@ -35,6 +35,11 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 		return nil, errorsmod.Wrapf(types.ErrHaltedHostZone, "halted host zone found for denom (%s)", msg.HostDenom)
 	}
 
+	// Liquid stakes are closed once a host zone begins sunsetting
+	if _, sunset := k.GetActiveHostZoneSunset(ctx, hostZone.ChainId); sunset {
+		return nil, errorsmod.Wrapf(types.ErrHostZoneSunset, "host zone %s is being sunset", hostZone.ChainId)
+	}
+
 	// Get user and module account addresses
 	liquidStakerAddress, err := sdk.AccAddressFromBech32(msg.Creator)
 	if err != nil {
@@ -81,6 +86,12 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 			"Liquid stake of %s%s would return 0 stTokens", msg.Amount.String(), hostZone.HostDenom)
 	}
 
+	// Abort if the redemption rate moved such that the user would receive fewer stTokens than they accepted
+	if msg.MinStTokenOut != nil && stAmount.LT(*msg.MinStTokenOut) {
+		return nil, errorsmod.Wrapf(types.ErrMinOutputNotMet,
+			"liquid stake would mint %v st%s, which is less than the minimum of %v", stAmount, hostZone.HostDenom, *msg.MinStTokenOut)
+	}
+
 	// Transfer the native tokens from the user to module account
 	if err := k.bankKeeper.SendCoins(ctx, liquidStakerAddress, hostZoneAddress, sdk.NewCoins(nativeCoin)); err != nil {
 		return nil, errorsmod.Wrap(err, "failed to send tokens from Account to Module")
@@ -115,5 +126,5 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 	)
 
 	k.hooks.AfterLiquidStake(ctx, liquidStakerAddress)
-	return &types.MsgLiquidStakeResponse{}, nil
+	return &types.MsgLiquidStakeResponse{StToken: stCoin}, nil
 }
@@ -97,8 +97,9 @@ func (s *KeeperTestSuite) TestLiquidStake_Successful() {
 	msg := tc.validMsg
 	initialStAtomSupply := s.App.BankKeeper.GetSupply(s.Ctx, StAtom)
 
-	_, err := s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	res, err := s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(s.Ctx), &msg)
 	s.Require().NoError(err)
+	s.CompareCoins(sdk.NewCoin(StAtom, msg.Amount), res.StToken, "minted stTokens in response")
 
 	// Confirm balances
 	// User IBC/UATOM balance should have DECREASED by the size of the stake
@@ -275,6 +276,33 @@ func (s *KeeperTestSuite) TestLiquidStake_ZeroStTokens() {
 	s.Require().EqualError(err, "Liquid stake of 1uatom would return 0 stTokens: Liquid staked amount is too small")
 }
 
+func (s *KeeperTestSuite) TestLiquidStake_MinStTokenOut() {
+	tc := s.SetupLiquidStake()
+
+	// With a redemption rate of 1.1, 1,000,000 tokens mints 909,090 stTokens
+	hostZone := tc.initialState.hostZone
+	hostZone.RedemptionRate = sdk.NewDecWithPrec(11, 1)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	tc.validMsg.Amount = sdkmath.NewInt(1_000_000)
+	expectedStAmount := sdkmath.NewInt(909_090)
+
+	// The liquid stake should fail if the minimum is above the minted amount
+	minStTokenOut := expectedStAmount.Add(sdkmath.OneInt())
+	tc.validMsg.MinStTokenOut = &minStTokenOut
+	_, err := s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().ErrorIs(err, stakeibctypes.ErrMinOutputNotMet)
+	s.Require().ErrorContains(err, "liquid stake would mint 909090 stuatom, which is less than the minimum of 909091")
+
+	// And succeed if the minimum is met exactly
+	tc.validMsg.MinStTokenOut = &expectedStAmount
+	res, err := s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().NoError(err, "no error expected when min st token out is met")
+	s.CompareCoins(sdk.NewCoin(StAtom, expectedStAmount), res.StToken, "minted stTokens in response")
+
+	actualStAtomBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.user.acc, StAtom)
+	s.CompareCoins(tc.user.stAtomBalance.AddAmount(expectedStAmount), actualStAtomBalance, "user stuatom balance")
+}
+
 func (s *KeeperTestSuite) TestLiquidStake_InsufficientBalance() {
 	tc := s.SetupLiquidStake()
 	// Set liquid stake amount to value greater than account balance
@@ -128,7 +128,7 @@ func (s *KeeperTestSuite) TestRebalanceValidators_Successful() {
 		HostZone:     "GAIA",
 		NumRebalance: 2,
 	}
-	_, err := s.GetMsgServer().RebalanceValidators(sdk.WrapSDKContext(s.Ctx), &badMsg_rightWeights)
+	res, err := s.GetMsgServer().RebalanceValidators(sdk.WrapSDKContext(s.Ctx), &badMsg_rightWeights)
 	s.Require().NoError(err, "rebalancing with 2 validators should succeed")
 
 	// get stored callback data
@@ -150,6 +150,9 @@ func (s *KeeperTestSuite) TestRebalanceValidators_Successful() {
 	s.Require().Equal(sdkmath.NewInt(13), secondRebal.Amt, "second rebalance should rebalance 13 ATOM")
 	s.Require().Equal("stride_VAL1", secondRebal.DstValidator, "second rebalance moves to val1")
 	s.Require().Equal("stride_VAL4", secondRebal.SrcValidator, "second rebalance takes from val4")
+
+	// the response should include the same redelegations
+	s.Require().Equal(callbackArgs.Rebalancings, res.Redelegations, "redelegations in response")
 }
 
 func (s *KeeperTestSuite) TestRebalanceValidators_InvalidNoValidators() {
@@ -8,6 +8,7 @@ import (
 	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -23,74 +24,114 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 	if err != nil {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "creator address is invalid: %s. err: %s", msg.Creator, err.Error())
 	}
+
+	minNativeOut := sdkmath.ZeroInt()
+	if msg.MinNativeOut != nil {
+		minNativeOut = *msg.MinNativeOut
+	}
+
+	redemptionId, nativeAmount, err := k.RedeemStakeFromHostZone(ctx, sender, msg.Amount, msg.HostZone, msg.Receiver, minNativeOut)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("executed redeem stake: %s", msg.String()))
+	return &types.MsgRedeemStakeResponse{NativeAmount: nativeAmount, UserRedemptionRecordId: redemptionId}, nil
+}
+
+// Escrows the sender's stTokens and records the redemption on the current day epoch's unbonding record
+// If the sender already redeemed from the host zone this epoch, the amount is added to their existing
+// user redemption record (the receiver must match the existing record)
+// The redemption fails if fewer than minNativeOut native tokens would be redeemed
+// Returns the id of the user redemption record and the number of native tokens redeemed
+func (k Keeper) RedeemStakeFromHostZone(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	amount sdkmath.Int,
+	hostZoneId string,
+	receiver string,
+	minNativeOut sdkmath.Int,
+) (string, sdkmath.Int, error) {
 	// then make sure host zone is valid
-	hostZone, found := k.GetHostZone(ctx, msg.HostZone)
+	hostZone, found := k.GetHostZone(ctx, hostZoneId)
 	if !found {
-		return nil, errorsmod.Wrapf(types.ErrInvalidHostZone, "host zone is invalid: %s", msg.HostZone)
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrInvalidHostZone, "host zone is invalid: %s", hostZoneId)
 	}
 
 	if hostZone.Halted {
-		k.Logger(ctx).Error(fmt.Sprintf("Host Zone halted for zone (%s)", msg.HostZone))
-		return nil, errorsmod.Wrapf(types.ErrHaltedHostZone, "halted host zone found for zone (%s)", msg.HostZone)
+		k.Logger(ctx).Error(fmt.Sprintf("Host Zone halted for zone (%s)", hostZoneId))
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrHaltedHostZone, "halted host zone found for zone (%s)", hostZoneId)
+	}
+
+	// Once a sunset host zone has begun unbonding, redemptions are paid out of the sunset unbonding
+	if k.IsHostZoneSunsetUnbonding(ctx, hostZoneId) {
+		return k.RedeemStakeFromSunsetHostZone(ctx, sender, amount, hostZone, receiver, minNativeOut)
 	}
 
 	// first construct a user redemption record
 	epochTracker, found := k.GetEpochTracker(ctx, "day")
 	if !found {
-		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "epoch tracker found: %s", "day")
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrEpochNotFound, "epoch tracker found: %s", "day")
 	}
 	senderAddr := sender.String()
 	redemptionId := recordstypes.UserRedemptionRecordKeyFormatter(hostZone.ChainId, epochTracker.EpochNumber, senderAddr)
-	_, found = k.RecordsKeeper.GetUserRedemptionRecord(ctx, redemptionId)
-	if found {
-		return nil, errorsmod.Wrapf(recordstypes.ErrRedemptionAlreadyExists, "user already redeemed this epoch: %s", redemptionId)
+	existingRedemptionRecord, redemptionRecordExists := k.RecordsKeeper.GetUserRedemptionRecord(ctx, redemptionId)
+	if redemptionRecordExists && existingRedemptionRecord.Receiver != receiver {
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(recordstypes.ErrRedemptionAlreadyExists,
+			"user already redeemed this epoch to a different receiver (%s): %s", existingRedemptionRecord.Receiver, redemptionId)
 	}
 
 	// ensure the recipient address is a valid bech32 address on the hostZone
 	// TODO(TEST-112) do we need to check the hostZone before this check? Would need access to keeper
-	_, err = utils.AccAddressFromBech32(msg.Receiver, hostZone.Bech32Prefix)
+	_, err := utils.AccAddressFromBech32(receiver, hostZone.Bech32Prefix)
 	if err != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
 	}
 
 	// construct desired unstaking amount from host zone
 	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
-	nativeAmount := sdk.NewDecFromInt(msg.Amount).Mul(hostZone.RedemptionRate).RoundInt()
+	nativeAmount := sdk.NewDecFromInt(amount).Mul(hostZone.RedemptionRate).RoundInt()
+
+	if nativeAmount.LT(minNativeOut) {
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrMinOutputNotMet,
+			"redemption would return %v%s, which is less than the minimum of %v", nativeAmount, hostZone.HostDenom, minNativeOut)
+	}
 
 	if nativeAmount.GT(hostZone.StakedBal) {
-		return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "cannot unstake an amount g.t. staked balance on host zone: %v", msg.Amount)
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrInvalidAmount, "cannot unstake an amount g.t. staked balance on host zone: %v", amount)
 	}
 
 	// safety check: redemption rate must be within safety bounds
 	rateIsSafe, err := k.IsRedemptionRateWithinSafetyBounds(ctx, hostZone)
 	if !rateIsSafe || (err != nil) {
 		errMsg := fmt.Sprintf("IsRedemptionRateWithinSafetyBounds check failed. hostZone: %s, err: %s", hostZone.String(), err.Error())
-		return nil, errorsmod.Wrapf(types.ErrRedemptionRateOutsideSafetyBounds, errMsg)
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrRedemptionRateOutsideSafetyBounds, errMsg)
 	}
 
 	// TODO(TEST-112) bigint safety
 	coinString := nativeAmount.String() + stDenom
 	inCoin, err := sdk.ParseCoinNormalized(coinString)
 	if err != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "could not parse inCoin: %s. err: %s", coinString, err.Error())
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "could not parse inCoin: %s. err: %s", coinString, err.Error())
 	}
 	// safety checks on the coin
 	// 	- Redemption amount must be positive
 	if !nativeAmount.IsPositive() {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be greater than 0. found: %v", msg.Amount)
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be greater than 0. found: %v", amount)
 	}
 	// 	- Creator owns at least "amount" stAssets
 	balance := k.bankKeeper.GetBalance(ctx, sender, stDenom)
 	k.Logger(ctx).Info(fmt.Sprintf("Redemption issuer IBCDenom balance: %v%s", balance.Amount, balance.Denom))
 	k.Logger(ctx).Info(fmt.Sprintf("Redemption requested redemotion amount: %v%s", inCoin.Amount, inCoin.Denom))
-	if balance.Amount.LT(msg.Amount) {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "balance is lower than redemption amount. redemption amount: %v, balance %v: ", msg.Amount, balance.Amount)
+	if balance.Amount.LT(amount) {
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "balance is lower than redemption amount. redemption amount: %v, balance %v: ", amount, balance.Amount)
 	}
 	// UNBONDING RECORD KEEPING
+	// If the user already redeemed this epoch, top up their existing record
 	userRedemptionRecord := recordstypes.UserRedemptionRecord{
 		Id:          redemptionId,
 		Sender:      senderAddr,
-		Receiver:    msg.Receiver,
+		Receiver:    receiver,
 		Amount:      nativeAmount,
 		Denom:       hostZone.HostDenom,
 		HostZoneId:  hostZone.ChainId,
@@ -99,34 +140,40 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 		// contingent on the host zone unbonding having status CLAIMABLE
 		ClaimIsPending: false,
 	}
+	if redemptionRecordExists {
+		userRedemptionRecord = existingRedemptionRecord
+		userRedemptionRecord.Amount = userRedemptionRecord.Amount.Add(nativeAmount)
+	}
 	// then add undelegation amount to epoch unbonding records
 	epochUnbondingRecord, found := k.RecordsKeeper.GetEpochUnbondingRecord(ctx, epochTracker.EpochNumber)
 	if !found {
 		k.Logger(ctx).Error("latest epoch unbonding record not found")
-		return nil, errorsmod.Wrapf(recordstypes.ErrEpochUnbondingRecordNotFound, "latest epoch unbonding record not found")
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(recordstypes.ErrEpochUnbondingRecordNotFound, "latest epoch unbonding record not found")
 	}
 	// get relevant host zone on this epoch unbonding record
 	hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, hostZone.ChainId)
 	if !found {
-		return nil, errorsmod.Wrapf(types.ErrInvalidHostZone, "host zone not found in unbondings: %s", hostZone.ChainId)
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrInvalidHostZone, "host zone not found in unbondings: %s", hostZone.ChainId)
 	}
 	hostZoneUnbonding.NativeTokenAmount = hostZoneUnbonding.NativeTokenAmount.Add(nativeAmount)
-	hostZoneUnbonding.UserRedemptionRecords = append(hostZoneUnbonding.UserRedemptionRecords, userRedemptionRecord.Id)
+	if !redemptionRecordExists {
+		hostZoneUnbonding.UserRedemptionRecords = append(hostZoneUnbonding.UserRedemptionRecords, userRedemptionRecord.Id)
+	}
 
 	// Escrow user's balance
-	redeemCoin := sdk.NewCoins(sdk.NewCoin(stDenom, msg.Amount))
+	redeemCoin := sdk.NewCoins(sdk.NewCoin(stDenom, amount))
 	bech32ZoneAddress, err := sdk.AccAddressFromBech32(hostZone.Address)
 	if err != nil {
-		return nil, fmt.Errorf("could not bech32 decode address %s of zone with id: %s", hostZone.Address, hostZone.ChainId)
+		return "", sdkmath.ZeroInt(), fmt.Errorf("could not bech32 decode address %s of zone with id: %s", hostZone.Address, hostZone.ChainId)
 	}
 	err = k.bankKeeper.SendCoins(ctx, sender, bech32ZoneAddress, redeemCoin)
 	if err != nil {
 		k.Logger(ctx).Error("Failed to send sdk.NewCoins(inCoins) from account to module")
-		return nil, errorsmod.Wrapf(types.ErrInsufficientFunds, "couldn't send %v derivative %s tokens to module account. err: %s", msg.Amount, hostZone.HostDenom, err.Error())
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrInsufficientFunds, "couldn't send %v derivative %s tokens to module account. err: %s", amount, hostZone.HostDenom, err.Error())
 	}
 
 	// record the number of stAssets that should be burned after unbonding
-	hostZoneUnbonding.StTokenAmount = hostZoneUnbonding.StTokenAmount.Add(msg.Amount)
+	hostZoneUnbonding.StTokenAmount = hostZoneUnbonding.StTokenAmount.Add(amount)
 
 	// Actually set the records, we wait until now to prevent any errors
 	k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
@@ -140,10 +187,9 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 	updatedEpochUnbondingRecord, success := k.RecordsKeeper.AddHostZoneToEpochUnbondingRecord(ctx, epochUnbondingRecord.EpochNumber, hostZone.ChainId, hostZoneUnbonding)
 	if !success {
 		k.Logger(ctx).Error(fmt.Sprintf("Failed to set host zone epoch unbonding record: epochNumber %d, chainId %s, hostZoneUnbonding %v", epochUnbondingRecord.EpochNumber, hostZone.ChainId, hostZoneUnbonding))
-		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "couldn't set host zone epoch unbonding record. err: %s", err.Error())
+		return "", sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrEpochNotFound, "couldn't set host zone epoch unbonding record for epoch %d", epochUnbondingRecord.EpochNumber)
 	}
 	k.RecordsKeeper.SetEpochUnbondingRecord(ctx, *updatedEpochUnbondingRecord)
 
-	k.Logger(ctx).Info(fmt.Sprintf("executed redeem stake: %s", msg.String()))
-	return &types.MsgRedeemStakeResponse{}, nil
+	return userRedemptionRecord.Id, nativeAmount, nil
 }
@@ -105,8 +105,11 @@ func (s *KeeperTestSuite) TestRedeemStake_Successful() {
 	redeemAmount := msg.Amount
 
 	// get the initial unbonding amount *before* calling liquid stake, so we can use it to calc expected vs actual in diff space
-	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	res, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &msg)
 	s.Require().NoError(err)
+	s.Require().Equal(redeemAmount, res.NativeAmount, "redeemed native amount in response")
+	expectedRedemptionId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, initialState.epochNumber, user.acc.String())
+	s.Require().Equal(expectedRedemptionId, res.UserRedemptionRecordId, "user redemption record id in response")
 
 	// User STUATOM balance should have DECREASED by the amount to be redeemed
 	expectedUserStAtomBalance := user.stAtomBalance.SubAmount(redeemAmount)
@@ -152,6 +155,35 @@ func (s *KeeperTestSuite) TestRedeemStake_Successful() {
 	s.Require().NotEqual(hostZoneUnbonding.Status, recordtypes.HostZoneUnbonding_CLAIMABLE, "host zone unbonding should NOT be marked as CLAIMABLE")
 }
 
+func (s *KeeperTestSuite) TestRedeemStake_MinNativeOut() {
+	tc := s.SetupRedeemStake()
+
+	// With a redemption rate of 1.5, 1,000,000 stTokens redeems for 1,500,000 native tokens
+	hostZone := tc.hostZone
+	hostZone.RedemptionRate = sdk.MustNewDecFromStr("1.5")
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	expectedNativeAmount := sdkmath.NewInt(1_500_000)
+
+	// The redemption should fail if the minimum is above the redeemed amount
+	msg := tc.validMsg
+	minNativeOut := expectedNativeAmount.Add(sdkmath.OneInt())
+	msg.MinNativeOut = &minNativeOut
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorIs(err, stakeibctypes.ErrMinOutputNotMet)
+	s.Require().ErrorContains(err, "redemption would return 1500000uatom, which is less than the minimum of 1500001")
+
+	// And succeed if the minimum is met exactly
+	msg.MinNativeOut = &expectedNativeAmount
+	res, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when min native out is met")
+	s.Require().Equal(expectedNativeAmount, res.NativeAmount, "redeemed native amount in response")
+
+	redemptionId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, tc.initialState.epochNumber, tc.user.acc.String())
+	userRedemptionRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, redemptionId)
+	s.Require().True(found, "user redemption record should have been found")
+	s.Require().Equal(expectedNativeAmount, userRedemptionRecord.Amount, "redemption record amount")
+}
+
 func (s *KeeperTestSuite) TestRedeemStake_InvalidCreatorAddress() {
 	tc := s.SetupRedeemStake()
 	invalidMsg := tc.validMsg
@@ -244,14 +276,55 @@ func (s *KeeperTestSuite) TestRedeemStake_NoEpochTrackerDay() {
 	s.Require().EqualError(err, "latest epoch unbonding record not found: epoch unbonding record not found")
 }
 
-func (s *KeeperTestSuite) TestRedeemStake_UserAlreadyRedeemedThisEpoch() {
+func (s *KeeperTestSuite) TestRedeemStake_SameEpochTopUp() {
 	tc := s.SetupRedeemStake()
 
-	invalidMsg := tc.validMsg
-	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &invalidMsg)
+	// Redeem twice in the same epoch, with a different amount each time
+	firstMsg := tc.validMsg
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &firstMsg)
+	s.Require().NoError(err, "no error expected for first redemption")
+
+	secondMsg := tc.validMsg
+	secondMsg.Amount = sdkmath.NewInt(500_000)
+	_, err = s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &secondMsg)
+	s.Require().NoError(err, "no error expected for second redemption")
+
+	totalRedeemAmount := firstMsg.Amount.Add(secondMsg.Amount)
+
+	// The user's stTokens should have been escrowed for both redemptions
+	expectedUserStAtomBalance := tc.user.stAtomBalance.SubAmount(totalRedeemAmount)
+	actualUserStAtomBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.user.acc, "stuatom")
+	s.CompareCoins(expectedUserStAtomBalance, actualUserStAtomBalance, "user stuatom balance")
+
+	// The host zone unbonding should include both redemptions, but only reference the single user redemption record
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, tc.initialState.epochNumber, HostChainId)
+	s.Require().True(found, "host zone unbonding")
+	s.Require().Equal(totalRedeemAmount, hostZoneUnbonding.NativeTokenAmount, "host zone native unbonding amount")
+	s.Require().Equal(totalRedeemAmount, hostZoneUnbonding.StTokenAmount, "host zone stToken burn amount")
+
+	expectedRecordId := fmt.Sprintf("GAIA.1.%s", s.TestAccs[0])
+	s.Require().Equal([]string{expectedRecordId}, hostZoneUnbonding.UserRedemptionRecords, "user redemption records")
+
+	// The user redemption record's amount should be topped up
+	userRedemptionRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, expectedRecordId)
+	s.Require().True(found, "user redemption record")
+	s.Require().Equal(totalRedeemAmount, userRedemptionRecord.Amount, "redemption record amount")
+	s.Require().Equal(tc.validMsg.Receiver, userRedemptionRecord.Receiver, "redemption record receiver")
+}
+
+func (s *KeeperTestSuite) TestRedeemStake_SameEpochDifferentReceiver() {
+	tc := s.SetupRedeemStake()
+
+	firstMsg := tc.validMsg
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &firstMsg)
 	s.Require().NoError(err)
-	_, err = s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &invalidMsg)
-	s.Require().EqualError(err, fmt.Sprintf("user already redeemed this epoch: GAIA.1.%s: redemption record already exists", s.TestAccs[0]))
+
+	// A second redemption in the same epoch must be sent to the same receiver
+	secondMsg := tc.validMsg
+	secondMsg.Receiver = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+	_, err = s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &secondMsg)
+	s.Require().ErrorContains(err, fmt.Sprintf("user already redeemed this epoch to a different receiver (%s): GAIA.1.%s",
+		tc.validMsg.Receiver, s.TestAccs[0]))
 }
 
 func (s *KeeperTestSuite) TestRedeemStake_HostZoneNoUnbondings() {
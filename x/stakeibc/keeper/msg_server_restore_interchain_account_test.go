@@ -286,3 +286,38 @@ func (s *KeeperTestSuite) TestRestoreInterchainAccount_NoRecordChange_Success()
 	s.VerifyDepositRecordsStatus(tc.depositRecordStatusUpdates, false)
 	s.VerifyHostZoneUnbondingStatus(tc.unbondingRecordStatusUpdate, false)
 }
+
+func (s *KeeperTestSuite) TestRestoreInterchainAccount_Redemption_PendingClaimsUnchanged() {
+	// Here, we're manually restoring the redemption channel, which should not reset any pending claims
+	tc := s.SetupRestoreInterchainAccount()
+	owner := "GAIA.REDEMPTION"
+	channelID := s.CreateICAChannel(owner)
+	portID := icatypes.ControllerPortPrefix + owner
+
+	// Store a user redemption record with a pending claim
+	userRedemptionRecord := recordtypes.UserRedemptionRecord{
+		Id:             "record-0",
+		HostZoneId:     HostChainId,
+		ClaimIsPending: true,
+	}
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, userRedemptionRecord)
+
+	// Close the redemption channel
+	channel, found := s.App.IBCKeeper.ChannelKeeper.GetChannel(s.Ctx, portID, channelID)
+	s.Require().True(found, "redemption channel found")
+	channel.State = channeltypes.CLOSED
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, portID, channelID, channel)
+
+	// Restore the channel
+	msg := tc.validMsg
+	msg.AccountType = stakeibc.ICAAccountType_REDEMPTION
+	s.RestoreChannelAndVerifySuccess(msg, portID, channelID)
+
+	// Verify the claim is still pending and the record status' were NOT reverted
+	actualRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, userRedemptionRecord.Id)
+	s.Require().True(found, "user redemption record found")
+	s.Require().True(actualRecord.ClaimIsPending, "claim should still be pending")
+
+	s.VerifyDepositRecordsStatus(tc.depositRecordStatusUpdates, false)
+	s.VerifyHostZoneUnbondingStatus(tc.unbondingRecordStatusUpdate, false)
+}
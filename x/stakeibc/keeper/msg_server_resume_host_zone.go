@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// Un-halts a host zone that was halted in the BeginBlocker after a redemption rate breach
+// The redemption rate must be back within the safety bounds before the zone can be resumed
+// Once resumed, the stToken is removed from the rate limit blacklist so that it can be transferred again
+func (k msgServer) ResumeHostZone(goCtx context.Context, msg *types.MsgResumeHostZone) (*types.MsgResumeHostZoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hostZone, found := k.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", msg.ChainId)
+	}
+
+	if !hostZone.Halted {
+		return nil, errorsmod.Wrapf(types.ErrHostZoneNotHalted, "host zone %s is not halted", msg.ChainId)
+	}
+
+	// Confirm the redemption rate is back within the safety bounds before resuming
+	if _, err := k.IsRedemptionRateWithinSafetyBounds(ctx, hostZone); err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to resume host zone %s", msg.ChainId)
+	}
+
+	hostZone.Halted = false
+	k.SetHostZone(ctx, hostZone)
+
+	// Remove the rate limit on the stAsset
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	k.RatelimitKeeper.RemoveDenomFromBlacklist(ctx, stDenom)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Resumed host zone %s with redemption rate %s", hostZone.ChainId, hostZone.RedemptionRate.String()))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHostZoneResume,
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyRedemptionRate, hostZone.RedemptionRate.String()),
+		),
+	)
+
+	return &types.MsgResumeHostZoneResponse{}, nil
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	stakeibctypes "github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+type ResumeHostZoneTestCase struct {
+	validMsg stakeibctypes.MsgResumeHostZone
+}
+
+func (s *KeeperTestSuite) SetupResumeHostZone() ResumeHostZoneTestCase {
+	// Register a halted host zone with a redemption rate that's back within the bounds
+	hostZone := stakeibctypes.HostZone{
+		ChainId:           HostChainId,
+		HostDenom:         Atom,
+		RedemptionRate:    sdk.OneDec(),
+		MinRedemptionRate: sdk.MustNewDecFromStr("0.9"),
+		MaxRedemptionRate: sdk.MustNewDecFromStr("1.5"),
+		Halted:            true,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// Blacklist the stToken as is done when the zone is halted
+	s.App.RatelimitKeeper.AddDenomToBlacklist(s.Ctx, StAtom)
+
+	return ResumeHostZoneTestCase{
+		validMsg: stakeibctypes.MsgResumeHostZone{
+			Creator: "stride_ADDRESS",
+			ChainId: HostChainId,
+		},
+	}
+}
+
+func (s *KeeperTestSuite) TestResumeHostZone_Successful() {
+	tc := s.SetupResumeHostZone()
+
+	_, err := s.GetMsgServer().ResumeHostZone(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().NoError(err, "no error expected when resuming host zone")
+
+	// Confirm the host zone is no longer halted
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone should have been found")
+	s.Require().False(hostZone.Halted, "host zone should not be halted")
+
+	// Confirm the stToken was removed from the blacklist
+	s.Require().False(s.App.RatelimitKeeper.IsDenomBlacklisted(s.Ctx, StAtom), "stToken should no longer be blacklisted")
+}
+
+func (s *KeeperTestSuite) TestResumeHostZone_HostZoneNotFound() {
+	tc := s.SetupResumeHostZone()
+
+	invalidMsg := tc.validMsg
+	invalidMsg.ChainId = "fake_chain"
+	_, err := s.GetMsgServer().ResumeHostZone(sdk.WrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().EqualError(err, "host zone fake_chain not found: host zone not found")
+}
+
+func (s *KeeperTestSuite) TestResumeHostZone_HostZoneNotHalted() {
+	tc := s.SetupResumeHostZone()
+
+	hostZone, _ := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	hostZone.Halted = false
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().ResumeHostZone(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().EqualError(err, "host zone GAIA is not halted: host zone is not halted")
+}
+
+func (s *KeeperTestSuite) TestResumeHostZone_RedemptionRateOutsideBounds() {
+	tc := s.SetupResumeHostZone()
+
+	// Set the redemption rate below the min
+	hostZone, _ := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	hostZone.RedemptionRate = sdk.MustNewDecFromStr("0.8")
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().ResumeHostZone(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().ErrorContains(err, "unable to resume host zone GAIA")
+	s.Require().ErrorIs(err, stakeibctypes.ErrRedemptionRateOutsideSafetyBounds)
+
+	// Confirm the zone is still halted and the stToken is still blacklisted
+	hostZone, _ = s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(hostZone.Halted, "host zone should still be halted")
+	s.Require().True(s.App.RatelimitKeeper.IsDenomBlacklisted(s.Ctx, StAtom), "stToken should still be blacklisted")
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// Opts a host zone in or out of automatically claiming redemptions each day epoch
+// When enabled, users no longer need to submit a MsgClaimUndelegatedTokens once their redemption is claimable
+func (k msgServer) SetAutoClaim(goCtx context.Context, msg *types.MsgSetAutoClaim) (*types.MsgSetAutoClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hostZone, found := k.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", msg.ChainId)
+	}
+
+	hostZone.AutoClaimEnabled = msg.Enabled
+	k.SetHostZone(ctx, hostZone)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Set auto claim to %t for host zone %s", msg.Enabled, hostZone.ChainId))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoClaim,
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyAutoClaimEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+	)
+
+	return &types.MsgSetAutoClaimResponse{}, nil
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// Sets how the weights of a host zone's validators are determined
+// Equal weights are applied immediately, while scored weights require the validator metrics to be queried first,
+// so the queries are submitted now and the weights are updated at the next day epoch
+func (k msgServer) SetValidatorSelectionStrategy(goCtx context.Context, msg *types.MsgSetValidatorSelectionStrategy) (*types.MsgSetValidatorSelectionStrategyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hostZone, found := k.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", msg.ChainId)
+	}
+
+	hostZone.ValidatorSelectionStrategy = msg.Strategy
+	k.SetHostZone(ctx, hostZone)
+
+	switch msg.Strategy {
+	case types.ValidatorSelectionStrategy_EQUAL:
+		if err := k.UpdateValidatorWeights(ctx, hostZone); err != nil {
+			return nil, err
+		}
+	case types.ValidatorSelectionStrategy_SCORED:
+		if err := k.SubmitValidatorMetricsICQs(ctx, hostZone); err != nil {
+			return nil, err
+		}
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("Set validator selection strategy to %s for host zone %s", msg.Strategy, hostZone.ChainId))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetValidatorSelectionStrategy,
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyValidatorSelectionStrategy, msg.Strategy.String()),
+		),
+	)
+
+	return &types.MsgSetValidatorSelectionStrategyResponse{}, nil
+}
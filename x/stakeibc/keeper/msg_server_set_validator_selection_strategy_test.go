@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochtypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v10/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestSetValidatorSelectionStrategy_Equal() {
+	s.SetupValidatorSelectionStrategy(types.ValidatorSelectionStrategy_STATIC)
+
+	msg := types.MsgSetValidatorSelectionStrategy{
+		ChainId:  HostChainId,
+		Strategy: types.ValidatorSelectionStrategy_EQUAL,
+	}
+	_, err := s.GetMsgServer().SetValidatorSelectionStrategy(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when setting strategy")
+
+	// The equal weights should be applied immediately
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(types.ValidatorSelectionStrategy_EQUAL, hostZone.ValidatorSelectionStrategy, "strategy")
+	s.checkValidatorWeights([]uint64{3333, 3333, 3333})
+}
+
+func (s *KeeperTestSuite) TestSetValidatorSelectionStrategy_Scored() {
+	s.CreateTransferChannel(HostChainId)
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier:    epochtypes.DAY_EPOCH,
+		EpochNumber:        1,
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 1_000_000_000),
+	})
+	s.SetupValidatorSelectionStrategy(types.ValidatorSelectionStrategy_STATIC)
+
+	msg := types.MsgSetValidatorSelectionStrategy{
+		ChainId:  HostChainId,
+		Strategy: types.ValidatorSelectionStrategy_SCORED,
+	}
+	_, err := s.GetMsgServer().SetValidatorSelectionStrategy(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when setting strategy")
+
+	// The weights should be unchanged until the metrics are queried
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(types.ValidatorSelectionStrategy_SCORED, hostZone.ValidatorSelectionStrategy, "strategy")
+	s.checkValidatorWeights([]uint64{1, 1, 1})
+
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 3, "number of metrics queries submitted")
+	for _, query := range queries {
+		s.Require().Equal(stakeibckeeper.ICQCallbackID_ValidatorMetrics, query.CallbackId, "query callback id")
+	}
+}
+
+func (s *KeeperTestSuite) TestSetValidatorSelectionStrategy_BackToStatic() {
+	s.SetupValidatorSelectionStrategy(types.ValidatorSelectionStrategy_EQUAL)
+
+	msg := types.MsgSetValidatorSelectionStrategy{
+		ChainId:  HostChainId,
+		Strategy: types.ValidatorSelectionStrategy_STATIC,
+	}
+	_, err := s.GetMsgServer().SetValidatorSelectionStrategy(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when setting strategy")
+
+	// The existing weights should be kept
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(types.ValidatorSelectionStrategy_STATIC, hostZone.ValidatorSelectionStrategy, "strategy")
+	s.checkValidatorWeights([]uint64{1, 1, 1})
+}
+
+func (s *KeeperTestSuite) TestSetValidatorSelectionStrategy_HostZoneNotFound() {
+	msg := types.MsgSetValidatorSelectionStrategy{
+		ChainId:  "fake_host_zone",
+		Strategy: types.ValidatorSelectionStrategy_EQUAL,
+	}
+	_, err := s.GetMsgServer().SetValidatorSelectionStrategy(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "host zone fake_host_zone not found")
+}
+
+func (s *KeeperTestSuite) TestChangeValidatorWeight_ManagedByStrategy() {
+	hostZone := s.SetupValidatorSelectionStrategy(types.ValidatorSelectionStrategy_SCORED)
+
+	msg := types.MsgChangeValidatorWeight{
+		HostZone: HostChainId,
+		ValAddr:  hostZone.Validators[0].Address,
+		Weight:   5,
+	}
+	_, err := s.GetMsgServer().ChangeValidatorWeight(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "host zone GAIA uses the SCORED strategy")
+	s.checkValidatorWeights([]uint64{1, 1, 1})
+
+	// Once the host zone is switched back to static weights, the weight can be changed
+	hostZone.ValidatorSelectionStrategy = types.ValidatorSelectionStrategy_STATIC
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err = s.GetMsgServer().ChangeValidatorWeight(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when changing weight")
+	s.checkValidatorWeights([]uint64{5, 1, 1})
+}
@@ -23,6 +23,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	epochstypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
+	icqkeeper "github.com/Stride-Labs/stride/v10/x/interchainquery/keeper"
 	icqtypes "github.com/Stride-Labs/stride/v10/x/interchainquery/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -140,7 +141,7 @@ func (k Keeper) SetWithdrawalAddressOnHost(ctx sdk.Context, hostZone types.HostZ
 	return nil
 }
 
-// Submits an ICQ for the withdrawal account balance
+// Registers a periodic ICQ for the withdrawal account balance
 func (k Keeper) UpdateWithdrawalBalance(ctx sdk.Context, hostZone types.HostZone) error {
 	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Submitting ICQ for withdrawal account balance"))
 
@@ -150,33 +151,67 @@ func (k Keeper) UpdateWithdrawalBalance(ctx sdk.Context, hostZone types.HostZone
 		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no withdrawal account found for %s", hostZone.ChainId)
 	}
 
-	// Encode the withdrawal account address for the query request
-	// The query request consists of the withdrawal account address and denom
-	_, withdrawalAddressBz, err := bech32.DecodeAndConvert(withdrawalAccount.Address)
+	return k.registerPeriodicBalanceQuery(ctx, hostZone, withdrawalAccount.Address, ICQCallbackID_WithdrawalBalance)
+}
+
+// Registers a periodic ICQ for the fee account balance
+func (k Keeper) UpdateFeeBalance(ctx sdk.Context, hostZone types.HostZone) error {
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Submitting ICQ for fee account balance"))
+
+	// Get the fee account address from the host zone
+	feeAccount := hostZone.FeeAccount
+	if feeAccount == nil || feeAccount.Address == "" {
+		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no fee account found for %s", hostZone.ChainId)
+	}
+
+	return k.registerPeriodicBalanceQuery(ctx, hostZone, feeAccount.Address, ICQCallbackID_FeeBalance)
+}
+
+// Registers a periodic ICQ for the host denom balance of one of the host zone's ICA accounts
+// The query is re-submitted by the ICQ module every reinvest interval, so it only needs to be
+// registered once (or re-registered if the reinvest interval has changed)
+func (k Keeper) registerPeriodicBalanceQuery(ctx sdk.Context, hostZone types.HostZone, address string, callbackId string) error {
+	// Encode the account address for the query request
+	// The query request consists of the account address and denom
+	_, addressBz, err := bech32.DecodeAndConvert(address)
 	if err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid withdrawal account address, could not decode (%s)", err.Error())
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account address, could not decode (%s)", err.Error())
 	}
-	queryData := append(bankTypes.CreateAccountBalancesPrefix(withdrawalAddressBz), []byte(hostZone.HostDenom)...)
+	queryData := append(bankTypes.CreateAccountBalancesPrefix(addressBz), []byte(hostZone.HostDenom)...)
 
-	// The query should timeout at the end of the ICA buffer window
+	reinvestInterval := k.GetParam(ctx, types.KeyReinvestInterval)
+	queryId := icqkeeper.GenerateQueryHash(hostZone.ConnectionId, hostZone.ChainId, icqtypes.BANK_STORE_QUERY_WITH_PROOF,
+		queryData, types.ModuleName, callbackId)
+	if periodicQuery, found := k.InterchainQueryKeeper.GetPeriodicQuery(ctx, queryId); found && periodicQuery.Period == reinvestInterval {
+		return nil
+	}
+
+	// Each query should timeout at the end of the ICA buffer window
 	ttl, err := k.GetICATimeoutNanos(ctx, epochstypes.STRIDE_EPOCH)
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
 			"Failed to get ICA timeout nanos for epochType %s using param, error: %s", epochstypes.STRIDE_EPOCH, err.Error())
 	}
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	if ttl <= blockTime {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "ICA timeout %d is not after the current block time %d", ttl, blockTime)
+	}
 
-	// Submit the ICQ for the withdrawal account balance
-	if err := k.InterchainQueryKeeper.MakeRequest(
+	// Register the periodic ICQ for the account balance
+	if err := k.InterchainQueryKeeper.RegisterPeriodicQuery(
 		ctx,
 		types.ModuleName,
-		ICQCallbackID_WithdrawalBalance,
+		callbackId,
 		hostZone.ChainId,
 		hostZone.ConnectionId,
 		icqtypes.BANK_STORE_QUERY_WITH_PROOF,
 		queryData,
-		ttl,
+		epochstypes.STRIDE_EPOCH,
+		reinvestInterval,
+		ttl-blockTime,
+		icqtypes.TimeoutPolicy_REJECT_QUERY_RESPONSE,
 	); err != nil {
-		k.Logger(ctx).Error(fmt.Sprintf("Error querying for withdrawal balance, error: %s", err.Error()))
+		k.Logger(ctx).Error(fmt.Sprintf("Error querying for %s balance, error: %s", callbackId, err.Error()))
 		return err
 	}
 
@@ -401,6 +436,7 @@ func (k Keeper) QueryValidatorExchangeRate(ctx sdk.Context, msg *types.MsgUpdate
 		icqtypes.STAKING_STORE_QUERY_WITH_PROOF,
 		queryData,
 		ttl,
+		icqtypes.TimeoutPolicy_RETRY_QUERY_REQUEST,
 	); err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("Error submitting ICQ for validator exchange rate, error %s", err.Error()))
 		return nil, err
@@ -452,6 +488,7 @@ func (k Keeper) QueryDelegationsIcq(ctx sdk.Context, hostZone types.HostZone, va
 		icqtypes.STAKING_STORE_QUERY_WITH_PROOF,
 		queryData,
 		ttl,
+		icqtypes.TimeoutPolicy_RETRY_QUERY_REQUEST,
 	); err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("Error submitting ICQ for delegation, error : %s", err.Error()))
 		return err
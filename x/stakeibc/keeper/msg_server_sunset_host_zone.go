@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cast"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// Begins winding down a host zone (governance only)
+// Liquid stakes are closed immediately, and once the remaining deposits have been delegated,
+// the full staked balance is unbonded so that stTokens can be redeemed at a final redemption rate
+// until they've all been redeemed or the redemption deadline passes, at which point the host zone is removed
+// Progress is tracked in the HostZoneSunset and advanced each day epoch (see ProcessAllHostZoneSunsets)
+func (k msgServer) SunsetHostZone(goCtx context.Context, msg *types.MsgSunsetHostZone) (*types.MsgSunsetHostZoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if _, found := k.GetHostZone(ctx, msg.ChainId); !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostZone, "host zone %s not found", msg.ChainId)
+	}
+	if _, active := k.GetActiveHostZoneSunset(ctx, msg.ChainId); active {
+		return nil, errorsmod.Wrapf(types.ErrHostZoneSunset, "host zone %s is already being sunset", msg.ChainId)
+	}
+
+	currentTime := cast.ToUint64(ctx.BlockTime().Unix())
+	if msg.RedemptionDeadline <= currentTime {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount,
+			"redemption deadline (%d) must be after the current block time (%d)", msg.RedemptionDeadline, currentTime)
+	}
+
+	k.SetHostZoneSunset(ctx, types.HostZoneSunset{
+		ChainId:             msg.ChainId,
+		Status:              types.HostZoneSunsetStatus_DRAINING_DEPOSITS,
+		RedemptionDeadline:  msg.RedemptionDeadline,
+		FinalRedemptionRate: sdk.ZeroDec(),
+		RedeemableAmount:    sdkmath.ZeroInt(),
+	})
+
+	k.Logger(ctx).Info(fmt.Sprintf("Sunsetting host zone %s with a redemption deadline of %d", msg.ChainId, msg.RedemptionDeadline))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSunsetHostZone,
+			sdk.NewAttribute(types.AttributeKeyHostZone, msg.ChainId),
+			sdk.NewAttribute(types.AttributeKeyRedemptionDeadline, fmt.Sprintf("%d", msg.RedemptionDeadline)),
+		),
+	)
+
+	return &types.MsgSunsetHostZoneResponse{}, nil
+}
@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/Stride-Labs/stride/v10/utils"
+	recordstypes "github.com/Stride-Labs/stride/v10/x/records/types"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// Builds the redelegations that move a host zone's delegations toward the validators' target weights,
+// pairing the most overweight validators with the most underweight validators
+//
+// Redelegations that would be rejected by the host are skipped:
+//   - a validator that's still receiving a redelegation can't be the source of another (no transitive redelegations)
+//   - a validator pair can't exceed the max number of in-flight redelegation entries
+func (k Keeper) GetRebalanceRedelegations(ctx sdk.Context, hostZone types.HostZone, maxRedelegations uint64) ([]*types.Rebalancing, error) {
+	validatorDeltas, err := k.GetValidatorDelegationAmtDifferences(ctx, hostZone)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Error getting validator deltas for Host Zone %s: %s", hostZone.ChainId, err))
+		return nil, err
+	}
+
+	totalDelegation := k.GetTotalValidatorDelegations(hostZone)
+	if totalDelegation.IsZero() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no validator delegations found for Host Zone %s, cannot rebalance 0 delegations!", hostZone.ChainId)
+	}
+
+	// Split the validators into those with excess delegation (negative delta) and those that need more (positive delta)
+	type valDelta struct {
+		valAddr  string
+		deltaAmt sdkmath.Int
+	}
+	overWeight := []*valDelta{}
+	underWeight := []*valDelta{}
+	// DO NOT REMOVE: StringMapKeys fixes non-deterministic map iteration
+	for _, valAddr := range utils.StringMapKeys(validatorDeltas) {
+		deltaAmt := validatorDeltas[valAddr]
+		if deltaAmt.IsNegative() {
+			overWeight = append(overWeight, &valDelta{valAddr: valAddr, deltaAmt: deltaAmt.Neg()})
+		} else if deltaAmt.IsPositive() {
+			underWeight = append(underWeight, &valDelta{valAddr: valAddr, deltaAmt: deltaAmt})
+		}
+	}
+	sort.SliceStable(overWeight, func(i, j int) bool { return overWeight[i].deltaAmt.GT(overWeight[j].deltaAmt) })
+	sort.SliceStable(underWeight, func(i, j int) bool { return underWeight[i].deltaAmt.GT(underWeight[j].deltaAmt) })
+
+	maxEntries := k.GetParam(ctx, types.KeyMaxRedelegationEntries)
+	rebalancings := []*types.Rebalancing{}
+	for _, src := range overWeight {
+		if k.IsReceivingRedelegation(ctx, hostZone.ChainId, src.valAddr) {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+				"Skipping redelegation from %s since it has an incomplete redelegation to it", src.valAddr))
+			continue
+		}
+
+		for _, dst := range underWeight {
+			if uint64(len(rebalancings)) >= maxRedelegations {
+				return rebalancings, nil
+			}
+			if src.deltaAmt.IsZero() {
+				break
+			}
+			if dst.deltaAmt.IsZero() {
+				continue
+			}
+			if k.GetNumPendingRedelegationEntries(ctx, hostZone.ChainId, src.valAddr, dst.valAddr) >= maxEntries {
+				k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+					"Skipping redelegation from %s to %s since the max number of entries has been reached", src.valAddr, dst.valAddr))
+				continue
+			}
+
+			amount := sdkmath.MinInt(src.deltaAmt, dst.deltaAmt)
+			src.deltaAmt = src.deltaAmt.Sub(amount)
+			dst.deltaAmt = dst.deltaAmt.Sub(amount)
+
+			rebalancings = append(rebalancings, &types.Rebalancing{
+				SrcValidator: src.valAddr,
+				DstValidator: dst.valAddr,
+				Amt:          amount,
+			})
+		}
+	}
+
+	return rebalancings, nil
+}
+
+// Submits the redelegations needed to rebalance a host zone's delegations in a single ICA tx
+// Returns the redelegations that were submitted
+func (k Keeper) RebalanceHostZoneDelegations(ctx sdk.Context, hostZone types.HostZone, maxRedelegations uint64) ([]*types.Rebalancing, error) {
+	rebalancings, err := k.GetRebalanceRedelegations(ctx, hostZone, maxRedelegations)
+	if err != nil {
+		return nil, err
+	}
+
+	delegationIca := hostZone.GetDelegationAccount()
+	if delegationIca == nil || delegationIca.GetAddress() == "" {
+		k.Logger(ctx).Error(fmt.Sprintf("Zone %s is missing a delegation address!", hostZone.ChainId))
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid delegation account")
+	}
+
+	if len(rebalancings) == 0 {
+		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "No redelegations needed to rebalance"))
+		return nil, nil
+	}
+
+	var msgs []proto.Message
+	for _, rebalancing := range rebalancings {
+		msgs = append(msgs, &stakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    delegationIca.GetAddress(),
+			ValidatorSrcAddress: rebalancing.SrcValidator,
+			ValidatorDstAddress: rebalancing.DstValidator,
+			Amount:              sdk.NewCoin(hostZone.HostDenom, rebalancing.Amt),
+		})
+	}
+
+	rebalanceCallback := types.RebalanceCallback{
+		HostZoneId:   hostZone.ChainId,
+		Rebalancings: rebalancings,
+	}
+	marshalledCallbackArgs, err := k.MarshalRebalanceCallbackArgs(ctx, rebalanceCallback)
+	if err != nil {
+		return nil, err
+	}
+
+	connectionId := hostZone.GetConnectionId()
+	_, err = k.SubmitTxsStrideEpoch(ctx, connectionId, msgs, *delegationIca, ICACallbackID_Rebalance, marshalledCallbackArgs)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "Failed to SubmitTxs for %s, %s, %s, %s", connectionId, hostZone.ChainId, msgs, err.Error())
+	}
+
+	return rebalancings, nil
+}
+
+// Checks whether any validator's delegation deviates from its target by more than the threshold,
+// where the threshold is a percent of the validator's target delegation
+func (k Keeper) HostZoneNeedsRebalance(ctx sdk.Context, hostZone types.HostZone, thresholdPercent uint64) (bool, error) {
+	totalDelegation := k.GetTotalValidatorDelegations(hostZone)
+	if totalDelegation.IsZero() {
+		return false, nil
+	}
+
+	targetDelegations, err := k.GetTargetValAmtsForHostZone(ctx, hostZone, totalDelegation)
+	if err != nil {
+		return false, err
+	}
+
+	for _, validator := range hostZone.Validators {
+		target, found := targetDelegations[validator.Address]
+		if !found {
+			target = sdkmath.ZeroInt()
+		}
+
+		// deviation / target > threshold / 100
+		deviation := validator.DelegationAmt.Sub(target).Abs()
+		if deviation.MulRaw(100).GT(target.Mul(sdkmath.NewIntFromUint64(thresholdPercent))) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Returns true if any of a host zone's unbondings have been submitted but not yet acknowledged
+func (k Keeper) HasUnbondingsInProgress(ctx sdk.Context, chainId string) bool {
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, chainId)
+		if found && hostZoneUnbonding.Status == recordstypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS {
+			return true
+		}
+	}
+	return false
+}
+
+// Rebalances the delegations of each active host zone that has drifted from its target weights
+func (k Keeper) AutoRebalanceAllHostZones(ctx sdk.Context) {
+	thresholdPercent := k.GetParam(ctx, types.KeyAutoRebalanceThresholdPercent)
+	if thresholdPercent == 0 {
+		return
+	}
+	maxRedelegations := k.GetParam(ctx, types.KeyMaxAutoRebalanceRedelegations)
+
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		k.PruneCompletedRedelegations(ctx, hostZone.ChainId)
+
+		// There's nothing left to rebalance once a sunset host zone begins unbonding
+		if k.IsHostZoneSunsetUnbonding(ctx, hostZone.ChainId) {
+			continue
+		}
+
+		// The delegation amounts are not updated until an undelegation is acknowledged,
+		// so wait for any in flight unbondings before rebalancing
+		if k.HasUnbondingsInProgress(ctx, hostZone.ChainId) {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Unbondings in progress - skipping auto rebalance"))
+			continue
+		}
+
+		needsRebalance, err := k.HostZoneNeedsRebalance(ctx, hostZone, thresholdPercent)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to determine if host zone %s needs a rebalance, err: %s", hostZone.ChainId, err.Error()))
+			continue
+		}
+		if !needsRebalance {
+			continue
+		}
+
+		// Rebalance in a cached context so that a failure does not leave partial state
+		cacheCtx, writeCache := ctx.CacheContext()
+		rebalancings, err := k.RebalanceHostZoneDelegations(cacheCtx, hostZone, maxRedelegations)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to rebalance host zone %s, err: %s", hostZone.ChainId, err.Error()))
+			continue
+		}
+		writeCache()
+
+		numRedelegations := len(rebalancings)
+		if numRedelegations == 0 {
+			continue
+		}
+
+		k.Logger(ctx).Info(fmt.Sprintf("Submitted %d redelegations to rebalance host zone %s", numRedelegations, hostZone.ChainId))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoRebalance,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+				sdk.NewAttribute(types.AttributeKeyNumRedelegations, fmt.Sprintf("%d", numRedelegations)),
+			),
+		)
+	}
+}
@@ -0,0 +1,226 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	_ "github.com/stretchr/testify/suite"
+
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+
+	icacallbackstypes "github.com/Stride-Labs/stride/v10/x/icacallbacks/types"
+	recordtypes "github.com/Stride-Labs/stride/v10/x/records/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// Shifts the weights of the rebalance test host zone so that val1 is underweight
+// and each of the other validators is overweight, and enables auto rebalancing at a 10% threshold
+func (s *KeeperTestSuite) SetupAutoRebalance() RebalanceValidatorsTestCase {
+	tc := s.SetupRebalanceValidators()
+
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.AutoRebalanceThresholdPercent = 10
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, "GAIA")
+	s.Require().True(found, "host zone should exist")
+	hostZone.Validators[0].Weight = 250
+	hostZone.Validators[2].Weight = 100
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	tc.hostZone = hostZone
+	return tc
+}
+
+func (s *KeeperTestSuite) checkRebalancings(expected []stakeibctypes.Rebalancing, actual []*stakeibctypes.Rebalancing) {
+	s.Require().Len(actual, len(expected), "number of rebalancings")
+	for i, expectedRebalancing := range expected {
+		s.Require().Equal(expectedRebalancing.SrcValidator, actual[i].SrcValidator, "rebalancing %d src validator", i)
+		s.Require().Equal(expectedRebalancing.DstValidator, actual[i].DstValidator, "rebalancing %d dst validator", i)
+		s.Require().Equal(expectedRebalancing.Amt.Int64(), actual[i].Amt.Int64(), "rebalancing %d amount", i)
+	}
+}
+
+func (s *KeeperTestSuite) TestGetRebalanceRedelegations_Successful() {
+	tc := s.SetupAutoRebalance()
+
+	rebalancings, err := s.App.StakeibcKeeper.GetRebalanceRedelegations(s.Ctx, tc.hostZone, 10)
+	s.Require().NoError(err, "no error expected when building redelegations")
+	s.checkRebalancings([]stakeibctypes.Rebalancing{
+		{SrcValidator: "stride_VAL3", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(104)},
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+		{SrcValidator: "stride_VAL5", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+		{SrcValidator: "stride_VAL2", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(12)},
+	}, rebalancings)
+
+	// The number of redelegations should be capped
+	rebalancings, err = s.App.StakeibcKeeper.GetRebalanceRedelegations(s.Ctx, tc.hostZone, 2)
+	s.Require().NoError(err, "no error expected when building capped redelegations")
+	s.checkRebalancings([]stakeibctypes.Rebalancing{
+		{SrcValidator: "stride_VAL3", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(104)},
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+	}, rebalancings)
+}
+
+func (s *KeeperTestSuite) TestGetRebalanceRedelegations_SkipsTransitiveRedelegation() {
+	tc := s.SetupAutoRebalance()
+
+	// val3 is still receiving a redelegation from val2, so it can't be used as a source
+	inProgress := s.Ctx.BlockTime().Add(time.Hour)
+	s.App.StakeibcKeeper.AddRedelegationEntry(s.Ctx, "GAIA", "stride_VAL2", "stride_VAL3", inProgress)
+
+	rebalancings, err := s.App.StakeibcKeeper.GetRebalanceRedelegations(s.Ctx, tc.hostZone, 10)
+	s.Require().NoError(err, "no error expected when building redelegations")
+	s.checkRebalancings([]stakeibctypes.Rebalancing{
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+		{SrcValidator: "stride_VAL5", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+		{SrcValidator: "stride_VAL2", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(12)},
+	}, rebalancings)
+
+	// Once the redelegation completes, val3 can be used again
+	completed := s.Ctx.BlockTime().Add(-time.Hour)
+	s.App.StakeibcKeeper.SetRedelegationTracker(s.Ctx, stakeibctypes.RedelegationTracker{
+		ChainId:         "GAIA",
+		SrcValidator:    "stride_VAL2",
+		DstValidator:    "stride_VAL3",
+		CompletionTimes: []uint64{uint64(completed.UnixNano())},
+	})
+
+	rebalancings, err = s.App.StakeibcKeeper.GetRebalanceRedelegations(s.Ctx, tc.hostZone, 10)
+	s.Require().NoError(err, "no error expected when building redelegations after completion")
+	s.Require().Len(rebalancings, 4, "number of rebalancings after completion")
+	s.Require().Equal("stride_VAL3", rebalancings[0].SrcValidator, "val3 should be the first source")
+}
+
+func (s *KeeperTestSuite) TestGetRebalanceRedelegations_SkipsMaxEntries() {
+	tc := s.SetupAutoRebalance()
+
+	// Fill up the val3 -> val1 entries
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.MaxRedelegationEntries = 2
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	inProgress := s.Ctx.BlockTime().Add(time.Hour)
+	s.App.StakeibcKeeper.AddRedelegationEntry(s.Ctx, "GAIA", "stride_VAL3", "stride_VAL1", inProgress)
+	s.App.StakeibcKeeper.AddRedelegationEntry(s.Ctx, "GAIA", "stride_VAL3", "stride_VAL1", inProgress)
+
+	rebalancings, err := s.App.StakeibcKeeper.GetRebalanceRedelegations(s.Ctx, tc.hostZone, 10)
+	s.Require().NoError(err, "no error expected when building redelegations")
+	s.checkRebalancings([]stakeibctypes.Rebalancing{
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+		{SrcValidator: "stride_VAL5", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+		{SrcValidator: "stride_VAL2", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(12)},
+	}, rebalancings)
+}
+
+func (s *KeeperTestSuite) TestHostZoneNeedsRebalance() {
+	tc := s.SetupRebalanceValidators()
+
+	// The delegations start out in line with the weights
+	needsRebalance, err := s.App.StakeibcKeeper.HostZoneNeedsRebalance(s.Ctx, tc.hostZone, 10)
+	s.Require().NoError(err, "no error expected for balanced host zone")
+	s.Require().False(needsRebalance, "balanced host zone should not need a rebalance")
+
+	// After the weight change, val1 is ~44% below its target and val3 is ~120% above its target
+	// (validators are matched by address since the target calculation sorts them in place)
+	for _, validator := range tc.hostZone.Validators {
+		switch validator.Address {
+		case "stride_VAL1":
+			validator.Weight = 250
+		case "stride_VAL3":
+			validator.Weight = 100
+		}
+	}
+
+	needsRebalance, err = s.App.StakeibcKeeper.HostZoneNeedsRebalance(s.Ctx, tc.hostZone, 10)
+	s.Require().NoError(err, "no error expected for 10% threshold")
+	s.Require().True(needsRebalance, "host zone should need a rebalance at a 10% threshold")
+
+	needsRebalance, err = s.App.StakeibcKeeper.HostZoneNeedsRebalance(s.Ctx, tc.hostZone, 150)
+	s.Require().NoError(err, "no error expected for 150% threshold")
+	s.Require().False(needsRebalance, "host zone should not need a rebalance at a 150% threshold")
+}
+
+func (s *KeeperTestSuite) TestAutoRebalanceAllHostZones_Successful() {
+	tc := s.SetupAutoRebalance()
+
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.MaxAutoRebalanceRedelegations = 2
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	// A completed redelegation should be pruned
+	completed := s.Ctx.BlockTime().Add(-time.Hour)
+	s.App.StakeibcKeeper.AddRedelegationEntry(s.Ctx, "GAIA", "stride_VAL2", "stride_VAL3", completed)
+
+	portId := icatypes.ControllerPortPrefix + "GAIA.DELEGATION"
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, portId, tc.delegationChannel)
+	s.Require().True(found, "sequence number not found before rebalance")
+
+	s.App.StakeibcKeeper.AutoRebalanceAllHostZones(s.Ctx)
+
+	_, found = s.App.StakeibcKeeper.GetRedelegationTracker(s.Ctx, "GAIA", "stride_VAL2", "stride_VAL3")
+	s.Require().False(found, "completed redelegation should have been pruned")
+
+	callbackKey := icacallbackstypes.PacketID(portId, tc.delegationChannel, startSequence)
+	callbackData, found := s.App.StakeibcKeeper.ICACallbacksKeeper.GetCallbackData(s.Ctx, callbackKey)
+	s.Require().True(found, "callback should exist")
+	s.Require().Equal("rebalance", callbackData.CallbackId, "callback id")
+
+	callbackArgs, err := s.App.StakeibcKeeper.UnmarshalRebalanceCallbackArgs(s.Ctx, callbackData.CallbackArgs)
+	s.Require().NoError(err, "no error expected when unmarshalling callback args")
+	s.Require().Equal("GAIA", callbackArgs.HostZoneId, "callback host zone")
+	s.checkRebalancings([]stakeibctypes.Rebalancing{
+		{SrcValidator: "stride_VAL3", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(104)},
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+	}, callbackArgs.Rebalancings)
+}
+
+func (s *KeeperTestSuite) TestAutoRebalanceAllHostZones_NoRebalance() {
+	testCases := []struct {
+		name                string
+		thresholdPercent    uint64
+		changeWeights       bool
+		unbondingInProgress bool
+	}{
+		{name: "disabled", thresholdPercent: 0, changeWeights: true},
+		{name: "within threshold", thresholdPercent: 150, changeWeights: true},
+		{name: "balanced", thresholdPercent: 10, changeWeights: false},
+		{name: "unbonding in progress", thresholdPercent: 10, changeWeights: true, unbondingInProgress: true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var rebalanceTc RebalanceValidatorsTestCase
+			if tc.changeWeights {
+				rebalanceTc = s.SetupAutoRebalance()
+			} else {
+				rebalanceTc = s.SetupRebalanceValidators()
+			}
+
+			params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+			params.AutoRebalanceThresholdPercent = tc.thresholdPercent
+			s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+			if tc.unbondingInProgress {
+				s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+					EpochNumber: 1,
+					HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+						{HostZoneId: "GAIA", Status: recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS},
+					},
+				})
+			}
+
+			portId := icatypes.ControllerPortPrefix + "GAIA.DELEGATION"
+			startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, portId, rebalanceTc.delegationChannel)
+			s.Require().True(found, "sequence number not found before rebalance")
+
+			s.App.StakeibcKeeper.AutoRebalanceAllHostZones(s.Ctx)
+
+			endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, portId, rebalanceTc.delegationChannel)
+			s.Require().True(found, "sequence number not found after rebalance")
+			s.Require().Equal(startSequence, endSequence, "no ICA tx should have been submitted")
+		})
+	}
+}
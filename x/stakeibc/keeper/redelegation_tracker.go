@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// SetRedelegationTracker set a specific redelegationTracker in the store from its chain ID and validator pair
+func (k Keeper) SetRedelegationTracker(ctx sdk.Context, redelegationTracker types.RedelegationTracker) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationTrackerKeyPrefixByChainId(redelegationTracker.ChainId))
+	b := k.cdc.MustMarshal(&redelegationTracker)
+	store.Set(types.RedelegationTrackerKey(redelegationTracker.SrcValidator, redelegationTracker.DstValidator), b)
+}
+
+// GetRedelegationTracker returns a redelegationTracker from its chain ID and validator pair
+func (k Keeper) GetRedelegationTracker(ctx sdk.Context, chainId, srcValidator, dstValidator string) (val types.RedelegationTracker, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationTrackerKeyPrefixByChainId(chainId))
+
+	b := store.Get(types.RedelegationTrackerKey(srcValidator, dstValidator))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRedelegationTracker removes a redelegationTracker from the store
+func (k Keeper) RemoveRedelegationTracker(ctx sdk.Context, chainId, srcValidator, dstValidator string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationTrackerKeyPrefixByChainId(chainId))
+	store.Delete(types.RedelegationTrackerKey(srcValidator, dstValidator))
+}
+
+// GetAllRedelegationTrackers returns all redelegationTrackers across every host zone
+func (k Keeper) GetAllRedelegationTrackers(ctx sdk.Context) (list []types.RedelegationTracker) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedelegationTrackerKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RedelegationTracker
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllRedelegationTrackersForHostZone returns all redelegationTrackers for a host zone
+func (k Keeper) GetAllRedelegationTrackersForHostZone(ctx sdk.Context, chainId string) (list []types.RedelegationTracker) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationTrackerKeyPrefixByChainId(chainId))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RedelegationTracker
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// Records a new in-flight redelegation entry between two validators
+func (k Keeper) AddRedelegationEntry(ctx sdk.Context, chainId, srcValidator, dstValidator string, completionTime time.Time) {
+	redelegationTracker, found := k.GetRedelegationTracker(ctx, chainId, srcValidator, dstValidator)
+	if !found {
+		redelegationTracker = types.RedelegationTracker{
+			ChainId:      chainId,
+			SrcValidator: srcValidator,
+			DstValidator: dstValidator,
+		}
+	}
+	redelegationTracker.CompletionTimes = append(redelegationTracker.CompletionTimes, uint64(completionTime.UnixNano()))
+	k.SetRedelegationTracker(ctx, redelegationTracker)
+}
+
+// Removes any redelegation entries on a host zone that have completed as of the current block time,
+// removing the tracker entirely once all its entries have completed
+func (k Keeper) PruneCompletedRedelegations(ctx sdk.Context, chainId string) {
+	currentTime := uint64(ctx.BlockTime().UnixNano())
+	for _, redelegationTracker := range k.GetAllRedelegationTrackersForHostZone(ctx, chainId) {
+		pendingCompletionTimes := []uint64{}
+		for _, completionTime := range redelegationTracker.CompletionTimes {
+			if completionTime > currentTime {
+				pendingCompletionTimes = append(pendingCompletionTimes, completionTime)
+			}
+		}
+
+		if len(pendingCompletionTimes) == 0 {
+			k.RemoveRedelegationTracker(ctx, chainId, redelegationTracker.SrcValidator, redelegationTracker.DstValidator)
+		} else if len(pendingCompletionTimes) != len(redelegationTracker.CompletionTimes) {
+			redelegationTracker.CompletionTimes = pendingCompletionTimes
+			k.SetRedelegationTracker(ctx, redelegationTracker)
+		}
+	}
+}
+
+// Checks whether a validator is the destination of a redelegation that has not yet completed
+// The host rejects redelegations from such a validator, since transitive redelegations are not allowed
+func (k Keeper) IsReceivingRedelegation(ctx sdk.Context, chainId, validator string) bool {
+	currentTime := uint64(ctx.BlockTime().UnixNano())
+	for _, redelegationTracker := range k.GetAllRedelegationTrackersForHostZone(ctx, chainId) {
+		if redelegationTracker.DstValidator != validator {
+			continue
+		}
+		for _, completionTime := range redelegationTracker.CompletionTimes {
+			if completionTime > currentTime {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Returns the number of redelegation entries between a pair of validators that have not yet completed
+func (k Keeper) GetNumPendingRedelegationEntries(ctx sdk.Context, chainId, srcValidator, dstValidator string) uint64 {
+	redelegationTracker, found := k.GetRedelegationTracker(ctx, chainId, srcValidator, dstValidator)
+	if !found {
+		return 0
+	}
+
+	currentTime := uint64(ctx.BlockTime().UnixNano())
+	numPending := uint64(0)
+	for _, completionTime := range redelegationTracker.CompletionTimes {
+		if completionTime > currentTime {
+			numPending++
+		}
+	}
+	return numPending
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"time"
+
+	_ "github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestGetRedelegationTrackers() {
+	trackers := []types.RedelegationTracker{
+		{ChainId: "GAIA", SrcValidator: "val1", DstValidator: "val2", CompletionTimes: []uint64{1}},
+		{ChainId: "GAIA", SrcValidator: "val2", DstValidator: "val1", CompletionTimes: []uint64{2}},
+		{ChainId: "OSMO", SrcValidator: "val1", DstValidator: "val2", CompletionTimes: []uint64{3}},
+	}
+	for _, tracker := range trackers {
+		s.App.StakeibcKeeper.SetRedelegationTracker(s.Ctx, tracker)
+	}
+
+	tracker, found := s.App.StakeibcKeeper.GetRedelegationTracker(s.Ctx, "GAIA", "val2", "val1")
+	s.Require().True(found, "tracker should have been found")
+	s.Require().Equal(trackers[1], tracker, "tracker")
+
+	s.Require().Len(s.App.StakeibcKeeper.GetAllRedelegationTrackersForHostZone(s.Ctx, "GAIA"), 2, "gaia trackers")
+	s.Require().Len(s.App.StakeibcKeeper.GetAllRedelegationTrackers(s.Ctx), 3, "all trackers")
+
+	s.App.StakeibcKeeper.RemoveRedelegationTracker(s.Ctx, "GAIA", "val1", "val2")
+	_, found = s.App.StakeibcKeeper.GetRedelegationTracker(s.Ctx, "GAIA", "val1", "val2")
+	s.Require().False(found, "tracker should have been removed")
+}
+
+func (s *KeeperTestSuite) TestRedelegationEntries() {
+	s.Ctx = s.Ctx.WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	blockTime := s.Ctx.BlockTime()
+	past := blockTime.Add(-time.Hour)
+	future := blockTime.Add(time.Hour)
+
+	// val1 -> val2 has one completed and two pending entries, val3 -> val1 has only a completed entry
+	s.App.StakeibcKeeper.AddRedelegationEntry(s.Ctx, HostChainId, "val1", "val2", past)
+	s.App.StakeibcKeeper.AddRedelegationEntry(s.Ctx, HostChainId, "val1", "val2", future)
+	s.App.StakeibcKeeper.AddRedelegationEntry(s.Ctx, HostChainId, "val1", "val2", future)
+	s.App.StakeibcKeeper.AddRedelegationEntry(s.Ctx, HostChainId, "val3", "val1", past)
+
+	s.Require().Equal(uint64(2), s.App.StakeibcKeeper.GetNumPendingRedelegationEntries(s.Ctx, HostChainId, "val1", "val2"), "val1 -> val2 pending entries")
+	s.Require().Equal(uint64(0), s.App.StakeibcKeeper.GetNumPendingRedelegationEntries(s.Ctx, HostChainId, "val3", "val1"), "val3 -> val1 pending entries")
+	s.Require().Equal(uint64(0), s.App.StakeibcKeeper.GetNumPendingRedelegationEntries(s.Ctx, HostChainId, "val2", "val1"), "val2 -> val1 pending entries")
+
+	s.Require().True(s.App.StakeibcKeeper.IsReceivingRedelegation(s.Ctx, HostChainId, "val2"), "val2 is receiving")
+	s.Require().False(s.App.StakeibcKeeper.IsReceivingRedelegation(s.Ctx, HostChainId, "val1"), "val1 is not receiving")
+	s.Require().False(s.App.StakeibcKeeper.IsReceivingRedelegation(s.Ctx, "OSMO", "val2"), "val2 is not receiving on osmo")
+
+	// Pruning should remove the completed entries, and the tracker without any remaining entries
+	s.App.StakeibcKeeper.PruneCompletedRedelegations(s.Ctx, HostChainId)
+
+	tracker, found := s.App.StakeibcKeeper.GetRedelegationTracker(s.Ctx, HostChainId, "val1", "val2")
+	s.Require().True(found, "val1 -> val2 tracker")
+	s.Require().Equal([]uint64{uint64(future.UnixNano()), uint64(future.UnixNano())}, tracker.CompletionTimes, "val1 -> val2 completion times")
+
+	_, found = s.App.StakeibcKeeper.GetRedelegationTracker(s.Ctx, HostChainId, "val3", "val1")
+	s.Require().False(found, "val3 -> val1 tracker should have been removed")
+}
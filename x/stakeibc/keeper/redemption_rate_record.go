@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// SetRedemptionRateRecord set a specific redemptionRateRecord in the store from its chain ID and epoch number
+func (k Keeper) SetRedemptionRateRecord(ctx sdk.Context, redemptionRateRecord types.RedemptionRateRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionRateRecordKeyPrefixByChainId(redemptionRateRecord.ChainId))
+	b := k.cdc.MustMarshal(&redemptionRateRecord)
+	store.Set(types.RedemptionRateRecordKey(redemptionRateRecord.EpochNumber), b)
+}
+
+// GetRedemptionRateRecord returns a redemptionRateRecord from its chain ID and epoch number
+func (k Keeper) GetRedemptionRateRecord(ctx sdk.Context, chainId string, epochNumber uint64) (val types.RedemptionRateRecord, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionRateRecordKeyPrefixByChainId(chainId))
+
+	b := store.Get(types.RedemptionRateRecordKey(epochNumber))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRedemptionRateRecord removes a redemptionRateRecord from the store
+func (k Keeper) RemoveRedemptionRateRecord(ctx sdk.Context, chainId string, epochNumber uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionRateRecordKeyPrefixByChainId(chainId))
+	store.Delete(types.RedemptionRateRecordKey(epochNumber))
+}
+
+// GetAllRedemptionRateRecords returns all redemptionRateRecords across every host zone
+func (k Keeper) GetAllRedemptionRateRecords(ctx sdk.Context) (list []types.RedemptionRateRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionRateRecordKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RedemptionRateRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllRedemptionRateRecordsForHostZone returns all redemptionRateRecords for a host zone, ordered by epoch
+func (k Keeper) GetAllRedemptionRateRecordsForHostZone(ctx sdk.Context, chainId string) (list []types.RedemptionRateRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionRateRecordKeyPrefixByChainId(chainId))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RedemptionRateRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// Removes the redemption rate records for a host zone that are older than the retention window
+// (i.e. records from epoch {currentEpoch - RedemptionRateHistoryRetention} and earlier)
+// If the retention is 0, the full history is kept
+func (k Keeper) PruneRedemptionRateHistory(ctx sdk.Context, chainId string, currentEpoch uint64) {
+	retention := k.GetParam(ctx, types.KeyRedemptionRateHistoryRetention)
+	if retention == 0 || currentEpoch < retention {
+		return
+	}
+	cutoffEpoch := currentEpoch - retention
+
+	// Since the records are keyed by epoch number, we only need to iterate up until the cutoff
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedemptionRateRecordKeyPrefixByChainId(chainId))
+	iterator := store.Iterator(nil, types.RedemptionRateRecordKey(cutoffEpoch+1))
+
+	expiredKeys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range expiredKeys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// Helper function to store a redemption rate record for each epoch in the range [1, numEpochs]
+func (s *KeeperTestSuite) createRedemptionRateRecords(chainId string, numEpochs uint64) []types.RedemptionRateRecord {
+	records := []types.RedemptionRateRecord{}
+	for epoch := uint64(1); epoch <= numEpochs; epoch++ {
+		record := types.RedemptionRateRecord{
+			ChainId:              chainId,
+			EpochNumber:          epoch,
+			RedemptionRate:       sdk.NewDec(int64(epoch)),
+			UndelegatedBalance:   sdk.NewInt(int64(epoch)),
+			StakedBalance:        sdk.NewInt(int64(epoch)),
+			ModuleAccountBalance: sdk.NewInt(int64(epoch)),
+			StTokenSupply:        sdk.NewInt(int64(epoch)),
+		}
+		s.App.StakeibcKeeper.SetRedemptionRateRecord(s.Ctx, record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// Helper function to grab the epoch numbers from a list of redemption rate records
+func getRedemptionRateRecordEpochs(records []types.RedemptionRateRecord) []uint64 {
+	epochs := []uint64{}
+	for _, record := range records {
+		epochs = append(epochs, record.EpochNumber)
+	}
+	return epochs
+}
+
+func (s *KeeperTestSuite) TestGetRedemptionRateRecordsForHostZone() {
+	s.createRedemptionRateRecords("GAIA", 3)
+	s.createRedemptionRateRecords("OSMO", 2)
+
+	gaiaRecords := s.App.StakeibcKeeper.GetAllRedemptionRateRecordsForHostZone(s.Ctx, "GAIA")
+	s.Require().Equal([]uint64{1, 2, 3}, getRedemptionRateRecordEpochs(gaiaRecords), "gaia records")
+
+	allRecords := s.App.StakeibcKeeper.GetAllRedemptionRateRecords(s.Ctx)
+	s.Require().Len(allRecords, 5, "all records")
+
+	s.App.StakeibcKeeper.RemoveRedemptionRateRecord(s.Ctx, "GAIA", 2)
+	_, found := s.App.StakeibcKeeper.GetRedemptionRateRecord(s.Ctx, "GAIA", 2)
+	s.Require().False(found, "record should have been removed")
+}
+
+func (s *KeeperTestSuite) TestPruneRedemptionRateHistory() {
+	s.createRedemptionRateRecords("GAIA", 10)
+	s.createRedemptionRateRecords("OSMO", 10)
+
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.RedemptionRateHistoryRetention = 4
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	// Only the most recent 4 epochs should be retained on GAIA, and OSMO should be untouched
+	s.App.StakeibcKeeper.PruneRedemptionRateHistory(s.Ctx, "GAIA", 10)
+
+	gaiaRecords := s.App.StakeibcKeeper.GetAllRedemptionRateRecordsForHostZone(s.Ctx, "GAIA")
+	s.Require().Equal([]uint64{7, 8, 9, 10}, getRedemptionRateRecordEpochs(gaiaRecords), "gaia records after prune")
+
+	osmoRecords := s.App.StakeibcKeeper.GetAllRedemptionRateRecordsForHostZone(s.Ctx, "OSMO")
+	s.Require().Len(osmoRecords, 10, "osmo records after prune")
+
+	// A retention of 0 should keep the full history
+	params.RedemptionRateHistoryRetention = 0
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	s.App.StakeibcKeeper.PruneRedemptionRateHistory(s.Ctx, "OSMO", 10)
+	osmoRecords = s.App.StakeibcKeeper.GetAllRedemptionRateRecordsForHostZone(s.Ctx, "OSMO")
+	s.Require().Len(osmoRecords, 10, "osmo records with no retention")
+}
+
+func (s *KeeperTestSuite) TestRedemptionRateHistoryQuery() {
+	s.createRedemptionRateRecords("GAIA", 10)
+	s.createRedemptionRateRecords("OSMO", 10)
+
+	for _, tc := range []struct {
+		desc           string
+		request        *types.QueryRedemptionRateHistoryRequest
+		expectedEpochs []uint64
+	}{
+		{
+			desc:           "full history",
+			request:        &types.QueryRedemptionRateHistoryRequest{ChainId: "GAIA"},
+			expectedEpochs: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		},
+		{
+			desc:           "start epoch only",
+			request:        &types.QueryRedemptionRateHistoryRequest{ChainId: "GAIA", StartEpoch: 8},
+			expectedEpochs: []uint64{8, 9, 10},
+		},
+		{
+			desc:           "start and end epoch",
+			request:        &types.QueryRedemptionRateHistoryRequest{ChainId: "GAIA", StartEpoch: 3, EndEpoch: 5},
+			expectedEpochs: []uint64{3, 4, 5},
+		},
+		{
+			desc: "paginated epoch range",
+			request: &types.QueryRedemptionRateHistoryRequest{
+				ChainId:    "GAIA",
+				StartEpoch: 3,
+				EndEpoch:   9,
+				Pagination: &query.PageRequest{Limit: 2},
+			},
+			expectedEpochs: []uint64{3, 4},
+		},
+		{
+			desc:           "unknown host zone",
+			request:        &types.QueryRedemptionRateHistoryRequest{ChainId: "JUNO"},
+			expectedEpochs: []uint64{},
+		},
+	} {
+		s.Run(tc.desc, func() {
+			response, err := s.App.StakeibcKeeper.RedemptionRateHistory(sdk.WrapSDKContext(s.Ctx), tc.request)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedEpochs, getRedemptionRateRecordEpochs(response.RedemptionRateRecords))
+		})
+	}
+
+	// Invalid requests
+	_, err := s.App.StakeibcKeeper.RedemptionRateHistory(sdk.WrapSDKContext(s.Ctx), &types.QueryRedemptionRateHistoryRequest{})
+	s.Require().ErrorContains(err, "invalid request")
+
+	_, err = s.App.StakeibcKeeper.RedemptionRateHistory(sdk.WrapSDKContext(s.Ctx),
+		&types.QueryRedemptionRateHistoryRequest{ChainId: "GAIA", StartEpoch: 5, EndEpoch: 4})
+	s.Require().ErrorContains(err, "end epoch (4) must be greater than or equal to start epoch (5)")
+}
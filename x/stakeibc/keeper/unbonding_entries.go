@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cast"
+
+	"github.com/Stride-Labs/stride/v10/utils"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// Returns the number of unbondings from a validator that have not yet completed on the host
+func (k Keeper) GetNumPendingUnbondingEntries(ctx sdk.Context, validator types.Validator) uint64 {
+	currentTime := cast.ToUint64(ctx.BlockTime().UnixNano())
+
+	numPending := uint64(0)
+	for _, completionTime := range validator.UnbondingCompletionTimes {
+		if completionTime > currentTime {
+			numPending++
+		}
+	}
+	return numPending
+}
+
+// Returns the number of additional unbondings that can be submitted from a validator
+// before the host's unbonding entry limit is reached
+func (k Keeper) GetNumAvailableUnbondingEntries(ctx sdk.Context, validator types.Validator) uint64 {
+	maxEntries := k.GetParam(ctx, types.KeyMaxUnbondingEntries)
+	numPending := k.GetNumPendingUnbondingEntries(ctx, validator)
+	if numPending >= maxEntries {
+		return 0
+	}
+	return maxEntries - numPending
+}
+
+// Returns the validators on a host zone that can still be unbonded from
+// The returned validators are copies so that they can be re-ordered without modifying the host zone
+func (k Keeper) GetValidatorsWithAvailableUnbondingEntries(ctx sdk.Context, hostZone types.HostZone) []*types.Validator {
+	validators := []*types.Validator{}
+	for _, validator := range hostZone.Validators {
+		if k.GetNumAvailableUnbondingEntries(ctx, *validator) == 0 {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+				"Skipping unbonding from %s since the max number of unbonding entries has been reached", validator.Address))
+			continue
+		}
+		validatorCopy := *validator
+		validators = append(validators, &validatorCopy)
+	}
+	return validators
+}
+
+// Records the completion time of each undelegation on the corresponding validator,
+// removing any entries that have already completed
+func (k Keeper) AddValidatorUnbondingEntries(
+	ctx sdk.Context,
+	chainId string,
+	splitDelegations []*types.SplitDelegation,
+	completionTimes []time.Time,
+) error {
+	if len(splitDelegations) != len(completionTimes) {
+		return errorsmod.Wrapf(types.ErrInvalidPacketCompletionTime,
+			"expected %d undelegation completion times, but received %d", len(splitDelegations), len(completionTimes))
+	}
+
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "Host zone not found: %s", chainId)
+	}
+
+	currentTime := cast.ToUint64(ctx.BlockTime().UnixNano())
+	for i, splitDelegation := range splitDelegations {
+		validator, valIndex, found := GetValidatorFromAddress(hostZone.Validators, splitDelegation.Validator)
+		if !found {
+			return errorsmod.Wrapf(types.ErrValidatorNotFound, "validator %s not found on host zone %s", splitDelegation.Validator, chainId)
+		}
+
+		pendingCompletionTimes := []uint64{}
+		for _, completionTime := range validator.UnbondingCompletionTimes {
+			if completionTime > currentTime {
+				pendingCompletionTimes = append(pendingCompletionTimes, completionTime)
+			}
+		}
+		validator.UnbondingCompletionTimes = append(pendingCompletionTimes, cast.ToUint64(completionTimes[i].UnixNano()))
+		hostZone.Validators[valIndex] = &validator
+
+		k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Validator %s has %d pending unbonding entries",
+			validator.Address, len(validator.UnbondingCompletionTimes)))
+	}
+	k.SetHostZone(ctx, hostZone)
+
+	return nil
+}
+
+// Returns the number of pending and available unbonding entries for each validator on a host zone
+func (k Keeper) GetValidatorUnbondingEntries(ctx sdk.Context, hostZone types.HostZone) []types.ValidatorUnbondingEntries {
+	unbondingEntries := []types.ValidatorUnbondingEntries{}
+	for _, validator := range hostZone.Validators {
+		unbondingEntries = append(unbondingEntries, types.ValidatorUnbondingEntries{
+			Address:             validator.Address,
+			NumUnbondingEntries: k.GetNumPendingUnbondingEntries(ctx, *validator),
+			NumAvailableEntries: k.GetNumAvailableUnbondingEntries(ctx, *validator),
+		})
+	}
+	return unbondingEntries
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	_ "github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestGetValidatorUnbondingEntries() {
+	s.Ctx = s.Ctx.WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	past := uint64(s.Ctx.BlockTime().Add(-time.Hour).UnixNano())
+	future := uint64(s.Ctx.BlockTime().Add(time.Hour).UnixNano())
+
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.MaxUnbondingEntries = 3
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	hostZone := types.HostZone{
+		ChainId: HostChainId,
+		Validators: []*types.Validator{
+			{Address: "val1"},
+			{Address: "val2", UnbondingCompletionTimes: []uint64{past, future}},
+			{Address: "val3", UnbondingCompletionTimes: []uint64{future, future, future}},
+			{Address: "val4", UnbondingCompletionTimes: []uint64{future, future, future, future}},
+		},
+	}
+
+	expectedEntries := []types.ValidatorUnbondingEntries{
+		{Address: "val1", NumUnbondingEntries: 0, NumAvailableEntries: 3},
+		{Address: "val2", NumUnbondingEntries: 1, NumAvailableEntries: 2},
+		{Address: "val3", NumUnbondingEntries: 3, NumAvailableEntries: 0},
+		{Address: "val4", NumUnbondingEntries: 4, NumAvailableEntries: 0},
+	}
+	s.Require().Equal(expectedEntries, s.App.StakeibcKeeper.GetValidatorUnbondingEntries(s.Ctx, hostZone), "unbonding entries")
+
+	availableValidators := s.App.StakeibcKeeper.GetValidatorsWithAvailableUnbondingEntries(s.Ctx, hostZone)
+	s.Require().Len(availableValidators, 2, "number of validators with available entries")
+	s.Require().Equal("val1", availableValidators[0].Address, "first available validator")
+	s.Require().Equal("val2", availableValidators[1].Address, "second available validator")
+}
+
+func (s *KeeperTestSuite) TestAddValidatorUnbondingEntries() {
+	s.Ctx = s.Ctx.WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	blockTime := s.Ctx.BlockTime()
+	past := uint64(blockTime.Add(-time.Hour).UnixNano())
+	future := uint64(blockTime.Add(time.Hour).UnixNano())
+
+	hostZone := types.HostZone{
+		ChainId: HostChainId,
+		Validators: []*types.Validator{
+			{Address: "val1", UnbondingCompletionTimes: []uint64{past, future}},
+			{Address: "val2"},
+			{Address: "val3", UnbondingCompletionTimes: []uint64{future}},
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	splitDelegations := []*types.SplitDelegation{
+		{Validator: "val1", Amount: sdkmath.NewInt(1)},
+		{Validator: "val2", Amount: sdkmath.NewInt(2)},
+	}
+	completionTimes := []time.Time{blockTime.Add(2 * time.Hour), blockTime.Add(3 * time.Hour)}
+
+	err := s.App.StakeibcKeeper.AddValidatorUnbondingEntries(s.Ctx, HostChainId, splitDelegations, completionTimes)
+	s.Require().NoError(err, "no error expected when adding unbonding entries")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone should have been found")
+
+	// The completed val1 entry should have been removed, and val3 should be unchanged
+	s.Require().Equal([]uint64{future, uint64(completionTimes[0].UnixNano())}, hostZone.Validators[0].UnbondingCompletionTimes, "val1 entries")
+	s.Require().Equal([]uint64{uint64(completionTimes[1].UnixNano())}, hostZone.Validators[1].UnbondingCompletionTimes, "val2 entries")
+	s.Require().Equal([]uint64{future}, hostZone.Validators[2].UnbondingCompletionTimes, "val3 entries")
+
+	// Mismatched completion times
+	err = s.App.StakeibcKeeper.AddValidatorUnbondingEntries(s.Ctx, HostChainId, splitDelegations, completionTimes[:1])
+	s.Require().ErrorContains(err, "expected 2 undelegation completion times, but received 1")
+
+	// Validator not found
+	splitDelegations[0].Validator = "val4"
+	err = s.App.StakeibcKeeper.AddValidatorUnbondingEntries(s.Ctx, HostChainId, splitDelegations, completionTimes)
+	s.Require().ErrorContains(err, "validator val4 not found on host zone GAIA")
+
+	// Host zone not found
+	err = s.App.StakeibcKeeper.AddValidatorUnbondingEntries(s.Ctx, "chain-2", splitDelegations, completionTimes)
+	s.Require().ErrorContains(err, "Host zone not found: chain-2")
+}
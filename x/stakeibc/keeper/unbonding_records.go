@@ -80,8 +80,13 @@ func (k Keeper) GetHostZoneUnbondingMsgs(ctx sdk.Context, hostZone types.HostZon
 		return nil, sdkmath.ZeroInt(), nil, nil, nil
 	}
 
+	// Validators that have reached the host's unbonding entry limit can't be unbonded from,
+	// so the unbonding is consolidated onto the validators that still have entries available
+	unbondableHostZone := hostZone
+	unbondableHostZone.Validators = k.GetValidatorsWithAvailableUnbondingEntries(ctx, hostZone)
+
 	// Determine the desired unbonding amount for each validator based based on our target weights
-	targetUnbondingsByValidator, err := k.GetTargetValAmtsForHostZone(ctx, hostZone, totalAmountToUnbond)
+	targetUnbondingsByValidator, err := k.GetTargetValAmtsForHostZone(ctx, unbondableHostZone, totalAmountToUnbond)
 	if err != nil {
 		errMsg := fmt.Sprintf("Error getting target val amts for host zone %s %v: %s", hostZone.ChainId, totalAmountToUnbond, err)
 		k.Logger(ctx).Error(errMsg)
@@ -92,7 +97,7 @@ func (k Keeper) GetHostZoneUnbondingMsgs(ctx sdk.Context, hostZone types.HostZon
 	// If it doesn't have enough, update the target to equal their total delegations and record the overflow amount
 	finalUnbondingsByValidator := make(map[string]sdkmath.Int)
 	overflowAmount := sdkmath.ZeroInt()
-	for _, validator := range hostZone.Validators {
+	for _, validator := range unbondableHostZone.Validators {
 
 		targetUnbondAmount := targetUnbondingsByValidator[validator.Address]
 		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
@@ -112,7 +117,7 @@ func (k Keeper) GetHostZoneUnbondingMsgs(ctx sdk.Context, hostZone types.HostZon
 		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
 			"Expected validator undelegation amount on is greater than it's current delegations. Redistributing undelegations accordingly."))
 
-		for _, validator := range hostZone.Validators {
+		for _, validator := range unbondableHostZone.Validators {
 			targetUnbondAmount := finalUnbondingsByValidator[validator.Address]
 
 			// Check if we can unbond more from this validator
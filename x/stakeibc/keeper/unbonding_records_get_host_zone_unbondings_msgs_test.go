@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -207,6 +208,58 @@ func (s *KeeperTestSuite) TestGetHostZoneUnbondingMsgs_UnbondingTooMuch() {
 	s.Require().EqualError(err, fmt.Sprintf("Could not unbond %v on Host Zone %s, unable to balance the unbond amount across validators: not found", tc.amtToUnbond.Mul(sdkmath.NewInt(int64(len(tc.epochUnbondingRecords)))), tc.hostZone.ChainId))
 }
 
+// Sets the max number of pending unbonding entries on the validator at the given index
+func (s *KeeperTestSuite) fillValidatorUnbondingEntries(hostZone *stakeibc.HostZone, validatorIndex int) {
+	completionTime := uint64(s.Ctx.BlockTime().Add(time.Hour).UnixNano())
+	for i := uint64(0); i < stakeibc.DefaultMaxUnbondingEntries; i++ {
+		hostZone.Validators[validatorIndex].UnbondingCompletionTimes = append(hostZone.Validators[validatorIndex].UnbondingCompletionTimes, completionTime)
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, *hostZone)
+}
+
+func (s *KeeperTestSuite) TestGetHostZoneUnbondingMsgs_MaxUnbondingEntries() {
+	tc := s.SetupGetHostZoneUnbondingMsgs()
+
+	// Val2 has no unbonding entries available, so its portion should be consolidated onto val1 and val3
+	s.fillValidatorUnbondingEntries(&tc.hostZone, 1)
+
+	msgs, totalAmtToUnbond, callbackArgsBz, _, err := s.App.StakeibcKeeper.GetHostZoneUnbondingMsgs(s.Ctx, tc.hostZone)
+	s.Require().NoError(err, "no error expected when getting unbonding msgs")
+	s.Require().Len(msgs, 2, "number of unbonding messages")
+
+	callbackArgs, err := s.App.StakeibcKeeper.UnmarshalUndelegateCallbackArgs(s.Ctx, callbackArgsBz)
+	s.Require().NoError(err, "no error expected when unmarshalling callback args")
+
+	// Val1 has weight 1 and val3 has weight 2, so val1 unbonds 1/3 of the total
+	expectedUnbondings := map[string]sdkmath.Int{
+		tc.valNames[0]: totalAmtToUnbond.QuoRaw(3),
+		tc.valNames[2]: totalAmtToUnbond.Sub(totalAmtToUnbond.QuoRaw(3)),
+	}
+	s.Require().Len(callbackArgs.SplitDelegations, 2, "number of split delegations")
+	for _, splitDelegation := range callbackArgs.SplitDelegations {
+		expectedAmount, ok := expectedUnbondings[splitDelegation.Validator]
+		s.Require().True(ok, "unexpected unbonding from %s", splitDelegation.Validator)
+		s.Require().Equal(expectedAmount.Int64(), splitDelegation.Amount.Int64(), "unbonding amount for %s", splitDelegation.Validator)
+	}
+
+	// Once the val2 unbondings complete, it should be included again
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(2 * time.Hour))
+	msgs, _, _, _, err = s.App.StakeibcKeeper.GetHostZoneUnbondingMsgs(s.Ctx, tc.hostZone)
+	s.Require().NoError(err, "no error expected after the unbondings complete")
+	s.Require().Len(msgs, 3, "number of unbonding messages after the unbondings complete")
+}
+
+func (s *KeeperTestSuite) TestGetHostZoneUnbondingMsgs_NoUnbondingEntriesAvailable() {
+	tc := s.SetupGetHostZoneUnbondingMsgs()
+
+	for i := range tc.hostZone.Validators {
+		s.fillValidatorUnbondingEntries(&tc.hostZone, i)
+	}
+
+	_, _, _, _, err := s.App.StakeibcKeeper.GetHostZoneUnbondingMsgs(s.Ctx, tc.hostZone)
+	s.Require().ErrorContains(err, "Error getting target val amts for host zone GAIA")
+}
+
 func (s *KeeperTestSuite) TestGetTargetValAmtsForHostZone_Success() {
 	tc := s.SetupGetHostZoneUnbondingMsgs()
 
@@ -80,7 +80,7 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRatesSuccessful() {
 	s.Require().Equal(initialRedemptionRate, sdk.NewDec(1), "t0 rr")
 
 	records := tc.allRecords
-	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, records)
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, records)
 
 	hz, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, tc.hostZone.ChainId)
 	s.Require().True(found, "hz found")
@@ -88,6 +88,15 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRatesSuccessful() {
 
 	expectedNewRate := sdk.NewDec(5 + 3 + 3).Quo(sdk.NewDec(10))
 	s.Require().Equal(rrNew, expectedNewRate, "rr as expected")
+
+	// Confirm the rate and its components were stored in the history
+	record, found := s.App.StakeibcKeeper.GetRedemptionRateRecord(s.Ctx, tc.hostZone.ChainId, 1)
+	s.Require().True(found, "redemption rate record found")
+	s.Require().Equal(expectedNewRate, record.RedemptionRate, "record redemption rate")
+	s.Require().Equal(undelegatedBal, record.UndelegatedBalance, "record undelegated balance")
+	s.Require().Equal(stakedBal, record.StakedBalance, "record staked balance")
+	s.Require().Equal(justDepositedBal, record.ModuleAccountBalance, "record module account balance")
+	s.Require().Equal(stSupply, record.StTokenSupply, "record stToken supply")
 }
 
 func (s *KeeperTestSuite) TestUpdateRedemptionRatesRandomized() {
@@ -107,7 +116,7 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRatesRandomized() {
 	s.Require().Equal(initialRedemptionRate, sdk.NewDec(1), "t0 rr")
 
 	records := tc.allRecords
-	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, records)
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, records)
 
 	hz, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, tc.hostZone.ChainId)
 	s.Require().True(found, "hz found")
@@ -146,7 +155,7 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRateZeroStAssets() {
 	s.Require().Equal(initialRedemptionRate, sdk.NewDec(1), "t0 rr")
 
 	records := tc.allRecords
-	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, records)
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, records)
 
 	hz, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, tc.hostZone.ChainId)
 	s.Require().True(found, "hz found")
@@ -169,7 +178,7 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRateZeroNativeAssets() {
 	s.Require().Equal(initialRedemptionRate, sdk.NewDec(1), "t0 rr")
 
 	records := tc.allRecords
-	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, records)
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, records)
 
 	hz, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, tc.hostZone.ChainId)
 	s.Require().True(found, "hz found")
@@ -193,7 +202,7 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRateNoModuleAccountRecords() {
 
 	// filter out the TRANSFER_QUEUE record from the records when updating the redemption rate
 	records := tc.allRecords[1:]
-	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, records)
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, records)
 
 	hz, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, tc.hostZone.ChainId)
 	s.Require().True(found, "hz found")
@@ -217,7 +226,7 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRateNoStakeDepositRecords() {
 
 	// filter out the DELEGATION_QUEUE record from the records when updating the redemption rate
 	records := tc.allRecords[:1]
-	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, records)
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, records)
 
 	hz, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, tc.hostZone.ChainId)
 	s.Require().True(found, "hz found")
@@ -242,7 +251,7 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRateNoStakedBal() {
 	s.Require().Equal(initialRedemptionRate, sdk.NewDec(1))
 
 	records := tc.allRecords
-	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, records)
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, records)
 
 	hz, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, tc.hostZone.ChainId)
 	s.Require().True(found, "hz found")
@@ -272,7 +281,7 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRateRandominitialRedemptionRate()
 	tc := s.SetupUpdateRedemptionRates(stakedBal, undelegatedBal, justDepositedBal, stSupply, initialRedemptionRate)
 
 	records := tc.allRecords
-	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, records)
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, 1, records)
 
 	hz, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, tc.hostZone.ChainId)
 	s.Require().True(found, "hz found")
@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Stride-Labs/stride/v10/utils"
+	epochstypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
+	icqtypes "github.com/Stride-Labs/stride/v10/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+// Total weight that's split across a host zone's validators by the EQUAL and SCORED strategies
+const ValidatorWeightPrecision = 10_000
+
+// Submits an ICQ for a validator's commission, tokens and jailed status
+// The callback then submits a follow up ICQ for the validator's signing info
+// Metrics are only needed once per day epoch, so the query expires at the start of the next day epoch
+func (k Keeper) SubmitValidatorMetricsICQ(ctx sdk.Context, hostZone types.HostZone, valoper string) error {
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Submitting ICQ for validator metrics of %s", valoper))
+
+	_, validatorAddressBz, err := bech32.DecodeAndConvert(valoper)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid validator address, could not decode (%s)", err.Error())
+	}
+	queryData := stakingtypes.GetValidatorKey(validatorAddressBz)
+
+	ttl, err := k.GetStartTimeNextEpoch(ctx, epochstypes.DAY_EPOCH)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "could not get start time for next epoch: %s", err.Error())
+	}
+
+	return k.InterchainQueryKeeper.MakeRequest(
+		ctx,
+		types.ModuleName,
+		ICQCallbackID_ValidatorMetrics,
+		hostZone.ChainId,
+		hostZone.ConnectionId,
+		icqtypes.STAKING_STORE_QUERY_WITH_PROOF,
+		queryData,
+		ttl,
+		icqtypes.TimeoutPolicy_REJECT_QUERY_RESPONSE,
+	)
+}
+
+// Submits an ICQ for a validator's signing info, used to determine the validator's uptime
+func (k Keeper) SubmitValidatorSigningInfoICQ(ctx sdk.Context, hostZone types.HostZone, consAddress sdk.ConsAddress) error {
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Submitting ICQ for validator signing info of %X", consAddress.Bytes()))
+
+	ttl, err := k.GetStartTimeNextEpoch(ctx, epochstypes.DAY_EPOCH)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "could not get start time for next epoch: %s", err.Error())
+	}
+
+	return k.InterchainQueryKeeper.MakeRequest(
+		ctx,
+		types.ModuleName,
+		ICQCallbackID_ValidatorSigningInfo,
+		hostZone.ChainId,
+		hostZone.ConnectionId,
+		icqtypes.SLASHING_STORE_QUERY_WITH_PROOF,
+		slashingtypes.ValidatorSigningInfoKey(consAddress),
+		ttl,
+		icqtypes.TimeoutPolicy_REJECT_QUERY_RESPONSE,
+	)
+}
+
+// Submits the validator metrics ICQs for each validator on a host zone
+func (k Keeper) SubmitValidatorMetricsICQs(ctx sdk.Context, hostZone types.HostZone) error {
+	for _, validator := range hostZone.Validators {
+		if err := k.SubmitValidatorMetricsICQ(ctx, hostZone, validator.Address); err != nil {
+			return errorsmod.Wrapf(types.ErrICQFailed, "unable to submit metrics query for validator %s: %s", validator.Address, err.Error())
+		}
+	}
+	return nil
+}
+
+// Scores a validator from its latest metrics as:
+//
+//	score = (1 - commission) * uptime * voting_power_factor
+//
+// where uptime is the fraction of the signed blocks window that was not missed, and the voting power factor
+// penalizes validators whose share of the host zone's stake exceeds the voting power cap (cap / share)
+// Jailed and tombstoned validators have a score of 0
+//
+// The score is only relative to the validators registered on Stride: the voting power share is taken
+// from the total tokens of the registered validators (not the host's full validator set), and the signed
+// blocks window is the ValidatorScoreSignedBlocksWindow param (not the host's slashing param), so it should
+// be kept in line with the host's window
+func ScoreValidator(metrics types.ValidatorMetrics, totalTokens sdkmath.Int, signedBlocksWindow uint64, votingPowerCap sdk.Dec) sdk.Dec {
+	if metrics.Jailed || metrics.Tombstoned {
+		return sdk.ZeroDec()
+	}
+
+	commissionFactor := sdk.OneDec().Sub(metrics.CommissionRate)
+	if commissionFactor.IsNegative() {
+		return sdk.ZeroDec()
+	}
+
+	window := sdk.NewDecFromInt(sdkmath.NewIntFromUint64(signedBlocksWindow))
+	uptime := sdk.OneDec().Sub(sdk.NewDec(metrics.MissedBlocksCounter).Quo(window))
+	if uptime.IsNegative() {
+		return sdk.ZeroDec()
+	}
+
+	votingPowerFactor := sdk.OneDec()
+	if totalTokens.IsPositive() {
+		share := sdk.NewDecFromInt(metrics.Tokens).Quo(sdk.NewDecFromInt(totalTokens))
+		if share.GT(votingPowerCap) {
+			votingPowerFactor = votingPowerCap.Quo(share)
+		}
+	}
+
+	return commissionFactor.Mul(uptime).Mul(votingPowerFactor)
+}
+
+// Converts validator scores into integer weights that sum to (at most) ValidatorWeightPrecision,
+// while ensuring no validator receives more than maxWeightPercent of the total
+// If the cap is too low to be satisfied with the number of scored validators, the validators are weighted equally
+// Excess weight from capped validators is redistributed proportionally to the remaining validators
+func CapValidatorWeights(scores []sdk.Dec, maxWeightPercent uint64) ([]uint64, error) {
+	totalScore := sdk.ZeroDec()
+	numScored := int64(0)
+	for _, score := range scores {
+		if score.IsPositive() {
+			totalScore = totalScore.Add(score)
+			numScored++
+		}
+	}
+	if numScored == 0 {
+		return nil, errorsmod.Wrap(types.ErrNoValidatorWeights, "no validator has a positive score")
+	}
+
+	maxShare := sdk.NewDec(int64(maxWeightPercent)).Quo(sdk.NewDec(100))
+	minimumMaxShare := sdk.OneDec().Quo(sdk.NewDec(numScored))
+	if maxShare.LT(minimumMaxShare) {
+		maxShare = minimumMaxShare
+	}
+
+	// Repeatedly cap any validator above the max share and split the remaining share amongst the uncapped validators
+	shares := make([]sdk.Dec, len(scores))
+	capped := make([]bool, len(scores))
+	remainingShare := sdk.OneDec()
+	remainingScore := totalScore
+	for {
+		newlyCapped := false
+		for i, score := range scores {
+			if capped[i] || !score.IsPositive() {
+				continue
+			}
+			share := score.Mul(remainingShare).Quo(remainingScore)
+			if share.GT(maxShare) {
+				capped[i] = true
+				newlyCapped = true
+				remainingShare = remainingShare.Sub(maxShare)
+				remainingScore = remainingScore.Sub(score)
+			}
+		}
+		if !newlyCapped || !remainingScore.IsPositive() {
+			break
+		}
+	}
+
+	for i, score := range scores {
+		switch {
+		case capped[i]:
+			shares[i] = maxShare
+		case score.IsPositive():
+			shares[i] = score.Mul(remainingShare).Quo(remainingScore)
+		default:
+			shares[i] = sdk.ZeroDec()
+		}
+	}
+
+	weights := make([]uint64, len(scores))
+	for i, share := range shares {
+		weights[i] = share.MulInt64(ValidatorWeightPrecision).TruncateInt().Uint64()
+	}
+	return weights, nil
+}
+
+// Computes the weight of each validator on the host zone (in the same order as hostZone.Validators)
+// from the selection strategy, returning false if the weights should not be changed
+func (k Keeper) GetStrategyValidatorWeights(ctx sdk.Context, hostZone types.HostZone) (weights []uint64, update bool, err error) {
+	maxWeightPercent := k.GetParam(ctx, types.KeyMaxValidatorWeightPercent)
+
+	scores := make([]sdk.Dec, len(hostZone.Validators))
+	switch hostZone.ValidatorSelectionStrategy {
+	case types.ValidatorSelectionStrategy_STATIC:
+		return nil, false, nil
+
+	case types.ValidatorSelectionStrategy_EQUAL:
+		for i := range hostZone.Validators {
+			scores[i] = sdk.OneDec()
+		}
+
+	case types.ValidatorSelectionStrategy_SCORED:
+		// Metrics are populated asynchronously from ICQ, so the weights can only be scored once every validator has been queried
+		// The voting power share of each validator is relative to the total tokens of the registered validators
+		totalTokens := sdkmath.ZeroInt()
+		for _, validator := range hostZone.Validators {
+			if validator.Metrics == nil {
+				k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+					"Validator %s does not have metrics yet, skipping weight update", validator.Address))
+				return nil, false, nil
+			}
+			totalTokens = totalTokens.Add(validator.Metrics.Tokens)
+		}
+
+		signedBlocksWindow := k.GetParam(ctx, types.KeyValidatorScoreSignedBlocksWindow)
+		votingPowerCap := sdk.NewDec(int64(k.GetParam(ctx, types.KeyValidatorScoreVotingPowerCap))).Quo(sdk.NewDec(100))
+		for i, validator := range hostZone.Validators {
+			scores[i] = ScoreValidator(*validator.Metrics, totalTokens, signedBlocksWindow, votingPowerCap)
+		}
+
+	default:
+		return nil, false, fmt.Errorf("unrecognized validator selection strategy %s", hostZone.ValidatorSelectionStrategy)
+	}
+
+	weights, err = CapValidatorWeights(scores, maxWeightPercent)
+	if err != nil {
+		return nil, false, err
+	}
+	return weights, true, nil
+}
+
+// Updates the weights of a host zone's validators according to the host zone's selection strategy
+func (k Keeper) UpdateValidatorWeights(ctx sdk.Context, hostZone types.HostZone) error {
+	weights, update, err := k.GetStrategyValidatorWeights(ctx, hostZone)
+	if err != nil {
+		return err
+	}
+	if !update {
+		return nil
+	}
+
+	for i, validator := range hostZone.Validators {
+		validator.Weight = weights[i]
+	}
+	k.SetHostZone(ctx, hostZone)
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Updated validator weights using the %s strategy", hostZone.ValidatorSelectionStrategy))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateValidatorWeights,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyValidatorSelectionStrategy, hostZone.ValidatorSelectionStrategy.String()),
+		),
+	)
+
+	return nil
+}
+
+// Recomputes validator weights for each active host zone that doesn't use static weights
+// For scored host zones, the metrics are then re-queried so they're refreshed by the next day epoch
+func (k Keeper) UpdateValidatorWeightsForAllHostZones(ctx sdk.Context) {
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		if hostZone.ValidatorSelectionStrategy == types.ValidatorSelectionStrategy_STATIC {
+			continue
+		}
+
+		if err := k.UpdateValidatorWeights(ctx, hostZone); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to update validator weights for host zone %s, err: %s", hostZone.ChainId, err.Error()))
+		}
+
+		if hostZone.ValidatorSelectionStrategy == types.ValidatorSelectionStrategy_SCORED {
+			// Re-read the host zone since the weights may have been updated
+			hostZone, _ = k.GetHostZone(ctx, hostZone.ChainId)
+			if err := k.SubmitValidatorMetricsICQs(ctx, hostZone); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Unable to query validator metrics for host zone %s, err: %s", hostZone.ChainId, err.Error()))
+			}
+		}
+	}
+}
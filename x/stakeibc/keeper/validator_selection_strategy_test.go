@@ -0,0 +1,280 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	epochtypes "github.com/Stride-Labs/stride/v10/x/epochs/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v10/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func TestScoreValidator(t *testing.T) {
+	signedBlocksWindow := uint64(10_000)
+	votingPowerCap := sdk.MustNewDecFromStr("0.1")
+	totalTokens := sdkmath.NewInt(1000)
+
+	testCases := []struct {
+		name          string
+		metrics       types.ValidatorMetrics
+		totalTokens   sdkmath.Int
+		expectedScore sdk.Dec
+	}{
+		{
+			name:          "commission only",
+			metrics:       types.ValidatorMetrics{CommissionRate: sdk.MustNewDecFromStr("0.1"), Tokens: sdkmath.NewInt(50)},
+			totalTokens:   totalTokens,
+			expectedScore: sdk.MustNewDecFromStr("0.9"),
+		},
+		{
+			name:          "missed blocks",
+			metrics:       types.ValidatorMetrics{CommissionRate: sdk.MustNewDecFromStr("0.1"), Tokens: sdkmath.NewInt(50), MissedBlocksCounter: 1000},
+			totalTokens:   totalTokens,
+			expectedScore: sdk.MustNewDecFromStr("0.81"),
+		},
+		{
+			name:          "above voting power cap",
+			metrics:       types.ValidatorMetrics{CommissionRate: sdk.MustNewDecFromStr("0.1"), Tokens: sdkmath.NewInt(500)},
+			totalTokens:   totalTokens,
+			expectedScore: sdk.MustNewDecFromStr("0.18"), // 0.9 * (0.1 / 0.5)
+		},
+		{
+			name:          "no total tokens",
+			metrics:       types.ValidatorMetrics{CommissionRate: sdk.MustNewDecFromStr("0.1"), Tokens: sdkmath.ZeroInt()},
+			totalTokens:   sdkmath.ZeroInt(),
+			expectedScore: sdk.MustNewDecFromStr("0.9"),
+		},
+		{
+			name:          "missed more blocks than the window",
+			metrics:       types.ValidatorMetrics{CommissionRate: sdk.ZeroDec(), Tokens: sdkmath.NewInt(50), MissedBlocksCounter: 20_000},
+			totalTokens:   totalTokens,
+			expectedScore: sdk.ZeroDec(),
+		},
+		{
+			name:          "jailed",
+			metrics:       types.ValidatorMetrics{CommissionRate: sdk.ZeroDec(), Tokens: sdkmath.NewInt(50), Jailed: true},
+			totalTokens:   totalTokens,
+			expectedScore: sdk.ZeroDec(),
+		},
+		{
+			name:          "tombstoned",
+			metrics:       types.ValidatorMetrics{CommissionRate: sdk.ZeroDec(), Tokens: sdkmath.NewInt(50), Tombstoned: true},
+			totalTokens:   totalTokens,
+			expectedScore: sdk.ZeroDec(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			score := stakeibckeeper.ScoreValidator(tc.metrics, tc.totalTokens, signedBlocksWindow, votingPowerCap)
+			require.Equal(t, tc.expectedScore.String(), score.String())
+		})
+	}
+}
+
+func TestCapValidatorWeights(t *testing.T) {
+	decs := func(scores ...int64) []sdk.Dec {
+		decScores := []sdk.Dec{}
+		for _, score := range scores {
+			decScores = append(decScores, sdk.NewDec(score))
+		}
+		return decScores
+	}
+
+	testCases := []struct {
+		name             string
+		scores           []sdk.Dec
+		maxWeightPercent uint64
+		expectedWeights  []uint64
+		expectedError    string
+	}{
+		{
+			name:             "proportional to score",
+			scores:           decs(1, 3),
+			maxWeightPercent: 100,
+			expectedWeights:  []uint64{2500, 7500},
+		},
+		{
+			name:             "cap below equal share",
+			scores:           decs(1, 1, 1, 1),
+			maxWeightPercent: 10,
+			expectedWeights:  []uint64{2500, 2500, 2500, 2500},
+		},
+		{
+			name:             "excess redistributed",
+			scores:           decs(10, 1, 1, 1, 1, 1, 1, 1, 1, 1),
+			maxWeightPercent: 20,
+			expectedWeights:  []uint64{2000, 888, 888, 888, 888, 888, 888, 888, 888, 888},
+		},
+		{
+			name:             "cascading caps",
+			scores:           decs(6, 3, 1),
+			maxWeightPercent: 40,
+			expectedWeights:  []uint64{4000, 4000, 2000},
+		},
+		{
+			name:             "zero scores excluded",
+			scores:           decs(2, 0, 2),
+			maxWeightPercent: 10,
+			expectedWeights:  []uint64{5000, 0, 5000},
+		},
+		{
+			name:             "all scores zero",
+			scores:           decs(0, 0),
+			maxWeightPercent: 10,
+			expectedError:    "no validator has a positive score",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			weights, err := stakeibckeeper.CapValidatorWeights(tc.scores, tc.maxWeightPercent)
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedWeights, weights)
+		})
+	}
+}
+
+// Creates a host zone with three validators, with the weights all set to 1
+func (s *KeeperTestSuite) SetupValidatorSelectionStrategy(strategy types.ValidatorSelectionStrategy) types.HostZone {
+	hostZone := types.HostZone{
+		ChainId:                    HostChainId,
+		ConnectionId:               ibctesting.FirstConnectionID,
+		Bech32Prefix:               "cosmos",
+		ValidatorSelectionStrategy: strategy,
+		Validators: []*types.Validator{
+			{Name: "val1", Address: "cosmosvaloper1uk4ze0x4nvh4fk0xm4jdud58eqn4yxhrdt795p", Weight: 1},
+			{Name: "val2", Address: "cosmosvaloper1zyfpx9q4zct3sxg6rvwp68slyqsjygey809juc", Weight: 1},
+			{Name: "val3", Address: "cosmosvaloper1yg3jgffxyu5zj23t9skjutesxyerxdp4jk5rq7", Weight: 1},
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	return hostZone
+}
+
+// Sets the metrics for each validator so that val1 and val2 have positive scores (of 0.95 and 0.9) and val3 is jailed
+func (s *KeeperTestSuite) SetValidatorMetrics(hostZone types.HostZone) types.HostZone {
+	hostZone.Validators[0].Metrics = &types.ValidatorMetrics{CommissionRate: sdk.MustNewDecFromStr("0.05"), Tokens: sdkmath.NewInt(100)}
+	hostZone.Validators[1].Metrics = &types.ValidatorMetrics{CommissionRate: sdk.MustNewDecFromStr("0.1"), Tokens: sdkmath.NewInt(100)}
+	hostZone.Validators[2].Metrics = &types.ValidatorMetrics{CommissionRate: sdk.MustNewDecFromStr("0.1"), Tokens: sdkmath.NewInt(100), Jailed: true}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// Remove the voting power penalty and relax the concentration cap so the weights are proportional to the score
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.ValidatorScoreVotingPowerCap = 100
+	params.MaxValidatorWeightPercent = 60
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	return hostZone
+}
+
+func (s *KeeperTestSuite) checkValidatorWeights(expectedWeights []uint64) {
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+
+	actualWeights := []uint64{}
+	for _, validator := range hostZone.Validators {
+		actualWeights = append(actualWeights, validator.Weight)
+	}
+	s.Require().Equal(expectedWeights, actualWeights, "validator weights")
+}
+
+func (s *KeeperTestSuite) TestUpdateValidatorWeights_Static() {
+	hostZone := s.SetupValidatorSelectionStrategy(types.ValidatorSelectionStrategy_STATIC)
+	hostZone = s.SetValidatorMetrics(hostZone)
+
+	err := s.App.StakeibcKeeper.UpdateValidatorWeights(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when updating weights")
+	s.checkValidatorWeights([]uint64{1, 1, 1})
+}
+
+func (s *KeeperTestSuite) TestUpdateValidatorWeights_Equal() {
+	hostZone := s.SetupValidatorSelectionStrategy(types.ValidatorSelectionStrategy_EQUAL)
+
+	err := s.App.StakeibcKeeper.UpdateValidatorWeights(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when updating weights")
+	s.checkValidatorWeights([]uint64{3333, 3333, 3333})
+}
+
+func (s *KeeperTestSuite) TestUpdateValidatorWeights_Scored() {
+	hostZone := s.SetupValidatorSelectionStrategy(types.ValidatorSelectionStrategy_SCORED)
+	hostZone = s.SetValidatorMetrics(hostZone)
+
+	err := s.App.StakeibcKeeper.UpdateValidatorWeights(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when updating weights")
+
+	// val1: 0.95 / 1.85, val2: 0.9 / 1.85, val3: jailed
+	s.checkValidatorWeights([]uint64{5135, 4864, 0})
+}
+
+func (s *KeeperTestSuite) TestUpdateValidatorWeights_ScoredConcentrationCap() {
+	hostZone := s.SetupValidatorSelectionStrategy(types.ValidatorSelectionStrategy_SCORED)
+	hostZone = s.SetValidatorMetrics(hostZone)
+
+	// With only two scored validators, the cap can't be below 50%
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.MaxValidatorWeightPercent = 10
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	err := s.App.StakeibcKeeper.UpdateValidatorWeights(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when updating weights")
+	s.checkValidatorWeights([]uint64{5000, 5000, 0})
+}
+
+func (s *KeeperTestSuite) TestUpdateValidatorWeights_ScoredMissingMetrics() {
+	hostZone := s.SetupValidatorSelectionStrategy(types.ValidatorSelectionStrategy_SCORED)
+	hostZone = s.SetValidatorMetrics(hostZone)
+
+	// If any validator hasn't been queried yet, the weights should not be changed
+	hostZone.Validators[1].Metrics = nil
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err := s.App.StakeibcKeeper.UpdateValidatorWeights(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when updating weights")
+	s.checkValidatorWeights([]uint64{1, 1, 1})
+}
+
+func (s *KeeperTestSuite) TestUpdateValidatorWeights_ScoredAllZero() {
+	hostZone := s.SetupValidatorSelectionStrategy(types.ValidatorSelectionStrategy_SCORED)
+	hostZone = s.SetValidatorMetrics(hostZone)
+
+	for _, validator := range hostZone.Validators {
+		validator.Metrics.Tombstoned = true
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err := s.App.StakeibcKeeper.UpdateValidatorWeights(s.Ctx, hostZone)
+	s.Require().ErrorContains(err, "no validator has a positive score")
+	s.checkValidatorWeights([]uint64{1, 1, 1})
+}
+
+func (s *KeeperTestSuite) TestUpdateValidatorWeightsForAllHostZones() {
+	s.CreateTransferChannel(HostChainId)
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier:    epochtypes.DAY_EPOCH,
+		EpochNumber:        1,
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 1_000_000_000),
+	})
+
+	hostZone := s.SetupValidatorSelectionStrategy(types.ValidatorSelectionStrategy_SCORED)
+	s.SetValidatorMetrics(hostZone)
+
+	s.App.StakeibcKeeper.UpdateValidatorWeightsForAllHostZones(s.Ctx)
+
+	// The weights should be updated from the existing metrics, and a metrics query should be submitted for each validator
+	s.checkValidatorWeights([]uint64{5135, 4864, 0})
+
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 3, "number of metrics queries submitted")
+	for _, query := range queries {
+		s.Require().Equal(stakeibckeeper.ICQCallbackID_ValidatorMetrics, query.CallbackId, "query callback id")
+	}
+}
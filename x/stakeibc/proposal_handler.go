@@ -16,6 +16,8 @@ func NewStakeibcProposalHandler(k keeper.Keeper) govtypes.Handler {
 		switch c := content.(type) {
 		case *types.AddValidatorsProposal:
 			return k.AddValidatorsProposal(ctx, c)
+		case *types.UpdateHostZoneProposal:
+			return k.UpdateHostZoneProposal(ctx, c)
 
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized stakeibc proposal content type: %T", c)
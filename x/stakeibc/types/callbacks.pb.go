@@ -191,6 +191,50 @@ func (m *ClaimCallback) GetEpochNumber() uint64 {
 	return 0
 }
 
+type AutoClaimCallback struct {
+	Claims []ClaimCallback `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims"`
+}
+
+func (m *AutoClaimCallback) Reset()         { *m = AutoClaimCallback{} }
+func (m *AutoClaimCallback) String() string { return proto.CompactTextString(m) }
+func (*AutoClaimCallback) ProtoMessage()    {}
+func (*AutoClaimCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41c99b09b96a5ac, []int{3}
+}
+func (m *AutoClaimCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoClaimCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoClaimCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoClaimCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoClaimCallback.Merge(m, src)
+}
+func (m *AutoClaimCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoClaimCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoClaimCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoClaimCallback proto.InternalMessageInfo
+
+func (m *AutoClaimCallback) GetClaims() []ClaimCallback {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
 // ---------------------- Reinvest Callback ---------------------- //
 type ReinvestCallback struct {
 	ReinvestAmount types.Coin `protobuf:"bytes,1,opt,name=reinvest_amount,json=reinvestAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"reinvest_amount"`
@@ -201,7 +245,7 @@ func (m *ReinvestCallback) Reset()         { *m = ReinvestCallback{} }
 func (m *ReinvestCallback) String() string { return proto.CompactTextString(m) }
 func (*ReinvestCallback) ProtoMessage()    {}
 func (*ReinvestCallback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41c99b09b96a5ac, []int{3}
+	return fileDescriptor_f41c99b09b96a5ac, []int{4}
 }
 func (m *ReinvestCallback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -255,7 +299,7 @@ func (m *UndelegateCallback) Reset()         { *m = UndelegateCallback{} }
 func (m *UndelegateCallback) String() string { return proto.CompactTextString(m) }
 func (*UndelegateCallback) ProtoMessage()    {}
 func (*UndelegateCallback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41c99b09b96a5ac, []int{4}
+	return fileDescriptor_f41c99b09b96a5ac, []int{5}
 }
 func (m *UndelegateCallback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,7 +359,7 @@ func (m *RedemptionCallback) Reset()         { *m = RedemptionCallback{} }
 func (m *RedemptionCallback) String() string { return proto.CompactTextString(m) }
 func (*RedemptionCallback) ProtoMessage()    {}
 func (*RedemptionCallback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41c99b09b96a5ac, []int{5}
+	return fileDescriptor_f41c99b09b96a5ac, []int{6}
 }
 func (m *RedemptionCallback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,7 +412,7 @@ func (m *Rebalancing) Reset()         { *m = Rebalancing{} }
 func (m *Rebalancing) String() string { return proto.CompactTextString(m) }
 func (*Rebalancing) ProtoMessage()    {}
 func (*Rebalancing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41c99b09b96a5ac, []int{6}
+	return fileDescriptor_f41c99b09b96a5ac, []int{7}
 }
 func (m *Rebalancing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,7 +464,7 @@ func (m *RebalanceCallback) Reset()         { *m = RebalanceCallback{} }
 func (m *RebalanceCallback) String() string { return proto.CompactTextString(m) }
 func (*RebalanceCallback) ProtoMessage()    {}
 func (*RebalanceCallback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41c99b09b96a5ac, []int{7}
+	return fileDescriptor_f41c99b09b96a5ac, []int{8}
 }
 func (m *RebalanceCallback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,6 +511,7 @@ func init() {
 	proto.RegisterType((*SplitDelegation)(nil), "stride.stakeibc.SplitDelegation")
 	proto.RegisterType((*DelegateCallback)(nil), "stride.stakeibc.DelegateCallback")
 	proto.RegisterType((*ClaimCallback)(nil), "stride.stakeibc.ClaimCallback")
+	proto.RegisterType((*AutoClaimCallback)(nil), "stride.stakeibc.AutoClaimCallback")
 	proto.RegisterType((*ReinvestCallback)(nil), "stride.stakeibc.ReinvestCallback")
 	proto.RegisterType((*UndelegateCallback)(nil), "stride.stakeibc.UndelegateCallback")
 	proto.RegisterType((*RedemptionCallback)(nil), "stride.stakeibc.RedemptionCallback")
@@ -477,47 +522,49 @@ func init() {
 func init() { proto.RegisterFile("stride/stakeibc/callbacks.proto", fileDescriptor_f41c99b09b96a5ac) }
 
 var fileDescriptor_f41c99b09b96a5ac = []byte{
-	// 630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x4f, 0x13, 0x41,
-	0x18, 0xc6, 0xbb, 0x94, 0xa0, 0xbc, 0x2d, 0x16, 0x36, 0x46, 0x0b, 0x21, 0xdb, 0xba, 0x24, 0x4a,
-	0x4c, 0xd8, 0x15, 0x3c, 0x19, 0x2f, 0x08, 0xc6, 0xa4, 0x09, 0x7a, 0x58, 0x82, 0x07, 0x2e, 0x9b,
-	0xd9, 0x9d, 0x49, 0x3b, 0x61, 0x77, 0xa6, 0xd9, 0x77, 0x5a, 0xd4, 0x4f, 0xe0, 0xd1, 0xab, 0x1f,
-	0x41, 0x2f, 0x7e, 0x07, 0x4f, 0x1c, 0x39, 0x1a, 0x0f, 0x68, 0xe8, 0x17, 0x31, 0xb3, 0x7f, 0xba,
-	0xa5, 0x18, 0x62, 0x3d, 0xb5, 0x7d, 0xdf, 0x67, 0xfa, 0x3e, 0xcf, 0xfc, 0x66, 0x06, 0x5a, 0xa8,
-	0x12, 0x4e, 0x99, 0x8b, 0x8a, 0x9c, 0x30, 0x1e, 0x84, 0x6e, 0x48, 0xa2, 0x28, 0x20, 0xe1, 0x09,
-	0x3a, 0xfd, 0x44, 0x2a, 0x69, 0x36, 0x32, 0x81, 0x53, 0x08, 0xd6, 0xee, 0x76, 0x65, 0x57, 0xa6,
-	0x3d, 0x57, 0x7f, 0xcb, 0x64, 0x6b, 0x56, 0x28, 0x31, 0x96, 0xe8, 0x06, 0x04, 0x99, 0x3b, 0xdc,
-	0x0e, 0x98, 0x22, 0xdb, 0x6e, 0x28, 0xb9, 0xc8, 0xfa, 0xf6, 0x29, 0x34, 0x0e, 0xfb, 0x11, 0x57,
-	0x2f, 0x59, 0xc4, 0xba, 0x44, 0x71, 0x29, 0xcc, 0x75, 0x58, 0x1c, 0x92, 0x88, 0x53, 0xa2, 0x64,
-	0xd2, 0x34, 0xda, 0xc6, 0xe6, 0xa2, 0x57, 0x16, 0xcc, 0x57, 0xb0, 0x40, 0x62, 0x39, 0x10, 0xaa,
-	0x39, 0xa7, 0x5b, 0x7b, 0xce, 0xd9, 0x45, 0xab, 0xf2, 0xf3, 0xa2, 0xf5, 0xb0, 0xcb, 0x55, 0x6f,
-	0x10, 0x38, 0xa1, 0x8c, 0xdd, 0x7c, 0x66, 0xf6, 0xb1, 0x85, 0xf4, 0xc4, 0x55, 0xef, 0xfb, 0x0c,
-	0x9d, 0x8e, 0x50, 0x5e, 0xbe, 0xda, 0xfe, 0x66, 0xc0, 0x72, 0x3e, 0x94, 0xed, 0xe7, 0xd9, 0xcc,
-	0x36, 0xd4, 0x7b, 0x12, 0x95, 0xff, 0x41, 0x0a, 0xe6, 0x73, 0x9a, 0x4f, 0x07, 0x5d, 0x3b, 0x96,
-	0x82, 0x75, 0xa8, 0xf9, 0x18, 0x56, 0x28, 0xeb, 0x4b, 0xe4, 0xca, 0x4f, 0x58, 0x28, 0x13, 0xaa,
-	0x65, 0xda, 0xc9, 0xbc, 0xd7, 0xc8, 0x1b, 0x5e, 0x5a, 0xef, 0x50, 0xf3, 0x35, 0xac, 0xa0, 0xce,
-	0xe6, 0xd3, 0x71, 0x38, 0x6c, 0x56, 0xdb, 0xd5, 0xcd, 0xda, 0x4e, 0xdb, 0x99, 0xda, 0x3e, 0x67,
-	0x6a, 0x17, 0xbc, 0x65, 0xbc, 0x5a, 0x40, 0xfb, 0xa3, 0x01, 0x4b, 0xfb, 0x11, 0xe1, 0xf1, 0xd8,
-	0xee, 0x33, 0x58, 0x1d, 0x20, 0x4b, 0xfc, 0x84, 0x51, 0x16, 0xf7, 0xb5, 0x6a, 0xc2, 0x54, 0xe6,
-	0xfd, 0x9e, 0x16, 0x78, 0xe3, 0xfe, 0xd8, 0xdb, 0x2a, 0xdc, 0x0e, 0x7b, 0x84, 0x8b, 0xc2, 0xfe,
-	0xa2, 0x77, 0x2b, 0xfd, 0xdd, 0xa1, 0xe6, 0x03, 0xa8, 0xb3, 0xbe, 0x0c, 0x7b, 0xbe, 0x18, 0xc4,
-	0x01, 0x4b, 0x9a, 0xd5, 0x34, 0x5d, 0x2d, 0xad, 0xbd, 0x49, 0x4b, 0xf6, 0x17, 0x03, 0x96, 0x3d,
-	0xc6, 0xc5, 0x90, 0xa1, 0x1a, 0xbb, 0x41, 0x68, 0x24, 0x79, 0xcd, 0xcf, 0x11, 0x69, 0x0f, 0xb5,
-	0x9d, 0x55, 0x27, 0x23, 0xe1, 0xe8, 0x43, 0xe0, 0xe4, 0x87, 0xc0, 0xd9, 0x97, 0x5c, 0xec, 0xb9,
-	0x9a, 0xde, 0xd7, 0x5f, 0xad, 0x47, 0xff, 0x40, 0x4f, 0x2f, 0xf0, 0xee, 0x14, 0x23, 0x5e, 0xa4,
-	0x13, 0xae, 0x11, 0xab, 0x4e, 0x13, 0xb3, 0xbf, 0x1b, 0x60, 0x1e, 0x09, 0x3a, 0x3b, 0xea, 0xbf,
-	0xe2, 0x9b, 0xfb, 0x5f, 0x7c, 0xe6, 0x73, 0x58, 0xcb, 0xb6, 0x75, 0x20, 0x02, 0x29, 0x28, 0x17,
-	0xdd, 0x12, 0x56, 0x76, 0x2c, 0xe6, 0xbd, 0xfb, 0xa9, 0xe2, 0xa8, 0x10, 0x14, 0xb4, 0xd0, 0x46,
-	0x30, 0x4b, 0x88, 0x33, 0x64, 0xb8, 0x79, 0xe8, 0xdc, 0xcd, 0x43, 0x3f, 0x1b, 0x50, 0xf3, 0x58,
-	0x40, 0x22, 0x22, 0x42, 0x2e, 0xba, 0xe6, 0x06, 0x2c, 0x61, 0x12, 0xfa, 0xd3, 0x97, 0xb3, 0x8e,
-	0x49, 0xf8, 0x76, 0x7c, 0x3f, 0x37, 0x60, 0x89, 0xa2, 0x9a, 0x10, 0x65, 0xa7, 0xab, 0x4e, 0x51,
-	0x95, 0xa2, 0x5d, 0xa8, 0x92, 0x58, 0x65, 0xb0, 0x66, 0xbe, 0xc1, 0x7a, 0xa9, 0x7d, 0x0a, 0x2b,
-	0x85, 0xb5, 0x59, 0x98, 0xee, 0x42, 0x3d, 0x29, 0x13, 0x15, 0x38, 0xd7, 0xaf, 0xe1, 0x9c, 0x88,
-	0xed, 0x5d, 0x59, 0xb1, 0x77, 0x70, 0x76, 0x69, 0x19, 0xe7, 0x97, 0x96, 0xf1, 0xfb, 0xd2, 0x32,
-	0x3e, 0x8d, 0xac, 0xca, 0xf9, 0xc8, 0xaa, 0xfc, 0x18, 0x59, 0x95, 0xe3, 0x9d, 0x09, 0xff, 0x87,
-	0xe9, 0xff, 0x6d, 0x1d, 0x90, 0x00, 0xdd, 0xfc, 0x25, 0x1d, 0x6e, 0x3f, 0x71, 0xdf, 0x95, 0xef,
-	0x69, 0x9a, 0x27, 0x58, 0x48, 0x5f, 0xc1, 0xa7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x23, 0x60,
-	0xde, 0x44, 0x6f, 0x05, 0x00, 0x00,
+	// 659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x9b, 0xaa, 0xdf, 0xd7, 0x9b, 0xf4, 0x4b, 0x63, 0x7d, 0x82, 0xb4, 0xaa, 0x9c, 0xe0,
+	0x4a, 0x50, 0x21, 0xd5, 0xa6, 0x65, 0x85, 0x60, 0xd1, 0xa6, 0x08, 0x29, 0x52, 0x41, 0x62, 0xaa,
+	0xb2, 0xe8, 0xc6, 0x1a, 0xcf, 0x8c, 0x92, 0x51, 0xed, 0x99, 0xc8, 0x33, 0x49, 0x81, 0x27, 0x60,
+	0xc9, 0x96, 0x47, 0x80, 0x0d, 0xef, 0xc0, 0xaa, 0xcb, 0x2e, 0x11, 0x8b, 0x82, 0xda, 0x17, 0x41,
+	0xe3, 0x9f, 0xfc, 0x15, 0x55, 0xb4, 0xab, 0xc4, 0xf7, 0x9e, 0x3b, 0xf7, 0x1c, 0x9f, 0xe3, 0x81,
+	0xa6, 0xd2, 0x09, 0xa7, 0xcc, 0x57, 0x1a, 0x1f, 0x33, 0x1e, 0x12, 0x9f, 0xe0, 0x28, 0x0a, 0x31,
+	0x39, 0x56, 0x5e, 0x3f, 0x91, 0x5a, 0xda, 0xb5, 0x0c, 0xe0, 0x15, 0x80, 0xd5, 0xff, 0xbb, 0xb2,
+	0x2b, 0xd3, 0x9e, 0x6f, 0xfe, 0x65, 0xb0, 0x55, 0x87, 0x48, 0x15, 0x4b, 0xe5, 0x87, 0x58, 0x31,
+	0x7f, 0xb8, 0x15, 0x32, 0x8d, 0xb7, 0x7c, 0x22, 0xb9, 0xc8, 0xfa, 0xee, 0x09, 0xd4, 0x0e, 0xfa,
+	0x11, 0xd7, 0xcf, 0x59, 0xc4, 0xba, 0x58, 0x73, 0x29, 0xec, 0x35, 0x58, 0x1c, 0xe2, 0x88, 0x53,
+	0xac, 0x65, 0xd2, 0xb0, 0x5a, 0xd6, 0xc6, 0x22, 0x1a, 0x17, 0xec, 0x17, 0xb0, 0x80, 0x63, 0x39,
+	0x10, 0xba, 0x31, 0x67, 0x5a, 0x6d, 0xef, 0xf4, 0xbc, 0x59, 0xfa, 0x71, 0xde, 0xbc, 0xdf, 0xe5,
+	0xba, 0x37, 0x08, 0x3d, 0x22, 0x63, 0x3f, 0xdf, 0x99, 0xfd, 0x6c, 0x2a, 0x7a, 0xec, 0xeb, 0x77,
+	0x7d, 0xa6, 0xbc, 0x8e, 0xd0, 0x28, 0x9f, 0x76, 0xbf, 0x5a, 0xb0, 0x9c, 0x2f, 0x65, 0x7b, 0xb9,
+	0x36, 0xbb, 0x05, 0xd5, 0x9e, 0x54, 0x3a, 0x78, 0x2f, 0x05, 0x0b, 0x38, 0xcd, 0xb7, 0x83, 0xa9,
+	0x1d, 0x49, 0xc1, 0x3a, 0xd4, 0x7e, 0x08, 0x75, 0xca, 0xfa, 0x52, 0x71, 0x1d, 0x24, 0x8c, 0xc8,
+	0x84, 0x1a, 0x98, 0x61, 0x32, 0x8f, 0x6a, 0x79, 0x03, 0xa5, 0xf5, 0x0e, 0xb5, 0x5f, 0x42, 0x5d,
+	0x19, 0x6d, 0x01, 0x1d, 0x89, 0x53, 0x8d, 0x72, 0xab, 0xbc, 0x51, 0xd9, 0x6e, 0x79, 0x33, 0xaf,
+	0xcf, 0x9b, 0x79, 0x0b, 0x68, 0x59, 0x4d, 0x17, 0x94, 0xfb, 0xc1, 0x82, 0xa5, 0xbd, 0x08, 0xf3,
+	0x78, 0x44, 0xf7, 0x09, 0xac, 0x0c, 0x14, 0x4b, 0x82, 0x84, 0x51, 0x16, 0xf7, 0x0d, 0x6a, 0x82,
+	0x54, 0xc6, 0xfd, 0x8e, 0x01, 0xa0, 0x51, 0x7f, 0xc4, 0x6d, 0x05, 0xfe, 0x25, 0x3d, 0xcc, 0x45,
+	0x41, 0x7f, 0x11, 0xfd, 0x93, 0x3e, 0x77, 0xa8, 0x7d, 0x0f, 0xaa, 0xac, 0x2f, 0x49, 0x2f, 0x10,
+	0x83, 0x38, 0x64, 0x49, 0xa3, 0x9c, 0xaa, 0xab, 0xa4, 0xb5, 0x57, 0x69, 0xc9, 0x7d, 0x0d, 0xf5,
+	0xdd, 0x81, 0x96, 0xd3, 0x6c, 0x9e, 0xc1, 0x02, 0x31, 0x05, 0xd5, 0xb0, 0x52, 0x8d, 0xce, 0x15,
+	0x8d, 0x53, 0xf8, 0xf6, 0xbc, 0x71, 0x0e, 0xe5, 0x33, 0xee, 0x67, 0x0b, 0x96, 0x11, 0xe3, 0x62,
+	0xc8, 0x94, 0x1e, 0x1d, 0xa9, 0xa0, 0x96, 0xe4, 0xb5, 0x20, 0x77, 0xdd, 0xc8, 0xaa, 0x6c, 0xaf,
+	0x78, 0x99, 0xb9, 0x9e, 0xc9, 0x95, 0x97, 0xe7, 0xca, 0xdb, 0x93, 0x5c, 0xb4, 0x7d, 0x73, 0xec,
+	0x97, 0x9f, 0xcd, 0x07, 0x7f, 0x11, 0x08, 0x33, 0x80, 0xfe, 0x2b, 0x56, 0xec, 0xa6, 0x1b, 0xae,
+	0x84, 0xa0, 0x3c, 0x1b, 0x02, 0xf7, 0x9b, 0x05, 0xf6, 0xa1, 0xa0, 0x37, 0x4f, 0xcf, 0x1f, 0x13,
+	0x31, 0x77, 0xdb, 0x44, 0xd8, 0x4f, 0x61, 0x35, 0x73, 0x6a, 0x20, 0x42, 0x29, 0x28, 0x17, 0xdd,
+	0xb1, 0xff, 0x59, 0xd2, 0xe6, 0xd1, 0xdd, 0x14, 0x71, 0x58, 0x00, 0x8a, 0x00, 0x28, 0x57, 0x81,
+	0x3d, 0xce, 0xc5, 0x0d, 0x34, 0x5c, 0xbf, 0x74, 0xee, 0xfa, 0xa5, 0x9f, 0x2c, 0xa8, 0x20, 0x16,
+	0xe2, 0x08, 0x0b, 0xc2, 0x45, 0xd7, 0x5e, 0x87, 0x25, 0x95, 0x90, 0x60, 0xf6, 0x7b, 0xaf, 0xaa,
+	0x84, 0xbc, 0x19, 0x7d, 0xf2, 0xeb, 0xb0, 0x44, 0x95, 0x9e, 0x00, 0x65, 0x81, 0xad, 0x52, 0xa5,
+	0xc7, 0xa0, 0x1d, 0x28, 0xe3, 0x58, 0x37, 0xca, 0xb7, 0xba, 0x14, 0xcc, 0xa8, 0x7b, 0x02, 0xf5,
+	0x82, 0xda, 0x4d, 0x3c, 0xdd, 0x81, 0x6a, 0x32, 0x56, 0x54, 0xd8, 0xb9, 0x76, 0xc5, 0xce, 0x09,
+	0xd9, 0x68, 0x6a, 0xa2, 0xbd, 0x7f, 0x7a, 0xe1, 0x58, 0x67, 0x17, 0x8e, 0xf5, 0xeb, 0xc2, 0xb1,
+	0x3e, 0x5e, 0x3a, 0xa5, 0xb3, 0x4b, 0xa7, 0xf4, 0xfd, 0xd2, 0x29, 0x1d, 0x6d, 0x4f, 0xf0, 0x3f,
+	0x48, 0xcf, 0xdb, 0xdc, 0xc7, 0xa1, 0xf2, 0xf3, 0xcb, 0x79, 0xb8, 0xf5, 0xc8, 0x7f, 0x3b, 0xbe,
+	0xa2, 0x53, 0x3d, 0xe1, 0x42, 0x7a, 0xb1, 0x3e, 0xfe, 0x3d, 0x00, 0xcf, 0xd1, 0x1f, 0x2c, 0xc2,
+	0x05, 0x00, 0x00,
 }
 
 func (m *SplitDelegation) Marshal() (dAtA []byte, err error) {
@@ -651,6 +698,43 @@ func (m *ClaimCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoClaimCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoClaimCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoClaimCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCallbacks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ReinvestCallback) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -960,6 +1044,21 @@ func (m *ClaimCallback) Size() (n int) {
 	return n
 }
 
+func (m *AutoClaimCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovCallbacks(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ReinvestCallback) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1449,6 +1548,90 @@ func (m *ClaimCallback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoClaimCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoClaimCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoClaimCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, ClaimCallback{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ReinvestCallback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
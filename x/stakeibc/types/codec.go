@@ -13,14 +13,20 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgLiquidStake{}, "stakeibc/LiquidStake", nil)
 	cdc.RegisterConcrete(&MsgRegisterHostZone{}, "stakeibc/RegisterHostZone", nil)
 	cdc.RegisterConcrete(&MsgRedeemStake{}, "stakeibc/RedeemStake", nil)
+	cdc.RegisterConcrete(&MsgBatchRedeemStake{}, "stakeibc/BatchRedeemStake", nil)
 	cdc.RegisterConcrete(&MsgClaimUndelegatedTokens{}, "stakeibc/ClaimUndelegatedTokens", nil)
 	cdc.RegisterConcrete(&MsgRebalanceValidators{}, "stakeibc/RebalanceValidators", nil)
 	cdc.RegisterConcrete(&MsgAddValidators{}, "stakeibc/AddValidators", nil)
 	cdc.RegisterConcrete(&MsgChangeValidatorWeight{}, "stakeibc/ChangeValidatorWeight", nil)
 	cdc.RegisterConcrete(&MsgDeleteValidator{}, "stakeibc/DeleteValidator", nil)
 	cdc.RegisterConcrete(&AddValidatorsProposal{}, "stakeibc/AddValidatorsProposal", nil)
+	cdc.RegisterConcrete(&UpdateHostZoneProposal{}, "stakeibc/UpdateHostZoneProposal", nil)
 	cdc.RegisterConcrete(&MsgRestoreInterchainAccount{}, "stakeibc/RestoreInterchainAccount", nil)
 	cdc.RegisterConcrete(&MsgUpdateValidatorSharesExchRate{}, "stakeibc/UpdateValidatorSharesExchRate", nil)
+	cdc.RegisterConcrete(&MsgResumeHostZone{}, "stakeibc/ResumeHostZone", nil)
+	cdc.RegisterConcrete(&MsgSetAutoClaim{}, "stakeibc/SetAutoClaim", nil)
+	cdc.RegisterConcrete(&MsgSetValidatorSelectionStrategy{}, "stakeibc/SetValidatorSelectionStrategy", nil)
+	cdc.RegisterConcrete(&MsgSunsetHostZone{}, "stakeibc/SunsetHostZone", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -30,6 +36,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgClearBalance{},
 		&MsgRegisterHostZone{},
 		&MsgRedeemStake{},
+		&MsgBatchRedeemStake{},
 		&MsgClaimUndelegatedTokens{},
 		&MsgRebalanceValidators{},
 		&MsgAddValidators{},
@@ -37,10 +44,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgDeleteValidator{},
 		&MsgRestoreInterchainAccount{},
 		&MsgUpdateValidatorSharesExchRate{},
+		&MsgResumeHostZone{},
+		&MsgSetAutoClaim{},
+		&MsgSetValidatorSelectionStrategy{},
+		&MsgSunsetHostZone{},
 	)
 
 	registry.RegisterImplementations((*govtypes.Content)(nil),
 		&AddValidatorsProposal{},
+		&UpdateHostZoneProposal{},
 	)
 
 	// this line is used by starport scaffolding # 3
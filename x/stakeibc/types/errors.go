@@ -49,4 +49,9 @@ var (
 	ErrRewardCollectorAccountNotFound    = errorsmod.Register(ModuleName, 1541, "Reward Collector account not found")
 	ErrHaltedHostZone                    = errorsmod.Register(ModuleName, 1542, "Halted host zone found")
 	ErrInsufficientLiquidStake           = errorsmod.Register(ModuleName, 1543, "Liquid staked amount is too small")
+	ErrHostZoneNotHalted                 = errorsmod.Register(ModuleName, 1544, "host zone is not halted")
+	ErrWeightsManagedByStrategy          = errorsmod.Register(ModuleName, 1545, "validator weights are managed by the host zone's selection strategy")
+	ErrHostZoneSunset                    = errorsmod.Register(ModuleName, 1546, "host zone is being sunset")
+	ErrHostZoneSunsetNotFound            = errorsmod.Register(ModuleName, 1547, "host zone sunset not found")
+	ErrMinOutputNotMet                   = errorsmod.Register(ModuleName, 1548, "output amount is below the specified minimum")
 )
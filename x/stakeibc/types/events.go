@@ -11,10 +11,20 @@ const (
 )
 
 const (
-	EventTypeRegisterZone       = "register_zone"
-	EventTypeRedemptionRequest  = "request_redemption"
-	EventTypeLiquidStakeRequest = "liquid_stake"
-	EventTypeHostZoneHalt       = "halt_zone"
+	EventTypeRegisterZone                  = "register_zone"
+	EventTypeRedemptionRequest             = "request_redemption"
+	EventTypeLiquidStakeRequest            = "liquid_stake"
+	EventTypeHostZoneHalt                  = "halt_zone"
+	EventTypeHostZoneResume                = "resume_zone"
+	EventTypeRestoreICAChannel             = "restore_ica_channel"
+	EventTypeUpdateHostZone                = "update_host_zone"
+	EventTypeSetAutoClaim                  = "set_auto_claim"
+	EventTypeAutoClaim                     = "auto_claim"
+	EventTypeSetValidatorSelectionStrategy = "set_validator_selection_strategy"
+	EventTypeUpdateValidatorWeights        = "update_validator_weights"
+	EventTypeAutoRebalance                 = "auto_rebalance"
+	EventTypeSunsetHostZone                = "sunset_host_zone"
+	EventTypeUpdateHostZoneSunset          = "update_host_zone_sunset"
 
 	AttributeKeyHostZone         = "host_zone"
 	AttributeKeyConnectionId     = "connection_id"
@@ -26,6 +36,25 @@ const (
 
 	AttributeKeyRedemptionRate = "redemption_rate"
 
+	AttributeKeyMinRedemptionRate   = "min_redemption_rate"
+	AttributeKeyMaxRedemptionRate   = "max_redemption_rate"
+	AttributeKeyUnbondingFrequency  = "unbonding_frequency"
+	AttributeKeyTransferChannelId   = "transfer_channel_id"
+	AttributeKeyIBCDenom            = "ibc_denom"
+	AttributeKeyPreviousValuePrefix = "previous_"
+
+	AttributeKeyICAAccountType  = "ica_account_type"
+	AttributeKeyClosedChannelId = "closed_channel_id"
+
+	AttributeKeyAutoClaimEnabled = "auto_claim_enabled"
+	AttributeKeyNumClaims        = "num_claims"
+
+	AttributeKeyValidatorSelectionStrategy = "validator_selection_strategy"
+	AttributeKeyNumRedelegations           = "num_redelegations"
+
+	AttributeKeySunsetStatus       = "sunset_status"
+	AttributeKeyRedemptionDeadline = "redemption_deadline"
+
 	AttributeKeyLiquidStaker    = "liquid_staker"
 	AttributeKeyNativeBaseDenom = "native_base_denom"
 	AttributeKeyNativeIBCDenom  = "native_ibc_denom"
@@ -43,4 +43,7 @@ type RatelimitKeeper interface {
 	AddDenomToBlacklist(ctx sdk.Context, denom string)
 	RemoveDenomFromBlacklist(ctx sdk.Context, denom string)
 	SetWhitelistedAddressPair(ctx sdk.Context, whitelist ratelimittypes.WhitelistedAddressPair)
+	RemoveWhitelistedAddressPair(ctx sdk.Context, sender, receiver string)
+	MoveRateLimit(ctx sdk.Context, denom string, oldChannelId string, newChannelId string) error
+	RemoveDenomRateLimits(ctx sdk.Context, denom string)
 }
@@ -12,10 +12,13 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		HostZoneList:     []HostZone{},
-		EpochTrackerList: []EpochTracker{},
-		Params:           DefaultParams(),
-		PortId:           PortID,
+		HostZoneList:          []HostZone{},
+		EpochTrackerList:      []EpochTracker{},
+		RedemptionRateHistory: []RedemptionRateRecord{},
+		RedelegationTrackers:  []RedelegationTracker{},
+		HostZoneSunsets:       []HostZoneSunset{},
+		Params:                DefaultParams(),
+		PortId:                PortID,
 	}
 }
 
@@ -46,5 +49,37 @@ func (gs GenesisState) Validate() error {
 		epochTrackerIndexMap[index] = struct{}{}
 	}
 
+	// Check for duplicated chain ID and epoch in the redemption rate history
+	redemptionRateRecordIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RedemptionRateHistory {
+		index := string(append(RedemptionRateRecordKeyPrefixByChainId(elem.ChainId), RedemptionRateRecordKey(elem.EpochNumber)...))
+		if _, ok := redemptionRateRecordIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for redemptionRateRecord: %s epoch %d", elem.ChainId, elem.EpochNumber)
+		}
+		redemptionRateRecordIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated validator pairs in the redelegation trackers
+	redelegationTrackerIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.RedelegationTrackers {
+		index := string(append(RedelegationTrackerKeyPrefixByChainId(elem.ChainId), RedelegationTrackerKey(elem.SrcValidator, elem.DstValidator)...))
+		if _, ok := redelegationTrackerIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for redelegationTracker: %s %s -> %s", elem.ChainId, elem.SrcValidator, elem.DstValidator)
+		}
+		redelegationTrackerIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated chain IDs in the host zone sunsets
+	hostZoneSunsetIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.HostZoneSunsets {
+		if _, ok := hostZoneSunsetIndexMap[elem.ChainId]; ok {
+			return fmt.Errorf("duplicated index for hostZoneSunset: %s", elem.ChainId)
+		}
+		hostZoneSunsetIndexMap[elem.ChainId] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
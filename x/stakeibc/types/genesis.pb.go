@@ -28,8 +28,11 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
 	// list of zones that are registered by the protocol
-	HostZoneList     []HostZone     `protobuf:"bytes,5,rep,name=host_zone_list,json=hostZoneList,proto3" json:"host_zone_list"`
-	EpochTrackerList []EpochTracker `protobuf:"bytes,10,rep,name=epoch_tracker_list,json=epochTrackerList,proto3" json:"epoch_tracker_list"`
+	HostZoneList          []HostZone             `protobuf:"bytes,5,rep,name=host_zone_list,json=hostZoneList,proto3" json:"host_zone_list"`
+	EpochTrackerList      []EpochTracker         `protobuf:"bytes,10,rep,name=epoch_tracker_list,json=epochTrackerList,proto3" json:"epoch_tracker_list"`
+	RedemptionRateHistory []RedemptionRateRecord `protobuf:"bytes,12,rep,name=redemption_rate_history,json=redemptionRateHistory,proto3" json:"redemption_rate_history"`
+	RedelegationTrackers  []RedelegationTracker  `protobuf:"bytes,13,rep,name=redelegation_trackers,json=redelegationTrackers,proto3" json:"redelegation_trackers"`
+	HostZoneSunsets       []HostZoneSunset       `protobuf:"bytes,14,rep,name=host_zone_sunsets,json=hostZoneSunsets,proto3" json:"host_zone_sunsets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -93,6 +96,27 @@ func (m *GenesisState) GetEpochTrackerList() []EpochTracker {
 	return nil
 }
 
+func (m *GenesisState) GetRedemptionRateHistory() []RedemptionRateRecord {
+	if m != nil {
+		return m.RedemptionRateHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedelegationTrackers() []RedelegationTracker {
+	if m != nil {
+		return m.RedelegationTrackers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHostZoneSunsets() []HostZoneSunset {
+	if m != nil {
+		return m.HostZoneSunsets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stride.stakeibc.GenesisState")
 }
@@ -100,29 +124,37 @@ func init() {
 func init() { proto.RegisterFile("stride/stakeibc/genesis.proto", fileDescriptor_dea81129ed6fb77a) }
 
 var fileDescriptor_dea81129ed6fb77a = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xcf, 0x4e, 0xea, 0x40,
-	0x14, 0xc6, 0x5b, 0x18, 0x4a, 0x19, 0xc8, 0xbd, 0x4d, 0x73, 0x13, 0xb8, 0xe4, 0x52, 0xc8, 0x75,
-	0xc3, 0xc6, 0x56, 0x31, 0xbe, 0x00, 0x09, 0x51, 0x1b, 0x16, 0x0a, 0xae, 0xd8, 0x34, 0x6d, 0x99,
-	0xb4, 0x13, 0x84, 0x69, 0x3a, 0x47, 0xa3, 0x3e, 0x85, 0x2b, 0x9f, 0x89, 0x25, 0x4b, 0x57, 0xc6,
-	0xc0, 0x8b, 0x98, 0x76, 0xc6, 0x7f, 0x65, 0x37, 0x67, 0xbe, 0x5f, 0x7e, 0xe7, 0xcb, 0xc1, 0x1d,
-	0x0e, 0x29, 0x9d, 0x13, 0x87, 0x83, 0xbf, 0x20, 0x34, 0x08, 0x9d, 0x88, 0xac, 0x08, 0xa7, 0xdc,
-	0x4e, 0x52, 0x06, 0xcc, 0xfc, 0x2d, 0x62, 0xfb, 0x23, 0x6e, 0xff, 0x89, 0x58, 0xc4, 0xf2, 0xcc,
-	0xc9, 0x5e, 0x02, 0x6b, 0xff, 0x2b, 0x5a, 0x12, 0x3f, 0xf5, 0x97, 0x52, 0xd2, 0xee, 0x16, 0xd3,
-	0x98, 0x71, 0xf0, 0x1e, 0xd9, 0x8a, 0x48, 0xe0, 0xa0, 0x08, 0x90, 0x84, 0x85, 0xb1, 0x07, 0xa9,
-	0x1f, 0x2e, 0x48, 0x2a, 0xa0, 0xff, 0xcf, 0x25, 0xdc, 0x38, 0x13, 0xe5, 0xa6, 0xe0, 0x03, 0x31,
-	0x4f, 0xb1, 0x26, 0xd6, 0xb4, 0xd4, 0x9e, 0xda, 0xaf, 0x0f, 0x9a, 0x76, 0xa1, 0xac, 0x7d, 0x99,
-	0xc7, 0x43, 0xb4, 0x7e, 0xed, 0x2a, 0x13, 0x09, 0x9b, 0x4d, 0x5c, 0x4d, 0x58, 0x0a, 0x1e, 0x9d,
-	0xb7, 0x4a, 0x3d, 0xb5, 0x5f, 0x9b, 0x68, 0xd9, 0x78, 0x31, 0x37, 0x47, 0xf8, 0xd7, 0x67, 0x31,
-	0xef, 0x86, 0x72, 0x68, 0x55, 0x7a, 0xe5, 0x7e, 0x7d, 0xf0, 0x77, 0xcf, 0x7b, 0xce, 0x38, 0xcc,
-	0xd8, 0x8a, 0x48, 0x73, 0x23, 0x96, 0xf3, 0x98, 0x72, 0x30, 0xaf, 0xb0, 0xf9, 0xa3, 0xbe, 0x50,
-	0xe1, 0x5c, 0xd5, 0xd9, 0x53, 0x8d, 0x32, 0xf4, 0x5a, 0x90, 0x52, 0x67, 0x90, 0x6f, 0x7f, 0x99,
-	0xd2, 0x45, 0x7a, 0xd9, 0x40, 0x2e, 0xd2, 0x91, 0x51, 0x71, 0x91, 0xae, 0x19, 0x55, 0x17, 0xe9,
-	0x35, 0x03, 0xbb, 0x48, 0xaf, 0x1b, 0x8d, 0xe1, 0x78, 0xbd, 0xb5, 0xd4, 0xcd, 0xd6, 0x52, 0xdf,
-	0xb6, 0x96, 0xfa, 0xb4, 0xb3, 0x94, 0xcd, 0xce, 0x52, 0x5e, 0x76, 0x96, 0x32, 0x1b, 0x44, 0x14,
-	0xe2, 0xdb, 0xc0, 0x0e, 0xd9, 0xd2, 0x99, 0xe6, 0x8b, 0x0f, 0xc7, 0x7e, 0xc0, 0x1d, 0x79, 0xee,
-	0xbb, 0xe3, 0x23, 0xe7, 0xfe, 0xeb, 0xe8, 0xf0, 0x90, 0x10, 0x1e, 0x68, 0xf9, 0xb5, 0x4f, 0xde,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x66, 0x4a, 0xc1, 0x39, 0x19, 0x02, 0x00, 0x00,
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0x87, 0x63, 0xea, 0xa6, 0xee, 0x36, 0xb4, 0xc6, 0x6a, 0x15, 0x13, 0x51, 0x27, 0xe2, 0x9f,
+	0x22, 0x04, 0x36, 0x04, 0xf1, 0x02, 0x95, 0x2a, 0x8a, 0x95, 0x03, 0x75, 0x38, 0xf5, 0x62, 0x6d,
+	0xec, 0x91, 0xbd, 0x6a, 0xe3, 0xb5, 0x76, 0xa7, 0x88, 0xf2, 0x14, 0xbc, 0x09, 0xaf, 0xd1, 0x63,
+	0x8f, 0x9c, 0x10, 0x4a, 0x5e, 0x04, 0x65, 0xbd, 0xa5, 0xd8, 0x49, 0x6f, 0xde, 0xfd, 0x7d, 0xfa,
+	0xc6, 0x3b, 0x33, 0xe4, 0x50, 0xa2, 0x60, 0x29, 0x04, 0x12, 0xe9, 0x39, 0xb0, 0x69, 0x12, 0x64,
+	0x50, 0x80, 0x64, 0xd2, 0x2f, 0x05, 0x47, 0xee, 0xec, 0x55, 0xb1, 0x7f, 0x1b, 0xf7, 0xf6, 0x33,
+	0x9e, 0x71, 0x95, 0x05, 0xcb, 0xaf, 0x0a, 0xeb, 0x3d, 0x69, 0x5a, 0x4a, 0x2a, 0xe8, 0x4c, 0x4b,
+	0x7a, 0xfd, 0x66, 0x9a, 0x73, 0x89, 0xf1, 0x77, 0x5e, 0x80, 0x06, 0x9e, 0x35, 0x01, 0x28, 0x79,
+	0x92, 0xc7, 0x28, 0x68, 0x72, 0x0e, 0x42, 0x43, 0xaf, 0x9b, 0x90, 0x80, 0x14, 0x66, 0x25, 0x32,
+	0x5e, 0xc4, 0x82, 0x22, 0xc4, 0x02, 0x12, 0x2e, 0x52, 0x4d, 0xbf, 0x5a, 0x47, 0x5f, 0x40, 0x46,
+	0x15, 0x5f, 0x37, 0xbf, 0xbc, 0xf7, 0xff, 0x62, 0x79, 0x59, 0x48, 0xc0, 0x8a, 0x7b, 0xfa, 0xd3,
+	0x24, 0x9d, 0x8f, 0x55, 0x7b, 0x26, 0x48, 0x11, 0x9c, 0x0f, 0xa4, 0x5d, 0x3d, 0xd4, 0x35, 0x06,
+	0xc6, 0x70, 0x67, 0xd4, 0xf5, 0x1b, 0xed, 0xf2, 0x3f, 0xab, 0xf8, 0xc8, 0xbc, 0xfe, 0xdd, 0x6f,
+	0x45, 0x1a, 0x76, 0xba, 0x64, 0xab, 0xe4, 0x02, 0x63, 0x96, 0xba, 0x0f, 0x06, 0xc6, 0x70, 0x3b,
+	0x6a, 0x2f, 0x8f, 0x9f, 0x52, 0xe7, 0x98, 0xec, 0xde, 0x95, 0xbe, 0x60, 0x12, 0xdd, 0xcd, 0xc1,
+	0xc6, 0x70, 0x67, 0xf4, 0x78, 0xc5, 0x7b, 0xc2, 0x25, 0x9e, 0xf1, 0x02, 0xb4, 0xb9, 0x93, 0xeb,
+	0xf3, 0x98, 0x49, 0x74, 0x4e, 0x89, 0x53, 0x6b, 0x60, 0xa5, 0x22, 0x4a, 0x75, 0xb8, 0xa2, 0x3a,
+	0x5e, 0xa2, 0x5f, 0x2a, 0x52, 0xeb, 0x6c, 0xf8, 0xef, 0x4e, 0x29, 0x13, 0xd2, 0x6d, 0xb6, 0x3b,
+	0x67, 0x12, 0xb9, 0xb8, 0x72, 0x3b, 0xca, 0xfb, 0x62, 0xc5, 0x1b, 0xfd, 0xe3, 0x23, 0x8a, 0x10,
+	0xa9, 0xe1, 0x68, 0xff, 0x81, 0xa8, 0x65, 0x27, 0x95, 0xc9, 0x89, 0xc9, 0xc1, 0xba, 0x29, 0x49,
+	0xf7, 0xa1, 0x2a, 0xf1, 0x7c, 0x6d, 0x89, 0x5b, 0xba, 0xfe, 0x82, 0x7d, 0xb1, 0x1a, 0x49, 0xe7,
+	0x94, 0x3c, 0x6a, 0x8e, 0x56, 0xba, 0xbb, 0x4a, 0xde, 0xbf, 0xb7, 0xc5, 0x13, 0xc5, 0x69, 0xef,
+	0x5e, 0x5e, 0xbb, 0x95, 0xa1, 0x69, 0x6d, 0xd8, 0x66, 0x68, 0x5a, 0xa6, 0xbd, 0x19, 0x9a, 0x56,
+	0xdb, 0xde, 0x0a, 0x4d, 0x6b, 0xdb, 0x26, 0xa1, 0x69, 0xed, 0xd8, 0x9d, 0xa3, 0xf1, 0xf5, 0xdc,
+	0x33, 0x6e, 0xe6, 0x9e, 0xf1, 0x67, 0xee, 0x19, 0x3f, 0x16, 0x5e, 0xeb, 0x66, 0xe1, 0xb5, 0x7e,
+	0x2d, 0xbc, 0xd6, 0xd9, 0x28, 0x63, 0x98, 0x5f, 0x4e, 0xfd, 0x84, 0xcf, 0x82, 0x89, 0xaa, 0xfc,
+	0x66, 0x4c, 0xa7, 0x32, 0xd0, 0xab, 0xf8, 0xf5, 0xdd, 0xdb, 0xe0, 0xdb, 0xdd, 0x42, 0xe2, 0x55,
+	0x09, 0x72, 0xda, 0x56, 0x6b, 0xf8, 0xfe, 0xef, 0x00, 0xde, 0x36, 0x8e, 0xb5, 0xb4, 0x03, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -145,6 +177,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HostZoneSunsets) > 0 {
+		for iNdEx := len(m.HostZoneSunsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostZoneSunsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.RedelegationTrackers) > 0 {
+		for iNdEx := len(m.RedelegationTrackers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedelegationTrackers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RedemptionRateHistory) > 0 {
+		for iNdEx := len(m.RedemptionRateHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionRateHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.EpochTrackerList) > 0 {
 		for iNdEx := len(m.EpochTrackerList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -228,6 +302,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RedemptionRateHistory) > 0 {
+		for _, e := range m.RedemptionRateHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedelegationTrackers) > 0 {
+		for _, e := range m.RedelegationTrackers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HostZoneSunsets) > 0 {
+		for _, e := range m.HostZoneSunsets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -399,6 +491,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionRateHistory = append(m.RedemptionRateHistory, RedemptionRateRecord{})
+			if err := m.RedemptionRateHistory[len(m.RedemptionRateHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationTrackers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegationTrackers = append(m.RedelegationTrackers, RedelegationTracker{})
+			if err := m.RedelegationTrackers[len(m.RedelegationTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneSunsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneSunsets = append(m.HostZoneSunsets, HostZoneSunset{})
+			if err := m.HostZoneSunsets[len(m.HostZoneSunsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -35,6 +35,30 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated redelegation tracker",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				RedelegationTrackers: []types.RedelegationTracker{
+					{ChainId: "GAIA", SrcValidator: "val1", DstValidator: "val2"},
+					{ChainId: "GAIA", SrcValidator: "val1", DstValidator: "val2"},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated host zone sunset",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				HostZoneSunsets: []types.HostZoneSunset{
+					{ChainId: "GAIA"},
+					{ChainId: "GAIA"},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
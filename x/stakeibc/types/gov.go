@@ -5,20 +5,25 @@ import (
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 )
 
 const (
-	ProposalTypeAddValidators = "AddValidators"
+	ProposalTypeAddValidators  = "AddValidators"
+	ProposalTypeUpdateHostZone = "UpdateHostZone"
 )
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeAddValidators)
+	govtypes.RegisterProposalType(ProposalTypeUpdateHostZone)
 }
 
 var (
 	_ govtypes.Content = &AddValidatorsProposal{}
+	_ govtypes.Content = &UpdateHostZoneProposal{}
 )
 
 func NewAddValidatorsProposal(title, description, hostZone string, validators []*Validator) govtypes.Content {
@@ -71,6 +76,83 @@ func (p AddValidatorsProposal) String() string {
   `, p.Title, p.Description, p.HostZone, p.Validators)
 }
 
+func NewUpdateHostZoneProposal(
+	title, description, hostZone string,
+	minRedemptionRate, maxRedemptionRate sdk.Dec,
+	unbondingFrequency uint64,
+	transferChannelId string,
+) govtypes.Content {
+	return &UpdateHostZoneProposal{
+		Title:              title,
+		Description:        description,
+		HostZone:           hostZone,
+		MinRedemptionRate:  minRedemptionRate,
+		MaxRedemptionRate:  maxRedemptionRate,
+		UnbondingFrequency: unbondingFrequency,
+		TransferChannelId:  transferChannelId,
+	}
+}
+
+func (p *UpdateHostZoneProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateHostZoneProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateHostZoneProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateHostZoneProposal) ProposalType() string {
+	return ProposalTypeUpdateHostZone
+}
+
+// Checks whether a redemption rate bound was specified in the proposal
+func isRedemptionRateSet(redemptionRate sdk.Dec) bool {
+	return !redemptionRate.IsNil() && !redemptionRate.IsZero()
+}
+
+func (p *UpdateHostZoneProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(strings.TrimSpace(p.HostZone)) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "host zone is required")
+	}
+
+	minRateSet := isRedemptionRateSet(p.MinRedemptionRate)
+	maxRateSet := isRedemptionRateSet(p.MaxRedemptionRate)
+	if !minRateSet && !maxRateSet && p.UnbondingFrequency == 0 && p.TransferChannelId == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "at least one host zone field must be updated")
+	}
+
+	if minRateSet && p.MinRedemptionRate.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "min redemption rate cannot be negative")
+	}
+	if maxRateSet && p.MaxRedemptionRate.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "max redemption rate cannot be negative")
+	}
+	if minRateSet && maxRateSet && p.MinRedemptionRate.GTE(p.MaxRedemptionRate) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "min redemption rate must be less than max redemption rate")
+	}
+
+	if p.TransferChannelId != "" && !channeltypes.IsValidChannelID(p.TransferChannelId) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid transfer channel id (%s)", p.TransferChannelId)
+	}
+
+	return nil
+}
+
+func (p UpdateHostZoneProposal) String() string {
+	return fmt.Sprintf(`Update Host Zone Proposal:
+	Title:              %s
+	Description:        %s
+	HostZone:           %s
+	MinRedemptionRate:  %s
+	MaxRedemptionRate:  %s
+	UnbondingFrequency: %d
+	TransferChannelId:  %s
+  `, p.Title, p.Description, p.HostZone, p.MinRedemptionRate, p.MaxRedemptionRate, p.UnbondingFrequency, p.TransferChannelId)
+}
+
 func (v *Validator) Equal(other *Validator) bool {
 	if v == nil || other == nil {
 		return false
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -63,34 +65,91 @@ func (m *AddValidatorsProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AddValidatorsProposal proto.InternalMessageInfo
 
+// Updates the safety parameters and transfer channel of a host zone
+// Fields that are left empty (or zero) are not changed
+type UpdateHostZoneProposal struct {
+	Title              string                                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string                                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	HostZone           string                                 `protobuf:"bytes,3,opt,name=host_zone,json=hostZone,proto3" json:"host_zone,omitempty"`
+	MinRedemptionRate  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_redemption_rate,json=minRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_redemption_rate"`
+	MaxRedemptionRate  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_redemption_rate,json=maxRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_redemption_rate"`
+	UnbondingFrequency uint64                                 `protobuf:"varint,6,opt,name=unbonding_frequency,json=unbondingFrequency,proto3" json:"unbonding_frequency,omitempty"`
+	TransferChannelId  string                                 `protobuf:"bytes,7,opt,name=transfer_channel_id,json=transferChannelId,proto3" json:"transfer_channel_id,omitempty"`
+	Deposit            string                                 `protobuf:"bytes,8,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *UpdateHostZoneProposal) Reset()      { *m = UpdateHostZoneProposal{} }
+func (*UpdateHostZoneProposal) ProtoMessage() {}
+func (*UpdateHostZoneProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8204317b384c5680, []int{1}
+}
+func (m *UpdateHostZoneProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateHostZoneProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateHostZoneProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateHostZoneProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateHostZoneProposal.Merge(m, src)
+}
+func (m *UpdateHostZoneProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateHostZoneProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateHostZoneProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateHostZoneProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AddValidatorsProposal)(nil), "stride.stakeibc.AddValidatorsProposal")
+	proto.RegisterType((*UpdateHostZoneProposal)(nil), "stride.stakeibc.UpdateHostZoneProposal")
 }
 
 func init() { proto.RegisterFile("stride/stakeibc/gov.proto", fileDescriptor_8204317b384c5680) }
 
 var fileDescriptor_8204317b384c5680 = []byte{
-	// 311 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0x2e, 0x29, 0xca,
-	0x4c, 0x49, 0xd5, 0x2f, 0x2e, 0x49, 0xcc, 0x4e, 0xcd, 0x4c, 0x4a, 0xd6, 0x4f, 0xcf, 0x2f, 0xd3,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0x48, 0xe9, 0xc1, 0xa4, 0xa4, 0x44, 0xd2, 0xf3,
-	0xd3, 0xf3, 0xc1, 0x72, 0xfa, 0x20, 0x16, 0x44, 0x99, 0x94, 0x3c, 0xba, 0x09, 0x65, 0x89, 0x39,
-	0x99, 0x29, 0x89, 0x25, 0xf9, 0x45, 0x10, 0x05, 0x4a, 0x4f, 0x18, 0xb9, 0x44, 0x1d, 0x53, 0x52,
-	0xc2, 0x60, 0xc2, 0xc5, 0x01, 0x45, 0xf9, 0x05, 0xf9, 0xc5, 0x89, 0x39, 0x42, 0x22, 0x5c, 0xac,
-	0x25, 0x99, 0x25, 0x39, 0xa9, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x90, 0x02,
-	0x17, 0x77, 0x4a, 0x6a, 0x71, 0x72, 0x51, 0x66, 0x41, 0x49, 0x66, 0x7e, 0x9e, 0x04, 0x13, 0x58,
-	0x0e, 0x59, 0x48, 0x48, 0x9a, 0x8b, 0x33, 0x23, 0xbf, 0xb8, 0x24, 0xbe, 0x2a, 0x3f, 0x2f, 0x55,
-	0x82, 0x19, 0x2c, 0xcf, 0x01, 0x12, 0x88, 0xca, 0xcf, 0x4b, 0x15, 0xb2, 0xe2, 0xe2, 0x82, 0xbb,
-	0xa0, 0x58, 0x82, 0x45, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x4a, 0x0f, 0xcd, 0x2f, 0x7a, 0x70, 0xd7,
-	0x04, 0x21, 0xa9, 0x16, 0xd2, 0xe1, 0x62, 0x4f, 0x49, 0x2d, 0xc8, 0x2f, 0xce, 0x2c, 0x91, 0x60,
-	0x03, 0x19, 0xeb, 0x24, 0xf4, 0xe9, 0x9e, 0x3c, 0x5f, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x54,
-	0x42, 0x29, 0x08, 0xa6, 0xc4, 0x8a, 0xa7, 0x63, 0x81, 0x3c, 0xc3, 0x8c, 0x05, 0xf2, 0x0c, 0x2f,
-	0x16, 0xc8, 0x33, 0x3a, 0xf9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
-	0x51, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x30, 0xd8, 0x1d, 0xba,
-	0x3e, 0x89, 0x49, 0xc5, 0xfa, 0xd0, 0x80, 0x2b, 0x33, 0x34, 0xd0, 0xaf, 0x40, 0x04, 0x5f, 0x49,
-	0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xec, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x66,
-	0x4c, 0x24, 0xda, 0xa0, 0x01, 0x00, 0x00,
+	// 482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x3d, 0x8f, 0xd3, 0x30,
+	0x18, 0xc7, 0x13, 0xda, 0x7b, 0xf3, 0x21, 0xd0, 0xb9, 0x07, 0xca, 0x15, 0x29, 0xa9, 0x3a, 0xa0,
+	0x1b, 0x68, 0x02, 0xc7, 0x56, 0xb1, 0x70, 0x9c, 0x10, 0x48, 0x37, 0xa0, 0x20, 0x18, 0x6e, 0x89,
+	0xdc, 0xf8, 0xb9, 0xd4, 0xba, 0xc4, 0x0e, 0xb6, 0x5b, 0xb5, 0x7c, 0x02, 0x46, 0x46, 0xc6, 0x7e,
+	0x08, 0x3e, 0xc4, 0x8d, 0x27, 0x26, 0xc4, 0x50, 0xa1, 0x16, 0x21, 0x66, 0x3e, 0x01, 0xaa, 0x93,
+	0x94, 0xaa, 0x4c, 0x48, 0x30, 0x25, 0xf6, 0xef, 0xef, 0xe7, 0xc5, 0x7f, 0x3f, 0xe8, 0x40, 0x69,
+	0xc9, 0x28, 0x04, 0x4a, 0x93, 0x0b, 0x60, 0xbd, 0x38, 0x48, 0xc4, 0xd0, 0xcf, 0xa5, 0xd0, 0x02,
+	0xdf, 0x2c, 0x90, 0x5f, 0xa1, 0xe6, 0x7e, 0x22, 0x12, 0x61, 0x58, 0xb0, 0xf8, 0x2b, 0x64, 0x4d,
+	0x6f, 0x3d, 0xc2, 0x90, 0xa4, 0x8c, 0x12, 0x2d, 0x64, 0x29, 0x38, 0x88, 0x85, 0xca, 0x84, 0x8a,
+	0x8a, 0x93, 0xc5, 0xa2, 0x40, 0xed, 0x6f, 0x36, 0xba, 0xf5, 0x98, 0xd2, 0xd7, 0xd5, 0x09, 0xf5,
+	0x42, 0x8a, 0x5c, 0x28, 0x92, 0xe2, 0x7d, 0xb4, 0xa1, 0x99, 0x4e, 0xc1, 0xb1, 0x5b, 0xf6, 0xe1,
+	0x4e, 0x58, 0x2c, 0x70, 0x0b, 0xed, 0x52, 0x50, 0xb1, 0x64, 0xb9, 0x66, 0x82, 0x3b, 0xd7, 0x0c,
+	0x5b, 0xdd, 0xc2, 0x77, 0xd0, 0x4e, 0x5f, 0x28, 0x1d, 0xbd, 0x15, 0x1c, 0x9c, 0x9a, 0xe1, 0xdb,
+	0x8b, 0x8d, 0x33, 0xc1, 0x01, 0x77, 0x11, 0x5a, 0x16, 0xa7, 0x9c, 0x7a, 0xab, 0x76, 0xb8, 0x7b,
+	0xd4, 0xf4, 0xd7, 0xda, 0xf4, 0x97, 0xd5, 0x84, 0x2b, 0x6a, 0x7c, 0x0f, 0x6d, 0x51, 0xc8, 0x85,
+	0x62, 0xda, 0xd9, 0x5c, 0x84, 0x3d, 0xc6, 0x3f, 0xa7, 0xde, 0x8d, 0x31, 0xc9, 0xd2, 0x6e, 0xbb,
+	0x04, 0xed, 0xb0, 0x92, 0x74, 0xaf, 0xbf, 0x9b, 0x78, 0xd6, 0x87, 0x89, 0x67, 0xfd, 0x98, 0x78,
+	0x76, 0xfb, 0x7b, 0x0d, 0xdd, 0x7e, 0x95, 0x53, 0xa2, 0xe1, 0x59, 0x59, 0xca, 0xff, 0xed, 0x33,
+	0x45, 0x8d, 0x8c, 0xf1, 0x48, 0x02, 0x85, 0xcc, 0xc8, 0x23, 0x49, 0x34, 0x38, 0x75, 0x53, 0xf7,
+	0xa3, 0xcb, 0xa9, 0x67, 0x7d, 0x99, 0x7a, 0x77, 0x13, 0xa6, 0xfb, 0x83, 0x9e, 0x1f, 0x8b, 0xac,
+	0x34, 0xa5, 0xfc, 0x74, 0x14, 0xbd, 0x08, 0xf4, 0x38, 0x07, 0xe5, 0x9f, 0x40, 0xfc, 0xe9, 0x63,
+	0x07, 0x95, 0x9e, 0x9d, 0x40, 0x1c, 0xee, 0x65, 0x8c, 0x87, 0xcb, 0xb8, 0x21, 0xd1, 0x45, 0x36,
+	0x32, 0xfa, 0x23, 0xdb, 0xc6, 0x3f, 0xc9, 0x46, 0x46, 0x6b, 0xd9, 0x02, 0xd4, 0x18, 0xf0, 0x9e,
+	0xe0, 0x94, 0xf1, 0x24, 0x3a, 0x97, 0xf0, 0x66, 0x00, 0x3c, 0x1e, 0x1b, 0x4f, 0xea, 0x21, 0x5e,
+	0xa2, 0xa7, 0x15, 0xc1, 0x3e, 0x6a, 0x68, 0x49, 0xb8, 0x3a, 0x07, 0x19, 0xc5, 0x7d, 0xc2, 0x39,
+	0xa4, 0x11, 0xa3, 0xce, 0x96, 0xb9, 0xb3, 0xbd, 0x0a, 0x3d, 0x29, 0xc8, 0x73, 0xba, 0x6a, 0xf4,
+	0xf6, 0x5f, 0x1a, 0x7d, 0x7c, 0x7a, 0x39, 0x73, 0xed, 0xab, 0x99, 0x6b, 0x7f, 0x9d, 0xb9, 0xf6,
+	0xfb, 0xb9, 0x6b, 0x5d, 0xcd, 0x5d, 0xeb, 0xf3, 0xdc, 0xb5, 0xce, 0x8e, 0x56, 0xfa, 0x7f, 0x69,
+	0x1e, 0x5c, 0xe7, 0x94, 0xf4, 0x54, 0x50, 0x0e, 0xcf, 0xf0, 0xc1, 0xfd, 0x60, 0xf4, 0x7b, 0x84,
+	0xcc, 0x7d, 0xf4, 0x36, 0xcd, 0x90, 0x3c, 0xfc, 0x35, 0x00, 0x84, 0x73, 0x2f, 0x4e, 0xa4, 0x03,
+	0x00, 0x00,
 }
 
 func (this *AddValidatorsProposal) Equal(that interface{}) bool {
@@ -134,6 +193,51 @@ func (this *AddValidatorsProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UpdateHostZoneProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateHostZoneProposal)
+	if !ok {
+		that2, ok := that.(UpdateHostZoneProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.HostZone != that1.HostZone {
+		return false
+	}
+	if !this.MinRedemptionRate.Equal(that1.MinRedemptionRate) {
+		return false
+	}
+	if !this.MaxRedemptionRate.Equal(that1.MaxRedemptionRate) {
+		return false
+	}
+	if this.UnbondingFrequency != that1.UnbondingFrequency {
+		return false
+	}
+	if this.TransferChannelId != that1.TransferChannelId {
+		return false
+	}
+	if this.Deposit != that1.Deposit {
+		return false
+	}
+	return true
+}
 func (m *AddValidatorsProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -199,6 +303,89 @@ func (m *AddValidatorsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UpdateHostZoneProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateHostZoneProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateHostZoneProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TransferChannelId) > 0 {
+		i -= len(m.TransferChannelId)
+		copy(dAtA[i:], m.TransferChannelId)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TransferChannelId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.UnbondingFrequency != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.UnbondingFrequency))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxRedemptionRate.Size()
+		i -= size
+		if _, err := m.MaxRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinRedemptionRate.Size()
+		i -= size
+		if _, err := m.MinRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -241,6 +428,42 @@ func (m *AddValidatorsProposal) Size() (n int) {
 	return n
 }
 
+func (m *UpdateHostZoneProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.MinRedemptionRate.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.MaxRedemptionRate.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if m.UnbondingFrequency != 0 {
+		n += 1 + sovGov(uint64(m.UnbondingFrequency))
+	}
+	l = len(m.TransferChannelId)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -459,6 +682,303 @@ func (m *AddValidatorsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpdateHostZoneProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateHostZoneProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateHostZoneProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFrequency", wireType)
+			}
+			m.UnbondingFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,110 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func TestUpdateHostZoneProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name     string
+		proposal types.UpdateHostZoneProposal
+		err      error
+	}{
+		{
+			name: "successful proposal with all fields",
+			proposal: types.UpdateHostZoneProposal{
+				Title:              "title",
+				Description:        "description",
+				HostZone:           "GAIA",
+				MinRedemptionRate:  sdk.MustNewDecFromStr("0.9"),
+				MaxRedemptionRate:  sdk.MustNewDecFromStr("1.5"),
+				UnbondingFrequency: 4,
+				TransferChannelId:  "channel-1",
+			},
+		},
+		{
+			name: "successful proposal with one field",
+			proposal: types.UpdateHostZoneProposal{
+				Title:              "title",
+				Description:        "description",
+				HostZone:           "GAIA",
+				UnbondingFrequency: 4,
+			},
+		},
+		{
+			name: "missing host zone",
+			proposal: types.UpdateHostZoneProposal{
+				Title:              "title",
+				Description:        "description",
+				UnbondingFrequency: 4,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "no fields updated",
+			proposal: types.UpdateHostZoneProposal{
+				Title:       "title",
+				Description: "description",
+				HostZone:    "GAIA",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "negative min redemption rate",
+			proposal: types.UpdateHostZoneProposal{
+				Title:             "title",
+				Description:       "description",
+				HostZone:          "GAIA",
+				MinRedemptionRate: sdk.MustNewDecFromStr("-0.9"),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "min redemption rate greater than max",
+			proposal: types.UpdateHostZoneProposal{
+				Title:             "title",
+				Description:       "description",
+				HostZone:          "GAIA",
+				MinRedemptionRate: sdk.MustNewDecFromStr("1.5"),
+				MaxRedemptionRate: sdk.MustNewDecFromStr("0.9"),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid transfer channel",
+			proposal: types.UpdateHostZoneProposal{
+				Title:             "title",
+				Description:       "description",
+				HostZone:          "GAIA",
+				TransferChannelId: "connection-1",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "non-numeric transfer channel",
+			proposal: types.UpdateHostZoneProposal{
+				Title:             "title",
+				Description:       "description",
+				HostZone:          "GAIA",
+				TransferChannelId: "channel-abc",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proposal.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
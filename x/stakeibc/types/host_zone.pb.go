@@ -25,7 +25,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// next id: 22
+// Strategy used to set the weights of a host zone's validators
+type ValidatorSelectionStrategy int32
+
+const (
+	// weights are set manually by the admin (via ChangeValidatorWeight)
+	ValidatorSelectionStrategy_STATIC ValidatorSelectionStrategy = 0
+	// every validator is given the same weight
+	ValidatorSelectionStrategy_EQUAL ValidatorSelectionStrategy = 1
+	// weights are computed each day epoch from each validator's commission,
+	// voting power and uptime (queried from the host zone via ICQ)
+	ValidatorSelectionStrategy_SCORED ValidatorSelectionStrategy = 2
+)
+
+var ValidatorSelectionStrategy_name = map[int32]string{
+	0: "STATIC",
+	1: "EQUAL",
+	2: "SCORED",
+}
+
+var ValidatorSelectionStrategy_value = map[string]int32{
+	"STATIC": 0,
+	"EQUAL":  1,
+	"SCORED": 2,
+}
+
+func (x ValidatorSelectionStrategy) String() string {
+	return proto.EnumName(ValidatorSelectionStrategy_name, int32(x))
+}
+
+func (ValidatorSelectionStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f81bf5b42c61245a, []int{0}
+}
+
+// next id: 24
 type HostZone struct {
 	ChainId               string       `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	ConnectionId          string       `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
@@ -53,6 +86,14 @@ type HostZone struct {
 	Halted            bool                                   `protobuf:"varint,19,opt,name=halted,proto3" json:"halted,omitempty"`
 	MinRedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=min_redemption_rate,json=minRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_redemption_rate"`
 	MaxRedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=max_redemption_rate,json=maxRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_redemption_rate"`
+	// if enabled, claimable redemptions are sent to their receivers each day
+	// epoch without requiring a MsgClaimUndelegatedTokens
+	AutoClaimEnabled bool `protobuf:"varint,22,opt,name=auto_claim_enabled,json=autoClaimEnabled,proto3" json:"auto_claim_enabled,omitempty"`
+	// determines how the weights of the host zone's validators are set
+	ValidatorSelectionStrategy ValidatorSelectionStrategy `protobuf:"varint,23,opt,name=validator_selection_strategy,json=validatorSelectionStrategy,proto3,enum=stride.stakeibc.ValidatorSelectionStrategy" json:"validator_selection_strategy,omitempty"`
+	// the host's unbonding period in seconds, refreshed each day epoch from an
+	// ICQ of the host's staking params (0 until the first query returns)
+	UnbondingPeriod uint64 `protobuf:"varint,24,opt,name=unbonding_period,json=unbondingPeriod,proto3" json:"unbonding_period,omitempty"`
 }
 
 func (m *HostZone) Reset()         { *m = HostZone{} }
@@ -193,56 +234,86 @@ func (m *HostZone) GetHalted() bool {
 	return false
 }
 
+func (m *HostZone) GetAutoClaimEnabled() bool {
+	if m != nil {
+		return m.AutoClaimEnabled
+	}
+	return false
+}
+
+func (m *HostZone) GetValidatorSelectionStrategy() ValidatorSelectionStrategy {
+	if m != nil {
+		return m.ValidatorSelectionStrategy
+	}
+	return ValidatorSelectionStrategy_STATIC
+}
+
+func (m *HostZone) GetUnbondingPeriod() uint64 {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("stride.stakeibc.ValidatorSelectionStrategy", ValidatorSelectionStrategy_name, ValidatorSelectionStrategy_value)
 	proto.RegisterType((*HostZone)(nil), "stride.stakeibc.HostZone")
 }
 
 func init() { proto.RegisterFile("stride/stakeibc/host_zone.proto", fileDescriptor_f81bf5b42c61245a) }
 
 var fileDescriptor_f81bf5b42c61245a = []byte{
-	// 672 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x4e, 0xdb, 0x4a,
-	0x14, 0xc6, 0xe3, 0x0b, 0x17, 0x92, 0x09, 0xff, 0x32, 0x01, 0x64, 0x40, 0x37, 0xc9, 0x4d, 0xa5,
-	0x2a, 0x8b, 0xe2, 0xb4, 0x61, 0x87, 0xd8, 0xf0, 0x47, 0x55, 0x83, 0xe8, 0xa2, 0xae, 0xc4, 0x82,
-	0x8d, 0x35, 0x9e, 0x39, 0x49, 0x46, 0x38, 0x33, 0xa9, 0x67, 0x02, 0xa1, 0x8f, 0xd0, 0x55, 0x1f,
-	0xa6, 0x0f, 0xc1, 0x12, 0x75, 0x55, 0x75, 0x81, 0x2a, 0x78, 0x83, 0x3e, 0x41, 0x95, 0xb1, 0x9d,
-	0x98, 0x64, 0x01, 0x95, 0x58, 0xd9, 0x73, 0xbe, 0xef, 0xfc, 0xbe, 0xd1, 0x19, 0x7b, 0x50, 0x59,
-	0xe9, 0x90, 0x33, 0xa8, 0x2b, 0x4d, 0xce, 0x81, 0xfb, 0xb4, 0xde, 0x91, 0x4a, 0x7b, 0x9f, 0xa5,
-	0x00, 0xa7, 0x17, 0x4a, 0x2d, 0xf1, 0x72, 0x64, 0x70, 0x12, 0xc3, 0xe6, 0x54, 0xc7, 0x05, 0x09,
-	0x38, 0x23, 0x5a, 0x86, 0x51, 0xc7, 0xe6, 0xff, 0x93, 0x06, 0x4e, 0x89, 0x47, 0x28, 0x95, 0x7d,
-	0xa1, 0x63, 0xcb, 0x6a, 0x5b, 0xb6, 0xa5, 0x79, 0xad, 0x0f, 0xdf, 0xe2, 0xea, 0x06, 0x95, 0xaa,
-	0x2b, 0x95, 0x17, 0x09, 0xd1, 0x22, 0x92, 0xaa, 0x5f, 0x10, 0xca, 0xbe, 0x93, 0x4a, 0x9f, 0x49,
-	0x01, 0x78, 0x03, 0x65, 0x69, 0x87, 0x70, 0xe1, 0x71, 0x66, 0x5b, 0x15, 0xab, 0x96, 0x73, 0xe7,
-	0xcd, 0xba, 0xc9, 0xf0, 0x0b, 0xb4, 0x48, 0xa5, 0x10, 0x40, 0x35, 0x97, 0x46, 0xff, 0xc7, 0xe8,
-	0x0b, 0xe3, 0x62, 0x93, 0xe1, 0x2a, 0x5a, 0xf0, 0x81, 0x76, 0x76, 0x1a, 0xbd, 0x10, 0x5a, 0x7c,
-	0x60, 0x17, 0x22, 0x4f, 0xba, 0x86, 0x1d, 0x54, 0xd4, 0x21, 0x11, 0xaa, 0x05, 0xa1, 0x47, 0x3b,
-	0x44, 0x08, 0x08, 0x86, 0xb8, 0x05, 0x63, 0x2d, 0x24, 0xd2, 0x61, 0xa4, 0x34, 0x19, 0xde, 0x45,
-	0x68, 0x34, 0x07, 0x65, 0xcf, 0x54, 0x66, 0x6a, 0xf9, 0xc6, 0xa6, 0x33, 0x31, 0x3b, 0xe7, 0x34,
-	0xb1, 0xb8, 0x29, 0x37, 0xfe, 0x80, 0xd6, 0xfd, 0x80, 0xd0, 0xf3, 0x80, 0x2b, 0x0d, 0xcc, 0x4b,
-	0x71, 0x66, 0x1f, 0xe5, 0xac, 0xa5, 0x3a, 0x4f, 0xc7, 0xc8, 0x63, 0x84, 0x2f, 0xb9, 0xee, 0xb0,
-	0x90, 0x5c, 0x92, 0x20, 0x19, 0xbe, 0xfd, 0x6f, 0xc5, 0xaa, 0xe5, 0x1b, 0x5b, 0x53, 0xb8, 0xe6,
-	0xe1, 0xfe, 0x7e, 0x64, 0x71, 0x0b, 0xe3, 0xb6, 0xb8, 0x84, 0xf7, 0x50, 0xbe, 0x05, 0x30, 0x82,
-	0xcc, 0x3d, 0x0e, 0x41, 0x2d, 0x80, 0xa4, 0xfb, 0x18, 0x61, 0x06, 0x01, 0xb4, 0x89, 0x39, 0x91,
-	0x04, 0x32, 0xff, 0x84, 0x9d, 0x8c, 0xdb, 0x52, 0xac, 0x10, 0x18, 0x74, 0x7b, 0x0f, 0x58, 0x2b,
-	0x4f, 0x60, 0x8d, 0xdb, 0x12, 0xd6, 0x16, 0xca, 0x71, 0x9f, 0x7a, 0x0c, 0x84, 0xec, 0xda, 0x59,
-	0x73, 0xac, 0x59, 0xee, 0xd3, 0xa3, 0xe1, 0x1a, 0xff, 0x87, 0x90, 0xf9, 0x0f, 0x22, 0x35, 0x67,
-	0xd4, 0xdc, 0xb0, 0x12, 0xc9, 0x02, 0xad, 0x06, 0x44, 0x69, 0x2f, 0xb5, 0x99, 0x90, 0x68, 0xb0,
-	0xd1, 0xd0, 0x78, 0xb0, 0x77, 0x7d, 0x5b, 0xce, 0xfc, 0xbc, 0x2d, 0xbf, 0x6c, 0x73, 0xdd, 0xe9,
-	0xfb, 0x0e, 0x95, 0xdd, 0xf8, 0x63, 0x8e, 0x1f, 0xdb, 0x8a, 0x9d, 0xd7, 0xf5, 0x55, 0x0f, 0x94,
-	0x73, 0x04, 0xf4, 0xfb, 0xb7, 0x6d, 0x14, 0x7f, 0xeb, 0x47, 0x40, 0x5d, 0x3c, 0x24, 0xbb, 0x23,
-	0xb0, 0x4b, 0x34, 0x60, 0x40, 0xcb, 0x93, 0x51, 0xf9, 0x67, 0x88, 0x5a, 0x0a, 0x1f, 0xc6, 0xd4,
-	0x51, 0xb1, 0x2f, 0x7c, 0x29, 0x18, 0x17, 0x6d, 0xaf, 0x15, 0xc2, 0xa7, 0x3e, 0x08, 0x7a, 0x65,
-	0x2f, 0x55, 0xac, 0xda, 0xac, 0x8b, 0x47, 0xd2, 0xdb, 0x44, 0xc1, 0xef, 0x11, 0x32, 0xd3, 0x66,
-	0x9e, 0x4f, 0x02, 0x7b, 0xd1, 0x6c, 0xc9, 0xf9, 0x8b, 0x2d, 0x35, 0x85, 0x76, 0x73, 0x11, 0xe1,
-	0x80, 0x04, 0xf8, 0x15, 0x9a, 0x27, 0x8c, 0x85, 0xa0, 0x94, 0x8d, 0x0d, 0x0b, 0xff, 0xbe, 0x2d,
-	0x2f, 0x5d, 0x91, 0x6e, 0xb0, 0x5b, 0x8d, 0x85, 0xaa, 0x9b, 0x58, 0xf0, 0x3a, 0x9a, 0xeb, 0x90,
-	0x40, 0x03, 0xb3, 0x8b, 0x15, 0xab, 0x96, 0x75, 0xe3, 0x15, 0x0e, 0x50, 0xb1, 0xcb, 0xc5, 0xd4,
-	0xd9, 0xac, 0x3e, 0xc3, 0xc0, 0x0a, 0x5d, 0x2e, 0x26, 0x8e, 0x66, 0x98, 0x46, 0x06, 0x53, 0x69,
-	0x6b, 0xcf, 0x92, 0x46, 0x06, 0x0f, 0xd3, 0x8e, 0x67, 0xb3, 0xcb, 0x2b, 0x2b, 0x07, 0x27, 0xd7,
-	0x77, 0x25, 0xeb, 0xe6, 0xae, 0x64, 0xfd, 0xba, 0x2b, 0x59, 0x5f, 0xef, 0x4b, 0x99, 0x9b, 0xfb,
-	0x52, 0xe6, 0xc7, 0x7d, 0x29, 0x73, 0xd6, 0x48, 0x05, 0x7d, 0x34, 0xbf, 0xc3, 0xf6, 0x09, 0xf1,
-	0x55, 0x3d, 0xbe, 0x91, 0x2f, 0xde, 0xbc, 0xae, 0x0f, 0xc6, 0xf7, 0xb2, 0x09, 0xf6, 0xe7, 0xcc,
-	0x0d, 0xbb, 0xf3, 0x27, 0x00, 0x00, 0xff, 0xff, 0x61, 0xac, 0x1f, 0x82, 0x0a, 0x06, 0x00, 0x00,
+	// 791 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xc1, 0x6e, 0xdb, 0x46,
+	0x10, 0x15, 0x13, 0xc7, 0x96, 0x46, 0x8e, 0x2d, 0xaf, 0x1d, 0x97, 0x51, 0x5a, 0x59, 0x75, 0x81,
+	0x42, 0x6d, 0x63, 0xaa, 0x55, 0x6e, 0x41, 0x80, 0xc2, 0x96, 0x5d, 0x54, 0x86, 0x8b, 0x36, 0x74,
+	0x9a, 0x43, 0x2e, 0xc4, 0x72, 0x77, 0x24, 0x2d, 0x4c, 0xee, 0xaa, 0xdc, 0x95, 0x63, 0xf7, 0x2b,
+	0xfa, 0x31, 0xfd, 0x88, 0x1c, 0x83, 0x9c, 0x8a, 0x1e, 0x8c, 0xc2, 0xfe, 0x83, 0x7e, 0x41, 0xc1,
+	0x25, 0x29, 0x29, 0x32, 0x8c, 0xa4, 0x80, 0x4f, 0xe2, 0xbc, 0xf7, 0xe6, 0xcd, 0x70, 0x86, 0xda,
+	0x85, 0x2d, 0x6d, 0x12, 0xc1, 0xb1, 0xad, 0x0d, 0x3d, 0x41, 0x11, 0xb2, 0xf6, 0x50, 0x69, 0x13,
+	0xfc, 0xae, 0x24, 0x7a, 0xa3, 0x44, 0x19, 0x45, 0x56, 0x33, 0x81, 0x57, 0x08, 0xea, 0xd7, 0x32,
+	0x4e, 0x69, 0x24, 0x38, 0x35, 0x2a, 0xc9, 0x32, 0xea, 0x9f, 0xcf, 0x0b, 0x04, 0xa3, 0x01, 0x65,
+	0x4c, 0x8d, 0xa5, 0xc9, 0x25, 0x1b, 0x03, 0x35, 0x50, 0xf6, 0xb1, 0x9d, 0x3e, 0xe5, 0xe8, 0x43,
+	0xa6, 0x74, 0xac, 0x74, 0x90, 0x11, 0x59, 0x90, 0x51, 0xdb, 0xef, 0xaa, 0x50, 0xfe, 0x51, 0x69,
+	0xf3, 0x4a, 0x49, 0x24, 0x0f, 0xa1, 0xcc, 0x86, 0x54, 0xc8, 0x40, 0x70, 0xd7, 0x69, 0x3a, 0xad,
+	0x8a, 0xbf, 0x64, 0xe3, 0x1e, 0x27, 0x5f, 0xc0, 0x7d, 0xa6, 0xa4, 0x44, 0x66, 0x84, 0xb2, 0xfc,
+	0x1d, 0xcb, 0x2f, 0x4f, 0xc1, 0x1e, 0x27, 0xdb, 0xb0, 0x1c, 0x22, 0x1b, 0x3e, 0xe9, 0x8c, 0x12,
+	0xec, 0x8b, 0x33, 0x77, 0x2d, 0xd3, 0xcc, 0x62, 0xc4, 0x83, 0x75, 0x93, 0x50, 0xa9, 0xfb, 0x98,
+	0x04, 0x6c, 0x48, 0xa5, 0xc4, 0x28, 0xb5, 0x5b, 0xb6, 0xd2, 0xb5, 0x82, 0xea, 0x66, 0x4c, 0x8f,
+	0x93, 0xa7, 0x00, 0x93, 0x39, 0x68, 0xf7, 0x6e, 0xf3, 0x6e, 0xab, 0xda, 0xa9, 0x7b, 0x73, 0xb3,
+	0xf3, 0x5e, 0x16, 0x12, 0x7f, 0x46, 0x4d, 0x9e, 0xc3, 0x66, 0x18, 0x51, 0x76, 0x12, 0x09, 0x6d,
+	0x90, 0x07, 0x33, 0x3e, 0x0b, 0x1f, 0xf4, 0x79, 0x30, 0x93, 0xf9, 0x72, 0x6a, 0x79, 0x08, 0xe4,
+	0xb5, 0x30, 0x43, 0x9e, 0xd0, 0xd7, 0x34, 0x2a, 0x86, 0xef, 0xde, 0x6b, 0x3a, 0xad, 0x6a, 0xe7,
+	0xd1, 0x35, 0xbb, 0x5e, 0x77, 0x77, 0x37, 0x93, 0xf8, 0x6b, 0xd3, 0xb4, 0x1c, 0x22, 0xcf, 0xa0,
+	0xda, 0x47, 0x9c, 0x98, 0x2c, 0x7e, 0xd8, 0x04, 0xfa, 0x88, 0x45, 0xf6, 0x21, 0x10, 0x8e, 0x11,
+	0x0e, 0xa8, 0xdd, 0x48, 0x61, 0xb2, 0xf4, 0x11, 0x9d, 0x4c, 0xd3, 0x66, 0xbc, 0x12, 0xe4, 0x18,
+	0x8f, 0xde, 0xf3, 0xaa, 0x7d, 0x84, 0xd7, 0x34, 0xad, 0xf0, 0x7a, 0x04, 0x15, 0x11, 0xb2, 0x80,
+	0xa3, 0x54, 0xb1, 0x5b, 0xb6, 0x6b, 0x2d, 0x8b, 0x90, 0xed, 0xa7, 0x31, 0xf9, 0x0c, 0xc0, 0xfe,
+	0x0f, 0x32, 0xb6, 0x62, 0xd9, 0x4a, 0x8a, 0x64, 0xb4, 0x84, 0x8d, 0x88, 0x6a, 0x13, 0xcc, 0x34,
+	0x93, 0x50, 0x83, 0x2e, 0xa4, 0xc2, 0xbd, 0x67, 0x6f, 0x2e, 0xb6, 0x4a, 0x7f, 0x5f, 0x6c, 0x7d,
+	0x39, 0x10, 0x66, 0x38, 0x0e, 0x3d, 0xa6, 0xe2, 0xfc, 0x63, 0xce, 0x7f, 0x76, 0x34, 0x3f, 0x69,
+	0x9b, 0xf3, 0x11, 0x6a, 0x6f, 0x1f, 0xd9, 0xbb, 0x3f, 0x77, 0x20, 0xc3, 0xd3, 0xc8, 0x27, 0xa9,
+	0xb3, 0x3f, 0x31, 0xf6, 0xa9, 0x41, 0x82, 0xb0, 0x3a, 0x5f, 0xaa, 0x7a, 0x0b, 0xa5, 0x56, 0x92,
+	0xf7, 0xcb, 0xb4, 0x61, 0x7d, 0x2c, 0x43, 0x25, 0xb9, 0x90, 0x83, 0xa0, 0x9f, 0xe0, 0x6f, 0x63,
+	0x94, 0xec, 0xdc, 0x5d, 0x69, 0x3a, 0xad, 0x05, 0x9f, 0x4c, 0xa8, 0x1f, 0x0a, 0x86, 0xfc, 0x04,
+	0x60, 0xa7, 0xcd, 0x83, 0x90, 0x46, 0xee, 0x7d, 0xdb, 0x92, 0xf7, 0x3f, 0x5a, 0xea, 0x49, 0xe3,
+	0x57, 0x32, 0x87, 0x3d, 0x1a, 0x91, 0xc7, 0xb0, 0x44, 0x39, 0x4f, 0x50, 0x6b, 0x97, 0x58, 0x2f,
+	0xf2, 0xef, 0xc5, 0xd6, 0xca, 0x39, 0x8d, 0xa3, 0xa7, 0xdb, 0x39, 0xb1, 0xed, 0x17, 0x12, 0xb2,
+	0x09, 0x8b, 0x43, 0x1a, 0x19, 0xe4, 0xee, 0x7a, 0xd3, 0x69, 0x95, 0xfd, 0x3c, 0x22, 0x11, 0xac,
+	0xc7, 0x42, 0x5e, 0xdb, 0xcd, 0xc6, 0x2d, 0x0c, 0x6c, 0x2d, 0x16, 0x72, 0x6e, 0x35, 0x69, 0x35,
+	0x7a, 0x76, 0xad, 0xda, 0x83, 0x5b, 0xa9, 0x46, 0xcf, 0xe6, 0xaa, 0x3d, 0x06, 0x42, 0xc7, 0x46,
+	0x05, 0x2c, 0xa2, 0x22, 0x0e, 0x50, 0xd2, 0x30, 0x42, 0xee, 0x6e, 0xda, 0xf7, 0xaf, 0xa5, 0x4c,
+	0x37, 0x25, 0x0e, 0x32, 0x9c, 0xc4, 0xf0, 0xe9, 0xe4, 0x2c, 0x09, 0x34, 0x46, 0xf9, 0xa9, 0xa8,
+	0x4d, 0xda, 0xe2, 0xe0, 0xdc, 0xfd, 0xa4, 0xe9, 0xb4, 0x56, 0x3a, 0xdf, 0xdc, 0x7c, 0xba, 0x1c,
+	0x17, 0x39, 0xc7, 0x79, 0x8a, 0x5f, 0x3f, 0xbd, 0x91, 0x23, 0x5f, 0x41, 0x6d, 0xfa, 0xf9, 0x8c,
+	0x30, 0x11, 0x8a, 0xbb, 0xae, 0xfd, 0x76, 0x56, 0x27, 0xf8, 0x2f, 0x16, 0x3e, 0x5c, 0x28, 0xaf,
+	0xd6, 0x6a, 0x5f, 0x7f, 0x0f, 0xf5, 0x9b, 0x4b, 0x11, 0x80, 0xc5, 0xe3, 0x17, 0xbb, 0x2f, 0x7a,
+	0xdd, 0x5a, 0x89, 0x54, 0xe0, 0xde, 0xc1, 0xf3, 0x5f, 0x77, 0x8f, 0x6a, 0x8e, 0x85, 0xbb, 0x3f,
+	0xfb, 0x07, 0xfb, 0xb5, 0x3b, 0x7b, 0x47, 0x6f, 0x2e, 0x1b, 0xce, 0xdb, 0xcb, 0x86, 0xf3, 0xcf,
+	0x65, 0xc3, 0xf9, 0xe3, 0xaa, 0x51, 0x7a, 0x7b, 0xd5, 0x28, 0xfd, 0x75, 0xd5, 0x28, 0xbd, 0xea,
+	0xcc, 0x4c, 0xfc, 0xd8, 0xbe, 0xde, 0xce, 0x11, 0x0d, 0x75, 0x3b, 0xbf, 0x9a, 0x4e, 0xbf, 0xfb,
+	0xb6, 0x7d, 0x36, 0xbd, 0xa0, 0xec, 0x06, 0xc2, 0x45, 0x7b, 0xd5, 0x3c, 0xf9, 0x6f, 0x00, 0xa6,
+	0xfe, 0xe1, 0x34, 0x13, 0x07, 0x00, 0x00,
 }
 
 func (m *HostZone) Marshal() (dAtA []byte, err error) {
@@ -265,6 +336,32 @@ func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnbondingPeriod != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.UnbondingPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.ValidatorSelectionStrategy != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.ValidatorSelectionStrategy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.AutoClaimEnabled {
+		i--
+		if m.AutoClaimEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
 	{
 		size := m.MaxRedemptionRate.Size()
 		i -= size
@@ -559,6 +656,15 @@ func (m *HostZone) Size() (n int) {
 	n += 2 + l + sovHostZone(uint64(l))
 	l = m.MaxRedemptionRate.Size()
 	n += 2 + l + sovHostZone(uint64(l))
+	if m.AutoClaimEnabled {
+		n += 3
+	}
+	if m.ValidatorSelectionStrategy != 0 {
+		n += 2 + sovHostZone(uint64(m.ValidatorSelectionStrategy))
+	}
+	if m.UnbondingPeriod != 0 {
+		n += 2 + sovHostZone(uint64(m.UnbondingPeriod))
+	}
 	return n
 }
 
@@ -1242,6 +1348,64 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoClaimEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoClaimEnabled = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSelectionStrategy", wireType)
+			}
+			m.ValidatorSelectionStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSelectionStrategy |= ValidatorSelectionStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			m.UnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHostZone(dAtA[iNdEx:])
@@ -0,0 +1,583 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/host_zone_sunset.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type HostZoneSunsetStatus int32
+
+const (
+	// Liquid staking is closed and the remaining deposits are being delegated
+	HostZoneSunsetStatus_DRAINING_DEPOSITS HostZoneSunsetStatus = 0
+	// The full staked balance is being undelegated, stTokens can be redeemed at
+	// the final redemption rate
+	HostZoneSunsetStatus_UNBONDING HostZoneSunsetStatus = 1
+	// The unbonded tokens are claimable, stTokens can be redeemed at the final
+	// redemption rate until they've all been redeemed or the deadline passes
+	HostZoneSunsetStatus_REDEEMING HostZoneSunsetStatus = 2
+	// The host zone and its records have been removed
+	HostZoneSunsetStatus_COMPLETE HostZoneSunsetStatus = 3
+)
+
+var HostZoneSunsetStatus_name = map[int32]string{
+	0: "DRAINING_DEPOSITS",
+	1: "UNBONDING",
+	2: "REDEEMING",
+	3: "COMPLETE",
+}
+
+var HostZoneSunsetStatus_value = map[string]int32{
+	"DRAINING_DEPOSITS": 0,
+	"UNBONDING":         1,
+	"REDEEMING":         2,
+	"COMPLETE":          3,
+}
+
+func (x HostZoneSunsetStatus) String() string {
+	return proto.EnumName(HostZoneSunsetStatus_name, int32(x))
+}
+
+func (HostZoneSunsetStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_18695ed252fb8deb, []int{0}
+}
+
+// Tracks the progress of a host zone that is being wound down
+type HostZoneSunset struct {
+	ChainId string               `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Status  HostZoneSunsetStatus `protobuf:"varint,2,opt,name=status,proto3,enum=stride.stakeibc.HostZoneSunsetStatus" json:"status,omitempty"`
+	// unix time (seconds) after which the host zone is removed, even if there
+	// are stTokens that have not been redeemed
+	RedemptionDeadline uint64 `protobuf:"varint,3,opt,name=redemption_deadline,json=redemptionDeadline,proto3" json:"redemption_deadline,omitempty"`
+	// redemption rate used for every redemption once the unbonding starts
+	FinalRedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=final_redemption_rate,json=finalRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"final_redemption_rate"`
+	// day epoch of the unbonding record that holds the undelegated balance
+	UnbondingEpochNumber uint64 `protobuf:"varint,5,opt,name=unbonding_epoch_number,json=unbondingEpochNumber,proto3" json:"unbonding_epoch_number,omitempty"`
+	// native tokens from the undelegated balance that have not yet been
+	// assigned to a redemption
+	RedeemableAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=redeemable_amount,json=redeemableAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"redeemable_amount"`
+}
+
+func (m *HostZoneSunset) Reset()         { *m = HostZoneSunset{} }
+func (m *HostZoneSunset) String() string { return proto.CompactTextString(m) }
+func (*HostZoneSunset) ProtoMessage()    {}
+func (*HostZoneSunset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18695ed252fb8deb, []int{0}
+}
+func (m *HostZoneSunset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostZoneSunset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostZoneSunset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostZoneSunset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostZoneSunset.Merge(m, src)
+}
+func (m *HostZoneSunset) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostZoneSunset) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostZoneSunset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostZoneSunset proto.InternalMessageInfo
+
+func (m *HostZoneSunset) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *HostZoneSunset) GetStatus() HostZoneSunsetStatus {
+	if m != nil {
+		return m.Status
+	}
+	return HostZoneSunsetStatus_DRAINING_DEPOSITS
+}
+
+func (m *HostZoneSunset) GetRedemptionDeadline() uint64 {
+	if m != nil {
+		return m.RedemptionDeadline
+	}
+	return 0
+}
+
+func (m *HostZoneSunset) GetUnbondingEpochNumber() uint64 {
+	if m != nil {
+		return m.UnbondingEpochNumber
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("stride.stakeibc.HostZoneSunsetStatus", HostZoneSunsetStatus_name, HostZoneSunsetStatus_value)
+	proto.RegisterType((*HostZoneSunset)(nil), "stride.stakeibc.HostZoneSunset")
+}
+
+func init() {
+	proto.RegisterFile("stride/stakeibc/host_zone_sunset.proto", fileDescriptor_18695ed252fb8deb)
+}
+
+var fileDescriptor_18695ed252fb8deb = []byte{
+	// 469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x6e, 0xd3, 0x40,
+	0x18, 0x85, 0xed, 0xa6, 0x84, 0x76, 0x04, 0x25, 0x9d, 0xa6, 0xc8, 0xed, 0xc2, 0x8d, 0x90, 0xa8,
+	0x22, 0xa4, 0xd8, 0x50, 0x58, 0xc2, 0xa2, 0xc1, 0x56, 0xb1, 0x94, 0x3a, 0x95, 0x5d, 0x16, 0x94,
+	0xc5, 0x68, 0x6c, 0xff, 0x24, 0x56, 0xe3, 0x19, 0xcb, 0x33, 0x46, 0xc0, 0x29, 0x38, 0x02, 0x87,
+	0xe0, 0x10, 0x5d, 0x56, 0xac, 0x10, 0x8b, 0x0a, 0x25, 0x17, 0x41, 0x1e, 0x9b, 0xa6, 0x20, 0x36,
+	0xac, 0xec, 0x37, 0xef, 0xcd, 0xd3, 0xe7, 0xdf, 0x3f, 0xda, 0x17, 0xb2, 0x48, 0x13, 0xb0, 0x85,
+	0xa4, 0xe7, 0x90, 0x46, 0xb1, 0x3d, 0xe5, 0x42, 0x92, 0x4f, 0x9c, 0x01, 0x11, 0x25, 0x13, 0x20,
+	0xad, 0xbc, 0xe0, 0x92, 0xe3, 0x7b, 0x75, 0xce, 0xfa, 0x9d, 0xdb, 0xdd, 0x89, 0xb9, 0xc8, 0xb8,
+	0x20, 0xca, 0xb6, 0x6b, 0x51, 0x67, 0x77, 0xbb, 0x13, 0x3e, 0xe1, 0xf5, 0x79, 0xf5, 0x56, 0x9f,
+	0x3e, 0xf8, 0xd2, 0x42, 0x1b, 0xaf, 0xb8, 0x90, 0x67, 0x9c, 0x41, 0xa8, 0xaa, 0xf1, 0x0e, 0x5a,
+	0x8b, 0xa7, 0x34, 0x65, 0x24, 0x4d, 0x0c, 0xbd, 0xa7, 0xf7, 0xd7, 0x83, 0xdb, 0x4a, 0x7b, 0x09,
+	0x7e, 0x81, 0xda, 0x42, 0x52, 0x59, 0x0a, 0x63, 0xa5, 0xa7, 0xf7, 0x37, 0x0e, 0x1e, 0x5a, 0x7f,
+	0x01, 0x58, 0x7f, 0x76, 0x85, 0x2a, 0x1c, 0x34, 0x97, 0xb0, 0x8d, 0xb6, 0x0a, 0x48, 0x20, 0xcb,
+	0x65, 0xca, 0x19, 0x49, 0x80, 0x26, 0xb3, 0x94, 0x81, 0xd1, 0xea, 0xe9, 0xfd, 0xd5, 0x00, 0x2f,
+	0x2d, 0xa7, 0x71, 0x70, 0x8e, 0xb6, 0xdf, 0xa5, 0x8c, 0xce, 0xc8, 0x8d, 0x6b, 0x05, 0x95, 0x60,
+	0xac, 0x56, 0x5c, 0xc3, 0xe7, 0x17, 0x57, 0x7b, 0xda, 0x8f, 0xab, 0xbd, 0xfd, 0x49, 0x2a, 0xa7,
+	0x65, 0x64, 0xc5, 0x3c, 0x6b, 0xbe, 0xb9, 0x79, 0x0c, 0x44, 0x72, 0x6e, 0xcb, 0x8f, 0x39, 0x08,
+	0xcb, 0x81, 0xf8, 0xdb, 0xd7, 0x01, 0x6a, 0x46, 0xe2, 0x40, 0x1c, 0x6c, 0xa9, 0xea, 0xe0, 0xba,
+	0x39, 0xa0, 0x12, 0xf0, 0x33, 0x74, 0xbf, 0x64, 0x11, 0x67, 0x49, 0xca, 0x26, 0x04, 0x72, 0x1e,
+	0x4f, 0x09, 0x2b, 0xb3, 0x08, 0x0a, 0xe3, 0x96, 0xa2, 0xec, 0x5e, 0xbb, 0x6e, 0x65, 0xfa, 0xca,
+	0xc3, 0x6f, 0xd1, 0x66, 0x45, 0x08, 0x19, 0x8d, 0x66, 0x40, 0x68, 0xc6, 0x4b, 0x26, 0x8d, 0xb6,
+	0x62, 0xb4, 0xfe, 0x83, 0xd1, 0x63, 0x32, 0xe8, 0x2c, 0x8b, 0x0e, 0x55, 0xcf, 0xa3, 0x37, 0xa8,
+	0xfb, 0xaf, 0xa9, 0xe2, 0x6d, 0xb4, 0xe9, 0x04, 0x87, 0x9e, 0xef, 0xf9, 0x47, 0xc4, 0x71, 0x4f,
+	0xc6, 0xa1, 0x77, 0x1a, 0x76, 0x34, 0x7c, 0x17, 0xad, 0xbf, 0xf6, 0x87, 0x63, 0xdf, 0xf1, 0xfc,
+	0xa3, 0x8e, 0x5e, 0xc9, 0xc0, 0x75, 0x5c, 0xf7, 0xb8, 0x92, 0x2b, 0xf8, 0x0e, 0x5a, 0x7b, 0x39,
+	0x3e, 0x3e, 0x19, 0xb9, 0xa7, 0x6e, 0xa7, 0x35, 0x1c, 0x5d, 0xcc, 0x4d, 0xfd, 0x72, 0x6e, 0xea,
+	0x3f, 0xe7, 0xa6, 0xfe, 0x79, 0x61, 0x6a, 0x97, 0x0b, 0x53, 0xfb, 0xbe, 0x30, 0xb5, 0xb3, 0x83,
+	0x1b, 0xb8, 0xa1, 0xfa, 0xc7, 0x83, 0x11, 0x8d, 0x84, 0xdd, 0x2c, 0xe6, 0xfb, 0x27, 0x8f, 0xed,
+	0x0f, 0xcb, 0xf5, 0x54, 0xf8, 0x51, 0x5b, 0xad, 0xd4, 0xd3, 0x5f, 0x03, 0x00, 0x55, 0xbd, 0xea,
+	0xcf, 0xbe, 0x02, 0x00, 0x00,
+}
+
+func (m *HostZoneSunset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostZoneSunset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostZoneSunset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RedeemableAmount.Size()
+		i -= size
+		if _, err := m.RedeemableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZoneSunset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.UnbondingEpochNumber != 0 {
+		i = encodeVarintHostZoneSunset(dAtA, i, uint64(m.UnbondingEpochNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.FinalRedemptionRate.Size()
+		i -= size
+		if _, err := m.FinalRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZoneSunset(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.RedemptionDeadline != 0 {
+		i = encodeVarintHostZoneSunset(dAtA, i, uint64(m.RedemptionDeadline))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintHostZoneSunset(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintHostZoneSunset(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHostZoneSunset(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHostZoneSunset(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HostZoneSunset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovHostZoneSunset(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovHostZoneSunset(uint64(m.Status))
+	}
+	if m.RedemptionDeadline != 0 {
+		n += 1 + sovHostZoneSunset(uint64(m.RedemptionDeadline))
+	}
+	l = m.FinalRedemptionRate.Size()
+	n += 1 + l + sovHostZoneSunset(uint64(l))
+	if m.UnbondingEpochNumber != 0 {
+		n += 1 + sovHostZoneSunset(uint64(m.UnbondingEpochNumber))
+	}
+	l = m.RedeemableAmount.Size()
+	n += 1 + l + sovHostZoneSunset(uint64(l))
+	return n
+}
+
+func sovHostZoneSunset(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHostZoneSunset(x uint64) (n int) {
+	return sovHostZoneSunset(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HostZoneSunset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZoneSunset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostZoneSunset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostZoneSunset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZoneSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZoneSunset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZoneSunset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZoneSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= HostZoneSunsetStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionDeadline", wireType)
+			}
+			m.RedemptionDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZoneSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalRedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZoneSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZoneSunset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZoneSunset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEpochNumber", wireType)
+			}
+			m.UnbondingEpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZoneSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZoneSunset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZoneSunset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZoneSunset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedeemableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZoneSunset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZoneSunset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHostZoneSunset(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHostZoneSunset
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHostZoneSunset
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHostZoneSunset
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHostZoneSunset
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHostZoneSunset
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHostZoneSunset
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHostZoneSunset        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHostZoneSunset          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHostZoneSunset = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "stakeibc"
@@ -47,10 +51,54 @@ func EpochTrackerKey(epochIdentifier string) []byte {
 	return key
 }
 
+// RedemptionRateRecordKeyPrefixByChainId returns the prefix to retrieve all RedemptionRateRecords for a host zone
+func RedemptionRateRecordKeyPrefixByChainId(chainId string) []byte {
+	var key []byte
+	key = append(key, KeyPrefix(RedemptionRateRecordKeyPrefix)...)
+	key = append(key, []byte(chainId)...)
+	key = append(key, []byte("/")...)
+	return key
+}
+
+// RedemptionRateRecordKey returns the store key to retrieve a RedemptionRateRecord from the index fields,
+// relative to the host zone's prefix (so that records are ordered by epoch)
+func RedemptionRateRecordKey(epochNumber uint64) []byte {
+	return sdk.Uint64ToBigEndian(epochNumber)
+}
+
+// RedelegationTrackerKeyPrefixByChainId returns the prefix to retrieve all RedelegationTrackers for a host zone
+func RedelegationTrackerKeyPrefixByChainId(chainId string) []byte {
+	var key []byte
+	key = append(key, KeyPrefix(RedelegationTrackerKeyPrefix)...)
+	key = append(key, []byte(chainId)...)
+	key = append(key, []byte("/")...)
+	return key
+}
+
+// RedelegationTrackerKey returns the store key to retrieve a RedelegationTracker from its validator pair,
+// relative to the host zone's prefix
+func RedelegationTrackerKey(srcValidator, dstValidator string) []byte {
+	var key []byte
+	key = append(key, []byte(srcValidator)...)
+	key = append(key, []byte("/")...)
+	key = append(key, []byte(dstValidator)...)
+	key = append(key, []byte("/")...)
+	return key
+}
+
 const (
 	// Host zone keys prefix the HostZone structs
 	HostZoneKey = "HostZone-value-"
 
 	// EpochTrackerKeyPrefix is the prefix to retrieve all EpochTracker
 	EpochTrackerKeyPrefix = "EpochTracker/value/"
+
+	// RedemptionRateRecordKeyPrefix is the prefix to retrieve all RedemptionRateRecords
+	RedemptionRateRecordKeyPrefix = "RedemptionRateRecord/value/"
+
+	// RedelegationTrackerKeyPrefix is the prefix to retrieve all RedelegationTrackers
+	RedelegationTrackerKeyPrefix = "RedelegationTracker/value/"
+
+	// HostZoneSunsetKeyPrefix is the prefix to retrieve all HostZoneSunsets
+	HostZoneSunsetKeyPrefix = "HostZoneSunset/value/"
 )
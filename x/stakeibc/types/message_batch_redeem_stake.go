@@ -0,0 +1,53 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgBatchRedeemStake = "batch_redeem_stake"
+
+var _ sdk.Msg = &MsgBatchRedeemStake{}
+
+func NewMsgBatchRedeemStake(creator string, redemptions []BatchRedemption) *MsgBatchRedeemStake {
+	return &MsgBatchRedeemStake{
+		Creator:     creator,
+		Redemptions: redemptions,
+	}
+}
+
+func (msg *MsgBatchRedeemStake) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBatchRedeemStake) Type() string {
+	return TypeMsgBatchRedeemStake
+}
+
+func (msg *MsgBatchRedeemStake) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBatchRedeemStake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBatchRedeemStake) ValidateBasic() error {
+	if len(msg.Redemptions) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "at least one redemption must be provided")
+	}
+	// each redemption must pass the same validation as an individual redeem
+	for i, redemption := range msg.Redemptions {
+		redeemMsg := NewMsgRedeemStake(msg.Creator, redemption.Amount, redemption.HostZone, redemption.Receiver)
+		if err := redeemMsg.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid redemption (index %d)", i)
+		}
+	}
+	return nil
+}
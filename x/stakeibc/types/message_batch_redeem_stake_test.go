@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/stride/v10/testutil/sample"
+)
+
+func TestMsgBatchRedeemStake_ValidateBasic(t *testing.T) {
+	validRedemption := BatchRedemption{
+		HostZone: "GAIA",
+		Receiver: sample.AccAddress(),
+		Amount:   sdkmath.NewInt(1),
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgBatchRedeemStake
+		err  error
+	}{
+		{
+			name: "success",
+			msg: MsgBatchRedeemStake{
+				Creator: sample.AccAddress(),
+				Redemptions: []BatchRedemption{
+					validRedemption,
+					{HostZone: "OSMO", Receiver: sample.AccAddress(), Amount: sdkmath.NewInt(2)},
+				},
+			},
+		},
+		{
+			name: "invalid creator",
+			msg: MsgBatchRedeemStake{
+				Creator:     "invalid_address",
+				Redemptions: []BatchRedemption{validRedemption},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no redemptions",
+			msg: MsgBatchRedeemStake{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "no host zone",
+			msg: MsgBatchRedeemStake{
+				Creator: sample.AccAddress(),
+				Redemptions: []BatchRedemption{
+					validRedemption,
+					{Receiver: sample.AccAddress(), Amount: sdkmath.NewInt(1)},
+				},
+			},
+			err: ErrRequiredFieldEmpty,
+		},
+		{
+			name: "no receiver",
+			msg: MsgBatchRedeemStake{
+				Creator:     sample.AccAddress(),
+				Redemptions: []BatchRedemption{{HostZone: "GAIA", Amount: sdkmath.NewInt(1)}},
+			},
+			err: ErrRequiredFieldEmpty,
+		},
+		{
+			name: "zero amount",
+			msg: MsgBatchRedeemStake{
+				Creator:     sample.AccAddress(),
+				Redemptions: []BatchRedemption{{HostZone: "GAIA", Receiver: sample.AccAddress(), Amount: sdkmath.ZeroInt()}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -10,6 +10,12 @@ import (
 	"github.com/Stride-Labs/stride/v10/testutil/sample"
 )
 
+// Returns a pointer to an Int, for the optional Int fields
+func newInt(amount int64) *sdkmath.Int {
+	i := sdkmath.NewInt(amount)
+	return &i
+}
+
 func TestMsgLiquidStake_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name string
@@ -60,6 +66,25 @@ func TestMsgLiquidStake_ValidateBasic(t *testing.T) {
 			},
 			err: ErrRequiredFieldEmpty,
 		},
+		{
+			name: "valid min st token out",
+			msg: MsgLiquidStake{
+				Creator:       sample.AccAddress(),
+				Amount:        sdkmath.NewInt(1),
+				HostDenom:     "uatom",
+				MinStTokenOut: newInt(1),
+			},
+		},
+		{
+			name: "negative min st token out",
+			msg: MsgLiquidStake{
+				Creator:       sample.AccAddress(),
+				Amount:        sdkmath.NewInt(1),
+				HostDenom:     "uatom",
+				MinStTokenOut: newInt(-1),
+			},
+			err: ErrInvalidAmount,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -61,5 +61,9 @@ func (msg *MsgRedeemStake) ValidateBasic() error {
 	if msg.HostZone == "" {
 		return errorsmod.Wrapf(ErrRequiredFieldEmpty, "host zone cannot be empty")
 	}
+	// the minimum native amount is optional, but cannot be negative
+	if msg.MinNativeOut != nil && (msg.MinNativeOut.IsNil() || msg.MinNativeOut.IsNegative()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid min native out (%v)", msg.MinNativeOut)
+	}
 	return nil
 }
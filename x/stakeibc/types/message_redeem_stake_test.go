@@ -54,6 +54,27 @@ func TestMsgRedeemStake_ValidateBasic(t *testing.T) {
 			},
 			err: ErrRequiredFieldEmpty,
 		},
+		{
+			name: "success with min native out",
+			msg: MsgRedeemStake{
+				Creator:      sample.AccAddress(),
+				HostZone:     "GAIA",
+				Receiver:     sample.AccAddress(),
+				Amount:       sdkmath.NewInt(1),
+				MinNativeOut: newInt(1),
+			},
+		},
+		{
+			name: "negative min native out",
+			msg: MsgRedeemStake{
+				Creator:      sample.AccAddress(),
+				HostZone:     "GAIA",
+				Receiver:     sample.AccAddress(),
+				Amount:       sdkmath.NewInt(1),
+				MinNativeOut: newInt(-1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v10/app/apptesting"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func TestMsgResumeHostZone_ValidateBasic(t *testing.T) {
+	validNotAdminAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	validAdminAddress, ok := apptesting.GetAdminAddress()
+	require.True(t, ok)
+
+	tests := []struct {
+		name string
+		msg  types.MsgResumeHostZone
+		err  error
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgResumeHostZone{
+				Creator: validAdminAddress,
+				ChainId: "GAIA",
+			},
+		},
+		{
+			name: "missing chain id",
+			msg: types.MsgResumeHostZone{
+				Creator: validAdminAddress,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgResumeHostZone{
+				Creator: invalidAddress,
+				ChainId: "GAIA",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid admin address",
+			msg: types.MsgResumeHostZone{
+				Creator: validNotAdminAddress,
+				ChainId: "GAIA",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
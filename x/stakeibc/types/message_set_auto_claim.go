@@ -0,0 +1,56 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v10/utils"
+)
+
+const TypeMsgSetAutoClaim = "set_auto_claim"
+
+var _ sdk.Msg = &MsgSetAutoClaim{}
+
+func NewMsgSetAutoClaim(creator string, chainId string, enabled bool) *MsgSetAutoClaim {
+	return &MsgSetAutoClaim{
+		Creator: creator,
+		ChainId: chainId,
+		Enabled: enabled,
+	}
+}
+
+func (msg *MsgSetAutoClaim) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetAutoClaim) Type() string {
+	return TypeMsgSetAutoClaim
+}
+
+func (msg *MsgSetAutoClaim) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetAutoClaim) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetAutoClaim) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if len(msg.ChainId) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chain id is required")
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v10/app/apptesting"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func TestMsgSetAutoClaim_ValidateBasic(t *testing.T) {
+	validNotAdminAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	validAdminAddress, ok := apptesting.GetAdminAddress()
+	require.True(t, ok)
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetAutoClaim
+		err  error
+	}{
+		{
+			name: "successful message enabling auto claim",
+			msg: types.MsgSetAutoClaim{
+				Creator: validAdminAddress,
+				ChainId: "GAIA",
+				Enabled: true,
+			},
+		},
+		{
+			name: "successful message disabling auto claim",
+			msg: types.MsgSetAutoClaim{
+				Creator: validAdminAddress,
+				ChainId: "GAIA",
+				Enabled: false,
+			},
+		},
+		{
+			name: "missing chain id",
+			msg: types.MsgSetAutoClaim{
+				Creator: validAdminAddress,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgSetAutoClaim{
+				Creator: invalidAddress,
+				ChainId: "GAIA",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid admin address",
+			msg: types.MsgSetAutoClaim{
+				Creator: validNotAdminAddress,
+				ChainId: "GAIA",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
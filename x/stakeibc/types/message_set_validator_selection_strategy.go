@@ -0,0 +1,59 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v10/utils"
+)
+
+const TypeMsgSetValidatorSelectionStrategy = "set_validator_selection_strategy"
+
+var _ sdk.Msg = &MsgSetValidatorSelectionStrategy{}
+
+func NewMsgSetValidatorSelectionStrategy(creator string, chainId string, strategy ValidatorSelectionStrategy) *MsgSetValidatorSelectionStrategy {
+	return &MsgSetValidatorSelectionStrategy{
+		Creator:  creator,
+		ChainId:  chainId,
+		Strategy: strategy,
+	}
+}
+
+func (msg *MsgSetValidatorSelectionStrategy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetValidatorSelectionStrategy) Type() string {
+	return TypeMsgSetValidatorSelectionStrategy
+}
+
+func (msg *MsgSetValidatorSelectionStrategy) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetValidatorSelectionStrategy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetValidatorSelectionStrategy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if len(msg.ChainId) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chain id is required")
+	}
+	if _, ok := ValidatorSelectionStrategy_name[int32(msg.Strategy)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid validator selection strategy: %d", msg.Strategy)
+	}
+	return nil
+}
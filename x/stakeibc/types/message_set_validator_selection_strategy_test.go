@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v10/app/apptesting"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func TestMsgSetValidatorSelectionStrategy_ValidateBasic(t *testing.T) {
+	validNotAdminAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	validAdminAddress, ok := apptesting.GetAdminAddress()
+	require.True(t, ok)
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetValidatorSelectionStrategy
+		err  error
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgSetValidatorSelectionStrategy{
+				Creator:  validAdminAddress,
+				ChainId:  "GAIA",
+				Strategy: types.ValidatorSelectionStrategy_SCORED,
+			},
+		},
+		{
+			name: "missing chain id",
+			msg: types.MsgSetValidatorSelectionStrategy{
+				Creator:  validAdminAddress,
+				Strategy: types.ValidatorSelectionStrategy_SCORED,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid strategy",
+			msg: types.MsgSetValidatorSelectionStrategy{
+				Creator:  validAdminAddress,
+				ChainId:  "GAIA",
+				Strategy: types.ValidatorSelectionStrategy(10),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgSetValidatorSelectionStrategy{
+				Creator:  invalidAddress,
+				ChainId:  "GAIA",
+				Strategy: types.ValidatorSelectionStrategy_SCORED,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid admin address",
+			msg: types.MsgSetValidatorSelectionStrategy{
+				Creator:  validNotAdminAddress,
+				ChainId:  "GAIA",
+				Strategy: types.ValidatorSelectionStrategy_SCORED,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSunsetHostZone = "sunset_host_zone"
+
+var _ sdk.Msg = &MsgSunsetHostZone{}
+
+func NewMsgSunsetHostZone(authority string, chainId string, redemptionDeadline uint64) *MsgSunsetHostZone {
+	return &MsgSunsetHostZone{
+		Authority:          authority,
+		ChainId:            chainId,
+		RedemptionDeadline: redemptionDeadline,
+	}
+}
+
+func (msg *MsgSunsetHostZone) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSunsetHostZone) Type() string {
+	return TypeMsgSunsetHostZone
+}
+
+func (msg *MsgSunsetHostZone) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSunsetHostZone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSunsetHostZone) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if len(msg.ChainId) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chain id is required")
+	}
+	if msg.RedemptionDeadline == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "redemption deadline is required")
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v10/app/apptesting"
+	"github.com/Stride-Labs/stride/v10/x/stakeibc/types"
+)
+
+func TestMsgSunsetHostZone_ValidateBasic(t *testing.T) {
+	validAuthority, invalidAddress := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name string
+		msg  types.MsgSunsetHostZone
+		err  error
+	}{
+		{
+			name: "successful message",
+			msg: types.MsgSunsetHostZone{
+				Authority:          validAuthority,
+				ChainId:            "GAIA",
+				RedemptionDeadline: 1_700_000_000,
+			},
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgSunsetHostZone{
+				Authority:          invalidAddress,
+				ChainId:            "GAIA",
+				RedemptionDeadline: 1_700_000_000,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "missing chain id",
+			msg: types.MsgSunsetHostZone{
+				Authority:          validAuthority,
+				RedemptionDeadline: 1_700_000_000,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "missing redemption deadline",
+			msg: types.MsgSunsetHostZone{
+				Authority: validAuthority,
+				ChainId:   "GAIA",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
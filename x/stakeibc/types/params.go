@@ -16,17 +16,26 @@ var (
 	DefaultRewardsInterval        uint64 = 1
 	DefaultRedemptionRateInterval uint64 = 1
 	// you apparently cannot safely encode floats, so we make commission / 100
-	DefaultStrideCommission           uint64 = 10
-	DefaultICATimeoutNanos            uint64 = 600000000000
-	DefaultBufferSize                 uint64 = 5             // 1/5=20% of the epoch
-	DefaultIbcTimeoutBlocks           uint64 = 300           // 300 blocks ~= 30 minutes
-	DefaultFeeTransferTimeoutNanos    uint64 = 1800000000000 // 30 minutes
-	DefaultMinRedemptionRateThreshold uint64 = 90            // divide by 100, so 90 = 0.9
-	DefaultMaxRedemptionRateThreshold uint64 = 150           // divide by 100, so 150 = 1.5
-	DefaultMaxStakeICACallsPerEpoch   uint64 = 100
-	DefaultIBCTransferTimeoutNanos    uint64 = 1800000000000 // 30 minutes
-	DefaultSafetyNumValidators        uint64 = 35
-	DefaultSafetyMaxSlashPercent      uint64 = 10
+	DefaultStrideCommission                 uint64 = 10
+	DefaultICATimeoutNanos                  uint64 = 600000000000
+	DefaultBufferSize                       uint64 = 5             // 1/5=20% of the epoch
+	DefaultIbcTimeoutBlocks                 uint64 = 300           // 300 blocks ~= 30 minutes
+	DefaultFeeTransferTimeoutNanos          uint64 = 1800000000000 // 30 minutes
+	DefaultMinRedemptionRateThreshold       uint64 = 90            // divide by 100, so 90 = 0.9
+	DefaultMaxRedemptionRateThreshold       uint64 = 150           // divide by 100, so 150 = 1.5
+	DefaultMaxStakeICACallsPerEpoch         uint64 = 100
+	DefaultIBCTransferTimeoutNanos          uint64 = 1800000000000 // 30 minutes
+	DefaultSafetyNumValidators              uint64 = 35
+	DefaultSafetyMaxSlashPercent            uint64 = 10
+	DefaultRedemptionRateHistoryRetention   uint64 = 1460 // ~1 year of stride epochs
+	DefaultMaxAutoClaimsPerEpoch            uint64 = 100
+	DefaultValidatorScoreSignedBlocksWindow uint64 = 10000
+	DefaultValidatorScoreVotingPowerCap     uint64 = 10
+	DefaultMaxValidatorWeightPercent        uint64 = 10
+	DefaultAutoRebalanceThresholdPercent    uint64 = 0 // disabled until enabled by governance
+	DefaultMaxAutoRebalanceRedelegations    uint64 = 10
+	DefaultMaxRedelegationEntries           uint64 = 7
+	DefaultMaxUnbondingEntries              uint64 = 7
 
 	// KeyDepositInterval is store's key for the DepositInterval option
 	KeyDepositInterval                   = []byte("DepositInterval")
@@ -47,6 +56,15 @@ var (
 	KeySafetyMaxSlashPercent             = []byte("SafetyMaxSlashPercent")
 	KeyMaxRedemptionRates                = []byte("MaxRedemptionRates")
 	KeyMinRedemptionRates                = []byte("MinRedemptionRates")
+	KeyRedemptionRateHistoryRetention    = []byte("RedemptionRateHistoryRetention")
+	KeyMaxAutoClaimsPerEpoch             = []byte("MaxAutoClaimsPerEpoch")
+	KeyValidatorScoreSignedBlocksWindow  = []byte("ValidatorScoreSignedBlocksWindow")
+	KeyValidatorScoreVotingPowerCap      = []byte("ValidatorScoreVotingPowerCap")
+	KeyMaxValidatorWeightPercent         = []byte("MaxValidatorWeightPercent")
+	KeyAutoRebalanceThresholdPercent     = []byte("AutoRebalanceThresholdPercent")
+	KeyMaxAutoRebalanceRedelegations     = []byte("MaxAutoRebalanceRedelegations")
+	KeyMaxRedelegationEntries            = []byte("MaxRedelegationEntries")
+	KeyMaxUnbondingEntries               = []byte("MaxUnbondingEntries")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -74,6 +92,15 @@ func NewParams(
 	ibcTransferTimeoutNanos uint64,
 	safetyNumValidators uint64,
 	safetyMaxSlashPercent uint64,
+	redemptionRateHistoryRetention uint64,
+	maxAutoClaimsPerEpoch uint64,
+	validatorScoreSignedBlocksWindow uint64,
+	validatorScoreVotingPowerCap uint64,
+	maxValidatorWeightPercent uint64,
+	autoRebalanceThresholdPercent uint64,
+	maxAutoRebalanceRedelegations uint64,
+	maxRedelegationEntries uint64,
+	maxUnbondingEntries uint64,
 ) Params {
 	return Params{
 		DepositInterval:                   depositInterval,
@@ -92,6 +119,15 @@ func NewParams(
 		IbcTransferTimeoutNanos:           ibcTransferTimeoutNanos,
 		SafetyNumValidators:               safetyNumValidators,
 		SafetyMaxSlashPercent:             safetyMaxSlashPercent,
+		RedemptionRateHistoryRetention:    redemptionRateHistoryRetention,
+		MaxAutoClaimsPerEpoch:             maxAutoClaimsPerEpoch,
+		ValidatorScoreSignedBlocksWindow:  validatorScoreSignedBlocksWindow,
+		ValidatorScoreVotingPowerCap:      validatorScoreVotingPowerCap,
+		MaxValidatorWeightPercent:         maxValidatorWeightPercent,
+		AutoRebalanceThresholdPercent:     autoRebalanceThresholdPercent,
+		MaxAutoRebalanceRedelegations:     maxAutoRebalanceRedelegations,
+		MaxRedelegationEntries:            maxRedelegationEntries,
+		MaxUnbondingEntries:               maxUnbondingEntries,
 	}
 }
 
@@ -114,6 +150,15 @@ func DefaultParams() Params {
 		DefaultIBCTransferTimeoutNanos,
 		DefaultSafetyNumValidators,
 		DefaultSafetyMaxSlashPercent,
+		DefaultRedemptionRateHistoryRetention,
+		DefaultMaxAutoClaimsPerEpoch,
+		DefaultValidatorScoreSignedBlocksWindow,
+		DefaultValidatorScoreVotingPowerCap,
+		DefaultMaxValidatorWeightPercent,
+		DefaultAutoRebalanceThresholdPercent,
+		DefaultMaxAutoRebalanceRedelegations,
+		DefaultMaxRedelegationEntries,
+		DefaultMaxUnbondingEntries,
 	)
 }
 
@@ -136,6 +181,15 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyIBCTransferTimeoutNanos, &p.IbcTransferTimeoutNanos, validTimeoutNanos),
 		paramtypes.NewParamSetPair(KeySafetyNumValidators, &p.SafetyNumValidators, isPositive),
 		paramtypes.NewParamSetPair(KeySafetyMaxSlashPercent, &p.SafetyMaxSlashPercent, validSlashPercent),
+		paramtypes.NewParamSetPair(KeyRedemptionRateHistoryRetention, &p.RedemptionRateHistoryRetention, validHistoryRetention),
+		paramtypes.NewParamSetPair(KeyMaxAutoClaimsPerEpoch, &p.MaxAutoClaimsPerEpoch, isPositive),
+		paramtypes.NewParamSetPair(KeyValidatorScoreSignedBlocksWindow, &p.ValidatorScoreSignedBlocksWindow, isPositive),
+		paramtypes.NewParamSetPair(KeyValidatorScoreVotingPowerCap, &p.ValidatorScoreVotingPowerCap, validWeightPercent),
+		paramtypes.NewParamSetPair(KeyMaxValidatorWeightPercent, &p.MaxValidatorWeightPercent, validWeightPercent),
+		paramtypes.NewParamSetPair(KeyAutoRebalanceThresholdPercent, &p.AutoRebalanceThresholdPercent, validRebalanceThreshold),
+		paramtypes.NewParamSetPair(KeyMaxAutoRebalanceRedelegations, &p.MaxAutoRebalanceRedelegations, isPositive),
+		paramtypes.NewParamSetPair(KeyMaxRedelegationEntries, &p.MaxRedelegationEntries, isPositive),
+		paramtypes.NewParamSetPair(KeyMaxUnbondingEntries, &p.MaxUnbondingEntries, isPositive),
 	}
 }
 
@@ -199,6 +253,35 @@ func validSlashPercent(i interface{}) error {
 	return nil
 }
 
+// Percentages used when weighting validators must be non-zero, otherwise no validator could receive weight
+func validWeightPercent(i interface{}) error {
+	ival, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("parameter not accepted: %T", i)
+	}
+	if ival == 0 || ival > 100 {
+		return fmt.Errorf("parameter must be between 1 and 100: %d", ival)
+	}
+
+	return nil
+}
+
+// The rebalance threshold can be any value, since 0 indicates that automatic rebalancing is disabled
+func validRebalanceThreshold(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("parameter not accepted: %T", i)
+	}
+	return nil
+}
+
+// The history retention can be any value, since 0 indicates that the full history should be kept
+func validHistoryRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("parameter not accepted: %T", i)
+	}
+	return nil
+}
+
 func isPositive(i interface{}) error {
 	ival, ok := i.(uint64)
 	if !ok {
@@ -273,6 +356,33 @@ func (p Params) Validate() error {
 	if err := validSlashPercent(p.SafetyMaxSlashPercent); err != nil {
 		return err
 	}
+	if err := validHistoryRetention(p.RedemptionRateHistoryRetention); err != nil {
+		return err
+	}
+	if err := isPositive(p.MaxAutoClaimsPerEpoch); err != nil {
+		return err
+	}
+	if err := isPositive(p.ValidatorScoreSignedBlocksWindow); err != nil {
+		return err
+	}
+	if err := validWeightPercent(p.ValidatorScoreVotingPowerCap); err != nil {
+		return err
+	}
+	if err := validWeightPercent(p.MaxValidatorWeightPercent); err != nil {
+		return err
+	}
+	if err := validRebalanceThreshold(p.AutoRebalanceThresholdPercent); err != nil {
+		return err
+	}
+	if err := isPositive(p.MaxAutoRebalanceRedelegations); err != nil {
+		return err
+	}
+	if err := isPositive(p.MaxRedelegationEntries); err != nil {
+		return err
+	}
+	if err := isPositive(p.MaxUnbondingEntries); err != nil {
+		return err
+	}
 
 	return nil
 }
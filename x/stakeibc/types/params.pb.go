@@ -24,7 +24,7 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
-// next id: 18
+// next id: 28
 type Params struct {
 	// define epoch lengths, in stride_epochs
 	RewardsInterval                   uint64 `protobuf:"varint,1,opt,name=rewards_interval,json=rewardsInterval,proto3" json:"rewards_interval,omitempty"`
@@ -43,6 +43,36 @@ type Params struct {
 	IbcTransferTimeoutNanos           uint64 `protobuf:"varint,16,opt,name=ibc_transfer_timeout_nanos,json=ibcTransferTimeoutNanos,proto3" json:"ibc_transfer_timeout_nanos,omitempty"`
 	SafetyNumValidators               uint64 `protobuf:"varint,17,opt,name=safety_num_validators,json=safetyNumValidators,proto3" json:"safety_num_validators,omitempty"`
 	SafetyMaxSlashPercent             uint64 `protobuf:"varint,18,opt,name=safety_max_slash_percent,json=safetyMaxSlashPercent,proto3" json:"safety_max_slash_percent,omitempty"`
+	// number of stride epochs of redemption rate history to retain
+	// (0 retains the full history)
+	RedemptionRateHistoryRetention uint64 `protobuf:"varint,19,opt,name=redemption_rate_history_retention,json=redemptionRateHistoryRetention,proto3" json:"redemption_rate_history_retention,omitempty"`
+	// max number of user redemption records that are automatically claimed
+	// per host zone each day epoch
+	MaxAutoClaimsPerEpoch uint64 `protobuf:"varint,20,opt,name=max_auto_claims_per_epoch,json=maxAutoClaimsPerEpoch,proto3" json:"max_auto_claims_per_epoch,omitempty"`
+	// number of host blocks over which a validator's uptime is measured when
+	// scoring validators (this is not queried from the host's slashing params,
+	// so it should be kept in line with the host's signed blocks window)
+	ValidatorScoreSignedBlocksWindow uint64 `protobuf:"varint,21,opt,name=validator_score_signed_blocks_window,json=validatorScoreSignedBlocksWindow,proto3" json:"validator_score_signed_blocks_window,omitempty"`
+	// validators with a share of the stake across the host zone's registered
+	// validators above this percent are penalized proportionally when scoring
+	// validators
+	ValidatorScoreVotingPowerCap uint64 `protobuf:"varint,22,opt,name=validator_score_voting_power_cap,json=validatorScoreVotingPowerCap,proto3" json:"validator_score_voting_power_cap,omitempty"`
+	// max percent of a host zone's total weight assigned to a single validator
+	// by the EQUAL and SCORED selection strategies
+	MaxValidatorWeightPercent uint64 `protobuf:"varint,23,opt,name=max_validator_weight_percent,json=maxValidatorWeightPercent,proto3" json:"max_validator_weight_percent,omitempty"`
+	// a host zone is automatically rebalanced each day epoch if any validator's
+	// delegation deviates from its target by more than this percent of the
+	// target (0 disables automatic rebalancing)
+	AutoRebalanceThresholdPercent uint64 `protobuf:"varint,24,opt,name=auto_rebalance_threshold_percent,json=autoRebalanceThresholdPercent,proto3" json:"auto_rebalance_threshold_percent,omitempty"`
+	// max number of redelegations submitted per host zone by each automatic
+	// rebalance
+	MaxAutoRebalanceRedelegations uint64 `protobuf:"varint,25,opt,name=max_auto_rebalance_redelegations,json=maxAutoRebalanceRedelegations,proto3" json:"max_auto_rebalance_redelegations,omitempty"`
+	// max number of in-flight redelegation entries between a pair of validators
+	// (the host's staking MaxEntries param)
+	MaxRedelegationEntries uint64 `protobuf:"varint,26,opt,name=max_redelegation_entries,json=maxRedelegationEntries,proto3" json:"max_redelegation_entries,omitempty"`
+	// max number of in-flight unbonding entries between the delegation account
+	// and a validator (the host's staking MaxEntries param)
+	MaxUnbondingEntries uint64 `protobuf:"varint,27,opt,name=max_unbonding_entries,json=maxUnbondingEntries,proto3" json:"max_unbonding_entries,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -189,6 +219,69 @@ func (m *Params) GetSafetyMaxSlashPercent() uint64 {
 	return 0
 }
 
+func (m *Params) GetRedemptionRateHistoryRetention() uint64 {
+	if m != nil {
+		return m.RedemptionRateHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoClaimsPerEpoch() uint64 {
+	if m != nil {
+		return m.MaxAutoClaimsPerEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetValidatorScoreSignedBlocksWindow() uint64 {
+	if m != nil {
+		return m.ValidatorScoreSignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *Params) GetValidatorScoreVotingPowerCap() uint64 {
+	if m != nil {
+		return m.ValidatorScoreVotingPowerCap
+	}
+	return 0
+}
+
+func (m *Params) GetMaxValidatorWeightPercent() uint64 {
+	if m != nil {
+		return m.MaxValidatorWeightPercent
+	}
+	return 0
+}
+
+func (m *Params) GetAutoRebalanceThresholdPercent() uint64 {
+	if m != nil {
+		return m.AutoRebalanceThresholdPercent
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoRebalanceRedelegations() uint64 {
+	if m != nil {
+		return m.MaxAutoRebalanceRedelegations
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRedelegationEntries() uint64 {
+	if m != nil {
+		return m.MaxRedelegationEntries
+	}
+	return 0
+}
+
+func (m *Params) GetMaxUnbondingEntries() uint64 {
+	if m != nil {
+		return m.MaxUnbondingEntries
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "stride.stakeibc.Params")
 }
@@ -196,43 +289,58 @@ func init() {
 func init() { proto.RegisterFile("stride/stakeibc/params.proto", fileDescriptor_5aeaab6a38c2b438) }
 
 var fileDescriptor_5aeaab6a38c2b438 = []byte{
-	// 567 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x5b, 0xa8, 0xca, 0xe6, 0x01, 0x6b, 0xc3, 0x3f, 0x6b, 0x82, 0x0c, 0x90, 0x90, 0x18,
-	0x83, 0x06, 0xc6, 0x01, 0xc4, 0x0e, 0x48, 0x9b, 0x38, 0x0c, 0x6d, 0x53, 0xd5, 0x56, 0x1c, 0xb8,
-	0x58, 0x8e, 0xf3, 0xa6, 0xb5, 0x96, 0xc4, 0x91, 0xed, 0x94, 0x6c, 0x9f, 0x82, 0x23, 0x47, 0x3e,
-	0x0e, 0xc7, 0x1d, 0x39, 0xa2, 0xf6, 0x1b, 0xf0, 0x09, 0x50, 0xec, 0x34, 0x5d, 0xd0, 0xe0, 0x56,
-	0x3d, 0xcf, 0xef, 0x7d, 0xf2, 0xfa, 0x71, 0x8d, 0xee, 0x2b, 0x2d, 0x79, 0x00, 0x9e, 0xd2, 0xf4,
-	0x04, 0xb8, 0xcf, 0xbc, 0x94, 0x4a, 0x1a, 0xab, 0x5e, 0x2a, 0x85, 0x16, 0xce, 0xba, 0x75, 0x7b,
-	0x0b, 0x77, 0xe3, 0xf6, 0x58, 0x8c, 0x85, 0xf1, 0xbc, 0xe2, 0x97, 0xc5, 0x1e, 0xff, 0x6e, 0xa3,
-	0x76, 0xdf, 0xcc, 0x39, 0x5b, 0xa8, 0x23, 0xe1, 0x0b, 0x95, 0x81, 0x22, 0x3c, 0xd1, 0x20, 0xa7,
-	0x34, 0xc2, 0xcd, 0x87, 0xcd, 0xa7, 0xad, 0xc1, 0x7a, 0xa9, 0x1f, 0x94, 0xb2, 0xb3, 0x8d, 0xba,
-	0x01, 0x44, 0x30, 0xa6, 0x1a, 0x96, 0x6c, 0xdb, 0xb0, 0x9d, 0x85, 0x51, 0xc1, 0x5b, 0xa8, 0x13,
-	0x40, 0x2a, 0x14, 0xd7, 0x4b, 0xf6, 0x8a, 0xcd, 0x2d, 0xf5, 0x0a, 0x7d, 0x8b, 0xb0, 0x84, 0x00,
-	0xe2, 0x54, 0x73, 0x91, 0x10, 0x59, 0x8b, 0xbf, 0x6a, 0x46, 0xee, 0x2e, 0xfd, 0xc1, 0xc5, 0x8f,
-	0x6c, 0xa3, 0xae, 0x3d, 0x30, 0x61, 0x22, 0x8e, 0xb9, 0x52, 0x5c, 0x24, 0xb8, 0x65, 0x37, 0xb2,
-	0xc6, 0x7e, 0xa5, 0x17, 0xb0, 0x04, 0x9e, 0x4c, 0x41, 0x5d, 0x58, 0xe9, 0x9a, 0x85, 0x17, 0x46,
-	0x95, 0xfc, 0x0c, 0x75, 0x39, 0xa3, 0x44, 0xf3, 0x18, 0x44, 0xa6, 0x49, 0x42, 0x13, 0xa1, 0xf0,
-	0xaa, 0xdd, 0x9f, 0x33, 0x3a, 0xb2, 0xfa, 0x71, 0x21, 0x3b, 0x9b, 0x68, 0xcd, 0xcf, 0xc2, 0x10,
-	0x24, 0x51, 0xfc, 0x0c, 0x30, 0x32, 0x14, 0xb2, 0xd2, 0x90, 0x9f, 0x81, 0xf3, 0x1c, 0x39, 0xdc,
-	0x67, 0x55, 0x98, 0x1f, 0x09, 0x76, 0xa2, 0xf0, 0x9a, 0xfd, 0x34, 0xf7, 0x59, 0x99, 0xb6, 0x67,
-	0x74, 0x67, 0x17, 0x6d, 0x84, 0x00, 0x44, 0x4b, 0x9a, 0xa8, 0x22, 0xb4, 0xbe, 0xc3, 0x75, 0x33,
-	0x75, 0x2f, 0x04, 0x18, 0x95, 0x40, 0x6d, 0x97, 0xf7, 0xe8, 0x41, 0x4c, 0x73, 0x62, 0xee, 0x9f,
-	0x14, 0x27, 0x60, 0x34, 0x8a, 0x14, 0x49, 0x41, 0x12, 0x48, 0x05, 0x9b, 0xe0, 0x1b, 0x66, 0x1e,
-	0xc7, 0x34, 0x1f, 0x16, 0xcc, 0x01, 0xa3, 0xfb, 0x05, 0xd1, 0x07, 0xf9, 0xa1, 0xf0, 0x9d, 0x3e,
-	0x7a, 0x12, 0x40, 0x48, 0xb3, 0x48, 0x93, 0x98, 0x27, 0xe4, 0xef, 0x8b, 0xd1, 0x13, 0x09, 0x6a,
-	0x22, 0xa2, 0x00, 0xdf, 0x34, 0x41, 0x8f, 0x4a, 0xf8, 0x88, 0x27, 0x83, 0xda, 0x1d, 0x8d, 0x16,
-	0x60, 0x2d, 0x91, 0xe6, 0xff, 0x49, 0x5c, 0xaf, 0x27, 0xd2, 0xfc, 0x5f, 0x89, 0xbb, 0x68, 0xc3,
-	0xf4, 0x79, 0x79, 0x43, 0x1d, 0xdb, 0x50, 0xd1, 0xeb, 0x65, 0x0d, 0xed, 0xa0, 0x3b, 0x8a, 0x86,
-	0xa0, 0x4f, 0x49, 0x92, 0xc5, 0x64, 0x4a, 0x23, 0x1e, 0x50, 0x2d, 0xa4, 0xc2, 0x5d, 0x33, 0x77,
-	0xcb, 0x9a, 0xc7, 0x59, 0xfc, 0xa9, 0xb2, 0x9c, 0x37, 0x08, 0x97, 0x33, 0xa6, 0xdc, 0x88, 0xaa,
-	0x49, 0x51, 0x29, 0x83, 0x44, 0x63, 0xc7, 0x8c, 0x95, 0x99, 0x47, 0x34, 0x1f, 0x16, 0x6e, 0xdf,
-	0x9a, 0xef, 0x5a, 0xdf, 0xbe, 0x6f, 0x36, 0x3e, 0xb6, 0x56, 0x56, 0x3a, 0xab, 0x7b, 0x87, 0x3f,
-	0x66, 0x6e, 0xf3, 0x7c, 0xe6, 0x36, 0x7f, 0xcd, 0xdc, 0xe6, 0xd7, 0xb9, 0xdb, 0x38, 0x9f, 0xbb,
-	0x8d, 0x9f, 0x73, 0xb7, 0xf1, 0x79, 0x67, 0xcc, 0xf5, 0x24, 0xf3, 0x7b, 0x4c, 0xc4, 0xde, 0xd0,
-	0xfc, 0x6d, 0x5f, 0x1c, 0x52, 0x5f, 0x79, 0xe5, 0x53, 0x9f, 0xbe, 0x7a, 0xe9, 0xe5, 0xcb, 0x07,
-	0xaf, 0x4f, 0x53, 0x50, 0x7e, 0xdb, 0xbc, 0xe4, 0xd7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x57,
-	0x55, 0x2e, 0x32, 0x10, 0x04, 0x00, 0x00,
+	// 806 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0x4f, 0x6f, 0x23, 0x35,
+	0x18, 0xc6, 0x13, 0x88, 0xca, 0xae, 0x0b, 0x34, 0x99, 0xfd, 0xe7, 0x0d, 0xdd, 0x6c, 0x16, 0x81,
+	0xc4, 0xb2, 0xd0, 0xc0, 0x72, 0x60, 0xc5, 0x1e, 0x2a, 0x1a, 0x15, 0x28, 0x6a, 0xab, 0x28, 0x29,
+	0xad, 0xc4, 0xc5, 0xf2, 0x78, 0xde, 0x24, 0x56, 0x67, 0xec, 0x91, 0xed, 0x49, 0xa6, 0xfd, 0x14,
+	0x1c, 0x39, 0xf2, 0x71, 0x38, 0xf6, 0xc8, 0x11, 0xb5, 0x5f, 0x04, 0xd9, 0x9e, 0x99, 0x64, 0xaa,
+	0xb2, 0xb7, 0xd1, 0xfb, 0xfc, 0xde, 0x67, 0xec, 0xc7, 0xaf, 0x6c, 0xb4, 0xad, 0x8d, 0xe2, 0x11,
+	0x0c, 0xb4, 0xa1, 0xe7, 0xc0, 0x43, 0x36, 0x48, 0xa9, 0xa2, 0x89, 0xde, 0x49, 0x95, 0x34, 0x32,
+	0xd8, 0xf2, 0xea, 0x4e, 0xa9, 0x76, 0x1f, 0xce, 0xe4, 0x4c, 0x3a, 0x6d, 0x60, 0xbf, 0x3c, 0xf6,
+	0xe9, 0xd5, 0x26, 0xda, 0x18, 0xb9, 0xbe, 0xe0, 0x25, 0x6a, 0x2b, 0x58, 0x52, 0x15, 0x69, 0xc2,
+	0x85, 0x01, 0xb5, 0xa0, 0x31, 0x6e, 0xf6, 0x9b, 0x5f, 0xb4, 0xc6, 0x5b, 0x45, 0xfd, 0xa0, 0x28,
+	0x07, 0xaf, 0x50, 0x27, 0x82, 0x18, 0x66, 0xd4, 0xc0, 0x8a, 0xdd, 0x70, 0x6c, 0xbb, 0x14, 0x2a,
+	0xf8, 0x25, 0x6a, 0x47, 0x90, 0x4a, 0xcd, 0xcd, 0x8a, 0x7d, 0xcf, 0xfb, 0x16, 0xf5, 0x0a, 0x7d,
+	0x83, 0xb0, 0x82, 0x08, 0x92, 0xd4, 0x70, 0x29, 0x88, 0xaa, 0xd9, 0xbf, 0xef, 0x5a, 0x1e, 0xaf,
+	0xf4, 0xf1, 0xfa, 0x4f, 0x5e, 0xa1, 0x8e, 0xdf, 0x30, 0x61, 0x32, 0x49, 0xb8, 0xd6, 0x5c, 0x0a,
+	0xdc, 0xf2, 0x2b, 0xf2, 0xc2, 0xb0, 0xaa, 0x5b, 0x58, 0x01, 0x17, 0x0b, 0xd0, 0x6b, 0x4b, 0xfa,
+	0xc0, 0xc3, 0xa5, 0x50, 0x39, 0x7f, 0x89, 0x3a, 0x9c, 0x51, 0x62, 0x78, 0x02, 0x32, 0x33, 0x44,
+	0x50, 0x21, 0x35, 0xbe, 0xef, 0xd7, 0xcf, 0x19, 0x3d, 0xf1, 0xf5, 0x63, 0x5b, 0x0e, 0x9e, 0xa3,
+	0xcd, 0x30, 0x9b, 0x4e, 0x41, 0x11, 0xcd, 0x2f, 0x01, 0x23, 0x47, 0x21, 0x5f, 0x9a, 0xf0, 0x4b,
+	0x08, 0xbe, 0x42, 0x01, 0x0f, 0x59, 0x65, 0x16, 0xc6, 0x92, 0x9d, 0x6b, 0xbc, 0xe9, 0x7f, 0xcd,
+	0x43, 0x56, 0xb8, 0xed, 0xb9, 0x7a, 0xf0, 0x16, 0x75, 0xa7, 0x00, 0xc4, 0x28, 0x2a, 0xb4, 0x35,
+	0xad, 0xaf, 0xe1, 0x43, 0xd7, 0xf5, 0x64, 0x0a, 0x70, 0x52, 0x00, 0xb5, 0xb5, 0xec, 0xa2, 0x67,
+	0x09, 0xcd, 0x89, 0x3b, 0x7f, 0x62, 0x77, 0xc0, 0x68, 0x1c, 0x6b, 0x92, 0x82, 0x22, 0x90, 0x4a,
+	0x36, 0xc7, 0x1f, 0xb9, 0x7e, 0x9c, 0xd0, 0x7c, 0x62, 0x99, 0x03, 0x46, 0x87, 0x96, 0x18, 0x81,
+	0xda, 0xb7, 0x7a, 0x30, 0x42, 0x9f, 0x47, 0x30, 0xa5, 0x59, 0x6c, 0x48, 0xc2, 0x05, 0xb9, 0x7d,
+	0x30, 0x66, 0xae, 0x40, 0xcf, 0x65, 0x1c, 0xe1, 0x8f, 0x9d, 0xd1, 0x8b, 0x02, 0x3e, 0xe2, 0x62,
+	0x5c, 0x3b, 0xa3, 0x93, 0x12, 0xac, 0x39, 0xd2, 0xfc, 0x1d, 0x8e, 0x5b, 0x75, 0x47, 0x9a, 0xff,
+	0x9f, 0xe3, 0x5b, 0xd4, 0x75, 0x79, 0xde, 0x9d, 0x50, 0xdb, 0x27, 0x64, 0x73, 0xbd, 0x2b, 0xa1,
+	0xd7, 0xe8, 0x91, 0xa6, 0x53, 0x30, 0x17, 0x44, 0x64, 0x09, 0x59, 0xd0, 0x98, 0x47, 0xd4, 0x48,
+	0xa5, 0x71, 0xc7, 0xf5, 0x3d, 0xf0, 0xe2, 0x71, 0x96, 0x9c, 0x56, 0x52, 0xf0, 0x3d, 0xc2, 0x45,
+	0x8f, 0x0b, 0x37, 0xa6, 0x7a, 0x6e, 0x23, 0x65, 0x20, 0x0c, 0x0e, 0x5c, 0x5b, 0xe1, 0x79, 0x44,
+	0xf3, 0x89, 0x55, 0x47, 0x5e, 0x0c, 0x0e, 0xd0, 0x8b, 0xdb, 0xfb, 0x9d, 0x73, 0x6d, 0xa4, 0xba,
+	0x20, 0x0a, 0x0c, 0x08, 0x5b, 0xc6, 0x0f, 0x9c, 0x43, 0xaf, 0x3e, 0xe3, 0xbf, 0x78, 0x6c, 0x5c,
+	0x52, 0xc1, 0x1b, 0xf4, 0xd4, 0xfe, 0x9c, 0x66, 0x46, 0x12, 0x16, 0x53, 0x9e, 0xac, 0x9f, 0xea,
+	0x43, 0xbf, 0x88, 0x84, 0xe6, 0x3f, 0x66, 0x46, 0x0e, 0x9d, 0x5c, 0x1d, 0xe9, 0x31, 0xfa, 0xac,
+	0xda, 0x26, 0xd1, 0x4c, 0x2a, 0x20, 0x9a, 0xcf, 0x04, 0x44, 0xc5, 0x24, 0x92, 0x25, 0x17, 0x91,
+	0x5c, 0xe2, 0x47, 0xce, 0xa4, 0x5f, 0xb1, 0x13, 0x8b, 0x4e, 0x1c, 0xe9, 0x47, 0xf3, 0xcc, 0x71,
+	0xc1, 0x4f, 0xa8, 0x7f, 0xdb, 0x6f, 0x21, 0x0d, 0x17, 0x33, 0x92, 0xca, 0x25, 0x28, 0xc2, 0x68,
+	0x8a, 0x1f, 0x3b, 0xaf, 0xed, 0xba, 0xd7, 0xa9, 0xa3, 0x46, 0x16, 0x1a, 0xd2, 0x34, 0xd8, 0x45,
+	0xdb, 0x76, 0x47, 0x2b, 0xaf, 0x25, 0xf0, 0xd9, 0xdc, 0x54, 0xc9, 0x3e, 0x71, 0x1e, 0x76, 0xd7,
+	0xd5, 0x51, 0x9c, 0x39, 0xa2, 0x4c, 0xf7, 0x67, 0xd4, 0x77, 0x71, 0x28, 0x08, 0x69, 0x4c, 0x05,
+	0x5b, 0x1b, 0xa6, 0xca, 0x04, 0x3b, 0x93, 0x67, 0x96, 0x1b, 0x97, 0x58, 0x35, 0x49, 0x6b, 0x46,
+	0x55, 0xb6, 0x2b, 0x33, 0x05, 0xc5, 0xad, 0xc6, 0xa5, 0xd0, 0xf8, 0xa9, 0x37, 0x2a, 0x22, 0xae,
+	0xbc, 0xc6, 0xeb, 0x90, 0xbd, 0xca, 0xca, 0x19, 0x2f, 0x8b, 0x04, 0x84, 0x51, 0x1c, 0x34, 0xee,
+	0xfa, 0xab, 0x2c, 0xf1, 0x73, 0x5d, 0xca, 0xfb, 0x5e, 0xb5, 0x63, 0x69, 0x3b, 0x33, 0x11, 0x4a,
+	0x11, 0xd9, 0x2c, 0xcb, 0xb6, 0x4f, 0xfc, 0x58, 0x26, 0x34, 0xff, 0xad, 0xd4, 0x8a, 0x9e, 0x1f,
+	0x5a, 0x7f, 0xfe, 0xf5, 0xbc, 0xf1, 0x6b, 0xeb, 0xde, 0xbd, 0xf6, 0xfd, 0xbd, 0xc3, 0xbf, 0xaf,
+	0x7b, 0xcd, 0xab, 0xeb, 0x5e, 0xf3, 0xdf, 0xeb, 0x5e, 0xf3, 0x8f, 0x9b, 0x5e, 0xe3, 0xea, 0xa6,
+	0xd7, 0xf8, 0xe7, 0xa6, 0xd7, 0xf8, 0xfd, 0xf5, 0x8c, 0x9b, 0x79, 0x16, 0xee, 0x30, 0x99, 0x0c,
+	0x26, 0xee, 0x52, 0xfc, 0xfa, 0x90, 0x86, 0x7a, 0x50, 0x3c, 0x24, 0x8b, 0x6f, 0xbf, 0x19, 0xe4,
+	0xab, 0xe7, 0xc4, 0x5c, 0xa4, 0xa0, 0xc3, 0x0d, 0xf7, 0x4e, 0x7c, 0xf7, 0xdf, 0x00, 0x73, 0x92,
+	0x49, 0x17, 0x6e, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -255,6 +363,69 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxUnbondingEntries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUnbondingEntries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.MaxRedelegationEntries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRedelegationEntries))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.MaxAutoRebalanceRedelegations != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAutoRebalanceRedelegations))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.AutoRebalanceThresholdPercent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoRebalanceThresholdPercent))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.MaxValidatorWeightPercent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxValidatorWeightPercent))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.ValidatorScoreVotingPowerCap != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ValidatorScoreVotingPowerCap))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.ValidatorScoreSignedBlocksWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ValidatorScoreSignedBlocksWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.MaxAutoClaimsPerEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAutoClaimsPerEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.RedemptionRateHistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RedemptionRateHistoryRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
 	if m.SafetyMaxSlashPercent != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.SafetyMaxSlashPercent))
 		i--
@@ -409,6 +580,33 @@ func (m *Params) Size() (n int) {
 	if m.SafetyMaxSlashPercent != 0 {
 		n += 2 + sovParams(uint64(m.SafetyMaxSlashPercent))
 	}
+	if m.RedemptionRateHistoryRetention != 0 {
+		n += 2 + sovParams(uint64(m.RedemptionRateHistoryRetention))
+	}
+	if m.MaxAutoClaimsPerEpoch != 0 {
+		n += 2 + sovParams(uint64(m.MaxAutoClaimsPerEpoch))
+	}
+	if m.ValidatorScoreSignedBlocksWindow != 0 {
+		n += 2 + sovParams(uint64(m.ValidatorScoreSignedBlocksWindow))
+	}
+	if m.ValidatorScoreVotingPowerCap != 0 {
+		n += 2 + sovParams(uint64(m.ValidatorScoreVotingPowerCap))
+	}
+	if m.MaxValidatorWeightPercent != 0 {
+		n += 2 + sovParams(uint64(m.MaxValidatorWeightPercent))
+	}
+	if m.AutoRebalanceThresholdPercent != 0 {
+		n += 2 + sovParams(uint64(m.AutoRebalanceThresholdPercent))
+	}
+	if m.MaxAutoRebalanceRedelegations != 0 {
+		n += 2 + sovParams(uint64(m.MaxAutoRebalanceRedelegations))
+	}
+	if m.MaxRedelegationEntries != 0 {
+		n += 2 + sovParams(uint64(m.MaxRedelegationEntries))
+	}
+	if m.MaxUnbondingEntries != 0 {
+		n += 2 + sovParams(uint64(m.MaxUnbondingEntries))
+	}
 	return n
 }
 
@@ -751,6 +949,177 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateHistoryRetention", wireType)
+			}
+			m.RedemptionRateHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionRateHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoClaimsPerEpoch", wireType)
+			}
+			m.MaxAutoClaimsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoClaimsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorScoreSignedBlocksWindow", wireType)
+			}
+			m.ValidatorScoreSignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorScoreSignedBlocksWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorScoreVotingPowerCap", wireType)
+			}
+			m.ValidatorScoreVotingPowerCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorScoreVotingPowerCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorWeightPercent", wireType)
+			}
+			m.MaxValidatorWeightPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidatorWeightPercent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRebalanceThresholdPercent", wireType)
+			}
+			m.AutoRebalanceThresholdPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoRebalanceThresholdPercent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoRebalanceRedelegations", wireType)
+			}
+			m.MaxAutoRebalanceRedelegations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoRebalanceRedelegations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedelegationEntries", wireType)
+			}
+			m.MaxRedelegationEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRedelegationEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnbondingEntries", wireType)
+			}
+			m.MaxUnbondingEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnbondingEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -263,15 +264,78 @@ func (m *QueryGetValidatorsRequest) GetChainId() string {
 	return ""
 }
 
+// The number of in-flight unbonding entries for a validator, and the number
+// of entries still available before the host's limit is reached
+type ValidatorUnbondingEntries struct {
+	Address             string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	NumUnbondingEntries uint64 `protobuf:"varint,2,opt,name=num_unbonding_entries,json=numUnbondingEntries,proto3" json:"num_unbonding_entries,omitempty"`
+	NumAvailableEntries uint64 `protobuf:"varint,3,opt,name=num_available_entries,json=numAvailableEntries,proto3" json:"num_available_entries,omitempty"`
+}
+
+func (m *ValidatorUnbondingEntries) Reset()         { *m = ValidatorUnbondingEntries{} }
+func (m *ValidatorUnbondingEntries) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUnbondingEntries) ProtoMessage()    {}
+func (*ValidatorUnbondingEntries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{5}
+}
+func (m *ValidatorUnbondingEntries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorUnbondingEntries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorUnbondingEntries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorUnbondingEntries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUnbondingEntries.Merge(m, src)
+}
+func (m *ValidatorUnbondingEntries) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorUnbondingEntries) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUnbondingEntries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorUnbondingEntries proto.InternalMessageInfo
+
+func (m *ValidatorUnbondingEntries) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorUnbondingEntries) GetNumUnbondingEntries() uint64 {
+	if m != nil {
+		return m.NumUnbondingEntries
+	}
+	return 0
+}
+
+func (m *ValidatorUnbondingEntries) GetNumAvailableEntries() uint64 {
+	if m != nil {
+		return m.NumAvailableEntries
+	}
+	return 0
+}
+
 type QueryGetValidatorsResponse struct {
-	Validators []*Validator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	Validators       []*Validator                `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	UnbondingEntries []ValidatorUnbondingEntries `protobuf:"bytes,2,rep,name=unbonding_entries,json=unbondingEntries,proto3" json:"unbonding_entries"`
 }
 
 func (m *QueryGetValidatorsResponse) Reset()         { *m = QueryGetValidatorsResponse{} }
 func (m *QueryGetValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetValidatorsResponse) ProtoMessage()    {}
 func (*QueryGetValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_494b786fe66f2b80, []int{5}
+	return fileDescriptor_494b786fe66f2b80, []int{6}
 }
 func (m *QueryGetValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,6 +371,13 @@ func (m *QueryGetValidatorsResponse) GetValidators() []*Validator {
 	return nil
 }
 
+func (m *QueryGetValidatorsResponse) GetUnbondingEntries() []ValidatorUnbondingEntries {
+	if m != nil {
+		return m.UnbondingEntries
+	}
+	return nil
+}
+
 type QueryGetHostZoneRequest struct {
 	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
@@ -315,7 +386,7 @@ func (m *QueryGetHostZoneRequest) Reset()         { *m = QueryGetHostZoneRequest
 func (m *QueryGetHostZoneRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetHostZoneRequest) ProtoMessage()    {}
 func (*QueryGetHostZoneRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_494b786fe66f2b80, []int{6}
+	return fileDescriptor_494b786fe66f2b80, []int{7}
 }
 func (m *QueryGetHostZoneRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +430,7 @@ func (m *QueryGetHostZoneResponse) Reset()         { *m = QueryGetHostZoneRespon
 func (m *QueryGetHostZoneResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetHostZoneResponse) ProtoMessage()    {}
 func (*QueryGetHostZoneResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_494b786fe66f2b80, []int{7}
+	return fileDescriptor_494b786fe66f2b80, []int{8}
 }
 func (m *QueryGetHostZoneResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +474,7 @@ func (m *QueryAllHostZoneRequest) Reset()         { *m = QueryAllHostZoneRequest
 func (m *QueryAllHostZoneRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllHostZoneRequest) ProtoMessage()    {}
 func (*QueryAllHostZoneRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_494b786fe66f2b80, []int{8}
+	return fileDescriptor_494b786fe66f2b80, []int{9}
 }
 func (m *QueryAllHostZoneRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +519,7 @@ func (m *QueryAllHostZoneResponse) Reset()         { *m = QueryAllHostZoneRespon
 func (m *QueryAllHostZoneResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllHostZoneResponse) ProtoMessage()    {}
 func (*QueryAllHostZoneResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_494b786fe66f2b80, []int{9}
+	return fileDescriptor_494b786fe66f2b80, []int{10}
 }
 func (m *QueryAllHostZoneResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +570,7 @@ func (m *QueryModuleAddressRequest) Reset()         { *m = QueryModuleAddressReq
 func (m *QueryModuleAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleAddressRequest) ProtoMessage()    {}
 func (*QueryModuleAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_494b786fe66f2b80, []int{10}
+	return fileDescriptor_494b786fe66f2b80, []int{11}
 }
 func (m *QueryModuleAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +614,7 @@ func (m *QueryModuleAddressResponse) Reset()         { *m = QueryModuleAddressRe
 func (m *QueryModuleAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleAddressResponse) ProtoMessage()    {}
 func (*QueryModuleAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_494b786fe66f2b80, []int{11}
+	return fileDescriptor_494b786fe66f2b80, []int{12}
 }
 func (m *QueryModuleAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,7 +658,7 @@ func (m *QueryGetEpochTrackerRequest) Reset()         { *m = QueryGetEpochTracke
 func (m *QueryGetEpochTrackerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetEpochTrackerRequest) ProtoMessage()    {}
 func (*QueryGetEpochTrackerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_494b786fe66f2b80, []int{12}
+	return fileDescriptor_494b786fe66f2b80, []int{13}
 }
 func (m *QueryGetEpochTrackerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -631,7 +702,7 @@ func (m *QueryGetEpochTrackerResponse) Reset()         { *m = QueryGetEpochTrack
 func (m *QueryGetEpochTrackerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetEpochTrackerResponse) ProtoMessage()    {}
 func (*QueryGetEpochTrackerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_494b786fe66f2b80, []int{13}
+	return fileDescriptor_494b786fe66f2b80, []int{14}
 }
 func (m *QueryGetEpochTrackerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +745,7 @@ func (m *QueryAllEpochTrackerRequest) Reset()         { *m = QueryAllEpochTracke
 func (m *QueryAllEpochTrackerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllEpochTrackerRequest) ProtoMessage()    {}
 func (*QueryAllEpochTrackerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_494b786fe66f2b80, []int{14}
+	return fileDescriptor_494b786fe66f2b80, []int{15}
 }
 func (m *QueryAllEpochTrackerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +782,7 @@ func (m *QueryAllEpochTrackerResponse) Reset()         { *m = QueryAllEpochTrack
 func (m *QueryAllEpochTrackerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllEpochTrackerResponse) ProtoMessage()    {}
 func (*QueryAllEpochTrackerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_494b786fe66f2b80, []int{15}
+	return fileDescriptor_494b786fe66f2b80, []int{16}
 }
 func (m *QueryAllEpochTrackerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -756,7 +827,7 @@ func (m *QueryGetNextPacketSequenceRequest) Reset()         { *m = QueryGetNextP
 func (m *QueryGetNextPacketSequenceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetNextPacketSequenceRequest) ProtoMessage()    {}
 func (*QueryGetNextPacketSequenceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_494b786fe66f2b80, []int{16}
+	return fileDescriptor_494b786fe66f2b80, []int{17}
 }
 func (m *QueryGetNextPacketSequenceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -807,7 +878,7 @@ func (m *QueryGetNextPacketSequenceResponse) Reset()         { *m = QueryGetNext
 func (m *QueryGetNextPacketSequenceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetNextPacketSequenceResponse) ProtoMessage()    {}
 func (*QueryGetNextPacketSequenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_494b786fe66f2b80, []int{17}
+	return fileDescriptor_494b786fe66f2b80, []int{18}
 }
 func (m *QueryGetNextPacketSequenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +922,7 @@ func (m *QueryAddressUnbondings) Reset()         { *m = QueryAddressUnbondings{}
 func (m *QueryAddressUnbondings) String() string { return proto.CompactTextString(m) }
 func (*QueryAddressUnbondings) ProtoMessage()    {}
 func (*QueryAddressUnbondings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_494b786fe66f2b80, []int{18}
+	return fileDescriptor_494b786fe66f2b80, []int{19}
 }
 func (m *QueryAddressUnbondings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -895,7 +966,7 @@ func (m *QueryAddressUnbondingsResponse) Reset()         { *m = QueryAddressUnbo
 func (m *QueryAddressUnbondingsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAddressUnbondingsResponse) ProtoMessage()    {}
 func (*QueryAddressUnbondingsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_494b786fe66f2b80, []int{19}
+	return fileDescriptor_494b786fe66f2b80, []int{20}
 }
 func (m *QueryAddressUnbondingsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -931,823 +1002,1012 @@ func (m *QueryAddressUnbondingsResponse) GetAddressUnbondings() []AddressUnbondi
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryInterchainAccountFromAddressRequest)(nil), "stride.stakeibc.QueryInterchainAccountFromAddressRequest")
-	proto.RegisterType((*QueryInterchainAccountFromAddressResponse)(nil), "stride.stakeibc.QueryInterchainAccountFromAddressResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "stride.stakeibc.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "stride.stakeibc.QueryParamsResponse")
-	proto.RegisterType((*QueryGetValidatorsRequest)(nil), "stride.stakeibc.QueryGetValidatorsRequest")
-	proto.RegisterType((*QueryGetValidatorsResponse)(nil), "stride.stakeibc.QueryGetValidatorsResponse")
-	proto.RegisterType((*QueryGetHostZoneRequest)(nil), "stride.stakeibc.QueryGetHostZoneRequest")
-	proto.RegisterType((*QueryGetHostZoneResponse)(nil), "stride.stakeibc.QueryGetHostZoneResponse")
-	proto.RegisterType((*QueryAllHostZoneRequest)(nil), "stride.stakeibc.QueryAllHostZoneRequest")
-	proto.RegisterType((*QueryAllHostZoneResponse)(nil), "stride.stakeibc.QueryAllHostZoneResponse")
-	proto.RegisterType((*QueryModuleAddressRequest)(nil), "stride.stakeibc.QueryModuleAddressRequest")
-	proto.RegisterType((*QueryModuleAddressResponse)(nil), "stride.stakeibc.QueryModuleAddressResponse")
-	proto.RegisterType((*QueryGetEpochTrackerRequest)(nil), "stride.stakeibc.QueryGetEpochTrackerRequest")
-	proto.RegisterType((*QueryGetEpochTrackerResponse)(nil), "stride.stakeibc.QueryGetEpochTrackerResponse")
-	proto.RegisterType((*QueryAllEpochTrackerRequest)(nil), "stride.stakeibc.QueryAllEpochTrackerRequest")
-	proto.RegisterType((*QueryAllEpochTrackerResponse)(nil), "stride.stakeibc.QueryAllEpochTrackerResponse")
-	proto.RegisterType((*QueryGetNextPacketSequenceRequest)(nil), "stride.stakeibc.QueryGetNextPacketSequenceRequest")
-	proto.RegisterType((*QueryGetNextPacketSequenceResponse)(nil), "stride.stakeibc.QueryGetNextPacketSequenceResponse")
-	proto.RegisterType((*QueryAddressUnbondings)(nil), "stride.stakeibc.QueryAddressUnbondings")
-	proto.RegisterType((*QueryAddressUnbondingsResponse)(nil), "stride.stakeibc.QueryAddressUnbondingsResponse")
+type QueryRedemptionRateHistoryRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// first epoch to include (inclusive)
+	StartEpoch uint64 `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// last epoch to include (inclusive), or 0 to include all remaining epochs
+	EndEpoch   uint64             `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("stride/stakeibc/query.proto", fileDescriptor_494b786fe66f2b80) }
-
-var fileDescriptor_494b786fe66f2b80 = []byte{
-	// 1158 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x97, 0xdd, 0x6e, 0x1b, 0x45,
-	0x1b, 0xc7, 0xb3, 0x4d, 0x9a, 0x8f, 0x27, 0x89, 0xf2, 0x76, 0xde, 0x88, 0x38, 0xdb, 0xc4, 0x21,
-	0xd3, 0xd2, 0x7c, 0x90, 0x7a, 0x1b, 0xa7, 0x20, 0x35, 0xa2, 0x82, 0x44, 0x6a, 0x1b, 0xa3, 0x80,
-	0x82, 0x0b, 0x15, 0x2a, 0x07, 0xd6, 0x78, 0x77, 0xb0, 0x57, 0x5d, 0xcf, 0xb8, 0xbb, 0xeb, 0x90,
-	0x10, 0x59, 0x95, 0xb8, 0x82, 0x0a, 0xc4, 0x09, 0x67, 0x45, 0x1c, 0x70, 0xcc, 0x55, 0xf4, 0x8c,
-	0x4a, 0x9c, 0x70, 0x14, 0xa1, 0x84, 0x2b, 0xa8, 0xb8, 0x00, 0xb4, 0x33, 0xb3, 0x6b, 0x7b, 0x3f,
-	0x8c, 0xd3, 0x33, 0xcf, 0xcc, 0xf3, 0xf1, 0x9b, 0x67, 0x9e, 0xf9, 0xcf, 0x1a, 0xae, 0x7a, 0xbe,
-	0x6b, 0x5b, 0xd4, 0xf0, 0x7c, 0xf2, 0x84, 0xda, 0x55, 0xd3, 0x78, 0xda, 0xa2, 0xee, 0x71, 0xa1,
-	0xe9, 0x72, 0x9f, 0xa3, 0x19, 0xb9, 0x58, 0x08, 0x17, 0xf5, 0xd9, 0x1a, 0xaf, 0x71, 0xb1, 0x66,
-	0x04, 0xbf, 0xa4, 0x99, 0xbe, 0x50, 0xe3, 0xbc, 0xe6, 0x50, 0x83, 0x34, 0x6d, 0x83, 0x30, 0xc6,
-	0x7d, 0xe2, 0xdb, 0x9c, 0x79, 0x6a, 0x75, 0xdd, 0xe4, 0x5e, 0x83, 0x7b, 0x46, 0x95, 0x78, 0x54,
-	0x46, 0x37, 0x0e, 0x37, 0xab, 0xd4, 0x27, 0x9b, 0x46, 0x93, 0xd4, 0x6c, 0x26, 0x8c, 0xc3, 0x48,
-	0x71, 0x9a, 0x26, 0x71, 0x49, 0x23, 0x8c, 0xb4, 0x14, 0x5f, 0x3d, 0x24, 0x8e, 0x6d, 0x11, 0x9f,
-	0xbb, 0x59, 0x06, 0x75, 0xee, 0xf9, 0x95, 0x6f, 0x39, 0xa3, 0xca, 0xe0, 0x5a, 0xdc, 0x80, 0x36,
-	0xb9, 0x59, 0xaf, 0xf8, 0x2e, 0x31, 0x9f, 0xd0, 0x30, 0xca, 0x4a, 0xdc, 0x88, 0x58, 0x96, 0x4b,
-	0x3d, 0xaf, 0xd2, 0x62, 0x55, 0xce, 0x2c, 0x9b, 0xd5, 0xa4, 0x21, 0x7e, 0x06, 0xab, 0x9f, 0x05,
-	0xfb, 0x29, 0x31, 0x9f, 0xba, 0x66, 0x9d, 0xd8, 0x6c, 0xc7, 0x34, 0x79, 0x8b, 0xf9, 0xf7, 0x5d,
-	0xde, 0xd8, 0x91, 0x4e, 0x65, 0xfa, 0xb4, 0x45, 0x3d, 0x1f, 0xcd, 0xc2, 0x65, 0xfe, 0x0d, 0xa3,
-	0x6e, 0x4e, 0x7b, 0x5b, 0x5b, 0x9d, 0x28, 0xcb, 0x01, 0xba, 0x0b, 0xd3, 0x26, 0x67, 0x8c, 0x9a,
-	0x41, 0x0d, 0x2a, 0xb6, 0x95, 0xbb, 0x14, 0xac, 0xee, 0xe6, 0x5e, 0x9f, 0x2e, 0xcd, 0x1e, 0x93,
-	0x86, 0xb3, 0x8d, 0x7b, 0x96, 0x71, 0x79, 0xaa, 0x33, 0x2e, 0x59, 0xf8, 0xb9, 0x06, 0x6b, 0x03,
-	0x10, 0x78, 0x4d, 0xce, 0x3c, 0x8a, 0x4c, 0xd0, 0xed, 0xc8, 0xae, 0x42, 0xa4, 0x61, 0x45, 0x6d,
-	0x4e, 0x72, 0xed, 0xbe, 0xf3, 0xfa, 0x74, 0x69, 0x59, 0x66, 0xce, 0xb6, 0xc5, 0xe5, 0x9c, 0x1d,
-	0x4f, 0xa8, 0x92, 0xe1, 0x59, 0x40, 0x82, 0xe8, 0x40, 0x1c, 0x9c, 0xda, 0x3d, 0xde, 0x87, 0xff,
-	0xf7, 0xcc, 0x2a, 0xa2, 0xf7, 0x60, 0x54, 0x1e, 0xb0, 0xc8, 0x3e, 0x59, 0x9c, 0x2b, 0xc4, 0x1a,
-	0xae, 0x20, 0x1d, 0x76, 0x47, 0x5e, 0x9e, 0x2e, 0x0d, 0x95, 0x95, 0x31, 0x7e, 0x1f, 0xe6, 0x45,
-	0xb4, 0x07, 0xd4, 0x7f, 0x14, 0x76, 0x40, 0x54, 0xe8, 0x79, 0x18, 0x97, 0xd0, 0xb6, 0xa5, 0x6a,
-	0x3d, 0x26, 0xc6, 0x25, 0x0b, 0x7f, 0x09, 0x7a, 0x9a, 0x9f, 0x82, 0xd9, 0x06, 0x88, 0xfa, 0x29,
-	0x00, 0x1a, 0x5e, 0x9d, 0x2c, 0xea, 0x09, 0xa0, 0xc8, 0xb1, 0xdc, 0x65, 0x8d, 0x6f, 0xc3, 0x5c,
-	0x18, 0x79, 0x8f, 0x7b, 0xfe, 0x63, 0xce, 0xe8, 0x40, 0x3c, 0xb9, 0xa4, 0x97, 0xa2, 0xf9, 0x00,
-	0x26, 0xa2, 0xe6, 0x55, 0xd5, 0x99, 0x4f, 0xc0, 0x84, 0x5e, 0xaa, 0x3e, 0xe3, 0x75, 0x35, 0xc6,
-	0x44, 0xf1, 0xec, 0x38, 0x4e, 0x9c, 0xe7, 0x3e, 0x40, 0xe7, 0xda, 0xa9, 0xc8, 0x37, 0x0a, 0xf2,
-	0x8e, 0x16, 0x82, 0x3b, 0x5a, 0x90, 0x0a, 0xa0, 0xee, 0x68, 0xe1, 0x80, 0xd4, 0x42, 0xdf, 0x72,
-	0x97, 0x27, 0x7e, 0xa1, 0x29, 0xfa, 0x9e, 0x1c, 0xe9, 0xf4, 0xc3, 0x17, 0xa2, 0x47, 0x0f, 0x7a,
-	0x10, 0x2f, 0x09, 0xc4, 0x95, 0xff, 0x44, 0x94, 0xa9, 0x7b, 0x18, 0x0d, 0xd5, 0x28, 0x9f, 0x70,
-	0xab, 0xe5, 0xd0, 0xd8, 0x8d, 0x44, 0x30, 0xc2, 0x48, 0x83, 0xaa, 0x43, 0x11, 0xbf, 0xf1, 0x2d,
-	0xd5, 0x21, 0x31, 0x07, 0xb5, 0x2b, 0x04, 0x23, 0xc1, 0x0d, 0x08, 0x3d, 0x82, 0xdf, 0x78, 0x0f,
-	0xae, 0x86, 0x67, 0x78, 0x2f, 0xd0, 0x92, 0xcf, 0xa5, 0x94, 0x84, 0x49, 0xd6, 0xe0, 0x7f, 0x52,
-	0x62, 0x6c, 0x8b, 0x32, 0xdf, 0xfe, 0xda, 0x8e, 0x14, 0x60, 0x46, 0xcc, 0x97, 0xa2, 0x69, 0x5c,
-	0x87, 0x85, 0xf4, 0x48, 0x2a, 0xfb, 0x1e, 0x4c, 0xf7, 0xa8, 0x95, 0x3a, 0xbb, 0xc5, 0x44, 0x5d,
-	0xbb, 0xbd, 0x55, 0x6d, 0xa7, 0x68, 0xd7, 0x1c, 0x5e, 0x54, 0xcc, 0x3b, 0x8e, 0x93, 0xc2, 0x1c,
-	0x81, 0x24, 0x96, 0xb3, 0x41, 0x86, 0xdf, 0x0c, 0xe4, 0x2b, 0x58, 0x0e, 0xb7, 0xfc, 0x29, 0x3d,
-	0xf2, 0x0f, 0x82, 0x59, 0xff, 0x61, 0x80, 0xc1, 0xcc, 0xa8, 0x61, 0x17, 0x01, 0xcc, 0x3a, 0x61,
-	0x8c, 0x3a, 0x9d, 0x2b, 0x34, 0xa1, 0x66, 0x4a, 0x16, 0x9a, 0x83, 0xb1, 0x26, 0x77, 0xfd, 0x48,
-	0x3c, 0xcb, 0xa3, 0xc1, 0xb0, 0x64, 0xe1, 0x8f, 0x00, 0xf7, 0x0b, 0xae, 0x36, 0xa3, 0xc3, 0xb8,
-	0xa7, 0xe6, 0x44, 0xec, 0x91, 0x72, 0x34, 0xc6, 0x45, 0x78, 0x4b, 0x16, 0x42, 0xf6, 0xc1, 0x17,
-	0xa1, 0xfc, 0x7b, 0x28, 0x07, 0x63, 0x3d, 0xba, 0x59, 0x0e, 0x87, 0xf8, 0x08, 0xf2, 0xe9, 0x3e,
-	0x51, 0xc6, 0x47, 0x80, 0x12, 0x0f, 0x4a, 0xa8, 0x37, 0xcb, 0x89, 0x1a, 0xc6, 0xe3, 0xa8, 0x3a,
-	0x5e, 0x21, 0xf1, 0xf8, 0xc5, 0x7f, 0xa6, 0xe0, 0xb2, 0x48, 0x8d, 0x9e, 0xc1, 0xa8, 0xd4, 0x4d,
-	0x74, 0x2d, 0x11, 0x2f, 0x29, 0xce, 0xfa, 0xf5, 0xfe, 0x46, 0x12, 0x1b, 0xaf, 0x7f, 0xf7, 0xc7,
-	0xdf, 0x3f, 0x5c, 0xba, 0x8e, 0xb0, 0xf1, 0x50, 0x58, 0x3b, 0xa4, 0xea, 0x19, 0xe9, 0xcf, 0x35,
-	0x7a, 0xa1, 0x01, 0x74, 0x14, 0x16, 0xad, 0xa7, 0x27, 0x48, 0x93, 0x6f, 0xfd, 0xdd, 0x81, 0x6c,
-	0x15, 0xd3, 0xb6, 0x60, 0xba, 0x8d, 0x8a, 0x8a, 0xe9, 0xe6, 0x7e, 0x1a, 0x54, 0x47, 0xa7, 0x8d,
-	0x93, 0x50, 0x8a, 0xdb, 0xe8, 0x27, 0x0d, 0xc6, 0x43, 0x05, 0x42, 0xab, 0x99, 0x59, 0x63, 0xf2,
-	0xa9, 0xaf, 0x0d, 0x60, 0xa9, 0xe8, 0xee, 0x08, 0xba, 0x2d, 0xb4, 0xd9, 0x97, 0x2e, 0xd2, 0xc9,
-	0x6e, 0xb8, 0xef, 0x35, 0x98, 0x0c, 0xe3, 0xed, 0x38, 0x4e, 0x16, 0x5f, 0x52, 0xde, 0xb3, 0xf8,
-	0x52, 0x44, 0x1a, 0x17, 0x04, 0xdf, 0x2a, 0xba, 0x31, 0x18, 0x1f, 0xfa, 0x45, 0x83, 0xe9, 0x1e,
-	0x61, 0xcc, 0x3a, 0xd8, 0x34, 0xb9, 0xcd, 0x3a, 0xd8, 0x54, 0xa5, 0x1d, 0xf0, 0x60, 0x1b, 0xc2,
-	0x37, 0xfc, 0x2a, 0x31, 0x4e, 0x02, 0x09, 0x6f, 0xa3, 0x1f, 0x35, 0x58, 0xe8, 0xf7, 0x3d, 0x84,
-	0xee, 0xa4, 0x93, 0x0c, 0xf0, 0x15, 0xa7, 0x6f, 0xbf, 0x89, 0xab, 0xba, 0xf7, 0xbf, 0x69, 0x30,
-	0xd5, 0xad, 0x88, 0x68, 0x23, 0xb3, 0x95, 0x52, 0x54, 0x59, 0xbf, 0x39, 0xa0, 0xb5, 0xaa, 0xe0,
-	0x3d, 0x51, 0xc1, 0x0f, 0xd1, 0xdd, 0xbe, 0x15, 0xec, 0xd1, 0x71, 0xe3, 0x24, 0xfe, 0x54, 0xb5,
-	0xd1, 0xcf, 0x1a, 0xcc, 0x74, 0xc7, 0x0f, 0x9a, 0x71, 0x23, 0xb3, 0xc5, 0x2e, 0xc0, 0x9d, 0xf1,
-	0xb8, 0xe0, 0xa2, 0xe0, 0xde, 0x40, 0xeb, 0x83, 0x73, 0xa3, 0xdf, 0x35, 0x40, 0x49, 0x89, 0x47,
-	0xc5, 0xcc, 0x8a, 0x65, 0x3e, 0x36, 0xfa, 0xd6, 0x85, 0x7c, 0x14, 0xf3, 0x81, 0x60, 0xfe, 0x18,
-	0xed, 0xf5, 0x65, 0x66, 0xf4, 0xc8, 0xaf, 0x34, 0x45, 0x84, 0x4a, 0xf8, 0xc4, 0x88, 0x3b, 0xaf,
-	0x9e, 0xb6, 0xb6, 0x71, 0xa2, 0x1e, 0xb2, 0x36, 0xfa, 0x55, 0x83, 0x2b, 0xc9, 0x57, 0x67, 0x25,
-	0xa3, 0x94, 0x71, 0x43, 0xdd, 0x18, 0xd0, 0xf0, 0x82, 0x52, 0xd5, 0x79, 0xae, 0x8c, 0x13, 0x75,
-	0xe9, 0xda, 0xbb, 0xfb, 0x2f, 0xcf, 0xf2, 0xda, 0xab, 0xb3, 0xbc, 0xf6, 0xd7, 0x59, 0x5e, 0x7b,
-	0x7e, 0x9e, 0x1f, 0x7a, 0x75, 0x9e, 0x1f, 0xfa, 0xf3, 0x3c, 0x3f, 0xf4, 0xb8, 0x58, 0xb3, 0xfd,
-	0x7a, 0xab, 0x5a, 0x30, 0x79, 0x23, 0x2d, 0xec, 0xe1, 0xe6, 0x2d, 0xe3, 0xa8, 0x13, 0xdc, 0x3f,
-	0x6e, 0x52, 0xaf, 0x3a, 0x2a, 0xfe, 0x59, 0x6d, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x59, 0x6d,
-	0xf9, 0x0b, 0x97, 0x0e, 0x00, 0x00,
+func (m *QueryRedemptionRateHistoryRequest) Reset()         { *m = QueryRedemptionRateHistoryRequest{} }
+func (m *QueryRedemptionRateHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRateHistoryRequest) ProtoMessage()    {}
+func (*QueryRedemptionRateHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{21}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a Validator by host zone.
-	Validators(ctx context.Context, in *QueryGetValidatorsRequest, opts ...grpc.CallOption) (*QueryGetValidatorsResponse, error)
-	// Queries a HostZone by id.
-	HostZone(ctx context.Context, in *QueryGetHostZoneRequest, opts ...grpc.CallOption) (*QueryGetHostZoneResponse, error)
-	// Queries a list of HostZone items.
-	HostZoneAll(ctx context.Context, in *QueryAllHostZoneRequest, opts ...grpc.CallOption) (*QueryAllHostZoneResponse, error)
-	// Queries a list of ModuleAddress items.
-	ModuleAddress(ctx context.Context, in *QueryModuleAddressRequest, opts ...grpc.CallOption) (*QueryModuleAddressResponse, error)
-	// QueryInterchainAccountFromAddress returns the interchain account for given
-	// owner address on a given connection pair
-	InterchainAccountFromAddress(ctx context.Context, in *QueryInterchainAccountFromAddressRequest, opts ...grpc.CallOption) (*QueryInterchainAccountFromAddressResponse, error)
-	// Queries a EpochTracker by index.
-	EpochTracker(ctx context.Context, in *QueryGetEpochTrackerRequest, opts ...grpc.CallOption) (*QueryGetEpochTrackerResponse, error)
-	// Queries a list of EpochTracker items.
-	EpochTrackerAll(ctx context.Context, in *QueryAllEpochTrackerRequest, opts ...grpc.CallOption) (*QueryAllEpochTrackerResponse, error)
-	// Queries the next packet sequence for one for a given channel
-	NextPacketSequence(ctx context.Context, in *QueryGetNextPacketSequenceRequest, opts ...grpc.CallOption) (*QueryGetNextPacketSequenceResponse, error)
-	// Queries an address's unbondings
-	AddressUnbondings(ctx context.Context, in *QueryAddressUnbondings, opts ...grpc.CallOption) (*QueryAddressUnbondingsResponse, error)
+func (m *QueryRedemptionRateHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryRedemptionRateHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRateHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryRedemptionRateHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRateHistoryRequest.Merge(m, src)
+}
+func (m *QueryRedemptionRateHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRateHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRateHistoryRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRedemptionRateHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionRateHistoryRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Validators(ctx context.Context, in *QueryGetValidatorsRequest, opts ...grpc.CallOption) (*QueryGetValidatorsResponse, error) {
-	out := new(QueryGetValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/Validators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionRateHistoryRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) HostZone(ctx context.Context, in *QueryGetHostZoneRequest, opts ...grpc.CallOption) (*QueryGetHostZoneResponse, error) {
-	out := new(QueryGetHostZoneResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/HostZone", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionRateHistoryRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) HostZoneAll(ctx context.Context, in *QueryAllHostZoneRequest, opts ...grpc.CallOption) (*QueryAllHostZoneResponse, error) {
-	out := new(QueryAllHostZoneResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/HostZoneAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionRateHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ModuleAddress(ctx context.Context, in *QueryModuleAddressRequest, opts ...grpc.CallOption) (*QueryModuleAddressResponse, error) {
-	out := new(QueryModuleAddressResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/ModuleAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryRedemptionRateHistoryResponse struct {
+	RedemptionRateRecords []RedemptionRateRecord `protobuf:"bytes,1,rep,name=redemption_rate_records,json=redemptionRateRecords,proto3" json:"redemption_rate_records"`
+	Pagination            *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) InterchainAccountFromAddress(ctx context.Context, in *QueryInterchainAccountFromAddressRequest, opts ...grpc.CallOption) (*QueryInterchainAccountFromAddressResponse, error) {
-	out := new(QueryInterchainAccountFromAddressResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/InterchainAccountFromAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionRateHistoryResponse) Reset()         { *m = QueryRedemptionRateHistoryResponse{} }
+func (m *QueryRedemptionRateHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRateHistoryResponse) ProtoMessage()    {}
+func (*QueryRedemptionRateHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{22}
+}
+func (m *QueryRedemptionRateHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionRateHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRateHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRedemptionRateHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRateHistoryResponse.Merge(m, src)
+}
+func (m *QueryRedemptionRateHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRateHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRateHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) EpochTracker(ctx context.Context, in *QueryGetEpochTrackerRequest, opts ...grpc.CallOption) (*QueryGetEpochTrackerResponse, error) {
-	out := new(QueryGetEpochTrackerResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/EpochTracker", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRedemptionRateHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionRateHistoryResponse) GetRedemptionRateRecords() []RedemptionRateRecord {
+	if m != nil {
+		return m.RedemptionRateRecords
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) EpochTrackerAll(ctx context.Context, in *QueryAllEpochTrackerRequest, opts ...grpc.CallOption) (*QueryAllEpochTrackerResponse, error) {
-	out := new(QueryAllEpochTrackerResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/EpochTrackerAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionRateHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) NextPacketSequence(ctx context.Context, in *QueryGetNextPacketSequenceRequest, opts ...grpc.CallOption) (*QueryGetNextPacketSequenceResponse, error) {
-	out := new(QueryGetNextPacketSequenceResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/NextPacketSequence", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryHostZoneSunsetRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (c *queryClient) AddressUnbondings(ctx context.Context, in *QueryAddressUnbondings, opts ...grpc.CallOption) (*QueryAddressUnbondingsResponse, error) {
-	out := new(QueryAddressUnbondingsResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/AddressUnbondings", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHostZoneSunsetRequest) Reset()         { *m = QueryHostZoneSunsetRequest{} }
+func (m *QueryHostZoneSunsetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHostZoneSunsetRequest) ProtoMessage()    {}
+func (*QueryHostZoneSunsetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{23}
+}
+func (m *QueryHostZoneSunsetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostZoneSunsetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostZoneSunsetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a Validator by host zone.
-	Validators(context.Context, *QueryGetValidatorsRequest) (*QueryGetValidatorsResponse, error)
-	// Queries a HostZone by id.
-	HostZone(context.Context, *QueryGetHostZoneRequest) (*QueryGetHostZoneResponse, error)
-	// Queries a list of HostZone items.
-	HostZoneAll(context.Context, *QueryAllHostZoneRequest) (*QueryAllHostZoneResponse, error)
-	// Queries a list of ModuleAddress items.
-	ModuleAddress(context.Context, *QueryModuleAddressRequest) (*QueryModuleAddressResponse, error)
-	// QueryInterchainAccountFromAddress returns the interchain account for given
-	// owner address on a given connection pair
-	InterchainAccountFromAddress(context.Context, *QueryInterchainAccountFromAddressRequest) (*QueryInterchainAccountFromAddressResponse, error)
-	// Queries a EpochTracker by index.
-	EpochTracker(context.Context, *QueryGetEpochTrackerRequest) (*QueryGetEpochTrackerResponse, error)
-	// Queries a list of EpochTracker items.
-	EpochTrackerAll(context.Context, *QueryAllEpochTrackerRequest) (*QueryAllEpochTrackerResponse, error)
-	// Queries the next packet sequence for one for a given channel
-	NextPacketSequence(context.Context, *QueryGetNextPacketSequenceRequest) (*QueryGetNextPacketSequenceResponse, error)
-	// Queries an address's unbondings
-	AddressUnbondings(context.Context, *QueryAddressUnbondings) (*QueryAddressUnbondingsResponse, error)
+func (m *QueryHostZoneSunsetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostZoneSunsetRequest.Merge(m, src)
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
-}
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryHostZoneSunsetRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Validators(ctx context.Context, req *QueryGetValidatorsRequest) (*QueryGetValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
+func (m *QueryHostZoneSunsetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostZoneSunsetRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) HostZone(ctx context.Context, req *QueryGetHostZoneRequest) (*QueryGetHostZoneResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostZone not implemented")
+
+var xxx_messageInfo_QueryHostZoneSunsetRequest proto.InternalMessageInfo
+
+func (m *QueryHostZoneSunsetRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) HostZoneAll(ctx context.Context, req *QueryAllHostZoneRequest) (*QueryAllHostZoneResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostZoneAll not implemented")
+
+type QueryHostZoneSunsetResponse struct {
+	HostZoneSunset HostZoneSunset `protobuf:"bytes,1,opt,name=host_zone_sunset,json=hostZoneSunset,proto3" json:"host_zone_sunset"`
+	// stTokens that have not yet been redeemed
+	RemainingStTokenSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=remaining_st_token_supply,json=remainingStTokenSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_st_token_supply"`
 }
-func (*UnimplementedQueryServer) ModuleAddress(ctx context.Context, req *QueryModuleAddressRequest) (*QueryModuleAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModuleAddress not implemented")
+
+func (m *QueryHostZoneSunsetResponse) Reset()         { *m = QueryHostZoneSunsetResponse{} }
+func (m *QueryHostZoneSunsetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHostZoneSunsetResponse) ProtoMessage()    {}
+func (*QueryHostZoneSunsetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{24}
 }
-func (*UnimplementedQueryServer) InterchainAccountFromAddress(ctx context.Context, req *QueryInterchainAccountFromAddressRequest) (*QueryInterchainAccountFromAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccountFromAddress not implemented")
+func (m *QueryHostZoneSunsetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) EpochTracker(ctx context.Context, req *QueryGetEpochTrackerRequest) (*QueryGetEpochTrackerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EpochTracker not implemented")
+func (m *QueryHostZoneSunsetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostZoneSunsetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) EpochTrackerAll(ctx context.Context, req *QueryAllEpochTrackerRequest) (*QueryAllEpochTrackerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EpochTrackerAll not implemented")
+func (m *QueryHostZoneSunsetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostZoneSunsetResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) NextPacketSequence(ctx context.Context, req *QueryGetNextPacketSequenceRequest) (*QueryGetNextPacketSequenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NextPacketSequence not implemented")
+func (m *QueryHostZoneSunsetResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AddressUnbondings(ctx context.Context, req *QueryAddressUnbondings) (*QueryAddressUnbondingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddressUnbondings not implemented")
+func (m *QueryHostZoneSunsetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostZoneSunsetResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryHostZoneSunsetResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryHostZoneSunsetResponse) GetHostZoneSunset() HostZoneSunset {
+	if m != nil {
+		return m.HostZoneSunset
 	}
-	return interceptor(ctx, in, info, handler)
+	return HostZoneSunset{}
 }
 
-func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Validators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/Validators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Validators(ctx, req.(*QueryGetValidatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryRedemptionEstimateRequest struct {
+	ChainId       string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	StTokenAmount string `protobuf:"bytes,2,opt,name=st_token_amount,json=stTokenAmount,proto3" json:"st_token_amount,omitempty"`
 }
 
-func _Query_HostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetHostZoneRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostZone(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/HostZone",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostZone(ctx, req.(*QueryGetHostZoneRequest))
+func (m *QueryRedemptionEstimateRequest) Reset()         { *m = QueryRedemptionEstimateRequest{} }
+func (m *QueryRedemptionEstimateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionEstimateRequest) ProtoMessage()    {}
+func (*QueryRedemptionEstimateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{25}
+}
+func (m *QueryRedemptionEstimateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionEstimateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionEstimateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRedemptionEstimateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionEstimateRequest.Merge(m, src)
+}
+func (m *QueryRedemptionEstimateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionEstimateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionEstimateRequest.DiscardUnknown(m)
 }
 
-func _Query_HostZoneAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllHostZoneRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostZoneAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/HostZoneAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostZoneAll(ctx, req.(*QueryAllHostZoneRequest))
+var xxx_messageInfo_QueryRedemptionEstimateRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionEstimateRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ModuleAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryModuleAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ModuleAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/ModuleAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ModuleAddress(ctx, req.(*QueryModuleAddressRequest))
+func (m *QueryRedemptionEstimateRequest) GetStTokenAmount() string {
+	if m != nil {
+		return m.StTokenAmount
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_InterchainAccountFromAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInterchainAccountFromAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InterchainAccountFromAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/InterchainAccountFromAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InterchainAccountFromAddress(ctx, req.(*QueryInterchainAccountFromAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryRedemptionEstimateResponse struct {
+	// native tokens the stTokens would redeem for at the current rate
+	NativeAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=native_amount,json=nativeAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"native_amount"`
+	// day epoch in which the redemption would be undelegated
+	UnbondingEpochNumber uint64 `protobuf:"varint,2,opt,name=unbonding_epoch_number,json=unbondingEpochNumber,proto3" json:"unbonding_epoch_number,omitempty"`
+	// estimated time at which the redemption can be claimed
+	EstimatedClaimableTime string `protobuf:"bytes,3,opt,name=estimated_claimable_time,json=estimatedClaimableTime,proto3" json:"estimated_claimable_time,omitempty"`
 }
 
-func _Query_EpochTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetEpochTrackerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EpochTracker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/EpochTracker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EpochTracker(ctx, req.(*QueryGetEpochTrackerRequest))
+func (m *QueryRedemptionEstimateResponse) Reset()         { *m = QueryRedemptionEstimateResponse{} }
+func (m *QueryRedemptionEstimateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionEstimateResponse) ProtoMessage()    {}
+func (*QueryRedemptionEstimateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{26}
+}
+func (m *QueryRedemptionEstimateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionEstimateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionEstimateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRedemptionEstimateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionEstimateResponse.Merge(m, src)
+}
+func (m *QueryRedemptionEstimateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionEstimateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionEstimateResponse.DiscardUnknown(m)
 }
 
-func _Query_EpochTrackerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllEpochTrackerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EpochTrackerAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/EpochTrackerAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EpochTrackerAll(ctx, req.(*QueryAllEpochTrackerRequest))
+var xxx_messageInfo_QueryRedemptionEstimateResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionEstimateResponse) GetUnbondingEpochNumber() uint64 {
+	if m != nil {
+		return m.UnbondingEpochNumber
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_NextPacketSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetNextPacketSequenceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NextPacketSequence(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/NextPacketSequence",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NextPacketSequence(ctx, req.(*QueryGetNextPacketSequenceRequest))
+func (m *QueryRedemptionEstimateResponse) GetEstimatedClaimableTime() string {
+	if m != nil {
+		return m.EstimatedClaimableTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AddressUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAddressUnbondings)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AddressUnbondings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/AddressUnbondings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AddressUnbondings(ctx, req.(*QueryAddressUnbondings))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterType((*QueryInterchainAccountFromAddressRequest)(nil), "stride.stakeibc.QueryInterchainAccountFromAddressRequest")
+	proto.RegisterType((*QueryInterchainAccountFromAddressResponse)(nil), "stride.stakeibc.QueryInterchainAccountFromAddressResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "stride.stakeibc.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "stride.stakeibc.QueryParamsResponse")
+	proto.RegisterType((*QueryGetValidatorsRequest)(nil), "stride.stakeibc.QueryGetValidatorsRequest")
+	proto.RegisterType((*ValidatorUnbondingEntries)(nil), "stride.stakeibc.ValidatorUnbondingEntries")
+	proto.RegisterType((*QueryGetValidatorsResponse)(nil), "stride.stakeibc.QueryGetValidatorsResponse")
+	proto.RegisterType((*QueryGetHostZoneRequest)(nil), "stride.stakeibc.QueryGetHostZoneRequest")
+	proto.RegisterType((*QueryGetHostZoneResponse)(nil), "stride.stakeibc.QueryGetHostZoneResponse")
+	proto.RegisterType((*QueryAllHostZoneRequest)(nil), "stride.stakeibc.QueryAllHostZoneRequest")
+	proto.RegisterType((*QueryAllHostZoneResponse)(nil), "stride.stakeibc.QueryAllHostZoneResponse")
+	proto.RegisterType((*QueryModuleAddressRequest)(nil), "stride.stakeibc.QueryModuleAddressRequest")
+	proto.RegisterType((*QueryModuleAddressResponse)(nil), "stride.stakeibc.QueryModuleAddressResponse")
+	proto.RegisterType((*QueryGetEpochTrackerRequest)(nil), "stride.stakeibc.QueryGetEpochTrackerRequest")
+	proto.RegisterType((*QueryGetEpochTrackerResponse)(nil), "stride.stakeibc.QueryGetEpochTrackerResponse")
+	proto.RegisterType((*QueryAllEpochTrackerRequest)(nil), "stride.stakeibc.QueryAllEpochTrackerRequest")
+	proto.RegisterType((*QueryAllEpochTrackerResponse)(nil), "stride.stakeibc.QueryAllEpochTrackerResponse")
+	proto.RegisterType((*QueryGetNextPacketSequenceRequest)(nil), "stride.stakeibc.QueryGetNextPacketSequenceRequest")
+	proto.RegisterType((*QueryGetNextPacketSequenceResponse)(nil), "stride.stakeibc.QueryGetNextPacketSequenceResponse")
+	proto.RegisterType((*QueryAddressUnbondings)(nil), "stride.stakeibc.QueryAddressUnbondings")
+	proto.RegisterType((*QueryAddressUnbondingsResponse)(nil), "stride.stakeibc.QueryAddressUnbondingsResponse")
+	proto.RegisterType((*QueryRedemptionRateHistoryRequest)(nil), "stride.stakeibc.QueryRedemptionRateHistoryRequest")
+	proto.RegisterType((*QueryRedemptionRateHistoryResponse)(nil), "stride.stakeibc.QueryRedemptionRateHistoryResponse")
+	proto.RegisterType((*QueryHostZoneSunsetRequest)(nil), "stride.stakeibc.QueryHostZoneSunsetRequest")
+	proto.RegisterType((*QueryHostZoneSunsetResponse)(nil), "stride.stakeibc.QueryHostZoneSunsetResponse")
+	proto.RegisterType((*QueryRedemptionEstimateRequest)(nil), "stride.stakeibc.QueryRedemptionEstimateRequest")
+	proto.RegisterType((*QueryRedemptionEstimateResponse)(nil), "stride.stakeibc.QueryRedemptionEstimateResponse")
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "stride.stakeibc.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Validators",
-			Handler:    _Query_Validators_Handler,
-		},
-		{
-			MethodName: "HostZone",
-			Handler:    _Query_HostZone_Handler,
-		},
-		{
-			MethodName: "HostZoneAll",
-			Handler:    _Query_HostZoneAll_Handler,
-		},
-		{
-			MethodName: "ModuleAddress",
-			Handler:    _Query_ModuleAddress_Handler,
-		},
-		{
-			MethodName: "InterchainAccountFromAddress",
-			Handler:    _Query_InterchainAccountFromAddress_Handler,
-		},
-		{
-			MethodName: "EpochTracker",
-			Handler:    _Query_EpochTracker_Handler,
-		},
-		{
-			MethodName: "EpochTrackerAll",
-			Handler:    _Query_EpochTrackerAll_Handler,
-		},
-		{
-			MethodName: "NextPacketSequence",
-			Handler:    _Query_NextPacketSequence_Handler,
-		},
-		{
-			MethodName: "AddressUnbondings",
-			Handler:    _Query_AddressUnbondings_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "stride/stakeibc/query.proto",
+func init() { proto.RegisterFile("stride/stakeibc/query.proto", fileDescriptor_494b786fe66f2b80) }
+
+var fileDescriptor_494b786fe66f2b80 = []byte{
+	// 1677 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5d, 0x4f, 0xdc, 0x56,
+	0x1a, 0xc6, 0x81, 0x10, 0x78, 0x81, 0x10, 0x4e, 0x08, 0x0c, 0x26, 0x61, 0x36, 0x4e, 0x42, 0x80,
+	0x90, 0x71, 0x18, 0xb2, 0xbb, 0x09, 0xda, 0x28, 0x19, 0x56, 0x24, 0xb0, 0xca, 0x07, 0x6b, 0x92,
+	0x68, 0x95, 0xd5, 0x6a, 0xe4, 0xb1, 0xcf, 0xce, 0x58, 0xd8, 0xc7, 0x13, 0xfb, 0x0c, 0x0b, 0x8b,
+	0x50, 0xa4, 0xfe, 0x82, 0xa8, 0x55, 0x6f, 0x2a, 0xf5, 0x22, 0x55, 0x2f, 0x7a, 0xd3, 0x9b, 0xde,
+	0xf4, 0xba, 0x52, 0x2f, 0xd2, 0x9b, 0x36, 0x6a, 0x6f, 0xda, 0x5e, 0xa0, 0x2a, 0xa9, 0xfa, 0x03,
+	0xf2, 0x0b, 0x2a, 0x1f, 0x1f, 0x7b, 0x66, 0xfc, 0x31, 0x31, 0xa8, 0x57, 0x8c, 0xcf, 0xfb, 0xf5,
+	0x9c, 0xf7, 0xbc, 0xe7, 0xf1, 0x63, 0x60, 0xd2, 0xa5, 0x8e, 0xa1, 0x63, 0xd9, 0xa5, 0xea, 0x26,
+	0x36, 0x2a, 0x9a, 0xfc, 0xb4, 0x81, 0x9d, 0x9d, 0x42, 0xdd, 0xb1, 0xa9, 0x8d, 0x86, 0x7d, 0x63,
+	0x21, 0x30, 0x8a, 0xa3, 0x55, 0xbb, 0x6a, 0x33, 0x9b, 0xec, 0xfd, 0xf2, 0xdd, 0xc4, 0xd3, 0x55,
+	0xdb, 0xae, 0x9a, 0x58, 0x56, 0xeb, 0x86, 0xac, 0x12, 0x62, 0x53, 0x95, 0x1a, 0x36, 0x71, 0xb9,
+	0x75, 0x4e, 0xb3, 0x5d, 0xcb, 0x76, 0xe5, 0x8a, 0xea, 0x62, 0x3f, 0xbb, 0xbc, 0xb5, 0x50, 0xc1,
+	0x54, 0x5d, 0x90, 0xeb, 0x6a, 0xd5, 0x20, 0xcc, 0x39, 0xc8, 0x14, 0x45, 0x53, 0x57, 0x1d, 0xd5,
+	0x0a, 0x32, 0xe5, 0xa3, 0xd6, 0x2d, 0xd5, 0x34, 0x74, 0x95, 0xda, 0x4e, 0x9a, 0x43, 0xcd, 0x76,
+	0x69, 0xf9, 0xff, 0x36, 0xc1, 0xdc, 0xe1, 0x5c, 0xd4, 0x01, 0xd7, 0x6d, 0xad, 0x56, 0xa6, 0x8e,
+	0xaa, 0x6d, 0xe2, 0x20, 0xcb, 0xc5, 0xa8, 0x93, 0xaa, 0xeb, 0x0e, 0x76, 0xdd, 0x72, 0x83, 0x54,
+	0x6c, 0xa2, 0x1b, 0xa4, 0xca, 0x1d, 0xe7, 0xa3, 0x8e, 0x0e, 0xd6, 0xb1, 0x55, 0xf7, 0xf6, 0x53,
+	0x76, 0x54, 0x8a, 0xcb, 0x0e, 0xd6, 0x6c, 0x47, 0xe7, 0xde, 0xd3, 0xa9, 0xe0, 0xca, 0x6e, 0x83,
+	0xb8, 0x98, 0xfa, 0x7e, 0xd2, 0x33, 0x98, 0xf9, 0xa7, 0xd7, 0xa5, 0x35, 0x42, 0xb1, 0xa3, 0xd5,
+	0x54, 0x83, 0x94, 0x34, 0xcd, 0x6e, 0x10, 0x7a, 0xdb, 0xb1, 0xad, 0x92, 0x0f, 0x45, 0xc1, 0x4f,
+	0x1b, 0xd8, 0xa5, 0x68, 0x14, 0x8e, 0xda, 0xff, 0x23, 0xd8, 0xc9, 0x09, 0x7f, 0x12, 0x66, 0xfa,
+	0x15, 0xff, 0x01, 0xdd, 0x80, 0x21, 0xcd, 0x26, 0x04, 0x6b, 0x0c, 0x89, 0xa1, 0xe7, 0x8e, 0x78,
+	0xd6, 0xe5, 0xdc, 0xdb, 0xfd, 0xfc, 0xe8, 0x8e, 0x6a, 0x99, 0x4b, 0x52, 0x9b, 0x59, 0x52, 0x06,
+	0x9b, 0xcf, 0x6b, 0xba, 0xf4, 0x5c, 0x80, 0xd9, 0x0c, 0x08, 0xdc, 0xba, 0x4d, 0x5c, 0x8c, 0x34,
+	0x10, 0x8d, 0xd0, 0xaf, 0xac, 0xfa, 0x8e, 0x65, 0xde, 0x32, 0x1f, 0xd7, 0xf2, 0x85, 0xb7, 0xfb,
+	0xf9, 0xb3, 0x7e, 0xe5, 0x74, 0x5f, 0x49, 0xc9, 0x19, 0xd1, 0x82, 0xbc, 0x98, 0x34, 0x0a, 0x88,
+	0x21, 0x5a, 0x67, 0xe3, 0xc0, 0x77, 0x2f, 0xdd, 0x85, 0x93, 0x6d, 0xab, 0x1c, 0xd1, 0x9f, 0xa1,
+	0xd7, 0x1f, 0x1b, 0x56, 0x7d, 0xa0, 0x38, 0x5e, 0x88, 0x8c, 0x71, 0xc1, 0x0f, 0x58, 0xee, 0x79,
+	0xb9, 0x9f, 0xef, 0x52, 0xb8, 0xb3, 0xf4, 0x17, 0x98, 0x60, 0xd9, 0xee, 0x60, 0xfa, 0x38, 0x98,
+	0xab, 0xb0, 0xd1, 0x13, 0xd0, 0xe7, 0x83, 0x36, 0x74, 0xde, 0xeb, 0x63, 0xec, 0x79, 0x4d, 0x97,
+	0x3e, 0x16, 0x60, 0x22, 0x0c, 0x78, 0x14, 0x8c, 0xc8, 0x0a, 0xa1, 0x8e, 0x81, 0x5d, 0x94, 0x83,
+	0x63, 0x6d, 0xbd, 0x50, 0x82, 0x47, 0x54, 0x84, 0x53, 0xa4, 0x61, 0x35, 0x87, 0xaa, 0x8c, 0xfd,
+	0x10, 0x76, 0x5a, 0x3d, 0xca, 0x49, 0xd2, 0xb0, 0x62, 0xd9, 0x78, 0x8c, 0xba, 0xa5, 0x1a, 0xa6,
+	0x5a, 0x31, 0x71, 0x18, 0xd3, 0x1d, 0xc6, 0x94, 0x02, 0x1b, 0x8f, 0x91, 0xbe, 0x14, 0x40, 0x4c,
+	0xda, 0x18, 0xef, 0xd6, 0x12, 0x40, 0x78, 0x8d, 0x3c, 0x8c, 0xdd, 0x33, 0x03, 0x45, 0x31, 0xd6,
+	0xb1, 0x30, 0x50, 0x69, 0xf1, 0x46, 0xff, 0x81, 0x91, 0x24, 0xf8, 0x5e, 0x8a, 0xb9, 0xf4, 0x14,
+	0xd1, 0x5d, 0xf1, 0x73, 0x38, 0xd1, 0x88, 0xac, 0x4b, 0x57, 0x61, 0x3c, 0x00, 0xbe, 0x6a, 0xbb,
+	0xf4, 0x89, 0x4d, 0x70, 0x86, 0xf3, 0xf8, 0x17, 0xe4, 0xe2, 0x51, 0x7c, 0xb3, 0x7f, 0x83, 0xfe,
+	0xf0, 0xd6, 0xf1, 0xe9, 0x98, 0x88, 0x01, 0x0d, 0xa2, 0x38, 0xae, 0xbe, 0x1a, 0x7f, 0x96, 0x54,
+	0x8e, 0xa7, 0x64, 0x9a, 0x51, 0x3c, 0xb7, 0x01, 0x9a, 0x64, 0xc6, 0x33, 0x4f, 0x17, 0x7c, 0xe6,
+	0x2b, 0x78, 0xcc, 0x57, 0xf0, 0x79, 0x95, 0x33, 0x5f, 0x61, 0x5d, 0xad, 0x06, 0xb1, 0x4a, 0x4b,
+	0xa4, 0xf4, 0x42, 0x80, 0x5c, 0xbc, 0x46, 0x32, 0xfa, 0xee, 0x03, 0xa1, 0x47, 0x77, 0xda, 0x20,
+	0x1e, 0x61, 0x10, 0x2f, 0xbe, 0x13, 0xa2, 0x5f, 0xba, 0x0d, 0xa3, 0xcc, 0x2f, 0xca, 0x3d, 0x5b,
+	0x6f, 0x98, 0x38, 0xc2, 0x48, 0x08, 0x7a, 0x88, 0x6a, 0x61, 0x7e, 0x28, 0xec, 0xb7, 0x74, 0x05,
+	0xc4, 0xa4, 0x00, 0xbe, 0x2b, 0x04, 0x3d, 0xde, 0x95, 0x08, 0x22, 0xbc, 0xdf, 0xd2, 0x2a, 0x4c,
+	0x06, 0x67, 0xb8, 0xe2, 0x31, 0xf4, 0x43, 0x9f, 0xa0, 0x83, 0x22, 0xb3, 0x70, 0xc2, 0x27, 0x6e,
+	0x43, 0xc7, 0x84, 0x1a, 0xff, 0x35, 0x42, 0x06, 0x1c, 0x66, 0xeb, 0x6b, 0xe1, 0xb2, 0x54, 0x83,
+	0xd3, 0xc9, 0x99, 0x78, 0xf5, 0x55, 0x18, 0x6a, 0x7b, 0x07, 0xf0, 0xb3, 0x3b, 0x13, 0xeb, 0x6b,
+	0x6b, 0x34, 0xef, 0xed, 0x20, 0x6e, 0x59, 0x93, 0xce, 0x70, 0xcc, 0x25, 0xd3, 0x4c, 0xc0, 0x1c,
+	0x02, 0x89, 0x99, 0xd3, 0x81, 0x74, 0x1f, 0x0e, 0xc8, 0xbf, 0xe1, 0x6c, 0xb0, 0xe5, 0xfb, 0x78,
+	0x9b, 0xae, 0x7b, 0xab, 0x74, 0xc3, 0x83, 0x41, 0xb4, 0x70, 0x60, 0xcf, 0x00, 0x68, 0x35, 0x95,
+	0x10, 0x6c, 0x36, 0xaf, 0x50, 0x3f, 0x5f, 0x59, 0xd3, 0xd1, 0x38, 0x1c, 0xab, 0xdb, 0x0e, 0x0d,
+	0x5f, 0x1e, 0x4a, 0xaf, 0xf7, 0xb8, 0xa6, 0x4b, 0xb7, 0x40, 0xea, 0x94, 0x9c, 0x6f, 0x46, 0x84,
+	0x3e, 0x97, 0xaf, 0xb1, 0xdc, 0x3d, 0x4a, 0xf8, 0x2c, 0x15, 0x61, 0xcc, 0x6f, 0x84, 0x3f, 0x07,
+	0x21, 0x1b, 0x74, 0xe0, 0x4a, 0x69, 0x1b, 0xa6, 0x92, 0x63, 0xc2, 0x8a, 0x8f, 0x01, 0xc5, 0x5e,
+	0xd3, 0x01, 0x9d, 0x9d, 0x8d, 0xf5, 0x30, 0x9a, 0x87, 0xf7, 0x71, 0x44, 0x8d, 0xe6, 0x97, 0xbe,
+	0x16, 0x78, 0x37, 0x95, 0xf0, 0xdd, 0xae, 0xa8, 0x14, 0xaf, 0x1a, 0x2e, 0xb5, 0x9d, 0x9d, 0xa0,
+	0x9b, 0xe9, 0x74, 0x84, 0xf2, 0x30, 0xe0, 0x52, 0xd5, 0xa1, 0x65, 0x76, 0x46, 0x9c, 0xdc, 0x81,
+	0x2d, 0xb1, 0x93, 0x44, 0x93, 0xd0, 0x8f, 0x89, 0xce, 0xcd, 0x3e, 0x8f, 0xf7, 0x61, 0xa2, 0xfb,
+	0xc6, 0x76, 0x5e, 0xe9, 0x39, 0x34, 0xaf, 0x7c, 0x2f, 0x80, 0xd4, 0x69, 0x1b, 0xe1, 0xcb, 0x7c,
+	0x3c, 0x59, 0xc3, 0x04, 0xad, 0xbc, 0x10, 0x6b, 0x65, 0x7b, 0x42, 0x85, 0x79, 0xf3, 0x76, 0x9e,
+	0x72, 0x12, 0x6c, 0xee, 0x1f, 0x47, 0x44, 0x7f, 0xe5, 0xbc, 0x12, 0x50, 0xde, 0x06, 0x93, 0x51,
+	0x19, 0x5e, 0x11, 0x3f, 0x09, 0x30, 0x99, 0x18, 0xc9, 0xdb, 0xf0, 0x00, 0x4e, 0x44, 0xc5, 0x19,
+	0xe7, 0x85, 0x7c, 0x2a, 0xdf, 0xfa, 0x29, 0xf8, 0xce, 0x8f, 0xd7, 0xda, 0x56, 0x91, 0x01, 0x13,
+	0x0e, 0xb6, 0x54, 0x83, 0x78, 0x2f, 0x4a, 0x97, 0x96, 0xa9, 0xbd, 0x89, 0x49, 0xd9, 0x6d, 0xd4,
+	0xeb, 0xe6, 0x0e, 0x57, 0x67, 0x05, 0x2f, 0xf0, 0xe7, 0xfd, 0xfc, 0x74, 0xd5, 0xa0, 0xb5, 0x46,
+	0xa5, 0xa0, 0xd9, 0x96, 0xcc, 0x95, 0xb3, 0xff, 0xe7, 0xb2, 0xab, 0x6f, 0xca, 0x74, 0xa7, 0x8e,
+	0xdd, 0xc2, 0x1a, 0xa1, 0xca, 0x58, 0x98, 0x70, 0x83, 0x3e, 0xf4, 0xd2, 0x6d, 0xb0, 0x6c, 0x92,
+	0xc6, 0xaf, 0x4a, 0xf3, 0x5c, 0x56, 0x5c, 0x6a, 0x58, 0xac, 0xff, 0xef, 0x1c, 0xd6, 0x69, 0x18,
+	0x0e, 0xd1, 0xa9, 0x96, 0xa7, 0xc0, 0xf8, 0xf5, 0x1f, 0x72, 0xfd, 0x22, 0x25, 0xb6, 0x28, 0xfd,
+	0x26, 0x40, 0x3e, 0xb5, 0x0a, 0x6f, 0xe2, 0x06, 0x0c, 0x79, 0xe7, 0xb4, 0x85, 0x83, 0x4c, 0xc2,
+	0xa1, 0xf6, 0x39, 0xe8, 0x27, 0xf1, 0x0b, 0xa3, 0xab, 0x30, 0xd6, 0xa2, 0x38, 0x18, 0x5f, 0x92,
+	0x86, 0x55, 0xc1, 0x0e, 0xbf, 0x58, 0xa3, 0x4d, 0x11, 0xe1, 0x19, 0xef, 0x33, 0x1b, 0xba, 0x06,
+	0x39, 0xcc, 0xe1, 0xe9, 0x65, 0xcd, 0x54, 0x0d, 0x8b, 0x89, 0x27, 0x6a, 0x58, 0x98, 0xdd, 0xb8,
+	0x7e, 0x65, 0x2c, 0xb4, 0xff, 0x3d, 0x30, 0x3f, 0x34, 0x2c, 0x5c, 0xfc, 0x6a, 0x04, 0x8e, 0xb2,
+	0x8d, 0xa2, 0x67, 0xd0, 0xeb, 0xcb, 0x46, 0x74, 0x2e, 0x36, 0x03, 0x71, 0x6d, 0x2a, 0x9e, 0xef,
+	0xec, 0xe4, 0xf7, 0x48, 0x9a, 0x7b, 0xef, 0x87, 0x5f, 0x3f, 0x38, 0x72, 0x1e, 0x49, 0xf2, 0x06,
+	0xf3, 0x36, 0xd5, 0x8a, 0x2b, 0x27, 0x7f, 0x03, 0xa1, 0x17, 0x02, 0x40, 0x53, 0xbf, 0xa1, 0xb9,
+	0xe4, 0x02, 0x49, 0xea, 0x55, 0xbc, 0x94, 0xc9, 0x97, 0x63, 0x5a, 0x62, 0x98, 0xae, 0xa2, 0x22,
+	0xc7, 0x74, 0xf9, 0x6e, 0x12, 0xa8, 0xa6, 0x0a, 0x94, 0x77, 0x83, 0x69, 0xda, 0x43, 0x1f, 0x09,
+	0xd0, 0x17, 0x5c, 0x08, 0x34, 0x93, 0x5a, 0x35, 0xa2, 0x9e, 0xc4, 0xd9, 0x0c, 0x9e, 0x1c, 0xdd,
+	0x75, 0x86, 0x6e, 0x11, 0x2d, 0x74, 0x44, 0x17, 0xde, 0xde, 0x56, 0x70, 0xef, 0x0b, 0x30, 0x10,
+	0xe4, 0x2b, 0x99, 0x66, 0x1a, 0xbe, 0xb8, 0xba, 0x13, 0x67, 0x33, 0x78, 0x72, 0x7c, 0x05, 0x86,
+	0x6f, 0x06, 0x4d, 0x67, 0xc3, 0x87, 0x3e, 0x15, 0x60, 0xa8, 0x4d, 0x17, 0xa5, 0x1d, 0x6c, 0x92,
+	0xda, 0x12, 0x2f, 0x65, 0xf2, 0x3d, 0xd0, 0xc1, 0x5a, 0x2c, 0x36, 0xf8, 0x28, 0x93, 0x77, 0x3d,
+	0x05, 0xb7, 0x87, 0x3e, 0x14, 0xe0, 0x74, 0xa7, 0xcf, 0x41, 0x74, 0x3d, 0x19, 0x49, 0x86, 0x8f,
+	0x58, 0x71, 0xe9, 0x30, 0xa1, 0x9c, 0x64, 0xbe, 0x10, 0x60, 0xb0, 0x55, 0x10, 0xa1, 0xf9, 0xd4,
+	0x51, 0x4a, 0x10, 0x65, 0xe2, 0xe5, 0x8c, 0xde, 0xbc, 0x83, 0x2b, 0xac, 0x83, 0x37, 0xd1, 0x8d,
+	0x8e, 0x1d, 0x6c, 0x93, 0x71, 0xf2, 0x6e, 0x54, 0xa9, 0xee, 0xa1, 0x4f, 0x04, 0x18, 0x6e, 0xcd,
+	0xef, 0x0d, 0xe3, 0x7c, 0xea, 0x88, 0x1d, 0x00, 0x77, 0x8a, 0xb6, 0x94, 0x8a, 0x0c, 0xf7, 0x3c,
+	0x9a, 0xcb, 0x8e, 0x1b, 0x7d, 0x27, 0x00, 0x8a, 0x2b, 0x3c, 0x54, 0x4c, 0xed, 0x58, 0xaa, 0xd6,
+	0x14, 0x17, 0x0f, 0x14, 0xc3, 0x31, 0xaf, 0x33, 0xcc, 0xff, 0x40, 0xab, 0x1d, 0x31, 0x13, 0xbc,
+	0x4d, 0xcb, 0x75, 0x96, 0xa1, 0x1c, 0x28, 0x4c, 0x79, 0x97, 0xeb, 0x58, 0xef, 0xd6, 0xcb, 0xbb,
+	0x5c, 0xc7, 0xee, 0xa1, 0xcf, 0x04, 0x18, 0x89, 0x8b, 0xce, 0x8b, 0x29, 0xad, 0x8c, 0x3a, 0x8a,
+	0x72, 0x46, 0xc7, 0x03, 0x52, 0x55, 0x53, 0xad, 0xca, 0xbb, 0xfc, 0xd2, 0xed, 0xa1, 0x6f, 0x04,
+	0x38, 0x95, 0xa8, 0xd4, 0xd2, 0xfa, 0xdf, 0x49, 0x9d, 0x8a, 0x8b, 0x07, 0x8a, 0xe1, 0xe8, 0xef,
+	0x30, 0xf4, 0x25, 0x74, 0xb3, 0x23, 0xfa, 0xa8, 0x5a, 0xac, 0xf9, 0x59, 0x5a, 0x69, 0xf7, 0x73,
+	0x01, 0x8e, 0xb7, 0x8b, 0x24, 0x94, 0x42, 0x5b, 0x89, 0x3a, 0x4e, 0x9c, 0xcf, 0xe6, 0xcc, 0x61,
+	0xdf, 0x62, 0xb0, 0x97, 0xd0, 0xb5, 0x6c, 0xfc, 0xcb, 0xd5, 0x5d, 0x2b, 0xde, 0x6f, 0x05, 0x40,
+	0x71, 0x59, 0x83, 0xe4, 0x77, 0x35, 0x31, 0x22, 0xb3, 0xc4, 0x2b, 0xd9, 0x03, 0x38, 0xf6, 0x47,
+	0x0c, 0xfb, 0x03, 0x74, 0x2f, 0x6b, 0xcb, 0x03, 0xd1, 0xd2, 0x02, 0x5f, 0xde, 0x8d, 0x08, 0xb8,
+	0xbd, 0xe5, 0xbb, 0x2f, 0x5f, 0x4f, 0x09, 0xaf, 0x5e, 0x4f, 0x09, 0xbf, 0xbc, 0x9e, 0x12, 0x9e,
+	0xbf, 0x99, 0xea, 0x7a, 0xf5, 0x66, 0xaa, 0xeb, 0xc7, 0x37, 0x53, 0x5d, 0x4f, 0x8a, 0x2d, 0x1a,
+	0x2c, 0xa1, 0xe4, 0xd6, 0xc2, 0x15, 0x79, 0xbb, 0x59, 0x98, 0x69, 0xb2, 0x4a, 0x2f, 0xfb, 0x2f,
+	0xe5, 0xe2, 0xef, 0x03, 0x00, 0x20, 0x92, 0x1d, 0xb5, 0x39, 0x16, 0x00, 0x00,
 }
 
-func (m *QueryInterchainAccountFromAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a Validator by host zone.
+	Validators(ctx context.Context, in *QueryGetValidatorsRequest, opts ...grpc.CallOption) (*QueryGetValidatorsResponse, error)
+	// Queries a HostZone by id.
+	HostZone(ctx context.Context, in *QueryGetHostZoneRequest, opts ...grpc.CallOption) (*QueryGetHostZoneResponse, error)
+	// Queries a list of HostZone items.
+	HostZoneAll(ctx context.Context, in *QueryAllHostZoneRequest, opts ...grpc.CallOption) (*QueryAllHostZoneResponse, error)
+	// Queries a list of ModuleAddress items.
+	ModuleAddress(ctx context.Context, in *QueryModuleAddressRequest, opts ...grpc.CallOption) (*QueryModuleAddressResponse, error)
+	// QueryInterchainAccountFromAddress returns the interchain account for given
+	// owner address on a given connection pair
+	InterchainAccountFromAddress(ctx context.Context, in *QueryInterchainAccountFromAddressRequest, opts ...grpc.CallOption) (*QueryInterchainAccountFromAddressResponse, error)
+	// Queries a EpochTracker by index.
+	EpochTracker(ctx context.Context, in *QueryGetEpochTrackerRequest, opts ...grpc.CallOption) (*QueryGetEpochTrackerResponse, error)
+	// Queries a list of EpochTracker items.
+	EpochTrackerAll(ctx context.Context, in *QueryAllEpochTrackerRequest, opts ...grpc.CallOption) (*QueryAllEpochTrackerResponse, error)
+	// Queries the next packet sequence for one for a given channel
+	NextPacketSequence(ctx context.Context, in *QueryGetNextPacketSequenceRequest, opts ...grpc.CallOption) (*QueryGetNextPacketSequenceResponse, error)
+	// Queries an address's unbondings
+	AddressUnbondings(ctx context.Context, in *QueryAddressUnbondings, opts ...grpc.CallOption) (*QueryAddressUnbondingsResponse, error)
+	// Queries the redemption rate history of a host zone, optionally bounded
+	// by an epoch range
+	RedemptionRateHistory(ctx context.Context, in *QueryRedemptionRateHistoryRequest, opts ...grpc.CallOption) (*QueryRedemptionRateHistoryResponse, error)
+	// Queries the progress of a host zone that is being sunset
+	HostZoneSunset(ctx context.Context, in *QueryHostZoneSunsetRequest, opts ...grpc.CallOption) (*QueryHostZoneSunsetResponse, error)
+	// Estimates the native amount and unbonding completion time of a
+	// redemption before it's submitted
+	RedemptionEstimate(ctx context.Context, in *QueryRedemptionEstimateRequest, opts ...grpc.CallOption) (*QueryRedemptionEstimateResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryInterchainAccountFromAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Validators(ctx context.Context, in *QueryGetValidatorsRequest, opts ...grpc.CallOption) (*QueryGetValidatorsResponse, error) {
+	out := new(QueryGetValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/Validators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryInterchainAccountFromAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) HostZone(ctx context.Context, in *QueryGetHostZoneRequest, opts ...grpc.CallOption) (*QueryGetHostZoneResponse, error) {
+	out := new(QueryGetHostZoneResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/HostZone", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryInterchainAccountFromAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) HostZoneAll(ctx context.Context, in *QueryAllHostZoneRequest, opts ...grpc.CallOption) (*QueryAllHostZoneResponse, error) {
+	out := new(QueryAllHostZoneResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/HostZoneAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryInterchainAccountFromAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ModuleAddress(ctx context.Context, in *QueryModuleAddressRequest, opts ...grpc.CallOption) (*QueryModuleAddressResponse, error) {
+	out := new(QueryModuleAddressResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/ModuleAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryInterchainAccountFromAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InterchainAccountAddress) > 0 {
-		i -= len(m.InterchainAccountAddress)
-		copy(dAtA[i:], m.InterchainAccountAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterchainAccountAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) InterchainAccountFromAddress(ctx context.Context, in *QueryInterchainAccountFromAddressRequest, opts ...grpc.CallOption) (*QueryInterchainAccountFromAddressResponse, error) {
+	out := new(QueryInterchainAccountFromAddressResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/InterchainAccountFromAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EpochTracker(ctx context.Context, in *QueryGetEpochTrackerRequest, opts ...grpc.CallOption) (*QueryGetEpochTrackerResponse, error) {
+	out := new(QueryGetEpochTrackerResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/EpochTracker", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EpochTrackerAll(ctx context.Context, in *QueryAllEpochTrackerRequest, opts ...grpc.CallOption) (*QueryAllEpochTrackerResponse, error) {
+	out := new(QueryAllEpochTrackerResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/EpochTrackerAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) NextPacketSequence(ctx context.Context, in *QueryGetNextPacketSequenceRequest, opts ...grpc.CallOption) (*QueryGetNextPacketSequenceResponse, error) {
+	out := new(QueryGetNextPacketSequenceResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/NextPacketSequence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AddressUnbondings(ctx context.Context, in *QueryAddressUnbondings, opts ...grpc.CallOption) (*QueryAddressUnbondingsResponse, error) {
+	out := new(QueryAddressUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/AddressUnbondings", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RedemptionRateHistory(ctx context.Context, in *QueryRedemptionRateHistoryRequest, opts ...grpc.CallOption) (*QueryRedemptionRateHistoryResponse, error) {
+	out := new(QueryRedemptionRateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/RedemptionRateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) HostZoneSunset(ctx context.Context, in *QueryHostZoneSunsetRequest, opts ...grpc.CallOption) (*QueryHostZoneSunsetResponse, error) {
+	out := new(QueryHostZoneSunsetResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/HostZoneSunset", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RedemptionEstimate(ctx context.Context, in *QueryRedemptionEstimateRequest, opts ...grpc.CallOption) (*QueryRedemptionEstimateResponse, error) {
+	out := new(QueryRedemptionEstimateResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/RedemptionEstimate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a Validator by host zone.
+	Validators(context.Context, *QueryGetValidatorsRequest) (*QueryGetValidatorsResponse, error)
+	// Queries a HostZone by id.
+	HostZone(context.Context, *QueryGetHostZoneRequest) (*QueryGetHostZoneResponse, error)
+	// Queries a list of HostZone items.
+	HostZoneAll(context.Context, *QueryAllHostZoneRequest) (*QueryAllHostZoneResponse, error)
+	// Queries a list of ModuleAddress items.
+	ModuleAddress(context.Context, *QueryModuleAddressRequest) (*QueryModuleAddressResponse, error)
+	// QueryInterchainAccountFromAddress returns the interchain account for given
+	// owner address on a given connection pair
+	InterchainAccountFromAddress(context.Context, *QueryInterchainAccountFromAddressRequest) (*QueryInterchainAccountFromAddressResponse, error)
+	// Queries a EpochTracker by index.
+	EpochTracker(context.Context, *QueryGetEpochTrackerRequest) (*QueryGetEpochTrackerResponse, error)
+	// Queries a list of EpochTracker items.
+	EpochTrackerAll(context.Context, *QueryAllEpochTrackerRequest) (*QueryAllEpochTrackerResponse, error)
+	// Queries the next packet sequence for one for a given channel
+	NextPacketSequence(context.Context, *QueryGetNextPacketSequenceRequest) (*QueryGetNextPacketSequenceResponse, error)
+	// Queries an address's unbondings
+	AddressUnbondings(context.Context, *QueryAddressUnbondings) (*QueryAddressUnbondingsResponse, error)
+	// Queries the redemption rate history of a host zone, optionally bounded
+	// by an epoch range
+	RedemptionRateHistory(context.Context, *QueryRedemptionRateHistoryRequest) (*QueryRedemptionRateHistoryResponse, error)
+	// Queries the progress of a host zone that is being sunset
+	HostZoneSunset(context.Context, *QueryHostZoneSunsetRequest) (*QueryHostZoneSunsetResponse, error)
+	// Estimates the native amount and unbonding completion time of a
+	// redemption before it's submitted
+	RedemptionEstimate(context.Context, *QueryRedemptionEstimateRequest) (*QueryRedemptionEstimateResponse, error)
 }
 
-func (m *QueryGetValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryGetValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryGetValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Validators(ctx context.Context, req *QueryGetValidatorsRequest) (*QueryGetValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
 }
-
-func (m *QueryGetValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) HostZone(ctx context.Context, req *QueryGetHostZoneRequest) (*QueryGetHostZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostZone not implemented")
 }
-
-func (m *QueryGetHostZoneRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) HostZoneAll(ctx context.Context, req *QueryAllHostZoneRequest) (*QueryAllHostZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostZoneAll not implemented")
+}
+func (*UnimplementedQueryServer) ModuleAddress(ctx context.Context, req *QueryModuleAddressRequest) (*QueryModuleAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAddress not implemented")
+}
+func (*UnimplementedQueryServer) InterchainAccountFromAddress(ctx context.Context, req *QueryInterchainAccountFromAddressRequest) (*QueryInterchainAccountFromAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccountFromAddress not implemented")
+}
+func (*UnimplementedQueryServer) EpochTracker(ctx context.Context, req *QueryGetEpochTrackerRequest) (*QueryGetEpochTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochTracker not implemented")
+}
+func (*UnimplementedQueryServer) EpochTrackerAll(ctx context.Context, req *QueryAllEpochTrackerRequest) (*QueryAllEpochTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochTrackerAll not implemented")
+}
+func (*UnimplementedQueryServer) NextPacketSequence(ctx context.Context, req *QueryGetNextPacketSequenceRequest) (*QueryGetNextPacketSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextPacketSequence not implemented")
+}
+func (*UnimplementedQueryServer) AddressUnbondings(ctx context.Context, req *QueryAddressUnbondings) (*QueryAddressUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) RedemptionRateHistory(ctx context.Context, req *QueryRedemptionRateHistoryRequest) (*QueryRedemptionRateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionRateHistory not implemented")
+}
+func (*UnimplementedQueryServer) HostZoneSunset(ctx context.Context, req *QueryHostZoneSunsetRequest) (*QueryHostZoneSunsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostZoneSunset not implemented")
+}
+func (*UnimplementedQueryServer) RedemptionEstimate(ctx context.Context, req *QueryRedemptionEstimateRequest) (*QueryRedemptionEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionEstimate not implemented")
 }
 
-func (m *QueryGetHostZoneRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryGetHostZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetHostZoneResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetValidatorsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Validators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/Validators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Validators(ctx, req.(*QueryGetValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_HostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetHostZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/HostZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostZone(ctx, req.(*QueryGetHostZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.HostZone.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_HostZoneAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllHostZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).HostZoneAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/HostZoneAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostZoneAll(ctx, req.(*QueryAllHostZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllHostZoneRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ModuleAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAddressRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/ModuleAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAddress(ctx, req.(*QueryModuleAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllHostZoneRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_InterchainAccountFromAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountFromAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccountFromAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/InterchainAccountFromAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccountFromAddress(ctx, req.(*QueryInterchainAccountFromAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllHostZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_EpochTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetEpochTrackerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).EpochTracker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/EpochTracker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochTracker(ctx, req.(*QueryGetEpochTrackerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllHostZoneResponse) Marshal() (dAtA []byte, err error) {
+func _Query_EpochTrackerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllEpochTrackerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochTrackerAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/EpochTrackerAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochTrackerAll(ctx, req.(*QueryAllEpochTrackerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextPacketSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetNextPacketSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextPacketSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/NextPacketSequence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextPacketSequence(ctx, req.(*QueryGetNextPacketSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressUnbondings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/AddressUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressUnbondings(ctx, req.(*QueryAddressUnbondings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedemptionRateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionRateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionRateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/RedemptionRateHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionRateHistory(ctx, req.(*QueryRedemptionRateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostZoneSunset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostZoneSunsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostZoneSunset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/HostZoneSunset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostZoneSunset(ctx, req.(*QueryHostZoneSunsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedemptionEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionEstimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/RedemptionEstimate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionEstimate(ctx, req.(*QueryRedemptionEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "stride.stakeibc.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Validators",
+			Handler:    _Query_Validators_Handler,
+		},
+		{
+			MethodName: "HostZone",
+			Handler:    _Query_HostZone_Handler,
+		},
+		{
+			MethodName: "HostZoneAll",
+			Handler:    _Query_HostZoneAll_Handler,
+		},
+		{
+			MethodName: "ModuleAddress",
+			Handler:    _Query_ModuleAddress_Handler,
+		},
+		{
+			MethodName: "InterchainAccountFromAddress",
+			Handler:    _Query_InterchainAccountFromAddress_Handler,
+		},
+		{
+			MethodName: "EpochTracker",
+			Handler:    _Query_EpochTracker_Handler,
+		},
+		{
+			MethodName: "EpochTrackerAll",
+			Handler:    _Query_EpochTrackerAll_Handler,
+		},
+		{
+			MethodName: "NextPacketSequence",
+			Handler:    _Query_NextPacketSequence_Handler,
+		},
+		{
+			MethodName: "AddressUnbondings",
+			Handler:    _Query_AddressUnbondings_Handler,
+		},
+		{
+			MethodName: "RedemptionRateHistory",
+			Handler:    _Query_RedemptionRateHistory_Handler,
+		},
+		{
+			MethodName: "HostZoneSunset",
+			Handler:    _Query_HostZoneSunset_Handler,
+		},
+		{
+			MethodName: "RedemptionEstimate",
+			Handler:    _Query_RedemptionEstimate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stride/stakeibc/query.proto",
+}
+
+func (m *QueryInterchainAccountFromAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1757,46 +2017,34 @@ func (m *QueryAllHostZoneResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryInterchainAccountFromAddressRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryInterchainAccountFromAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.HostZone) > 0 {
-		for iNdEx := len(m.HostZone) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HostZone[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleAddressRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryInterchainAccountFromAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1806,27 +2054,27 @@ func (m *QueryModuleAddressRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryModuleAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryInterchainAccountFromAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryModuleAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryInterchainAccountFromAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+	if len(m.InterchainAccountAddress) > 0 {
+		i -= len(m.InterchainAccountAddress)
+		copy(dAtA[i:], m.InterchainAccountAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterchainAccountAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1836,27 +2084,20 @@ func (m *QueryModuleAddressResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryModuleAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryModuleAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetEpochTrackerRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1866,27 +2107,30 @@ func (m *QueryGetEpochTrackerRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetEpochTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetEpochTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EpochIdentifier) > 0 {
-		i -= len(m.EpochIdentifier)
-		copy(dAtA[i:], m.EpochIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochIdentifier)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetEpochTrackerResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetValidatorsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1896,30 +2140,27 @@ func (m *QueryGetEpochTrackerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetEpochTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetEpochTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.EpochTracker.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllEpochTrackerRequest) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorUnbondingEntries) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1929,20 +2170,37 @@ func (m *QueryAllEpochTrackerRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllEpochTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorUnbondingEntries) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllEpochTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorUnbondingEntries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.NumAvailableEntries != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumAvailableEntries))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumUnbondingEntries != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumUnbondingEntries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllEpochTrackerResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetValidatorsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1952,20 +2210,34 @@ func (m *QueryAllEpochTrackerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllEpochTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllEpochTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EpochTracker) > 0 {
-		for iNdEx := len(m.EpochTracker) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.UnbondingEntries) > 0 {
+		for iNdEx := len(m.UnbondingEntries) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.EpochTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.UnbondingEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1979,7 +2251,7 @@ func (m *QueryAllEpochTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetNextPacketSequenceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetHostZoneRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1989,34 +2261,27 @@ func (m *QueryGetNextPacketSequenceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetNextPacketSequenceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetHostZoneRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetNextPacketSequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetHostZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetNextPacketSequenceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetHostZoneResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2026,25 +2291,30 @@ func (m *QueryGetNextPacketSequenceResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetNextPacketSequenceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetNextPacketSequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x8
+	{
+		size, err := m.HostZone.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAddressUnbondings) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllHostZoneRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2054,27 +2324,32 @@ func (m *QueryAddressUnbondings) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAddressUnbondings) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllHostZoneRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAddressUnbondings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllHostZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAddressUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllHostZoneResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2084,20 +2359,32 @@ func (m *QueryAddressUnbondingsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAddressUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAddressUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AddressUnbondings) > 0 {
-		for iNdEx := len(m.AddressUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HostZone) > 0 {
+		for iNdEx := len(m.HostZone) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AddressUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.HostZone[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2111,289 +2398,1719 @@ func (m *QueryAddressUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryModuleAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryInterchainAccountFromAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryModuleAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInterchainAccountFromAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryModuleAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.InterchainAccountAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryModuleAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryModuleAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryModuleAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryGetEpochTrackerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetHostZoneRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetEpochTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEpochTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetHostZoneResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetEpochTrackerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.HostZone.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllHostZoneRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetEpochTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllHostZoneResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetEpochTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.HostZone) > 0 {
-		for _, e := range m.HostZone {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.EpochTracker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllEpochTrackerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryModuleAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllEpochTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetEpochTrackerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllEpochTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EpochIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetEpochTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllEpochTrackerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.EpochTracker.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllEpochTrackerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryAllEpochTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllEpochTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllEpochTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.EpochTracker) > 0 {
-		for _, e := range m.EpochTracker {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.EpochTracker) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetNextPacketSequenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetNextPacketSequenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNextPacketSequenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNextPacketSequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetNextPacketSequenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetNextPacketSequenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNextPacketSequenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNextPacketSequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAddressUnbondings) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAddressUnbondings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressUnbondings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressUnbondings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAddressUnbondingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAddressUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.AddressUnbondings) > 0 {
-		for _, e := range m.AddressUnbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.AddressUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryRedemptionRateHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryRedemptionRateHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRateHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionRateHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionRateHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRateHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RedemptionRateRecords) > 0 {
+		for iNdEx := len(m.RedemptionRateRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionRateRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostZoneSunsetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostZoneSunsetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostZoneSunsetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostZoneSunsetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostZoneSunsetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostZoneSunsetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingStTokenSupply.Size()
+		i -= size
+		if _, err := m.RemainingStTokenSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.HostZoneSunset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionEstimateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StTokenAmount) > 0 {
+		i -= len(m.StTokenAmount)
+		copy(dAtA[i:], m.StTokenAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StTokenAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionEstimateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EstimatedClaimableTime) > 0 {
+		i -= len(m.EstimatedClaimableTime)
+		copy(dAtA[i:], m.EstimatedClaimableTime)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EstimatedClaimableTime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UnbondingEpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingEpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.NativeAmount.Size()
+		i -= size
+		if _, err := m.NativeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryInterchainAccountFromAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountFromAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InterchainAccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorUnbondingEntries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumUnbondingEntries != 0 {
+		n += 1 + sovQuery(uint64(m.NumUnbondingEntries))
+	}
+	if m.NumAvailableEntries != 0 {
+		n += 1 + sovQuery(uint64(m.NumAvailableEntries))
+	}
+	return n
+}
+
+func (m *QueryGetValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnbondingEntries) > 0 {
+		for _, e := range m.UnbondingEntries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetHostZoneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetHostZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostZone.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllHostZoneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllHostZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HostZone) > 0 {
+		for _, e := range m.HostZone {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetEpochTrackerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetEpochTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochTracker.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllEpochTrackerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllEpochTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochTracker) > 0 {
+		for _, e := range m.EpochTracker {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetNextPacketSequenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNextPacketSequenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryAddressUnbondings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AddressUnbondings) > 0 {
+		for _, e := range m.AddressUnbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRedemptionRateHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EndEpoch))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionRateHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RedemptionRateRecords) > 0 {
+		for _, e := range m.RedemptionRateRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostZoneSunsetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostZoneSunsetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostZoneSunset.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingStTokenSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRedemptionEstimateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StTokenAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionEstimateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NativeAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.UnbondingEpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingEpochNumber))
+	}
+	l = len(m.EstimatedClaimableTime)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountFromAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountFromAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountFromAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountFromAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterchainAccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorUnbondingEntries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorUnbondingEntries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorUnbondingEntries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumUnbondingEntries", wireType)
+			}
+			m.NumUnbondingEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumUnbondingEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumAvailableEntries", wireType)
+			}
+			m.NumAvailableEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumAvailableEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingEntries = append(m.UnbondingEntries, ValidatorUnbondingEntries{})
+			if err := m.UnbondingEntries[len(m.UnbondingEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetHostZoneRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2416,17 +4133,17 @@ func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterchainAccountFromAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetHostZoneResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterchainAccountFromAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2436,55 +4153,24 @@ func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.HostZone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2507,7 +4193,7 @@ func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2530,17 +4216,17 @@ func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterchainAccountFromAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllHostZoneRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterchainAccountFromAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2550,23 +4236,27 @@ func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterchainAccountAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2589,7 +4279,7 @@ func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2612,12 +4302,82 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllHostZoneResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZone = append(m.HostZone, HostZone{})
+			if err := m.HostZone[len(m.HostZone)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2639,7 +4399,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2662,17 +4422,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2682,24 +4442,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2722,7 +4481,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2745,15 +4504,15 @@ func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2781,7 +4540,7 @@ func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2804,7 +4563,7 @@ func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2827,17 +4586,17 @@ func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEpochTrackerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEpochTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2847,25 +4606,23 @@ func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2888,7 +4645,7 @@ func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2911,17 +4668,17 @@ func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetHostZoneRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetEpochTrackerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetEpochTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTracker", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2931,24 +4688,75 @@ func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEpochTrackerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEpochTrackerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEpochTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2970,7 +4778,7 @@ func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2993,15 +4801,15 @@ func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetHostZoneResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllEpochTrackerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllEpochTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTracker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3028,7 +4836,8 @@ func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HostZone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EpochTracker = append(m.EpochTracker, EpochTracker{})
+			if err := m.EpochTracker[len(m.EpochTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3053,7 +4862,7 @@ func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3076,17 +4885,17 @@ func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllHostZoneRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetNextPacketSequenceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetNextPacketSequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3096,27 +4905,55 @@ func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3139,7 +4976,7 @@ func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetNextPacketSequenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3162,51 +4999,17 @@ func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllHostZoneResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetNextPacketSequenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetNextPacketSequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostZone = append(m.HostZone, HostZone{})
-			if err := m.HostZone[len(m.HostZone)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var msglen int
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3216,28 +5019,11 @@ func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3259,7 +5045,7 @@ func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAddressUnbondings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3282,15 +5068,15 @@ func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAddressUnbondings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAddressUnbondings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3318,7 +5104,7 @@ func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3341,7 +5127,7 @@ func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAddressUnbondingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3364,17 +5150,17 @@ func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAddressUnbondingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAddressUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressUnbondings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3384,23 +5170,25 @@ func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.AddressUnbondings = append(m.AddressUnbondings, AddressUnbonding{})
+			if err := m.AddressUnbondings[len(m.AddressUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3423,7 +5211,7 @@ func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRedemptionRateHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3446,15 +5234,15 @@ func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochTrackerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedemptionRateHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedemptionRateHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3475,14 +5263,88 @@ func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3505,7 +5367,7 @@ func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRedemptionRateHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3528,15 +5390,15 @@ func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochTrackerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedemptionRateHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedemptionRateHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3563,60 +5425,47 @@ func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RedemptionRateRecords = append(m.RedemptionRateRecords, RedemptionRateRecord{})
+			if err := m.RedemptionRateRecords[len(m.RedemptionRateRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllEpochTrackerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochTrackerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3638,7 +5487,7 @@ func (m *QueryAllEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHostZoneSunsetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3661,17 +5510,17 @@ func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochTrackerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostZoneSunsetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostZoneSunsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3681,25 +5530,23 @@ func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochTracker = append(m.EpochTracker, EpochTracker{})
-			if err := m.EpochTracker[len(m.EpochTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3722,7 +5569,7 @@ func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHostZoneSunsetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3745,17 +5592,17 @@ func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetNextPacketSequenceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHostZoneSunsetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetNextPacketSequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHostZoneSunsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneSunset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3765,27 +5612,28 @@ func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if err := m.HostZoneSunset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingStTokenSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3813,7 +5661,9 @@ func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.RemainingStTokenSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3836,7 +5686,7 @@ func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetNextPacketSequenceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRedemptionEstimateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3859,17 +5709,17 @@ func (m *QueryGetNextPacketSequenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetNextPacketSequenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedemptionEstimateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetNextPacketSequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedemptionEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.Sequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3879,64 +5729,27 @@ func (m *QueryGetNextPacketSequenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAddressUnbondings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressUnbondings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressUnbondings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StTokenAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3964,7 +5777,7 @@ func (m *QueryAddressUnbondings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.StTokenAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3987,7 +5800,7 @@ func (m *QueryAddressUnbondings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressUnbondingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRedemptionEstimateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4010,17 +5823,17 @@ func (m *QueryAddressUnbondingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressUnbondingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedemptionEstimateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedemptionEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressUnbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4030,26 +5843,77 @@ func (m *QueryAddressUnbondingsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressUnbondings = append(m.AddressUnbondings, AddressUnbonding{})
-			if err := m.AddressUnbondings[len(m.AddressUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NativeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEpochNumber", wireType)
+			}
+			m.UnbondingEpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedClaimableTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EstimatedClaimableTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
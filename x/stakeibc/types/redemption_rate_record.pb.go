@@ -0,0 +1,681 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/redemption_rate_record.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Snapshot of a host zone's redemption rate and its components,
+// recorded each time the redemption rate is updated
+type RedemptionRateRecord struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// stride epoch number at which the redemption rate was calculated
+	EpochNumber uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	BlockHeight int64  `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// unix timestamp (in seconds) of the block
+	BlockTime            int64                                  `protobuf:"varint,4,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	RedemptionRate       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=redemption_rate,json=redemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redemption_rate"`
+	UndelegatedBalance   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=undelegated_balance,json=undelegatedBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"undelegated_balance"`
+	StakedBalance        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=staked_balance,json=stakedBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"staked_balance"`
+	ModuleAccountBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=module_account_balance,json=moduleAccountBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"module_account_balance"`
+	StTokenSupply        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=st_token_supply,json=stTokenSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"st_token_supply"`
+}
+
+func (m *RedemptionRateRecord) Reset()         { *m = RedemptionRateRecord{} }
+func (m *RedemptionRateRecord) String() string { return proto.CompactTextString(m) }
+func (*RedemptionRateRecord) ProtoMessage()    {}
+func (*RedemptionRateRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b10edb8ecb4bacfd, []int{0}
+}
+func (m *RedemptionRateRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionRateRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionRateRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedemptionRateRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionRateRecord.Merge(m, src)
+}
+func (m *RedemptionRateRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionRateRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionRateRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionRateRecord proto.InternalMessageInfo
+
+func (m *RedemptionRateRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *RedemptionRateRecord) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *RedemptionRateRecord) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *RedemptionRateRecord) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RedemptionRateRecord)(nil), "stride.stakeibc.RedemptionRateRecord")
+}
+
+func init() {
+	proto.RegisterFile("stride/stakeibc/redemption_rate_record.proto", fileDescriptor_b10edb8ecb4bacfd)
+}
+
+var fileDescriptor_b10edb8ecb4bacfd = []byte{
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x56, 0xb6, 0xd5, 0x83, 0x55, 0x32, 0x15, 0xca, 0x26, 0x91, 0x15, 0x0e, 0xa8,
+	0x07, 0x9a, 0xf0, 0xe7, 0xca, 0x85, 0x6a, 0x07, 0x26, 0x4d, 0x1c, 0xbc, 0xc1, 0x81, 0x8b, 0xe5,
+	0xd8, 0xaf, 0x12, 0xab, 0x89, 0x1d, 0xd9, 0x0e, 0x62, 0xdf, 0x82, 0x0f, 0xc3, 0x87, 0xd8, 0x71,
+	0xe2, 0x84, 0x38, 0x4c, 0xa8, 0xfd, 0x08, 0x7c, 0x01, 0x54, 0xbb, 0xdd, 0x0a, 0xb7, 0xf5, 0x94,
+	0xf8, 0x79, 0x1f, 0xfd, 0x1e, 0x3f, 0xb2, 0x8d, 0x5e, 0x58, 0x67, 0xa4, 0x80, 0xcc, 0x3a, 0x36,
+	0x05, 0x99, 0xf3, 0xcc, 0x80, 0x80, 0xba, 0x71, 0x52, 0x2b, 0x6a, 0x98, 0x03, 0x6a, 0x80, 0x6b,
+	0x23, 0xd2, 0xc6, 0x68, 0xa7, 0x71, 0x3f, 0xb8, 0xd3, 0x95, 0xfb, 0x70, 0x50, 0xe8, 0x42, 0xfb,
+	0x59, 0xb6, 0xf8, 0x0b, 0xb6, 0xc3, 0x03, 0xae, 0x6d, 0xad, 0x2d, 0x0d, 0x83, 0xb0, 0x08, 0xa3,
+	0x67, 0x7f, 0xba, 0x68, 0x40, 0x6e, 0x22, 0x08, 0x73, 0x40, 0x7c, 0x00, 0x3e, 0x40, 0xbb, 0xbc,
+	0x64, 0x52, 0x51, 0x29, 0xe2, 0x68, 0x18, 0x8d, 0x7a, 0x64, 0xc7, 0xaf, 0x4f, 0x04, 0x7e, 0x8a,
+	0x1e, 0x40, 0xa3, 0x79, 0x49, 0x55, 0x5b, 0xe7, 0x60, 0xe2, 0x7b, 0xc3, 0x68, 0xd4, 0x25, 0x7b,
+	0x5e, 0xfb, 0xe0, 0xa5, 0x85, 0x25, 0xaf, 0x34, 0x9f, 0xd2, 0x12, 0x64, 0x51, 0xba, 0x78, 0x6b,
+	0x18, 0x8d, 0xb6, 0xc8, 0x9e, 0xd7, 0xde, 0x7b, 0x09, 0x3f, 0x41, 0x28, 0x58, 0x9c, 0xac, 0x21,
+	0xee, 0x7a, 0x43, 0xcf, 0x2b, 0xe7, 0xb2, 0x06, 0x0c, 0xa8, 0xff, 0x5f, 0xf5, 0xf8, 0xfe, 0x62,
+	0x1b, 0x93, 0xb7, 0x97, 0xd7, 0x47, 0x9d, 0x5f, 0xd7, 0x47, 0xcf, 0x0b, 0xe9, 0xca, 0x36, 0x4f,
+	0xb9, 0xae, 0x97, 0x95, 0x96, 0x9f, 0xb1, 0x15, 0xd3, 0xcc, 0x5d, 0x34, 0x60, 0xd3, 0x63, 0xe0,
+	0x3f, 0xbe, 0x8f, 0xd1, 0xb2, 0xf1, 0x31, 0x70, 0xb2, 0x6f, 0xfe, 0x29, 0x8b, 0x29, 0x7a, 0xd4,
+	0x2a, 0x01, 0x15, 0x14, 0xcc, 0x81, 0xa0, 0x39, 0xab, 0x98, 0xe2, 0x10, 0x6f, 0xfb, 0xa8, 0xf4,
+	0x0e, 0x51, 0x27, 0xca, 0x11, 0xbc, 0x86, 0x9a, 0x04, 0x12, 0xfe, 0x88, 0xf6, 0xfd, 0xe9, 0xdc,
+	0xb2, 0x77, 0x36, 0x62, 0x3f, 0x0c, 0x94, 0x15, 0x56, 0xa0, 0xc7, 0xb5, 0x16, 0x6d, 0x05, 0x94,
+	0x71, 0xae, 0x5b, 0xe5, 0x6e, 0xf0, 0xbb, 0x1b, 0xe1, 0x07, 0x81, 0xf6, 0x2e, 0xc0, 0x56, 0x29,
+	0x9f, 0x50, 0xdf, 0x3a, 0xea, 0xf4, 0x14, 0x14, 0xb5, 0x6d, 0xd3, 0x54, 0x17, 0x71, 0x6f, 0xd3,
+	0xdd, 0x9f, 0x2f, 0x28, 0x67, 0x1e, 0x32, 0x39, 0xbd, 0x9c, 0x25, 0xd1, 0xd5, 0x2c, 0x89, 0x7e,
+	0xcf, 0x92, 0xe8, 0xdb, 0x3c, 0xe9, 0x5c, 0xcd, 0x93, 0xce, 0xcf, 0x79, 0xd2, 0xf9, 0xfc, 0x7a,
+	0x0d, 0x78, 0xe6, 0x2f, 0xf7, 0xf8, 0x94, 0xe5, 0x36, 0x5b, 0x3e, 0x8b, 0x2f, 0xaf, 0x5e, 0x66,
+	0x5f, 0x6f, 0x1f, 0x87, 0x0f, 0xc8, 0xb7, 0xfd, 0x55, 0x7e, 0xf3, 0x77, 0x00, 0x40, 0x29, 0x53,
+	0xa6, 0x3c, 0x03, 0x00, 0x00,
+}
+
+func (m *RedemptionRateRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionRateRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionRateRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StTokenSupply.Size()
+		i -= size
+		if _, err := m.StTokenSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedemptionRateRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ModuleAccountBalance.Size()
+		i -= size
+		if _, err := m.ModuleAccountBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedemptionRateRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.StakedBalance.Size()
+		i -= size
+		if _, err := m.StakedBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedemptionRateRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.UndelegatedBalance.Size()
+		i -= size
+		if _, err := m.UndelegatedBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedemptionRateRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RedemptionRate.Size()
+		i -= size
+		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedemptionRateRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.BlockTime != 0 {
+		i = encodeVarintRedemptionRateRecord(dAtA, i, uint64(m.BlockTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintRedemptionRateRecord(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintRedemptionRateRecord(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRedemptionRateRecord(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRedemptionRateRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRedemptionRateRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RedemptionRateRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRedemptionRateRecord(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovRedemptionRateRecord(uint64(m.EpochNumber))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovRedemptionRateRecord(uint64(m.BlockHeight))
+	}
+	if m.BlockTime != 0 {
+		n += 1 + sovRedemptionRateRecord(uint64(m.BlockTime))
+	}
+	l = m.RedemptionRate.Size()
+	n += 1 + l + sovRedemptionRateRecord(uint64(l))
+	l = m.UndelegatedBalance.Size()
+	n += 1 + l + sovRedemptionRateRecord(uint64(l))
+	l = m.StakedBalance.Size()
+	n += 1 + l + sovRedemptionRateRecord(uint64(l))
+	l = m.ModuleAccountBalance.Size()
+	n += 1 + l + sovRedemptionRateRecord(uint64(l))
+	l = m.StTokenSupply.Size()
+	n += 1 + l + sovRedemptionRateRecord(uint64(l))
+	return n
+}
+
+func sovRedemptionRateRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRedemptionRateRecord(x uint64) (n int) {
+	return sovRedemptionRateRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RedemptionRateRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRedemptionRateRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionRateRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionRateRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemptionRateRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptionRateRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			m.BlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemptionRateRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptionRateRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegatedBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemptionRateRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptionRateRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UndelegatedBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemptionRateRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptionRateRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakedBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccountBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemptionRateRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptionRateRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ModuleAccountBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StTokenSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptionRateRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemptionRateRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptionRateRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StTokenSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRedemptionRateRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRedemptionRateRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRedemptionRateRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRedemptionRateRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedemptionRateRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedemptionRateRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRedemptionRateRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRedemptionRateRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRedemptionRateRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRedemptionRateRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRedemptionRateRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRedemptionRateRecord = fmt.Errorf("proto: unexpected end of group")
+)
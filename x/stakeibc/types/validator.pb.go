@@ -70,19 +70,104 @@ func (m *ValidatorExchangeRate) GetEpochNumber() uint64 {
 	return 0
 }
 
+// Validator stats queried from the host zone, used to score the validator
+// when the host zone uses the SCORED selection strategy
+type ValidatorMetrics struct {
+	CommissionRate      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+	Tokens              github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
+	Jailed              bool                                   `protobuf:"varint,3,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	MissedBlocksCounter int64                                  `protobuf:"varint,4,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	Tombstoned          bool                                   `protobuf:"varint,5,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	EpochNumber         uint64                                 `protobuf:"varint,6,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// bech32 consensus address on the host, used to match signing info queries
+	ConsensusAddress string `protobuf:"bytes,7,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+}
+
+func (m *ValidatorMetrics) Reset()         { *m = ValidatorMetrics{} }
+func (m *ValidatorMetrics) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMetrics) ProtoMessage()    {}
+func (*ValidatorMetrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d2f32e16bd6ab8f, []int{1}
+}
+func (m *ValidatorMetrics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMetrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMetrics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorMetrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMetrics.Merge(m, src)
+}
+func (m *ValidatorMetrics) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMetrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMetrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorMetrics proto.InternalMessageInfo
+
+func (m *ValidatorMetrics) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *ValidatorMetrics) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+func (m *ValidatorMetrics) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+func (m *ValidatorMetrics) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *ValidatorMetrics) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
 type Validator struct {
 	Name                 string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Address              string                                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	DelegationAmt        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=delegation_amt,json=delegationAmt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegation_amt"`
 	Weight               uint64                                 `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
 	InternalExchangeRate *ValidatorExchangeRate                 `protobuf:"bytes,7,opt,name=internal_exchange_rate,json=internalExchangeRate,proto3" json:"internal_exchange_rate,omitempty"`
+	Metrics              *ValidatorMetrics                      `protobuf:"bytes,8,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	// completion times (unix nanos) of the in-flight unbondings from this
+	// validator, used to stay under the host's unbonding entry limit
+	UnbondingCompletionTimes []uint64 `protobuf:"varint,9,rep,packed,name=unbonding_completion_times,json=unbondingCompletionTimes,proto3" json:"unbonding_completion_times,omitempty"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5d2f32e16bd6ab8f, []int{1}
+	return fileDescriptor_5d2f32e16bd6ab8f, []int{2}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -139,43 +224,69 @@ func (m *Validator) GetInternalExchangeRate() *ValidatorExchangeRate {
 	return nil
 }
 
+func (m *Validator) GetMetrics() *ValidatorMetrics {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
+func (m *Validator) GetUnbondingCompletionTimes() []uint64 {
+	if m != nil {
+		return m.UnbondingCompletionTimes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ValidatorExchangeRate)(nil), "stride.stakeibc.ValidatorExchangeRate")
+	proto.RegisterType((*ValidatorMetrics)(nil), "stride.stakeibc.ValidatorMetrics")
 	proto.RegisterType((*Validator)(nil), "stride.stakeibc.Validator")
 }
 
 func init() { proto.RegisterFile("stride/stakeibc/validator.proto", fileDescriptor_5d2f32e16bd6ab8f) }
 
 var fileDescriptor_5d2f32e16bd6ab8f = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xbf, 0x6f, 0x13, 0x31,
-	0x14, 0x8e, 0xcb, 0x35, 0x6d, 0x5c, 0x7e, 0x54, 0x56, 0xa8, 0x8e, 0x0c, 0x97, 0xd0, 0xa1, 0xca,
-	0x92, 0x3b, 0x08, 0x2b, 0x4b, 0xa3, 0x32, 0x50, 0x55, 0x0c, 0x97, 0xc2, 0x80, 0x90, 0x4e, 0xbe,
-	0xbb, 0xa7, 0x3b, 0x2b, 0x39, 0x3b, 0xb2, 0x5f, 0x4b, 0xd9, 0xf8, 0x13, 0xf8, 0x43, 0x18, 0xbb,
-	0xb2, 0x77, 0xac, 0x3a, 0x21, 0x86, 0x0a, 0x25, 0xff, 0x08, 0xc2, 0xe7, 0x6b, 0x2b, 0xc4, 0xd2,
-	0xc9, 0xf6, 0xe7, 0xcf, 0xef, 0xfb, 0x3e, 0xbf, 0x47, 0xfb, 0x06, 0xb5, 0xc8, 0x21, 0x32, 0xc8,
-	0x67, 0x20, 0xd2, 0x2c, 0x3a, 0xe5, 0x73, 0x91, 0x73, 0x54, 0x3a, 0x5c, 0x68, 0x85, 0x8a, 0x3d,
-	0xa9, 0x09, 0x61, 0x43, 0xe8, 0x3d, 0xcb, 0x94, 0xa9, 0x94, 0x49, 0xec, 0x75, 0x54, 0x1f, 0x6a,
-	0x6e, 0xaf, 0x5b, 0xa8, 0x42, 0xd5, 0xf8, 0xdf, 0x5d, 0x8d, 0xee, 0xfe, 0x20, 0xf4, 0xe9, 0x87,
-	0xa6, 0xea, 0x9b, 0xb3, 0xac, 0xe4, 0xb2, 0x80, 0x98, 0x23, 0xb0, 0xaf, 0x84, 0x06, 0x42, 0x22,
-	0x68, 0xc9, 0xe7, 0x09, 0xaa, 0x19, 0x48, 0x93, 0xa0, 0x4a, 0x4c, 0xc9, 0x35, 0x98, 0x44, 0x73,
-	0x04, 0x9f, 0x0c, 0xc8, 0xb0, 0x33, 0x79, 0x7d, 0x71, 0xdd, 0x6f, 0xfd, 0xba, 0xee, 0xef, 0x15,
-	0x02, 0xcb, 0x93, 0x34, 0xcc, 0x54, 0xe5, 0x94, 0xdd, 0x32, 0x32, 0xf9, 0x2c, 0xc2, 0x2f, 0x0b,
-	0x30, 0xe1, 0x01, 0x64, 0x57, 0xe7, 0x23, 0xea, 0x8c, 0x1d, 0x40, 0x16, 0xf7, 0x1a, 0x8d, 0x63,
-	0x2b, 0x71, 0xac, 0xa6, 0x56, 0xc0, 0x5a, 0x78, 0x4e, 0x1f, 0xc2, 0x42, 0x65, 0x65, 0x22, 0x4f,
-	0xaa, 0x14, 0xb4, 0xbf, 0x36, 0x20, 0x43, 0x2f, 0xde, 0xb2, 0xd8, 0x3b, 0x0b, 0xed, 0x7e, 0x5f,
-	0xa3, 0x9d, 0x1b, 0xff, 0x8c, 0x51, 0x4f, 0xf2, 0xca, 0x19, 0x8b, 0xed, 0x9e, 0x8d, 0xe9, 0x06,
-	0xcf, 0x73, 0x0d, 0xc6, 0xd8, 0xf7, 0x9d, 0x89, 0x7f, 0x75, 0x3e, 0xea, 0x3a, 0x07, 0xfb, 0xf5,
-	0xcd, 0x14, 0xb5, 0x90, 0x45, 0xdc, 0x10, 0xd9, 0x7b, 0xfa, 0x38, 0x87, 0x39, 0x14, 0x1c, 0x85,
-	0x92, 0x09, 0xaf, 0xd0, 0x5f, 0xb7, 0x4f, 0xc3, 0x7b, 0x44, 0x7d, 0x2b, 0x31, 0x7e, 0x74, 0x5b,
-	0x65, 0xbf, 0x42, 0xb6, 0x43, 0xdb, 0x9f, 0x41, 0x14, 0x25, 0xfa, 0x6d, 0x9b, 0xc4, 0x9d, 0xd8,
-	0x27, 0xba, 0x73, 0xf3, 0xd3, 0xe0, 0x7a, 0x50, 0xff, 0xf0, 0xc6, 0x80, 0x0c, 0xb7, 0xc6, 0x7b,
-	0xe1, 0x3f, 0x7d, 0x0e, 0xff, 0xdb, 0xb2, 0xb8, 0xdb, 0x54, 0xb9, 0x8b, 0x1e, 0x7a, 0x9b, 0x0f,
-	0xb6, 0xbd, 0x43, 0x6f, 0xd3, 0xdb, 0x5e, 0x9f, 0x1c, 0x5d, 0x2c, 0x03, 0x72, 0xb9, 0x0c, 0xc8,
-	0xef, 0x65, 0x40, 0xbe, 0xad, 0x82, 0xd6, 0xe5, 0x2a, 0x68, 0xfd, 0x5c, 0x05, 0xad, 0x8f, 0xe3,
-	0x3b, 0x91, 0xa6, 0x56, 0x6d, 0x74, 0xc4, 0x53, 0x13, 0xb9, 0x11, 0x3c, 0x7d, 0xf9, 0x22, 0x3a,
-	0xbb, 0x1d, 0x44, 0x1b, 0x31, 0x6d, 0xdb, 0x19, 0x7a, 0xf5, 0x27, 0x00, 0x00, 0xff, 0xff, 0x06,
-	0xc4, 0x2d, 0x4c, 0xa8, 0x02, 0x00, 0x00,
+	// 617 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0x35, 0xeb, 0x5a, 0x0f, 0xb6, 0x62, 0xb6, 0x29, 0xf4, 0x90, 0x75, 0x3b, 0x4c,
+	0x95, 0x50, 0x53, 0x28, 0x47, 0x76, 0x59, 0x37, 0x90, 0x98, 0x06, 0x87, 0x6c, 0x70, 0x40, 0x48,
+	0x91, 0xe3, 0x3c, 0xa5, 0xa6, 0x8d, 0x5d, 0xc5, 0xee, 0x18, 0x37, 0x3e, 0x02, 0xdf, 0x82, 0x2f,
+	0xb0, 0x2b, 0xf7, 0x1d, 0xa7, 0x9d, 0x10, 0x87, 0x09, 0x6d, 0x9f, 0x03, 0x09, 0xc5, 0x71, 0xb2,
+	0xc1, 0xe0, 0x00, 0xe2, 0x14, 0xfb, 0xbd, 0xe7, 0xff, 0x7b, 0xfe, 0xe5, 0x3d, 0xa3, 0x55, 0xa9,
+	0x52, 0x16, 0x41, 0x4f, 0x2a, 0x32, 0x02, 0x16, 0xd2, 0xde, 0x21, 0x19, 0xb3, 0x88, 0x28, 0x91,
+	0x7a, 0x93, 0x54, 0x28, 0x81, 0x17, 0xf3, 0x00, 0xaf, 0x08, 0x68, 0xdd, 0xa3, 0x42, 0x26, 0x42,
+	0x06, 0xda, 0xdd, 0xcb, 0x37, 0x79, 0x6c, 0x6b, 0x29, 0x16, 0xb1, 0xc8, 0xed, 0xd9, 0x2a, 0xb7,
+	0xae, 0x7f, 0xb6, 0xd0, 0xf2, 0xab, 0x42, 0xf5, 0xc9, 0x11, 0x1d, 0x12, 0x1e, 0x83, 0x4f, 0x14,
+	0xe0, 0x0f, 0x16, 0x72, 0x19, 0x57, 0x90, 0x72, 0x32, 0x0e, 0x94, 0x18, 0x01, 0x97, 0x81, 0x12,
+	0x81, 0x1c, 0x92, 0x14, 0x64, 0x90, 0x12, 0x05, 0x8e, 0xd5, 0xb6, 0x3a, 0x8d, 0xc1, 0xe6, 0xc9,
+	0xf9, 0x6a, 0xe5, 0xeb, 0xf9, 0xea, 0x46, 0xcc, 0xd4, 0x70, 0x1a, 0x7a, 0x54, 0x24, 0x26, 0xb3,
+	0xf9, 0x74, 0x65, 0x34, 0xea, 0xa9, 0xf7, 0x13, 0x90, 0xde, 0x0e, 0xd0, 0xb3, 0xe3, 0x2e, 0x32,
+	0x85, 0xed, 0x00, 0xf5, 0x5b, 0x45, 0x8e, 0x03, 0x9d, 0xe2, 0x40, 0xec, 0xeb, 0x04, 0xba, 0x84,
+	0x35, 0x74, 0x0b, 0x26, 0x82, 0x0e, 0x03, 0x3e, 0x4d, 0x42, 0x48, 0x9d, 0x99, 0xb6, 0xd5, 0xb1,
+	0xfd, 0x79, 0x6d, 0x7b, 0xa1, 0x4d, 0xeb, 0xdf, 0x67, 0x50, 0xb3, 0xac, 0xff, 0x39, 0xa8, 0x94,
+	0x51, 0x89, 0x01, 0x2d, 0x52, 0x91, 0x24, 0x4c, 0x4a, 0x26, 0xf8, 0xff, 0x2b, 0x75, 0xe1, 0x4a,
+	0x54, 0x97, 0xf7, 0x14, 0xd5, 0x72, 0x2e, 0xba, 0xb0, 0xc6, 0xc0, 0xfb, 0x0b, 0xf5, 0x67, 0x5c,
+	0xf9, 0xe6, 0x34, 0x5e, 0x41, 0xb5, 0xb7, 0x84, 0x8d, 0x21, 0x72, 0xaa, 0x6d, 0xab, 0x53, 0xf7,
+	0xcd, 0x0e, 0xf7, 0xd1, 0x72, 0x96, 0x0e, 0xa2, 0x20, 0x1c, 0x0b, 0x3a, 0x92, 0x01, 0x15, 0xd3,
+	0x0c, 0x96, 0x63, 0xb7, 0xad, 0x4e, 0xd5, 0xbf, 0x9b, 0x3b, 0x07, 0xda, 0xb7, 0x9d, 0xbb, 0xb0,
+	0x8b, 0x90, 0x12, 0x49, 0x28, 0x95, 0xe0, 0x10, 0x39, 0xb3, 0x5a, 0xef, 0x9a, 0xe5, 0x06, 0xd2,
+	0xda, 0x0d, 0xa4, 0xf8, 0x3e, 0xba, 0x43, 0x05, 0x97, 0xc0, 0xe5, 0x54, 0x06, 0x24, 0x8a, 0x52,
+	0x90, 0xd2, 0x99, 0xcb, 0x6e, 0xe8, 0x37, 0x4b, 0xc7, 0x56, 0x6e, 0x5f, 0xff, 0x54, 0x45, 0x8d,
+	0x92, 0x3f, 0xc6, 0xc8, 0xe6, 0x24, 0x31, 0xb4, 0x7d, 0xbd, 0xc6, 0x7d, 0x34, 0x57, 0x88, 0xe4,
+	0x98, 0x9c, 0xb3, 0xe3, 0xee, 0x92, 0xc1, 0x6a, 0x64, 0xf6, 0x55, 0xca, 0x78, 0xec, 0x17, 0x81,
+	0xf8, 0x25, 0x5a, 0x88, 0x60, 0x0c, 0x31, 0x51, 0xd9, 0x0f, 0x24, 0x89, 0x72, 0x66, 0xff, 0x89,
+	0xf0, 0xed, 0x2b, 0x95, 0xad, 0x44, 0x65, 0xa0, 0xdf, 0x01, 0x8b, 0x87, 0xca, 0x5c, 0xdb, 0xec,
+	0xf0, 0x1b, 0xb4, 0x52, 0x76, 0x3a, 0x98, 0x19, 0xc8, 0xdb, 0x26, 0xbb, 0xf6, 0x7c, 0x7f, 0xc3,
+	0xfb, 0x65, 0xce, 0xbc, 0xdf, 0x8e, 0x8c, 0xbf, 0x54, 0xa8, 0xfc, 0x34, 0x48, 0x8f, 0xd1, 0x5c,
+	0x92, 0x37, 0xa6, 0x53, 0xd7, 0x72, 0x6b, 0x7f, 0x96, 0x33, 0x1d, 0xec, 0x17, 0x27, 0xf0, 0x26,
+	0x6a, 0x4d, 0x79, 0x28, 0x78, 0xc4, 0x78, 0x1c, 0x50, 0x91, 0x4c, 0xc6, 0xa0, 0x99, 0x28, 0x96,
+	0x80, 0x74, 0x1a, 0xed, 0x6a, 0xc7, 0xf6, 0x9d, 0x32, 0x62, 0xbb, 0x0c, 0x38, 0xc8, 0xfc, 0xbb,
+	0x76, 0xbd, 0xda, 0xb4, 0x77, 0xed, 0xba, 0xdd, 0x9c, 0x1d, 0xec, 0x9d, 0x5c, 0xb8, 0xd6, 0xe9,
+	0x85, 0x6b, 0x7d, 0xbb, 0x70, 0xad, 0x8f, 0x97, 0x6e, 0xe5, 0xf4, 0xd2, 0xad, 0x7c, 0xb9, 0x74,
+	0x2b, 0xaf, 0xfb, 0xd7, 0x68, 0xee, 0xeb, 0xca, 0xba, 0x7b, 0x24, 0x94, 0x3d, 0xf3, 0xfa, 0x1c,
+	0x3e, 0x7c, 0xd0, 0x3b, 0xba, 0x7a, 0x83, 0x34, 0xdd, 0xb0, 0xa6, 0x9f, 0x8f, 0x47, 0x3f, 0x06,
+	0x00, 0x6a, 0x41, 0x4a, 0x9d, 0xa3, 0x04, 0x00, 0x00,
 }
 
 func (m *ValidatorExchangeRate) Marshal() (dAtA []byte, err error) {
@@ -216,6 +327,86 @@ func (m *ValidatorExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorMetrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorMetrics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Validator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -236,6 +427,36 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnbondingCompletionTimes) > 0 {
+		dAtA2 := make([]byte, len(m.UnbondingCompletionTimes)*10)
+		var j1 int
+		for _, num := range m.UnbondingCompletionTimes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintValidator(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Metrics != nil {
+		{
+			size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintValidator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.InternalExchangeRate != nil {
 		{
 			size, err := m.InternalExchangeRate.MarshalToSizedBuffer(dAtA[:i])
@@ -305,6 +526,35 @@ func (m *ValidatorExchangeRate) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorMetrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovValidator(uint64(l))
+	l = m.Tokens.Size()
+	n += 1 + l + sovValidator(uint64(l))
+	if m.Jailed {
+		n += 2
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovValidator(uint64(m.MissedBlocksCounter))
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovValidator(uint64(m.EpochNumber))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	return n
+}
+
 func (m *Validator) Size() (n int) {
 	if m == nil {
 		return 0
@@ -328,6 +578,17 @@ func (m *Validator) Size() (n int) {
 		l = m.InternalExchangeRate.Size()
 		n += 1 + l + sovValidator(uint64(l))
 	}
+	if m.Metrics != nil {
+		l = m.Metrics.Size()
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	if len(m.UnbondingCompletionTimes) > 0 {
+		l = 0
+		for _, e := range m.UnbondingCompletionTimes {
+			l += sovValidator(uint64(e))
+		}
+		n += 1 + sovValidator(uint64(l)) + l
+	}
 	return n
 }
 
@@ -440,6 +701,234 @@ func (m *ValidatorExchangeRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorMetrics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorMetrics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Validator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -622,6 +1111,118 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metrics == nil {
+				m.Metrics = &ValidatorMetrics{}
+			}
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnbondingCompletionTimes = append(m.UnbondingCompletionTimes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowValidator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthValidator
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthValidator
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnbondingCompletionTimes) == 0 {
+					m.UnbondingCompletionTimes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowValidator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnbondingCompletionTimes = append(m.UnbondingCompletionTimes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingCompletionTimes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipValidator(dAtA[iNdEx:])